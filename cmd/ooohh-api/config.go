@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// redacted replaces the values of secret config fields when they're shown.
+const redacted = "[REDACTED]"
+
+// configHandler returns a handler that responds with the given config struct
+// as JSON, with secrets redacted. Secrets are the fields tagged `noprint`.
+func configHandler(cfg interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := json.MarshalIndent(redactConfig(cfg), "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b) //nolint:errcheck
+	})
+}
+
+// redactConfig returns a copy of the config struct, with the values of any set
+// fields tagged `noprint` redacted. Nested structs are redacted too.
+func redactConfig(cfg interface{}) interface{} {
+	v := reflect.New(reflect.TypeOf(cfg)).Elem()
+	v.Set(reflect.ValueOf(cfg))
+
+	redact(v)
+
+	return v.Interface()
+}
+
+// redact redacts the struct value in place.
+func redact(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		switch {
+		case f.Kind() == reflect.Struct:
+			redact(f)
+		case !f.IsZero() && noprint(t.Field(i).Tag):
+			redactValue(f)
+		}
+	}
+}
+
+// redactValue redacts the set value of a secret field. Strings are replaced,
+// each element of a slice is redacted in a new slice, so the config's own
+// slice isn't changed, and values of other kinds are cleared, as they can't
+// hold the redacted marker.
+func redactValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(redacted)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if !v.Index(i).IsZero() {
+				redactValue(s.Index(i))
+			}
+		}
+		v.Set(s)
+	default:
+		v.Set(reflect.Zero(v.Type()))
+	}
+}
+
+// noprint reports whether the conf tag has the noprint option.
+func noprint(tag reflect.StructTag) bool {
+	for _, opt := range strings.Split(tag.Get("conf"), ",") {
+		if opt == "noprint" {
+			return true
+		}
+	}
+	return false
+}
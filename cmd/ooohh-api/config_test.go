@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestConfigHandler(t *testing.T) {
+
+	is := is.New(t)
+
+	var cfg struct {
+		Web struct {
+			APIHost string        `conf:"default:0.0.0.0:8080"`
+			Timeout time.Duration `conf:"default:5s"`
+		}
+		Admin struct {
+			Token string `conf:"noprint"`
+		}
+		Slack struct {
+			SigningSecret string `conf:"noprint"`
+		}
+		Salt     string   `conf:"default:salt,noprint"`
+		OldSalts []string `conf:"noprint"`
+		Secrets  struct {
+			Numbers []int `conf:"noprint"`
+			Port    int   `conf:"noprint"`
+		}
+	}
+	cfg.Web.APIHost = "0.0.0.0:8080"
+	cfg.Web.Timeout = 5 * time.Second
+	cfg.Admin.Token = "admin-token"
+	cfg.Salt = "pepper"
+	cfg.OldSalts = []string{"old-pepper", "older-pepper"}
+	cfg.Secrets.Numbers = []int{1, 2}
+	cfg.Secrets.Port = 8080
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	r, err := http.NewRequest("GET", "/debug/config", nil)
+	is.NoErr(err)
+
+	configHandler(cfg).ServeHTTP(rr, r)
+
+	is.Equal(rr.Code, http.StatusOK)                              // status code is correct.
+	is.Equal(rr.Header().Get("Content-Type"), "application/json") // response is json.
+
+	var body map[string]interface{}
+	is.NoErr(json.Unmarshal(rr.Body.Bytes(), &body)) // body is json.
+
+	field := func(section, name string) interface{} {
+		return body[section].(map[string]interface{})[name]
+	}
+
+	is.Equal(field("Web", "APIHost"), "0.0.0.0:8080")              // non-secret field is shown.
+	is.Equal(field("Web", "Timeout"), float64(5*time.Second))      // non-string field is shown.
+	is.Equal(field("Admin", "Token"), redacted)                    // secret field is redacted.
+	is.Equal(field("Slack", "SigningSecret"), "")                  // unset secret field is shown as unset.
+	is.Equal(body["Salt"], redacted)                               // top-level secret field is redacted.
+	is.Equal(body["OldSalts"], []interface{}{redacted, redacted})  // each secret in a list is redacted.
+	is.Equal(field("Secrets", "Numbers"), []interface{}{0.0, 0.0}) // secret non-string list values are cleared.
+	is.Equal(field("Secrets", "Port"), 0.0)                        // secret non-string field is cleared.
+
+	is.Equal(cfg.Admin.Token, "admin-token")                       // config itself isn't changed.
+	is.Equal(cfg.OldSalts, []string{"old-pepper", "older-pepper"}) // config lists aren't changed.
+}
@@ -11,16 +11,19 @@ import (
 
 	"github.com/ardanlabs/conf"
 	"github.com/blendle/zapdriver"
-	"github.com/boltdb/bolt"
 	kitapi "github.com/dlmiddlecote/kit/api"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 
+	"github.com/dlmiddlecote/ooohh"
 	"github.com/dlmiddlecote/ooohh/pkg/api"
 	"github.com/dlmiddlecote/ooohh/pkg/service"
 	"github.com/dlmiddlecote/ooohh/pkg/slack"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
 	"github.com/dlmiddlecote/ooohh/pkg/ui"
+	"github.com/dlmiddlecote/ooohh/pkg/webhook"
 )
 
 const (
@@ -48,11 +51,136 @@ func run() error {
 			DebugHost       string        `conf:"default:0.0.0.0:8090"`
 			EnableDebug     bool          `conf:"default:true"`
 			ShutdownTimeout time.Duration `conf:"default:5s"`
+			RequestTimeout  time.Duration `conf:"default:5s"`
+			// TimeFormat is the format of times in API responses, one of
+			// rfc3339nano, rfc3339 or unix.
+			TimeFormat string `conf:"default:rfc3339nano"`
+			// JSONP enables the legacy `callback` parameter on the board
+			// endpoint, for old embeds that can only consume JSONP.
+			JSONP bool `conf:"default:false"`
+			// PrivateBoards requires the board token, as a bearer token, to read
+			// a board from the API. Board pages aren't shown, as they can't be
+			// sent the token. By default, boards are readable by anyone.
+			PrivateBoards bool `conf:"default:false"`
+			// MaskedBoards hides dial values, and IDs, when a board is read
+			// without its token, so boards can be shared without their values.
+			// Board pages are always masked, as they can't be sent the token.
+			MaskedBoards bool `conf:"default:false"`
+			// CORSOrigins are the origins browsers may call the API from, or
+			// "*" for any origin. Cross-origin calls aren't allowed if empty.
+			CORSOrigins []string
 		}
 		DB struct {
 			Path string `conf:"default:/tmp/ooohh.db"`
+			// Unsafe skips syncing writes to disk. It is fast, but data can be
+			// lost on a crash, so only enable it where durability doesn't matter.
+			Unsafe bool `conf:"default:false"`
+			// ReplicaPath, if set, is where a read-only copy of the db is kept,
+			// that dials and boards are read from. It is refreshed from the db
+			// every ReplicaRefresh, so reads can be stale by up to that long.
+			ReplicaPath    string
+			ReplicaRefresh time.Duration `conf:"default:10s"`
+			// Format is how dials and boards are stored, either msgpack or json.
+			// Records stored in either format can be read, whichever is set.
+			Format string `conf:"default:msgpack"`
+			// BucketPrefix, if set, prefixes the names of the db's buckets, so
+			// that multiple instances can share a db without seeing each
+			// other's data.
+			BucketPrefix string
 		}
-		Salt string `conf:"default:salt"`
+		UI struct {
+			Title string `conf:"default:ooohh"`
+		}
+		Display struct {
+			// Precision is the number of decimal places dial values are shown
+			// with, in the UI and Slack.
+			Precision int `conf:"default:1"`
+			// StaleAfter is how long dials go without being updated before
+			// they are marked stale on boards.
+			StaleAfter time.Duration `conf:"default:24h"`
+		}
+		Webhooks struct {
+			// Interval is how often boards are checked for changes to push to
+			// their webhooks. Changes made in between are pushed together.
+			Interval time.Duration `conf:"default:10s"`
+			// MaxFailures is the number of failed pushes in a row after which a
+			// webhook is disabled.
+			MaxFailures int `conf:"default:5"`
+			// AllowPrivate allows boards to be pushed to loopback and private
+			// addresses. Only for trusted deployments, as anyone who can add a
+			// webhook can then make requests to services on the local network.
+			AllowPrivate bool `conf:"default:false"`
+		}
+		Metrics struct {
+			// CountInterval is how often the dials and boards are counted.
+			CountInterval time.Duration `conf:"default:1m"`
+		}
+		Values struct {
+			// Min and Max are the range, inclusive of both ends, that dial
+			// values must be within.
+			Min float64 `conf:"default:0"`
+			Max float64 `conf:"default:100"`
+		}
+		Lockout struct {
+			// Attempts is the number of bad dial token attempts within the window
+			// that lock the dial out of updates, and the number of board token
+			// lookups matching no boards that lock a client out of listing
+			// boards. Zero disables lockouts.
+			Attempts int           `conf:"default:10"`
+			Window   time.Duration `conf:"default:15m"`
+		}
+		Decay struct {
+			// PerHour is how much dial values fall by per hour since they were last
+			// updated, when retrieved. Zero disables decay.
+			PerHour float64 `conf:"default:0"`
+		}
+		Tokens struct {
+			// Generate allows dials and boards to be created without a token, in
+			// which case a random one is generated and returned once on create.
+			Generate bool `conf:"default:false"`
+		}
+		Admin struct {
+			// Token enables the admin endpoints, if set. They are disabled
+			// otherwise.
+			Token string `conf:"noprint"`
+		}
+		Slack struct {
+			// SigningSecret verifies that Slack requests were sent by Slack.
+			// Slack requests are rejected if it isn't set, unless Insecure is.
+			SigningSecret string        `conf:"noprint"`
+			Tolerance     time.Duration `conf:"default:5m"`
+			// Insecure accepts Slack requests without verifying them, when no
+			// signing secret is set. Only for local development, as anyone can
+			// then send commands as any Slack user.
+			Insecure bool `conf:"default:false"`
+			// MaxBodySize is the largest request body, in bytes, read from Slack.
+			MaxBodySize int64 `conf:"default:65536"`
+			// Teams are the IDs of the only workspaces allowed to use the Slack
+			// command. All workspaces are allowed if empty.
+			Teams []string
+			// Messages shown when a dial is set to exactly 0 or 100.
+			Messages struct {
+				Zero    string
+				Hundred string
+				// Bands replace the default messages shown for values above
+				// each threshold, e.g. `75:Check in;50:Take a break`, highest
+				// first. Default is shown for values below every band.
+				Bands   []string
+				Default string
+			}
+			// The daily summary is posted if a board is configured.
+			Summary struct {
+				Board   string
+				Channel string
+				Token   string        `conf:"noprint"`
+				At      time.Duration `conf:"default:9h"`
+			}
+		}
+		Salt string `conf:"default:salt,noprint"`
+		// OldSalts are salts that Slack dial tokens were previously generated
+		// with. Dials with these tokens are upgraded to the current salt. They
+		// are only needed for users stored before their tokens were kept.
+		OldSalts []string `conf:"noprint"`
 	}
 
 	// Parse configuration, showing usage if needed.
@@ -69,6 +197,16 @@ func run() error {
 		return errors.Wrap(err, "parsing config")
 	}
 
+	timeFormat := api.TimeFormat(cfg.Web.TimeFormat)
+	if !timeFormat.Valid() {
+		return errors.Errorf("invalid time format %q", cfg.Web.TimeFormat)
+	}
+
+	codec, ok := service.CodecByName(cfg.DB.Format)
+	if !ok {
+		return errors.Errorf("invalid db format %q", cfg.DB.Format)
+	}
+
 	//
 	// Logging
 	//
@@ -91,12 +229,24 @@ func run() error {
 	// DB
 	//
 
-	db, err := bolt.Open(cfg.DB.Path, 0600, nil)
+	db, err := service.OpenDB(cfg.DB.Path, service.DBOptions{Unsafe: cfg.DB.Unsafe})
 	if err != nil {
 		return errors.Wrap(err, "opening db")
 	}
+	if cfg.DB.Unsafe {
+		logger.Warn("DB writes are not synced to disk, data may be lost")
+	}
 	defer db.Close()
 
+	var replica *store.Replica
+	if cfg.DB.ReplicaPath != "" {
+		replica, err = store.NewReplica(db, cfg.DB.ReplicaPath)
+		if err != nil {
+			return errors.Wrap(err, "opening db replica")
+		}
+		defer replica.Close()
+	}
+
 	//
 	// Debug listener
 	//
@@ -106,6 +256,9 @@ func run() error {
 		// Expose Prometheus metrics at '/metrics'.
 		http.Handle("/metrics", promhttp.Handler())
 
+		// Expose the config, with secrets redacted, at '/debug/config'.
+		http.Handle("/debug/config", configHandler(cfg))
+
 		// Start the debug listener in the background, we don't gracefully shut this down.
 		go func() {
 			logger.Infow("Debug listener starting", "addr", cfg.Web.DebugHost)
@@ -119,35 +272,240 @@ func run() error {
 	//
 
 	var app http.Server
+	var summarizer *slack.Summarizer
+	var dispatcher *webhook.Dispatcher
+	var counts *service.CountMetrics
+	var startup func(ctx context.Context) error
+	readiness := &api.Readiness{}
 	{
 		now := func() time.Time {
 			return time.Now()
 		}
 
+		serviceOpts := []service.Option{
+			service.WithLockout(cfg.Lockout.Attempts, cfg.Lockout.Window),
+			service.WithDecay(cfg.Decay.PerHour),
+			service.WithValueBounds(cfg.Values.Min, cfg.Values.Max),
+			service.WithCodec(codec),
+		}
+		if replica != nil {
+			serviceOpts = append(serviceOpts, service.WithReadStore(replica))
+		}
+		if cfg.DB.BucketPrefix != "" {
+			serviceOpts = append(serviceOpts, service.WithBucketPrefix(cfg.DB.BucketPrefix))
+		}
+		if cfg.Tokens.Generate {
+			serviceOpts = append(serviceOpts, service.WithGeneratedTokens())
+		}
+
+		// The webhooks and count metrics use the same bucket prefix as the service.
+		var st store.Store = store.NewBolt(db)
+		if cfg.DB.BucketPrefix != "" {
+			st = store.NewPrefixed(st, cfg.DB.BucketPrefix)
+		}
+
 		// Initialise our ooohh service. This exposes all our desired interactions.
-		s, err := service.NewService(db, logger.Named("service"), now)
+		bs, err := service.NewService(store.NewBolt(db), logger.Named("service"), now, serviceOpts...)
 		if err != nil {
 			return errors.Wrap(err, "creating service")
 		}
 
+		// Record metrics of the calls made to the service, and of how many
+		// dials and boards there are.
+		s, err := service.NewMetricsService(bs, prometheus.DefaultRegisterer)
+		if err != nil {
+			return errors.Wrap(err, "creating metrics service")
+		}
+		counts, err = service.NewCountMetrics(logger.Named("metrics"), st, prometheus.DefaultRegisterer)
+		if err != nil {
+			return errors.Wrap(err, "creating count metrics")
+		}
+
+		// Initialise our webhook service, and the dispatcher that pushes boards
+		// to their webhooks.
+		var (
+			webhookOpts    []webhook.Option
+			dispatcherOpts = []webhook.DispatcherOption{
+				webhook.WithMaxFailures(cfg.Webhooks.MaxFailures),
+			}
+		)
+		if cfg.Webhooks.AllowPrivate {
+			logger.Warnw("Webhooks can be pushed to private addresses")
+		} else {
+			webhookOpts = append(webhookOpts, webhook.WithoutPrivateHosts())
+			dispatcherOpts = append(dispatcherOpts, webhook.WithoutPrivateAddresses())
+		}
+		ws, err := webhook.NewService(st, s, now, webhookOpts...)
+		if err != nil {
+			return errors.Wrap(err, "creating webhook service")
+		}
+		dispatcher = webhook.NewDispatcher(logger.Named("webhooks"), st, s, dispatcherOpts...)
+
 		// Initialise our slack service.
-		ss, err := slack.NewService(logger.Named("slack"), db, s, cfg.Salt)
+		ss, err := slack.NewService(logger.Named("slack"), db, s, cfg.Salt,
+			slack.WithOldSalts(cfg.OldSalts...),
+			slack.WithBucketPrefix(cfg.DB.BucketPrefix),
+		)
 		if err != nil {
 			return errors.Wrap(err, "creating slack service")
 		}
 
 		// Initialise our UI component.
-		ui := ui.NewUI(s)
+		uiOpts := []ui.Option{
+			ui.WithTitle(cfg.UI.Title),
+			ui.WithPrecision(cfg.Display.Precision),
+			ui.WithNow(now),
+			ui.WithStaleAfter(cfg.Display.StaleAfter),
+			ui.WithValueBounds(cfg.Values.Min, cfg.Values.Max),
+		}
+		if cfg.Web.PrivateBoards {
+			uiOpts = append(uiOpts, ui.WithPrivateBoards())
+		}
+		if cfg.Web.MaskedBoards {
+			uiOpts = append(uiOpts, ui.WithMaskedBoards())
+		}
+		ui := ui.NewUI(s, uiOpts...)
+
+		// Initialise our daily Slack summary, if configured.
+		if cfg.Slack.Summary.Board != "" {
+			summarizer = slack.NewSummarizer(logger.Named("summary"), s, now,
+				ooohh.BoardID(cfg.Slack.Summary.Board),
+				cfg.Slack.Summary.Channel,
+				cfg.Slack.Summary.Token,
+				cfg.Slack.Summary.At,
+				slack.WithSummaryPrecision(cfg.Display.Precision),
+			)
+		}
+
+		// Configure the messages shown when a dial is set from Slack.
+		valueMessages := api.DefaultValueMessages()
+		valueMessages.Zero = cfg.Slack.Messages.Zero
+		valueMessages.Hundred = cfg.Slack.Messages.Hundred
+		if len(cfg.Slack.Messages.Bands) > 0 {
+			if valueMessages.Bands, err = api.ParseValueBands(cfg.Slack.Messages.Bands); err != nil {
+				return errors.Wrap(err, "parsing slack message bands")
+			}
+		}
+		if cfg.Slack.Messages.Default != "" {
+			valueMessages.Default = cfg.Slack.Messages.Default
+		}
+		if err := valueMessages.Validate(); err != nil {
+			return errors.Wrap(err, "validating slack messages")
+		}
 
 		// Create our API. This is an implementation of the kit API.
 		// It has a dependency on the ooohh service, as it provides this service as a
 		// HTTP API.
-		oApi := api.NewAPI(logger.Named("api"), s, ss, ui)
+		apiOpts := []api.Option{
+			api.WithNow(now),
+			api.WithSlackSigningSecret(cfg.Slack.SigningSecret),
+			api.WithSlackTolerance(cfg.Slack.Tolerance),
+			api.WithSlackMaxBodySize(cfg.Slack.MaxBodySize),
+			api.WithSlackTeams(cfg.Slack.Teams...),
+			api.WithAdminToken(cfg.Admin.Token),
+			api.WithValueMessages(valueMessages),
+			api.WithTimeFormat(timeFormat),
+			api.WithPrecision(cfg.Display.Precision),
+			api.WithStaleAfter(cfg.Display.StaleAfter),
+			api.WithValueBounds(cfg.Values.Min, cfg.Values.Max),
+			api.WithReadiness(readiness),
+			api.WithHealthCheck(bs.Ping),
+			api.WithListLockout(cfg.Lockout.Attempts, cfg.Lockout.Window),
+			api.WithWebhooks(ws),
+		}
+		if cfg.Web.JSONP {
+			apiOpts = append(apiOpts, api.WithJSONP())
+		}
+		if cfg.Web.PrivateBoards {
+			apiOpts = append(apiOpts, api.WithPrivateBoards())
+		}
+		if cfg.Web.MaskedBoards {
+			apiOpts = append(apiOpts, api.WithMaskedBoards())
+		}
+		if cfg.Tokens.Generate {
+			apiOpts = append(apiOpts, api.WithGeneratedTokens())
+		}
+		if cfg.Slack.SigningSecret == "" {
+			if cfg.Slack.Insecure {
+				logger.Warnw("Slack requests are not verified, anyone can send commands as any Slack user")
+				apiOpts = append(apiOpts, api.WithInsecureSlackRequests())
+			} else {
+				logger.Warnw("Slack requests will be rejected, as no signing secret is set")
+			}
+		}
+		apiOpts = append(apiOpts, api.WithRequestTimeout(cfg.Web.RequestTimeout))
+		oApi := api.NewAPI(logger.Named("api"), s, ss, ui, apiOpts...)
+
+		// Check the service works before reporting ready.
+		startup = bs.Ping
 
 		// Create our http.Server, exposing the account API on the given host.
 		app = kitapi.NewServer(cfg.Web.APIHost, logger.Named("http"), oApi)
+
+		// Serve trailing slash variants of paths as their canonical form.
+		app.Handler = api.StripTrailingSlash(app.Handler)
+
+		// Respond to unsupported methods with a problem, like other errors.
+		app.Handler = api.MethodNotAllowed(app.Handler)
+
+		// Allow browsers to call the API from other origins, if configured.
+		if len(cfg.Web.CORSOrigins) > 0 {
+			app.Handler = api.CORS(cfg.Web.CORSOrigins...)(app.Handler)
+		}
 	}
 
+	// Start the daily summary in the background, stopping it on shutdown.
+	summaryCtx, stopSummary := context.WithCancel(context.Background())
+	summaryDone := make(chan struct{})
+	go func() {
+		defer close(summaryDone)
+		if summarizer != nil {
+			summarizer.Run(summaryCtx)
+		}
+	}()
+	defer func() {
+		stopSummary()
+		<-summaryDone
+	}()
+
+	// Push boards to their webhooks in the background, stopping on shutdown.
+	webhooksCtx, stopWebhooks := context.WithCancel(context.Background())
+	webhooksDone := make(chan struct{})
+	go func() {
+		defer close(webhooksDone)
+		dispatcher.Run(webhooksCtx, cfg.Webhooks.Interval)
+	}()
+	defer func() {
+		stopWebhooks()
+		<-webhooksDone
+	}()
+
+	// Count dials and boards in the background, stopping on shutdown.
+	countsCtx, stopCounts := context.WithCancel(context.Background())
+	countsDone := make(chan struct{})
+	go func() {
+		defer close(countsDone)
+		counts.Run(countsCtx, cfg.Metrics.CountInterval)
+	}()
+	defer func() {
+		stopCounts()
+		<-countsDone
+	}()
+
+	// Refresh the db replica in the background, stopping it on shutdown.
+	replicaCtx, stopReplica := context.WithCancel(context.Background())
+	replicaDone := make(chan struct{})
+	go func() {
+		defer close(replicaDone)
+		if replica != nil {
+			refreshReplica(replicaCtx, logger.Named("replica"), replica, cfg.DB.ReplicaRefresh)
+		}
+	}()
+	defer func() {
+		stopReplica()
+		<-replicaDone
+	}()
+
 	// Make a channel to listen for an interrupt or terminate signal from the OS.
 	// Use a buffered channel because the signal package requires it.
 	shutdown := make(chan os.Signal, 1)
@@ -163,6 +521,14 @@ func run() error {
 		serverErrors <- app.ListenAndServe()
 	}()
 
+	// Report ready once the startup checks pass. Until then, the API is
+	// live but not ready, so isn't sent traffic.
+	if err := startup(context.Background()); err != nil {
+		return errors.Wrap(err, "startup check")
+	}
+	readiness.SetReady()
+	logger.Info("API ready")
+
 	//
 	// Shutdown
 	//
@@ -193,3 +559,20 @@ func run() error {
 
 	return nil
 }
+
+// refreshReplica refreshes the replica every interval, until the context is done.
+func refreshReplica(ctx context.Context, logger *zap.SugaredLogger, replica *store.Replica, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := replica.Refresh(); err != nil {
+				logger.Errorw("Could not refresh replica", "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/backupcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/boardcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/createcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/democmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/querycmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/setcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/verifycmd"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/wtfcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/client"
+)
+
+// buildVersion is the git version of this program. It is set using build flags.
+var buildVersion = "dev"
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	rootCommand, rootConfig := newCommand(os.Stdout)
+
+	// Parse the commandline, selecting the command to run.
+	if err := rootCommand.Parse(args); err != nil {
+		return err
+	}
+
+	// Now flags are parsed, create the client and load any cached dial details.
+	c := client.NewClient(rootConfig.URL, client.WithUserAgent(client.UserAgent(buildVersion)))
+	defer c.Close() //nolint:errcheck
+
+	rootConfig.Client = c
+	if err := rootConfig.LoadCache(); err != nil {
+		return err
+	}
+
+	// Stop long running commands, like board tail, when interrupted. Another
+	// interrupt exits immediately.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		signal.Stop(interrupt)
+		cancel()
+	}()
+
+	return rootCommand.Run(ctx)
+}
+
+// newCommand builds the command tree, writing the output of commands to out.
+// Each subcommand shares the returned root config.
+func newCommand(out io.Writer) (*cli.Command, *rootcmd.Config) {
+	var (
+		rootCommand, rootConfig = rootcmd.New()
+		createCommand           = createcmd.New(rootConfig, out)
+		wtfCommand              = wtfcmd.New(rootConfig, out)
+		setCommand              = setcmd.New(rootConfig, out)
+		verifyCommand           = verifycmd.New(rootConfig, out)
+		queryCommand            = querycmd.New(rootConfig, out)
+		boardCommand            = boardcmd.New(rootConfig, out)
+		demoCommand             = democmd.New(rootConfig, out)
+		exportCommand           = backupcmd.NewExport(rootConfig, out)
+		importCommand           = backupcmd.NewImport(rootConfig, out)
+	)
+
+	rootCommand.Subcommands = []*cli.Command{
+		createCommand,
+		wtfCommand,
+		setCommand,
+		verifyCommand,
+		queryCommand,
+		boardCommand,
+		demoCommand,
+		exportCommand,
+		importCommand,
+	}
+
+	return rootCommand, rootConfig
+}
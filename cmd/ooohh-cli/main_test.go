@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+func TestCommandTree(t *testing.T) {
+
+	is := is.New(t)
+
+	root, _ := newCommand(&bytes.Buffer{})
+
+	var names []string
+	for _, sub := range root.Subcommands {
+		names = append(names, sub.Name)
+	}
+
+	is.Equal(names, []string{"create", "wtf", "set", "verify", "?", "board", "demo", "export", "import"}) // all subcommands are registered.
+}
+
+func TestSubcommandsShareRootConfig(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "query",
+		args: []string{"?"},
+	}, {
+		msg:  "set",
+		args: []string{"set", "dial-id", "token"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			dir, err := ioutil.TempDir("", "ooohh-cli-")
+			is.NoErr(err)           // temporary directory is created.
+			defer os.RemoveAll(dir) //nolint:errcheck
+
+			// Cache a dial, as a previous invocation would have.
+			path := filepath.Join(dir, "cache.json")
+			err = ioutil.WriteFile(path, []byte(`{"dial_id": "cached-id", "token": "cached-token"}`), 0600)
+			is.NoErr(err) // cache is written.
+
+			root, cfg := newCommand(&bytes.Buffer{})
+
+			// Pass the root flags after the subcommand.
+			args := append(tt.args[:1:1], "--url", "http://example.com", "--cache", path)
+			err = root.Parse(append(args, tt.args[1:]...))
+			is.NoErr(err) // command line parses.
+
+			is.Equal(cfg.URL, "http://example.com") // url flag reaches the root config.
+			is.Equal(cfg.CachePath, path)           // cache flag reaches the root config.
+
+			is.NoErr(cfg.LoadCache())                             // cache loads.
+			is.Equal(cfg.Cache.DialID, ooohh.DialID("cached-id")) // cached dial is loaded.
+			is.Equal(cfg.Cache.Token, "cached-token")             // cached token is loaded.
+		})
+	}
+}
@@ -9,6 +9,7 @@ require (
 	github.com/boltdb/bolt v1.3.1
 	github.com/dlmiddlecote/kit v0.3.1
 	github.com/julienschmidt/httprouter v1.3.0
+	github.com/lib/pq v1.7.0
 	github.com/markbates/pkger v0.17.0
 	github.com/matryer/is v1.3.0
 	github.com/pkg/errors v0.9.1
@@ -2,7 +2,12 @@ package ooohh
 
 import (
 	"context"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // DialID represents the unique identifier of a dial.
@@ -13,22 +18,224 @@ type BoardID string
 
 // Dial represents an ooohh, wtf level for a user.
 // The token is defined by the user, and is used for some simple authorization.
+// It is stored hashed, so is checked against tokens with TokenMatches.
+// The color is optional, and overrides the color the dial is displayed with.
+// The group is optional, and is used to group dials together on boards.
 type Dial struct {
 	ID        DialID    `json:"id"`
 	Token     string    `json:"-"`
 	Name      string    `json:"name"`
 	Value     float64   `json:"value"`
+	Color     string    `json:"color,omitempty"`
+	Group     string    `json:"group,omitempty"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// DefaultStaleAfter is how long dials go without being updated before they
+// are shown as stale, unless configured otherwise.
+const DefaultStaleAfter = 24 * time.Hour
+
+// Stale reports whether the dial hasn't been updated for longer than after, as
+// of now. Dials without an update time, and all dials if after isn't positive,
+// are never stale.
+func (d Dial) Stale(now time.Time, after time.Duration) bool {
+	if after <= 0 || d.UpdatedAt.IsZero() {
+		return false
+	}
+
+	return now.Sub(d.UpdatedAt) > after
+}
+
+// DialReading is a value a dial was set to, kept in the dial's history.
+// The note is optional, and records why the value was set.
+type DialReading struct {
+	Value float64   `json:"value"`
+	Note  string    `json:"note,omitempty"`
+	At    time.Time `json:"at"`
+}
+
+// NewDial is a dial to create, with a name and the token it is updated with.
+// The value is optional, and is recorded in the dial's history when given.
+type NewDial struct {
+	Name  string
+	Token string
+	Value *float64
+	Color string
+	Group string
+}
+
+// DialUpdate is a change to a dial. Only the fields that are set are changed.
+// At most one of Value, which sets the dial's value, and Delta, which adds to
+// it, is set. The note is kept with a new value in the dial's history. Token
+// replaces the token the dial is updated with.
+type DialUpdate struct {
+	Name  *string
+	Color *string
+	Group *string
+	Value *float64
+	Delta *float64
+	Note  string
+	Token *string
+}
+
+// Valued reports whether the update changes the dial's value.
+func (u DialUpdate) Valued() bool {
+	return u.Value != nil || u.Delta != nil
+}
+
+// ValueOnly reports whether the update only changes the dial's value.
+func (u DialUpdate) ValueOnly() bool {
+	return u.Name == nil && u.Color == nil && u.Group == nil && u.Token == nil
+}
+
+// DefaultGroup is the name of the group that dials without a group are shown in.
+const DefaultGroup = "Ungrouped"
+
+// DialGroup is a named group of the dials on a board.
+type DialGroup struct {
+	Name  string `json:"name"`
+	Dials []Dial `json:"dials"`
+}
+
+// DialPage is a page of dials, in ID order. Next is the ID to get the
+// following page after, and is empty on the last page. Total is the number
+// of dials across all pages.
+type DialPage struct {
+	Dials []Dial
+	Next  DialID
+	Total int
+}
+
+// DefaultPrecision is the number of decimal places values are displayed with,
+// unless configured otherwise.
+const DefaultPrecision = 1
+
+// FormatValue formats the value for display, with the given number of decimal places.
+func FormatValue(value float64, precision int) string {
+	return strconv.FormatFloat(value, 'f', precision, 64)
+}
+
+// RoundValue rounds the value to the given number of decimal places.
+func RoundValue(value float64, precision int) float64 {
+	p := math.Pow10(precision)
+	return math.Round(value*p) / p
+}
+
+// colorRegexp matches hex colors, e.g. #fff or #00ff00.
+var colorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// ValidColor reports whether the given color is a valid dial color.
+// The empty color is valid, and means the dial has no color override.
+func ValidColor(color string) bool {
+	return color == "" || colorRegexp.MatchString(color)
+}
+
 // Board represents a collection of Dials to be displayed together.
 // The token is defined by the user, and is used for some simple authorization.
+// It is stored hashed, so is checked against tokens with TokenMatches.
+// The code is a short, human-typeable alias of the ID.
+// The description is optional, and gives context to the board.
 type Board struct {
-	ID        BoardID   `json:"id"`
-	Token     string    `json:"-"`
-	Name      string    `json:"name"`
-	Dials     []Dial    `json:"dials"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          BoardID   `json:"id"`
+	Code        string    `json:"code"`
+	Token       string    `json:"-"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	OwnsDials   bool      `json:"owns_dials,omitempty"`
+	Frozen      bool      `json:"frozen,omitempty"`
+	Dials       []Dial    `json:"dials"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// BoardUpdate is a change to a board. Only the fields that are set are
+// changed. Setting Frozen freezes or unfreezes the board; a board being
+// unfrozen has its dials changed after it is unfrozen, and a board being
+// frozen after its dials are changed.
+type BoardUpdate struct {
+	Name        *string
+	Description *string
+	Dials       *[]DialID
+	OwnsDials   *bool
+	Frozen      *bool
+}
+
+// MaxDescriptionLength is the most characters a board description can have.
+const MaxDescriptionLength = 280
+
+// ValidDescription reports whether the given board description is valid.
+// Surrounding whitespace doesn't count towards its length.
+func ValidDescription(description string) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(description)) <= MaxDescriptionLength
+}
+
+// Average returns the average value of the board's dials, or 0 if it has none.
+func (b Board) Average() float64 {
+	if len(b.Dials) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, d := range b.Dials {
+		total += d.Value
+	}
+
+	return total / float64(len(b.Dials))
+}
+
+// Mask zeroes the values of the board's dials, and clears their IDs, so the
+// board can be shown without its dials' values, or a way to read them.
+func (b *Board) Mask() {
+	for i := range b.Dials {
+		b.Dials[i].ID = ""
+		b.Dials[i].Value = 0
+	}
+}
+
+// Groups returns the board's dials grouped by their group, keeping the order the
+// groups and dials are first seen in. Dials without a group are in the
+// DefaultGroup, which is last.
+func (b Board) Groups() []DialGroup {
+	groups := make([]DialGroup, 0)
+	index := make(map[string]int)
+
+	var ungrouped []Dial
+	for _, d := range b.Dials {
+		if d.Group == "" {
+			ungrouped = append(ungrouped, d)
+			continue
+		}
+
+		i, ok := index[d.Group]
+		if !ok {
+			i = len(groups)
+			index[d.Group] = i
+			groups = append(groups, DialGroup{Name: d.Group})
+		}
+		groups[i].Dials = append(groups[i].Dials, d)
+	}
+
+	if len(ungrouped) > 0 {
+		groups = append(groups, DialGroup{Name: DefaultGroup, Dials: ungrouped})
+	}
+
+	return groups
+}
+
+// primaryReadsKey is the context key primary reads are requested under.
+type primaryReadsKey struct{}
+
+// WithPrimaryReads returns a copy of the context that services read with from
+// the store they write to, rather than a read replica, so that the reads see
+// the writes made before them.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsKey{}, true)
+}
+
+// PrimaryReads reports whether reads with the context should be from the store
+// that is written to.
+func PrimaryReads(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryReadsKey{}).(bool)
+	return primary
 }
 
 // Service represents a service for managing dials and boards
@@ -36,20 +243,123 @@ type Service interface {
 	// CreateDial will create the dial with the given name,
 	// and associate it to the specified token.
 	CreateDial(ctx context.Context, name, token string) (*Dial, error)
+	// CreateDialWithValue is like CreateDial, but the dial is created with the
+	// given value rather than zero, which is recorded in its history.
+	CreateDialWithValue(ctx context.Context, name, token string, value float64) (*Dial, error)
+	// CreateDialFrom is like CreateDial, but the dial is also created with the
+	// given value, color and group, all at once, or not at all.
+	CreateDialFrom(ctx context.Context, nd NewDial) (*Dial, error)
 	// GetDial retrieves a dial by ID. Anyone can retrieve any dial with its ID.
 	GetDial(ctx context.Context, id DialID) (*Dial, error)
+	// EnsureDial retrieves the dial mapped to the given external ID, creating it
+	// with the given name and token if there isn't one. Whether the dial was
+	// created is also returned. The token must match that of an existing dial.
+	EnsureDial(ctx context.Context, externalID, name, token string) (*Dial, bool, error)
+	// GetDials retrieves many dials by ID. Dials that are not found are omitted.
+	GetDials(ctx context.Context, ids []DialID) (map[DialID]Dial, error)
+	// ListDials calls fn with each dial, in ID order. Iteration stops at the
+	// first error returned by fn, which is then returned.
+	ListDials(ctx context.Context, fn func(Dial) error) error
+	// PageDials retrieves up to limit dials, in ID order, starting after the
+	// given ID. The first page is retrieved with an empty ID.
+	PageDials(ctx context.Context, after DialID, limit int) (*DialPage, error)
 	// SetDial updates the dial value. It can be updated by anyone who knows
-	// the original token it was created with.
+	// the original token it was created with, or the token of a board it is
+	// on that owns its dials, unless a board it is on is frozen.
 	SetDial(ctx context.Context, id DialID, token string, value float64) error
+	// SetDialWithNote is like SetDial, but also records the note against the
+	// value in the dial's history.
+	SetDialWithNote(ctx context.Context, id DialID, token string, value float64, note string) error
+	// AdjustDial adds the delta to the dial value, clamping the result to the
+	// value bounds. The value is read and updated at once, so concurrent
+	// adjustments aren't lost. It can be adjusted by whoever can set it.
+	AdjustDial(ctx context.Context, id DialID, token string, delta float64) error
+	// GetDialHistory retrieves the values the dial has been set to since the
+	// given time, oldest first. The zero time retrieves the whole history.
+	GetDialHistory(ctx context.Context, id DialID, since time.Time) ([]DialReading, error)
+	// SetDialColor updates the dial color. It can be updated by anyone who knows
+	// the original token it was created with.
+	SetDialColor(ctx context.Context, id DialID, token, color string) error
+	// SetDialGroup updates the group the dial is displayed in on boards. It can
+	// be updated by anyone who knows the original token it was created with.
+	SetDialGroup(ctx context.Context, id DialID, token, group string) error
+	// RenameDial updates the dial name. It can be renamed by anyone who knows
+	// the original token it was created with.
+	RenameDial(ctx context.Context, id DialID, token, name string) error
+	// SetDialToken replaces the token the dial is updated with. It can be
+	// replaced by anyone who knows the dial's current token.
+	SetDialToken(ctx context.Context, id DialID, token, newToken string) error
+	// UpdateDial applies the update to the dial, all at once, so either all of
+	// its changes are made, or none are. It can be updated by anyone who knows
+	// the original token it was created with, or, if only its value is
+	// updated, by whoever can set it. The updated dial is returned.
+	UpdateDial(ctx context.Context, id DialID, token string, u DialUpdate) (*Dial, error)
+	// DeleteDial removes the dial, and its history. It can be deleted by anyone
+	// who knows the original token it was created with. Boards the dial is on
+	// keep its ID, but skip it as they do any other missing dial.
+	DeleteDial(ctx context.Context, id DialID, token string) error
+	// VerifyDialToken checks the token is the one the dial was created with,
+	// without changing the dial. Bad tokens count towards the dial's lockout.
+	VerifyDialToken(ctx context.Context, id DialID, token string) error
 
 	// CreateBoard will create a board with the given name,
 	// and associate it to the specified token.
 	CreateBoard(ctx context.Context, name, token string) (*Board, error)
-	// GetBoard retrieves a board by ID. Anyone can retrieve any board with its ID.
+	// CreateBoardWithDescription is like CreateBoard, but the board is created
+	// with the given description, together, or not at all.
+	CreateBoardWithDescription(ctx context.Context, name, token, description string) (*Board, error)
+	// CreateBoardWithDials will create a board with the given name, and a dial
+	// with each of the given names on it, all associated to the specified token.
+	// Either the board and all of its dials are created, or none are.
+	CreateBoardWithDials(ctx context.Context, name, token string, dials []string) (*Board, error)
+	// CloneBoard creates a board with the given name and token, with the same
+	// dials as the board with the given ID or code. The dials are shared with
+	// the board, not copied, so stay live on both.
+	CloneBoard(ctx context.Context, id BoardID, name, token string) (*Board, error)
+	// GetBoard retrieves a board by ID or code. Anyone can retrieve any board with its ID.
 	GetBoard(ctx context.Context, id BoardID) (*Board, error)
+	// GetBoardDialIDs retrieves the IDs of the dials stored against a board,
+	// including any that no longer exist and so are skipped by GetBoard.
+	GetBoardDialIDs(ctx context.Context, id BoardID) ([]DialID, error)
+	// ListBoardsByToken retrieves the boards created with the given token.
+	// Only the IDs of the boards' dials are retrieved, not their values.
+	ListBoardsByToken(ctx context.Context, token string) ([]Board, error)
 	// SetBoard updates the dials associated with the board. It can be updated
 	// by anyone who knows the original token it was created with.
 	SetBoard(ctx context.Context, id BoardID, token string, dials []DialID) error
+	// AddBoardDial adds the dial to the end of the board's dials, unless it is
+	// already on the board. The dial is added in one go, so concurrent
+	// additions to the board aren't lost. It can be added by anyone who knows
+	// the board's original token.
+	AddBoardDial(ctx context.Context, id BoardID, token string, dialID DialID) error
+	// SetBoardName renames the board. It can be renamed by anyone who knows
+	// the original token it was created with.
+	SetBoardName(ctx context.Context, id BoardID, token, name string) error
+	// SetBoardOwnsDials sets whether the board owns its dials. The values of
+	// the dials on a board that owns them can also be set with the board's
+	// token. It can be set by anyone who knows the original token the board
+	// was created with.
+	SetBoardOwnsDials(ctx context.Context, id BoardID, token string, owns bool) error
+	// FreezeBoard snapshots the values of the board's dials, which the board
+	// is then retrieved with, and stops the dials' values being set, until it
+	// is unfrozen. It can be frozen by anyone who knows the original token the
+	// board was created with.
+	FreezeBoard(ctx context.Context, id BoardID, token string) error
+	// UnfreezeBoard undoes FreezeBoard, so the board is retrieved with the
+	// live values of its dials again. It can be unfrozen by anyone who knows
+	// the original token the board was created with.
+	UnfreezeBoard(ctx context.Context, id BoardID, token string) error
+	// SetBoardDescription updates the board description. It can be updated by
+	// anyone who knows the original token it was created with.
+	SetBoardDescription(ctx context.Context, id BoardID, token, description string) error
+	// UpdateBoard applies the update to the board, all at once, so either all
+	// of its changes are made, or none are. It can be updated by anyone who
+	// knows the original token it was created with.
+	UpdateBoard(ctx context.Context, id BoardID, token string, u BoardUpdate) error
+	// DeleteBoard removes the board. It can be deleted by anyone who knows the
+	// original token it was created with. The board's dials are not deleted, as
+	// they may be on other boards.
+	DeleteBoard(ctx context.Context, id BoardID, token string) error
 }
 
 //
@@ -59,12 +369,22 @@ type Service interface {
 const (
 	// ErrUnauthorized signifies the token is unauthorized to perform the attempted action
 	ErrUnauthorized = Error("unauthorized")
+	// ErrLockedOut signifies that too many unauthorized attempts have been made recently
+	ErrLockedOut = Error("locked out")
 	// ErrDialNotFound signifies that the dial specified is not found
 	ErrDialNotFound = Error("dial not found")
 	// ErrDialValueInvalid signifies that the dial value is out of bounds
 	ErrDialValueInvalid = Error("dial value invalid")
+	// ErrDialColorInvalid signifies that the dial color is not a hex color
+	ErrDialColorInvalid = Error("dial color invalid")
+	// ErrDialTokenInvalid signifies that the new dial token is empty
+	ErrDialTokenInvalid = Error("dial token invalid")
 	// ErrBoardNotFound signifies that the board specified is not found
 	ErrBoardNotFound = Error("board not found")
+	// ErrBoardDescriptionInvalid signifies that the board description is too long
+	ErrBoardDescriptionInvalid = Error("board description invalid")
+	// ErrBoardFrozen signifies that the board, or a board the dial is on, is frozen
+	ErrBoardFrozen = Error("board frozen")
 )
 
 // Error represents a ooohh, wtf error.
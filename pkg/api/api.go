@@ -1,39 +1,808 @@
 package api
 
 import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"image/png"
+	"io"
+	"io/ioutil"
 	"math"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
 
 	"github.com/dlmiddlecote/kit/api"
+	"github.com/markbates/pkger"
+	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 
 	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/service/lockout"
 	"github.com/dlmiddlecote/ooohh/pkg/slack"
 	"github.com/dlmiddlecote/ooohh/pkg/ui"
+	"github.com/dlmiddlecote/ooohh/pkg/webhook"
 )
 
+const (
+	// maxBatchSize is the maximum number of items that can be requested at once.
+	maxBatchSize = 100
+	// streamFlushInterval is the number of items written to a streamed
+	// response between each flush.
+	streamFlushInterval = 100
+	// maxPrecision is the largest number of decimal places values can be
+	// rounded to with the `precision` query parameter.
+	maxPrecision = 6
+	// defaultSlackTolerance is how far a Slack request timestamp may be from
+	// the current time before the request is rejected.
+	defaultSlackTolerance = 5 * time.Minute
+	// maxCallbackLength is the longest JSONP callback name accepted.
+	maxCallbackLength = 128
+	// defaultSlackMaxBodySize is the largest Slack request body, in bytes,
+	// that is read before the request is rejected.
+	defaultSlackMaxBodySize = 64 << 10
+	// defaultRequestTimeout is the longest a request is spent on before it
+	// times out.
+	defaultRequestTimeout = 5 * time.Second
+)
+
+// Problem codes, returned as the `code` of problem responses, so that clients
+// can tell problems apart without matching their titles. Codes are stable, so
+// must not be changed once added.
+const (
+	codeInternal         = "internal_error"
+	codeTimeout          = "timeout"
+	codeMethodNotAllowed = "method_not_allowed"
+	codeInvalidRequest   = "invalid_request"
+	codeInvalidJSON      = "invalid_json"
+	codeValidation       = "validation_error"
+	codeValueInvalid     = "value_invalid"
+	codeColorInvalid     = "color_invalid"
+	codeTokenInvalid     = "token_invalid"
+	codeTokenRequired    = "token_required"
+	codeUnauthorized     = "unauthorized"
+	codeForbidden        = "forbidden"
+	codeLockedOut        = "locked_out"
+	codeBoardFrozen      = "board_frozen"
+	codeNotFound         = "not_found"
+	codeDialNotFound     = "dial_not_found"
+	codeBoardNotFound    = "board_not_found"
+	codeWebhookNotFound  = "webhook_not_found"
+)
+
+// withCode adds the problem code to a problem response.
+func withCode(code string) api.ProblemExtra {
+	return api.WithFields(map[string]interface{}{"code": code})
+}
+
+// ValueMessages configures the messages shown to Slack users after they set
+// their dial value.
+type ValueMessages struct {
+	// Zero and Hundred, if set, are shown when the value is exactly 0 or 100,
+	// in preference to the bands.
+	Zero    string
+	Hundred string
+	// Bands are checked in order, the first band the value is above is shown.
+	Bands []ValueBand
+	// Default is shown when the value isn't above any band.
+	Default string
+}
+
+// ValueBand is a message shown for values above a threshold.
+type ValueBand struct {
+	Above   float64
+	Message string
+}
+
+// DefaultValueMessages returns the value messages used when none are configured.
+func DefaultValueMessages() ValueMessages {
+	return ValueMessages{
+		Bands: []ValueBand{
+			{75, "Ooohh, make sure you check in with someone, maybe they can help."},
+			{50, "Ooohh, make sure you take a break!"},
+		},
+		Default: "Ooohh, I wish I felt like that.",
+	}
+}
+
+// message returns the message to show for the given value.
+func (m ValueMessages) message(value float64) string {
+	if value == 0 && m.Zero != "" {
+		return m.Zero
+	}
+	if value == 100 && m.Hundred != "" {
+		return m.Hundred
+	}
+
+	for _, b := range m.Bands {
+		if value > b.Above {
+			return b.Message
+		}
+	}
+
+	return m.Default
+}
+
+// Validate checks that every value is shown a message. Bands must be in
+// descending order, as a band no higher than the one before it would never be
+// shown, and the default must be set, for values that aren't above any band.
+func (m ValueMessages) Validate() error {
+	for i, b := range m.Bands {
+		if b.Message == "" {
+			return fmt.Errorf("band above %s has no message", strconv.FormatFloat(b.Above, 'f', -1, 64))
+		}
+		if i > 0 && b.Above >= m.Bands[i-1].Above {
+			return fmt.Errorf("band above %s overlaps the band before it", strconv.FormatFloat(b.Above, 'f', -1, 64))
+		}
+	}
+
+	if m.Default == "" {
+		return errors.New("default message is not set")
+	}
+
+	return nil
+}
+
+// ParseValueBands parses bands from strings of the form "<above>:<message>",
+// e.g. "75:Check in with someone".
+func ParseValueBands(specs []string) ([]ValueBand, error) {
+	bands := make([]ValueBand, len(specs))
+	for i, spec := range specs {
+		parts := strings.SplitN(spec, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("band %q is not of the form <above>:<message>", spec)
+		}
+
+		above, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		if err != nil || math.IsNaN(above) || math.IsInf(above, 0) {
+			return nil, fmt.Errorf("band %q has an invalid threshold", spec)
+		}
+
+		bands[i] = ValueBand{Above: above, Message: strings.TrimSpace(parts[1])}
+	}
+
+	return bands, nil
+}
+
+// TimeFormat is the format of times in API responses.
+type TimeFormat string
+
+const (
+	// TimeFormatRFC3339Nano formats times as RFC3339, with fractional seconds
+	// if there are any. This is the default.
+	TimeFormatRFC3339Nano TimeFormat = "rfc3339nano"
+	// TimeFormatRFC3339 formats times as RFC3339, without fractional seconds.
+	TimeFormatRFC3339 TimeFormat = "rfc3339"
+	// TimeFormatUnix formats times as the number of seconds since the Unix epoch.
+	TimeFormatUnix TimeFormat = "unix"
+)
+
+// Valid reports whether the time format is known.
+func (f TimeFormat) Valid() bool {
+	switch f {
+	case TimeFormatRFC3339Nano, TimeFormatRFC3339, TimeFormatUnix:
+		return true
+	}
+	return false
+}
+
+// jsonTime is a time that is marshalled to JSON in the given format.
+type jsonTime struct {
+	t      time.Time
+	format TimeFormat
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t jsonTime) MarshalJSON() ([]byte, error) {
+	switch t.format {
+	case TimeFormatRFC3339:
+		return json.Marshal(t.t.Format(time.RFC3339))
+	case TimeFormatUnix:
+		return json.Marshal(t.t.Unix())
+	default:
+		return json.Marshal(t.t)
+	}
+}
+
+// Readiness reports whether the application has finished starting up, and
+// so is ready to serve traffic. It is safe for concurrent use.
+type Readiness struct {
+	ready int32
+}
+
+// SetReady marks the application as ready.
+func (r *Readiness) SetReady() {
+	atomic.StoreInt32(&r.ready, 1)
+}
+
+// Ready reports whether the application is ready.
+func (r *Readiness) Ready() bool {
+	return atomic.LoadInt32(&r.ready) == 1
+}
+
 type ooohhAPI struct {
 	logger *zap.SugaredLogger
 	s      ooohh.Service
 	ss     slack.Service
+	ws     webhook.Service
 
 	ui *ui.UI
+
+	now            func() time.Time
+	staleAfter     time.Duration
+	slackSecret    string
+	slackInsecure  bool
+	slackTolerance time.Duration
+	slackMaxBody   int64
+	slackTeams     map[string]bool
+	requestTimeout time.Duration
+	adminToken     string
+	valueMessages  ValueMessages
+	timeFormat     TimeFormat
+	precision      int
+	minValue       float64
+	maxValue       float64
+	readiness      *Readiness
+	healthCheck    func(ctx context.Context) error
+	jsonp          bool
+	privateBoards  bool
+	maskedBoards   bool
+	generateTokens bool
+
+	// listLockout locks clients out of listing boards by token after too
+	// many tokens that match no boards.
+	listLockout *lockout.Lockout
+}
+
+// Option configures the API.
+type Option func(*ooohhAPI)
+
+// WithNow sets the function used to get the current time.
+func WithNow(now func() time.Time) Option {
+	return func(a *ooohhAPI) {
+		a.now = now
+	}
+}
+
+// WithSlackSigningSecret verifies Slack requests using the signing secret of
+// the Slack app. Without it, Slack requests are rejected, unless
+// WithInsecureSlackRequests is given.
+func WithSlackSigningSecret(secret string) Option {
+	return func(a *ooohhAPI) {
+		a.slackSecret = secret
+	}
+}
+
+// WithInsecureSlackRequests accepts Slack requests without verifying them,
+// when no signing secret is configured. Anyone who can reach the API can then
+// send Slack commands as any user, so this is only for local development.
+func WithInsecureSlackRequests() Option {
+	return func(a *ooohhAPI) {
+		a.slackInsecure = true
+	}
+}
+
+// WithListLockout locks a client out of listing boards by token for a while,
+// once it has tried the given number of tokens that match no boards within
+// the window, so board tokens can't be guessed. Clients are told apart by
+// their address. Clients are never locked out by default.
+func WithListLockout(attempts int, window time.Duration) Option {
+	return func(a *ooohhAPI) {
+		a.listLockout = lockout.New(attempts, window)
+	}
+}
+
+// WithSlackTolerance sets how far a Slack request timestamp may be from the
+// current time before the request is rejected, allowing for clock skew.
+func WithSlackTolerance(tolerance time.Duration) Option {
+	return func(a *ooohhAPI) {
+		a.slackTolerance = tolerance
+	}
+}
+
+// WithStaleAfter sets how long a dial goes without being updated before it is
+// marked stale on boards. Dials are never stale if d isn't positive. Dials are
+// stale after 24 hours by default.
+func WithStaleAfter(d time.Duration) Option {
+	return func(a *ooohhAPI) {
+		a.staleAfter = d
+	}
+}
+
+// WithRequestTimeout sets the longest a request is spent on before it times
+// out, with a 503 response. Streamed responses are never timed out. Requests
+// time out after 5 seconds by default.
+func WithRequestTimeout(d time.Duration) Option {
+	return func(a *ooohhAPI) {
+		a.requestTimeout = d
+	}
+}
+
+// WithSlackMaxBodySize sets the largest Slack request body, in bytes, that is
+// read before the request is rejected.
+func WithSlackMaxBodySize(size int64) Option {
+	return func(a *ooohhAPI) {
+		a.slackMaxBody = size
+	}
+}
+
+// WithSlackTeams only allows the Slack workspaces with the given team IDs to
+// use the Slack command. By default, all workspaces are allowed.
+func WithSlackTeams(teams ...string) Option {
+	return func(a *ooohhAPI) {
+		for _, team := range teams {
+			if a.slackTeams == nil {
+				a.slackTeams = make(map[string]bool)
+			}
+			a.slackTeams[team] = true
+		}
+	}
+}
+
+// WithAdminToken enables the admin endpoints, which must be called with the
+// given token as a bearer token. Without it, the admin endpoints are disabled.
+func WithAdminToken(token string) Option {
+	return func(a *ooohhAPI) {
+		a.adminToken = token
+	}
+}
+
+// WithValueMessages sets the messages shown to Slack users after they set
+// their dial value.
+func WithValueMessages(m ValueMessages) Option {
+	return func(a *ooohhAPI) {
+		a.valueMessages = m
+	}
+}
+
+// WithTimeFormat sets the format of times in API responses.
+func WithTimeFormat(f TimeFormat) Option {
+	return func(a *ooohhAPI) {
+		a.timeFormat = f
+	}
+}
+
+// WithPrecision sets the number of decimal places dial values are shown with
+// in Slack responses.
+func WithPrecision(precision int) Option {
+	return func(a *ooohhAPI) {
+		a.precision = precision
+	}
+}
+
+// WithValueBounds sets the range, inclusive of both ends, that values set from
+// Slack must be within. Values outside of it are rejected without calling the
+// Slack service. The range is 0 to 100 by default, and should match the range
+// the ooohh service is configured with.
+func WithValueBounds(min, max float64) Option {
+	return func(a *ooohhAPI) {
+		a.minValue = min
+		a.maxValue = max
+	}
+}
+
+// WithReadiness makes the readiness endpoint report the given readiness.
+// Without it, the API is always ready.
+func WithReadiness(r *Readiness) Option {
+	return func(a *ooohhAPI) {
+		a.readiness = r
+	}
+}
+
+// WithWebhooks enables the board webhook endpoints, managing webhooks with
+// the given service.
+func WithWebhooks(ws webhook.Service) Option {
+	return func(a *ooohhAPI) {
+		a.ws = ws
+	}
+}
+
+// WithPrivateBoards requires the board token to read a board from the API,
+// given as a bearer token. By default, anyone with a board's ID can read it.
+func WithPrivateBoards() Option {
+	return func(a *ooohhAPI) {
+		a.privateBoards = true
+	}
+}
+
+// WithMaskedBoards hides the values of a board's dials from the API unless the
+// board token is given, as a bearer token or a `token` query parameter. Without
+// it, each dial's value is zeroed and its ID left out, and the board is marked
+// as masked, so that a board can be shared read-only without exposing its live
+// values.
+func WithMaskedBoards() Option {
+	return func(a *ooohhAPI) {
+		a.maskedBoards = true
+	}
+}
+
+// WithGeneratedTokens allows dials and boards to be created without a token,
+// for the service to generate one. The generated token is returned in the
+// `token` field of the create response, which is the only time it is exposed.
+// The service must be configured to generate tokens too.
+func WithGeneratedTokens() Option {
+	return func(a *ooohhAPI) {
+		a.generateTokens = true
+	}
+}
+
+// WithHealthCheck makes the readiness endpoint call the check on each request,
+// reporting the API as unavailable if it fails. It should be cheap, e.g. a
+// read from the database.
+func WithHealthCheck(check func(ctx context.Context) error) Option {
+	return func(a *ooohhAPI) {
+		a.healthCheck = check
+	}
+}
+
+// WithJSONP enables legacy JSONP support on the board endpoint, for embeds
+// that can't make cross-origin requests. When enabled, a `callback` query
+// parameter wraps the response in a call to the named JavaScript function.
+func WithJSONP() Option {
+	return func(a *ooohhAPI) {
+		a.jsonp = true
+	}
 }
 
 // NewAPI returns an implementation of api.API.
 // The returned API exposes the given ooohh service as an HTTP API.
 // The Slack command webhook is also exposed.
-func NewAPI(logger *zap.SugaredLogger, s ooohh.Service, ss slack.Service, ui *ui.UI) *ooohhAPI {
-	return &ooohhAPI{logger, s, ss, ui}
+func NewAPI(logger *zap.SugaredLogger, s ooohh.Service, ss slack.Service, ui *ui.UI, opts ...Option) *ooohhAPI {
+	a := &ooohhAPI{
+		logger:         logger,
+		s:              s,
+		ss:             ss,
+		ui:             ui,
+		now:            time.Now,
+		staleAfter:     ooohh.DefaultStaleAfter,
+		slackTolerance: defaultSlackTolerance,
+		slackMaxBody:   defaultSlackMaxBodySize,
+		requestTimeout: defaultRequestTimeout,
+		valueMessages:  DefaultValueMessages(),
+		maxValue:       100,
+		timeFormat:     TimeFormatRFC3339Nano,
+		precision:      ooohh.DefaultPrecision,
+		listLockout:    lockout.New(0, 0),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// StripTrailingSlash is middleware that removes any trailing slash from the
+// request path before routing, so that `/api/dials/:id/` is served the same as
+// `/api/dials/:id`. The path is rewritten rather than redirected, as not all
+// clients, such as Slack, follow redirects.
+func StripTrailingSlash(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p := strings.TrimRight(r.URL.Path, "/"); p != r.URL.Path {
+			if p == "" {
+				p = "/"
+			}
+			r.URL.Path = p
+			r.URL.RawPath = ""
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MethodNotAllowed is middleware that responds to requests with a method that
+// isn't supported by the requested path with a 405 problem. The router already
+// responds to them with a 405, and an Allow header listing the methods the
+// path supports, but as plain text. Handlers never respond with a 405, so any
+// 405 with an Allow header is the router's.
+func MethodNotAllowed(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&methodNotAllowedWriter{ResponseWriter: w, r: r}, r)
+	})
+}
+
+// methodNotAllowedWriter is a http.ResponseWriter that responds with a method
+// not allowed problem, instead of the router's plain text response.
+type methodNotAllowedWriter struct {
+	http.ResponseWriter
+	r          *http.Request
+	notAllowed bool
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *methodNotAllowedWriter) WriteHeader(code int) {
+	if allow := w.Header().Get("Allow"); code == http.StatusMethodNotAllowed && allow != "" {
+		w.notAllowed = true
+		w.Header().Del("X-Content-Type-Options")
+		api.Problem(w.ResponseWriter, w.r, "Method Not Allowed", fmt.Sprintf("Method %s is not allowed, allowed methods are %s", w.r.Method, allow), code, withCode(codeMethodNotAllowed))
+		return
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements http.ResponseWriter. The router's plain text body is
+// discarded, as the problem has already been written.
+func (w *methodNotAllowedWriter) Write(b []byte) (int, error) {
+	if w.notAllowed {
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// CORS is middleware that allows browsers to call the API from the given
+// origins, such as dashboards served elsewhere. An origin of "*" allows any
+// origin. Preflight requests from allowed origins are responded to with a 204,
+// without reaching the API. Requests from other origins are served as they
+// would be without the middleware, so browsers block their responses.
+func CORS(origins ...string) func(http.Handler) http.Handler {
+	allowed := make(map[string]bool)
+	for _, o := range origins {
+		allowed[o] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" || len(allowed) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Responses differ by origin, so mustn't be cached across them.
+			w.Header().Add("Vary", "Origin")
+
+			if !allowed["*"] && !allowed[origin] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+
+			// Let scripts read ETags, to make conditional requests with, and
+			// request IDs.
+			w.Header().Set("Access-Control-Expose-Headers", "ETag, X-Request-ID")
+
+			// Answer preflight requests.
+			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE")
+				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, If-None-Match, X-Request-ID, X-Request-Timeout")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Timeout is middleware that bounds the time spent on each request, by
+// applying a deadline to the request context. Clients can ask for a shorter
+// deadline with the X-Request-Timeout header, e.g. `X-Request-Timeout: 2s`,
+// which is capped at max. Invalid header values are ignored. Server errors
+// responded with once the deadline has passed are replaced with a 503
+// "Request timed out" problem.
+func Timeout(max time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := max
+			if d, err := time.ParseDuration(r.Header.Get("X-Request-Timeout")); err == nil && d > 0 && d < max {
+				timeout = d
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			r = r.WithContext(ctx)
+			next.ServeHTTP(&timeoutWriter{ResponseWriter: w, r: r}, r)
+		})
+	}
+}
+
+// timeoutWriter is a http.ResponseWriter that responds with a timeout problem,
+// instead of a server error, if the request's deadline has passed.
+type timeoutWriter struct {
+	http.ResponseWriter
+	r        *http.Request
+	timedOut bool
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *timeoutWriter) WriteHeader(code int) {
+	if code >= 500 && errors.Is(w.r.Context().Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+		api.Problem(w.ResponseWriter, w.r, "Service Unavailable", "Request timed out", http.StatusServiceUnavailable, withCode(codeTimeout))
+		return
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements http.ResponseWriter. The body of a timed out response is
+// discarded, as the timeout problem has already been written.
+func (w *timeoutWriter) Write(b []byte) (int, error) {
+	if w.timedOut {
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// requestIDHeader is the header requests are identified by.
+const requestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength is the longest request ID that is propagated.
+const maxRequestIDLength = 128
+
+// requestIDKey is the context key the request ID is stored under.
+type requestIDKey struct{}
+
+// RequestID returns the ID of the request the context belongs to, or the empty
+// string if it doesn't belong to one.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestLog is middleware that identifies each request by its X-Request-ID
+// header, or a new ksuid if it doesn't have a valid one. The ID is responded
+// with in the same header, and is added to the request context, so handler
+// logs include it. If logRequest is set, the request's method, path, status,
+// duration and ID are logged once it is responded to.
+func (a *ooohhAPI) requestLog(logRequest bool) api.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			id := r.Header.Get(requestIDHeader)
+			if !validRequestID(id) {
+				id = ksuid.New().String()
+			}
+
+			// Keep the request details in step, as they identify the request too.
+			if d := api.GetDetails(r); d != nil {
+				d.RequestID = id
+			}
+
+			w.Header().Set(requestIDHeader, id)
+			r = r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id))
+
+			sw := &statusWriter{ResponseWriter: w}
+			next.ServeHTTP(sw, r)
+
+			if !logRequest {
+				return
+			}
+
+			a.logger.Infow("request",
+				"request_id", id,
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", sw.Status(),
+				"duration", time.Since(start).String(),
+			)
+		})
+	}
+}
+
+// validRequestID reports whether the request ID can be propagated. IDs must be
+// printable ASCII, without spaces, so they can't break up log lines.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestLogger returns the logger to log about the request with, which
+// includes the request's ID.
+func (a *ooohhAPI) requestLogger(r *http.Request) *zap.SugaredLogger {
+	if id := RequestID(r.Context()); id != "" {
+		return a.logger.With("request_id", id)
+	}
+
+	return a.logger
+}
+
+// statusWriter is a http.ResponseWriter that records the status code
+// responded with.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *statusWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements http.ResponseWriter.
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher, if the wrapped writer does, so streamed
+// responses are still flushed.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Status returns the status code responded with. Responses that weren't
+// written to are responded to with a 200.
+func (w *statusWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+
+	return w.status
 }
 
 // Endpoints implements api.API. We list all API endpoints here.
 func (a *ooohhAPI) Endpoints() []api.Endpoint {
-	return []api.Endpoint{
+	endpoints := []api.Endpoint{
+		{
+			Method:  "GET",
+			Path:    "/api/health",
+			Handler: a.health(),
+			// Probes are frequent, so don't log them.
+			SuppressLogs: true,
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/ready",
+			Handler: a.ready(),
+			// Probes are frequent, so don't log them.
+			SuppressLogs: true,
+		},
+		{
+			// Kubernetes style aliases of the health and readiness endpoints.
+			Method:       "GET",
+			Path:         "/healthz",
+			Handler:      a.health(),
+			SuppressLogs: true,
+		},
+		{
+			Method:       "GET",
+			Path:         "/readyz",
+			Handler:      a.ready(),
+			SuppressLogs: true,
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/openapi.json",
+			Handler: a.openAPI(),
+		},
 		{
 			Method:  "POST",
 			Path:    "/api/dials",
@@ -41,29 +810,137 @@ func (a *ooohhAPI) Endpoints() []api.Endpoint {
 		},
 		{
 			Method:  "GET",
-			Path:    "/api/dials/:id",
-			Handler: a.getDial(),
+			Path:    "/api/dials",
+			Handler: a.listDials(),
+			// Listing every dial is for admins only.
+			Middlewares: []api.Middleware{a.requireAdmin},
 		},
 		{
-			Method:  "PATCH",
+			// httprouter can't route /api/dials/batch-get alongside the :id
+			// wildcard of /api/dials/:id/verify-token, so it is matched by the handler.
+			Method:  "POST",
 			Path:    "/api/dials/:id",
-			Handler: a.setDialValue(),
+			Handler: a.getDials(),
 		},
 		{
-			Method:  "POST",
-			Path:    "/api/boards",
-			Handler: a.createBoard(),
+			Method:  "PUT",
+			Path:    "/api/dials/by-external/:extID",
+			Handler: a.ensureDial(),
 		},
 		{
 			Method:  "GET",
-			Path:    "/api/boards/:id",
-			Handler: a.getBoard(),
+			Path:    "/api/dials/:id",
+			Handler: a.getDial(),
+		},
+		{
+			Method:  "PATCH",
+			Path:    "/api/dials/:id",
+			Handler: a.setDialValue(),
+		},
+		{
+			Method:  "DELETE",
+			Path:    "/api/dials/:id",
+			Handler: a.deleteDial(),
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/dials/:id/verify-token",
+			Handler: a.verifyDialToken(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/dials/:id/history",
+			Handler: a.getDialHistory(),
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/boards",
+			Handler: a.createBoard(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/boards",
+			Handler: a.listBoards(),
+		},
+		{
+			// httprouter can't route /api/boards/with-dials alongside the :id
+			// wildcard of /api/boards/:id/webhooks, so it is matched by the handler.
+			Method:  "POST",
+			Path:    "/api/boards/:id",
+			Handler: a.createBoardWithDials(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/boards/:id",
+			Handler: a.getBoard(),
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/boards/:id/clone",
+			Handler: a.cloneBoard(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/boards/:id/board.png",
+			Handler: a.getBoardImage(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/boards/:id/distribution",
+			Handler: a.getBoardDistribution(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/boards/:id/leaderboard",
+			Handler: a.getBoardLeaderboard(),
 		},
 		{
 			Method:  "PATCH",
 			Path:    "/api/boards/:id",
 			Handler: a.setBoardDials(),
 		},
+		{
+			Method:  "DELETE",
+			Path:    "/api/boards/:id",
+			Handler: a.deleteBoard(),
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/boards/:id/dials",
+			Handler: a.addBoardDial(),
+		},
+		{
+			Method:  "DELETE",
+			Path:    "/api/boards/:id/dials",
+			Handler: a.clearBoardDials(),
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/boards/:id/webhooks",
+			Handler: a.addWebhook(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/boards/:id/webhooks",
+			Handler: a.listWebhooks(),
+		},
+		{
+			Method:  "DELETE",
+			Path:    "/api/boards/:id/webhooks/:webhookID",
+			Handler: a.deleteWebhook(),
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/leaderboard",
+			Handler: a.leaderboard(),
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/admin/dials",
+			Handler: a.exportDials(),
+			// Exporting every dial is for admins only.
+			Middlewares: []api.Middleware{a.requireAdmin},
+		},
 		{
 			Method:  "POST",
 			Path:    "/api/slack/command",
@@ -103,218 +980,1639 @@ func (a *ooohhAPI) Endpoints() []api.Endpoint {
 			Handler: a.ui.Static(),
 		},
 	}
+
+	// Bound the time spent on each request, except the dial export, which
+	// streams every dial, so takes as long as there are dials.
+	for i, e := range endpoints {
+		if e.Method == "GET" && e.Path == "/api/admin/dials" {
+			continue
+		}
+		endpoints[i].Middlewares = append(e.Middlewares, Timeout(a.requestTimeout))
+	}
+
+	// Identify and log each request first, in place of the server's request
+	// log, so the request ID is logged by everything after. Requests to
+	// endpoints that suppress logs are still identified.
+	for i, e := range endpoints {
+		endpoints[i].Middlewares = append([]api.Middleware{a.requestLog(!e.SuppressLogs)}, e.Middlewares...)
+		endpoints[i].SuppressLogs = true
+	}
+
+	return endpoints
+}
+
+func (a *ooohhAPI) health() http.Handler {
+	type response struct {
+		Status string `json:"status"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Serving the request is enough to show the process is alive.
+		api.Respond(w, r, http.StatusOK, response{"ok"})
+	})
+}
+
+func (a *ooohhAPI) ready() http.Handler {
+	type response struct {
+		Status string `json:"status"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.readiness != nil && !a.readiness.Ready() {
+			api.Respond(w, r, http.StatusServiceUnavailable, response{"starting"})
+			return
+		}
+
+		if a.healthCheck != nil {
+			if err := a.healthCheck(r.Context()); err != nil {
+				a.requestLogger(r).Errorw("health check failed", "err", err)
+				api.Respond(w, r, http.StatusServiceUnavailable, response{"unavailable"})
+				return
+			}
+		}
+
+		api.Respond(w, r, http.StatusOK, response{"ok"})
+	})
+}
+
+func (a *ooohhAPI) openAPI() http.Handler {
+	var spec []byte
+	f, err := pkger.Open("/pkg/api/openapi.json")
+	if err == nil {
+		defer f.Close() //nolint:errcheck
+		spec, err = ioutil.ReadAll(f)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			a.requestLogger(r).Errorw("could not read openapi document", "err", err)
+			api.Problem(w, r, "Internal Server Error", "Could not read OpenAPI document", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(spec) //nolint:errcheck
+	})
 }
 
 func (a *ooohhAPI) createDial() http.Handler {
 	type request struct {
-		Name  string `json:"name"`
+		Name  string   `json:"name"`
+		Token string   `json:"token"`
+		Value *float64 `json:"value,omitempty"`
+		Color string   `json:"color"`
+		Group string   `json:"group"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Name == "" || (body.Token == "" && !a.generateTokens) {
+			api.Problem(w, r, "Validation Error", "Both `name` and `token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if !ooohh.ValidColor(body.Color) {
+			api.Problem(w, r, "Validation Error", "`color` must be a hex color, e.g. #00ff00.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		d, err := a.s.CreateDialFrom(r.Context(), ooohh.NewDial{
+			Name:  body.Name,
+			Token: body.Token,
+			Value: body.Value,
+			Color: body.Color,
+			Group: body.Group,
+		})
+		if err != nil {
+			if errors.Is(err, ooohh.ErrDialValueInvalid) {
+				api.Problem(w, r, "Bad Request", "Invalid value", http.StatusBadRequest, withCode(codeValueInvalid))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not create dial", "err", err)
+			api.Problem(w, r, "Internal Server Error", "Could not create dial", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		resp := createdDialResponse{dialResponse: a.newDialResponse(*d)}
+		if body.Token == "" {
+			resp.Token = d.Token
+		}
+
+		api.Respond(w, r, http.StatusCreated, resp)
+	})
+}
+
+func (a *ooohhAPI) ensureDial() http.Handler {
+	type request struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		extID := api.URLParam(r, "extID")
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Name == "" || body.Token == "" {
+			api.Problem(w, r, "Validation Error", "Both `name` and `token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		d, created, err := a.s.EnsureDial(r.Context(), extID, body.Name, body.Token)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrLockedOut) {
+				api.Problem(w, r, "Too Many Requests", "Too many invalid token attempts, try again later", http.StatusTooManyRequests, withCode(codeLockedOut))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not ensure dial", "err", err, "external_id", extID)
+			api.Problem(w, r, "Internal Server Error", "Could not ensure dial", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		status := http.StatusOK
+		if created {
+			status = http.StatusCreated
+		}
+
+		api.Respond(w, r, status, a.newDialResponse(*d))
+	})
+}
+
+func (a *ooohhAPI) getDial() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.DialID(api.URLParam(r, "id"))
+
+		precision, ok := a.queryPrecision(w, r)
+		if !ok {
+			return
+		}
+
+		stats := false
+		if q := r.URL.Query().Get("include"); q != "" {
+			if q != "stats" {
+				api.Problem(w, r, "Validation Error", "`include` must be `stats`.", http.StatusBadRequest, withCode(codeValidation))
+				return
+			}
+			stats = true
+		}
+
+		d, err := a.s.GetDial(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrDialNotFound) {
+				api.NotFound(w, r, withCode(codeDialNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve dial", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve dial", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		roundDials(precision, d)
+
+		if !stats {
+			respondWithETag(w, r, "", a.newDialResponse(*d))
+			return
+		}
+
+		now := a.now()
+		readings, err := a.s.GetDialHistory(r.Context(), id, now.Add(-time.Hour))
+		if err != nil {
+			a.requestLogger(r).Errorw("could not retrieve dial history", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve dial", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		respondWithETag(w, r, "", dialWithStatsResponse{
+			dialResponse: a.newDialResponse(*d),
+			Stats:        newDialStats(readings, now),
+		})
+	})
+}
+
+// dialStats describes how a dial has been used recently. A high number of sets
+// can point to a misbehaving integration thrashing the dial.
+type dialStats struct {
+	SetsLastHour int `json:"sets_last_hour"`
+}
+
+// newDialStats computes the stats of a dial from its history, as of now.
+func newDialStats(readings []ooohh.DialReading, now time.Time) dialStats {
+	var stats dialStats
+
+	since := now.Add(-time.Hour)
+	for _, r := range readings {
+		if r.At.After(since) && !r.At.After(now) {
+			stats.SetsLastHour++
+		}
+	}
+
+	return stats
+}
+
+// dialWithStatsResponse is a dial, along with its stats.
+type dialWithStatsResponse struct {
+	dialResponse
+	Stats dialStats `json:"stats"`
+}
+
+func (a *ooohhAPI) getDialHistory() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.DialID(api.URLParam(r, "id"))
+
+		var since time.Time
+		if q := r.URL.Query().Get("since"); q != "" {
+			var err error
+			if since, err = time.Parse(time.RFC3339, q); err != nil {
+				api.Problem(w, r, "Validation Error", "`since` must be an RFC 3339 time.", http.StatusBadRequest, withCode(codeValidation))
+				return
+			}
+		}
+
+		readings, err := a.s.GetDialHistory(r.Context(), id, since)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrDialNotFound) {
+				api.NotFound(w, r, withCode(codeDialNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve dial history", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve dial history", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		resp := make([]readingResponse, len(readings))
+		for i := range readings {
+			resp[i] = readingResponse{readings[i], jsonTime{readings[i].At, a.timeFormat}}
+		}
+
+		api.Respond(w, r, http.StatusOK, resp)
+	})
+}
+
+func (a *ooohhAPI) getDials() http.Handler {
+	type request struct {
+		IDs []string `json:"ids"`
+	}
+	type response map[ooohh.DialID]dialResponse
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if api.URLParam(r, "id") != "batch-get" {
+			api.NotFound(w, r, withCode(codeNotFound))
+			return
+		}
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if len(body.IDs) == 0 {
+			api.Problem(w, r, "Validation Error", "`ids` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if len(body.IDs) > maxBatchSize {
+			api.Problem(w, r, "Validation Error", fmt.Sprintf("At most %d `ids` can be provided.", maxBatchSize), http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		precision, ok := a.queryPrecision(w, r)
+		if !ok {
+			return
+		}
+
+		ids := make([]ooohh.DialID, len(body.IDs))
+		for i := range ids {
+			ids[i] = ooohh.DialID(body.IDs[i])
+		}
+
+		dials, err := a.s.GetDials(r.Context(), ids)
+		if err != nil {
+			a.requestLogger(r).Errorw("could not retrieve dials", "err", err)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve dials", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		resp := make(response, len(dials))
+		for id, d := range dials {
+			roundDials(precision, &d)
+			resp[id] = a.newDialResponse(d)
+		}
+
+		api.Respond(w, r, http.StatusOK, resp)
+	})
+}
+
+func (a *ooohhAPI) exportDials() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Paginate if asked to, otherwise stream all dials.
+		if r.URL.Query().Get("limit") != "" {
+			if p, ok := a.pageDials(w, r); ok {
+				api.Respond(w, r, http.StatusOK, a.newDialResponses(p.Dials))
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err := streamJSONArray(w, func(write func(v interface{}) error) error {
+			return a.s.ListDials(r.Context(), func(d ooohh.Dial) error {
+				return write(a.newDialResponse(d))
+			})
+		})
+		if err != nil {
+			// The response has already started, so it is left as invalid JSON.
+			a.requestLogger(r).Errorw("could not export dials", "err", err)
+		}
+	})
+}
+
+// pageDials gets the page of dials asked for by the request, for the dial
+// listing endpoints to respond with. The page starts after the `after` dial
+// (`cursor` is accepted as an alias), and holds up to `limit` dials, clamped to
+// maxBatchSize. The link to the next page is given in the Link header, and the
+// total number of dials in the X-Total-Count header. If the page can't be got, a
+// problem is written and false is returned.
+func (a *ooohhAPI) pageDials(w http.ResponseWriter, r *http.Request) (*ooohh.DialPage, bool) {
+	q := r.URL.Query()
+
+	after := ooohh.DialID(q.Get("after"))
+	if after == "" {
+		after = ooohh.DialID(q.Get("cursor"))
+	}
+
+	// Clamp large limits, rather than rejecting them.
+	limit := maxBatchSize
+	if q.Get("limit") != "" {
+		var err error
+		limit, err = strconv.Atoi(q.Get("limit"))
+		if err != nil || limit < 1 {
+			api.Problem(w, r, "Validation Error", "`limit` must be a positive integer.", http.StatusBadRequest, withCode(codeValidation))
+			return nil, false
+		}
+		if limit > maxBatchSize {
+			limit = maxBatchSize
+		}
+	}
+
+	p, err := a.s.PageDials(r.Context(), after, limit)
+	if err != nil {
+		a.requestLogger(r).Errorw("could not page dials", "err", err)
+		api.Problem(w, r, "Internal Server Error", "Could not retrieve dials", http.StatusInternalServerError, withCode(codeInternal))
+		return nil, false
+	}
+
+	if p.Next != "" {
+		next := url.Values{}
+		next.Set("after", string(p.Next))
+		next.Set("limit", strconv.Itoa(limit))
+		w.Header().Set("Link", fmt.Sprintf(`<%s?%s>; rel="next"`, r.URL.Path, next.Encode()))
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(p.Total))
+
+	return p, true
+}
+
+// listDials lists the dials for admin tooling, a page at a time. The cursor to
+// get the next page with is returned alongside the dials, and is empty on the
+// last page.
+func (a *ooohhAPI) listDials() http.Handler {
+	type response struct {
+		Dials      []dialResponse `json:"dials"`
+		NextCursor ooohh.DialID   `json:"next_cursor"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := a.pageDials(w, r)
+		if !ok {
+			return
+		}
+
+		api.Respond(w, r, http.StatusOK, response{a.newDialResponses(p.Dials), p.Next})
+	})
+}
+
+func (a *ooohhAPI) setDialValue() http.Handler {
+	type request struct {
+		Token string   `json:"token"`
+		Name  *string  `json:"name,omitempty"`
+		Value *float64 `json:"value,omitempty"`
+		Delta *float64 `json:"delta,omitempty"`
+		Color *string  `json:"color,omitempty"`
+		Group *string  `json:"group,omitempty"`
+		Note  string   `json:"note,omitempty"`
+
+		NewToken *string `json:"new_token,omitempty"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.DialID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Token == "" || (body.Name == nil && body.Value == nil && body.Delta == nil && body.Color == nil && body.Group == nil && body.NewToken == nil) {
+			api.Problem(w, r, "Validation Error", "`token` and at least one of `name`, `value`, `delta`, `color`, `group` or `new_token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if body.Value != nil && body.Delta != nil {
+			api.Problem(w, r, "Validation Error", "Only one of `value` or `delta` can be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if body.Name != nil && *body.Name == "" {
+			api.Problem(w, r, "Validation Error", "`name` must not be empty.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if body.Note != "" && body.Value == nil {
+			api.Problem(w, r, "Validation Error", "`note` can only be provided with `value`.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		// The changes are made at once, so a failed update changes nothing.
+		d, err := a.s.UpdateDial(r.Context(), id, body.Token, ooohh.DialUpdate{
+			Name:  body.Name,
+			Color: body.Color,
+			Group: body.Group,
+			Value: body.Value,
+			Delta: body.Delta,
+			Note:  body.Note,
+			Token: body.NewToken,
+		})
+		if err != nil {
+			if errors.Is(err, ooohh.ErrDialNotFound) {
+				api.NotFound(w, r, withCode(codeDialNotFound))
+				return
+			} else if errors.Is(err, ooohh.ErrDialValueInvalid) {
+				api.Problem(w, r, "Bad Request", "Invalid value", http.StatusBadRequest, withCode(codeValueInvalid))
+				return
+			} else if errors.Is(err, ooohh.ErrDialColorInvalid) {
+				api.Problem(w, r, "Bad Request", "Invalid color", http.StatusBadRequest, withCode(codeColorInvalid))
+				return
+			} else if errors.Is(err, ooohh.ErrDialTokenInvalid) {
+				api.Problem(w, r, "Bad Request", "Invalid new token", http.StatusBadRequest, withCode(codeTokenInvalid))
+				return
+			} else if errors.Is(err, ooohh.ErrBoardFrozen) {
+				api.Problem(w, r, "Conflict", "Dial is on a frozen board", http.StatusConflict, withCode(codeBoardFrozen))
+				return
+			} else if errors.Is(err, ooohh.ErrLockedOut) {
+				api.Problem(w, r, "Too Many Requests", "Too many invalid token attempts, try again later", http.StatusTooManyRequests, withCode(codeLockedOut))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not update dial", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not update dial", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		api.Respond(w, r, http.StatusOK, a.newDialResponse(*d))
+	})
+}
+
+func (a *ooohhAPI) deleteDial() http.Handler {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.DialID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Token == "" {
+			api.Problem(w, r, "Validation Error", "`token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		err = a.s.DeleteDial(r.Context(), id, body.Token)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrDialNotFound) {
+				api.NotFound(w, r, withCode(codeDialNotFound))
+				return
+			} else if errors.Is(err, ooohh.ErrLockedOut) {
+				api.Problem(w, r, "Too Many Requests", "Too many invalid token attempts, try again later", http.StatusTooManyRequests, withCode(codeLockedOut))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not delete dial", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not delete dial", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		api.Respond(w, r, http.StatusNoContent, nil)
+	})
+}
+
+func (a *ooohhAPI) verifyDialToken() http.Handler {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.DialID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Token == "" {
+			api.Problem(w, r, "Validation Error", "`token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		err = a.s.VerifyDialToken(r.Context(), id, body.Token)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrDialNotFound) {
+				api.NotFound(w, r, withCode(codeDialNotFound))
+				return
+			} else if errors.Is(err, ooohh.ErrLockedOut) {
+				api.Problem(w, r, "Too Many Requests", "Too many invalid token attempts, try again later", http.StatusTooManyRequests, withCode(codeLockedOut))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not verify dial token", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not verify token", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		api.Respond(w, r, http.StatusNoContent, nil)
+	})
+}
+
+func (a *ooohhAPI) createBoard() http.Handler {
+	type request struct {
+		Name        string `json:"name"`
+		Token       string `json:"token"`
+		Description string `json:"description"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Name == "" || (body.Token == "" && !a.generateTokens) {
+			api.Problem(w, r, "Validation Error", "Both `name` and `token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if !ooohh.ValidDescription(body.Description) {
+			api.Problem(w, r, "Validation Error", fmt.Sprintf("`description` must be at most %d characters.", ooohh.MaxDescriptionLength), http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		b, err := a.s.CreateBoardWithDescription(r.Context(), body.Name, body.Token, body.Description)
+		if err != nil {
+			a.requestLogger(r).Errorw("could not create board", "err", err)
+			api.Problem(w, r, "Internal Server Error", "Could not create board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		resp := createdBoardResponse{boardResponse: a.newBoardResponse(*b)}
+		if body.Token == "" {
+			resp.Token = b.Token
+		}
+
+		api.Respond(w, r, http.StatusCreated, resp)
+	})
+}
+
+func (a *ooohhAPI) createBoardWithDials() http.Handler {
+	type request struct {
+		Name  string   `json:"name"`
+		Token string   `json:"token"`
+		Dials []string `json:"dials"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if api.URLParam(r, "id") != "with-dials" {
+			api.NotFound(w, r, withCode(codeNotFound))
+			return
+		}
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Name == "" || (body.Token == "" && !a.generateTokens) || len(body.Dials) == 0 {
+			api.Problem(w, r, "Validation Error", "`name`, `token` and `dials` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		for _, name := range body.Dials {
+			if name == "" {
+				api.Problem(w, r, "Validation Error", "`dials` must not contain empty names.", http.StatusBadRequest, withCode(codeValidation))
+				return
+			}
+		}
+
+		b, err := a.s.CreateBoardWithDials(r.Context(), body.Name, body.Token, body.Dials)
+		if err != nil {
+			a.requestLogger(r).Errorw("could not create board with dials", "err", err)
+			api.Problem(w, r, "Internal Server Error", "Could not create board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		resp := createdBoardResponse{boardResponse: a.newBoardResponse(*b)}
+		if body.Token == "" {
+			resp.Token = b.Token
+		}
+
+		api.Respond(w, r, http.StatusCreated, resp)
+	})
+}
+
+func (a *ooohhAPI) cloneBoard() http.Handler {
+	type request struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Name == "" || (body.Token == "" && !a.generateTokens) {
+			api.Problem(w, r, "Validation Error", "Both `name` and `token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		// Read the board from where it is written, so new boards can be cloned.
+		src, err := a.s.GetBoard(ooohh.WithPrimaryReads(r.Context()), id)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		if !a.canReadBoard(w, r, src) {
+			return
+		}
+
+		// The clone's values can be read with its own token, so masked boards
+		// can only be cloned by those who can already read their values.
+		if a.maskBoard(r, src) {
+			api.Problem(w, r, "Forbidden", "Board token required to clone masked board", http.StatusForbidden, withCode(codeForbidden))
+			return
+		}
+
+		b, err := a.s.CloneBoard(r.Context(), src.ID, body.Name, body.Token)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not clone board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not clone board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		resp := createdBoardResponse{boardResponse: a.newBoardResponse(*b)}
+		if body.Token == "" {
+			resp.Token = b.Token
+		}
+
+		api.Respond(w, r, http.StatusCreated, resp)
+	})
+}
+
+// webhookResponse is a webhook, with its time in the configured format.
+type webhookResponse struct {
+	webhook.Webhook
+	CreatedAt jsonTime `json:"created_at"`
+}
+
+func (a *ooohhAPI) newWebhookResponse(wh webhook.Webhook) webhookResponse {
+	return webhookResponse{wh, jsonTime{wh.CreatedAt, a.timeFormat}}
+}
+
+// dialResponse is a dial, with its time in the configured format.
+type dialResponse struct {
+	ooohh.Dial
+	UpdatedAt jsonTime `json:"updated_at"`
+}
+
+func (a *ooohhAPI) newDialResponse(d ooohh.Dial) dialResponse {
+	return dialResponse{d, jsonTime{d.UpdatedAt, a.timeFormat}}
+}
+
+// createdDialResponse is a newly created dial, with the token that was
+// generated for it, if it was created without one.
+type createdDialResponse struct {
+	dialResponse
+	Token string `json:"token,omitempty"`
+}
+
+func (a *ooohhAPI) newDialResponses(dials []ooohh.Dial) []dialResponse {
+	resp := make([]dialResponse, len(dials))
+	for i := range dials {
+		resp[i] = a.newDialResponse(dials[i])
+	}
+	return resp
+}
+
+// readingResponse is a dial reading, with its time in the configured format.
+type readingResponse struct {
+	ooohh.DialReading
+	At jsonTime `json:"at"`
+}
+
+// boardDialResponse is a dial on a board, with whether its value is stale.
+type boardDialResponse struct {
+	dialResponse
+	Stale bool `json:"stale"`
+}
+
+// newBoardDialResponses returns the board's dials, marking those that haven't
+// been updated for longer than the configured stale threshold.
+func (a *ooohhAPI) newBoardDialResponses(dials []ooohh.Dial) []boardDialResponse {
+	now := a.now()
+
+	resp := make([]boardDialResponse, len(dials))
+	for i := range dials {
+		resp[i] = boardDialResponse{a.newDialResponse(dials[i]), dials[i].Stale(now, a.staleAfter)}
+	}
+	return resp
+}
+
+// groupResponse is a group of dials, with their times in the configured format.
+type groupResponse struct {
+	Name  string              `json:"name"`
+	Dials []boardDialResponse `json:"dials"`
+}
+
+// boardResponse is a board, along with its dials grouped for display, with
+// times in the configured format.
+type boardResponse struct {
+	ooohh.Board
+	Dials     []boardDialResponse `json:"dials"`
+	Groups    []groupResponse     `json:"groups"`
+	UpdatedAt jsonTime            `json:"updated_at"`
+	Masked    bool                `json:"masked,omitempty"`
+}
+
+func (a *ooohhAPI) newBoardResponse(b ooohh.Board) boardResponse {
+	groups := make([]groupResponse, 0)
+	for _, g := range b.Groups() {
+		groups = append(groups, groupResponse{g.Name, a.newBoardDialResponses(g.Dials)})
+	}
+
+	return boardResponse{
+		Board:     b,
+		Dials:     a.newBoardDialResponses(b.Dials),
+		Groups:    groups,
+		UpdatedAt: jsonTime{b.UpdatedAt, a.timeFormat},
+	}
+}
+
+// createdBoardResponse is a newly created board, with the token that was
+// generated for it, if it was created without one.
+type createdBoardResponse struct {
+	boardResponse
+	Token string `json:"token,omitempty"`
+}
+
+// boardSummaryResponse is a board with only the IDs of its dials, with its
+// time in the configured format.
+type boardSummaryResponse struct {
+	ooohh.Board
+	Dials     []ooohh.DialID `json:"dials"`
+	UpdatedAt jsonTime       `json:"updated_at"`
+}
+
+func (a *ooohhAPI) newBoardSummaryResponse(b ooohh.Board) boardSummaryResponse {
+	ids := make([]ooohh.DialID, len(b.Dials))
+	for i := range b.Dials {
+		ids[i] = b.Dials[i].ID
+	}
+
+	return boardSummaryResponse{b, ids, jsonTime{b.UpdatedAt, a.timeFormat}}
+}
+
+// listBoards lists the boards created with the token given in the `token`
+// query parameter. Tokens that match no boards count towards the client's
+// lockout, so board tokens can't be guessed.
+func (a *ooohhAPI) listBoards() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if token == "" {
+			api.Problem(w, r, "Validation Error", "`token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		client := clientKey(r)
+		if a.listLockout.Locked(client, a.now()) {
+			api.Problem(w, r, "Too Many Requests", "Too many invalid token attempts, try again later", http.StatusTooManyRequests, withCode(codeLockedOut))
+			return
+		}
+
+		boards, err := a.s.ListBoardsByToken(r.Context(), token)
+		if err != nil {
+			a.requestLogger(r).Errorw("could not list boards", "err", err)
+			api.Problem(w, r, "Internal Server Error", "Could not list boards", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		if len(boards) == 0 {
+			a.listLockout.Fail(client, a.now())
+		} else {
+			a.listLockout.Reset(client)
+		}
+
+		resp := make([]boardSummaryResponse, len(boards))
+		for i := range boards {
+			resp[i] = a.newBoardSummaryResponse(boards[i])
+		}
+
+		api.Respond(w, r, http.StatusOK, resp)
+	})
+}
+
+// clientKey returns the key the request's client is locked out by, which is
+// the host of its address.
+func clientKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+func (a *ooohhAPI) getBoard() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		precision, ok := a.queryPrecision(w, r)
+		if !ok {
+			return
+		}
+
+		callback, ok := a.queryCallback(w, r)
+		if !ok {
+			return
+		}
+
+		b, err := a.s.GetBoard(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		if !a.canReadBoard(w, r, b) {
+			return
+		}
+
+		masked := a.maskBoard(r, b)
+
+		for i := range b.Dials {
+			roundDials(precision, &b.Dials[i])
+		}
+
+		resp := a.newBoardResponse(*b)
+		resp.Masked = masked
+
+		respondWithETag(w, r, callback, resp)
+	})
+}
+
+func (a *ooohhAPI) getBoardImage() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		b, err := a.s.GetBoard(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		if !a.canReadBoard(w, r, b) {
+			return
+		}
+
+		a.maskBoard(r, b)
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, ui.BoardImage(*b, a.minValue, a.maxValue)); err != nil {
+			a.requestLogger(r).Errorw("could not render board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not render board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		// Set status code value on request details so other middlewares can access it
+		if d := api.GetDetails(r); d != nil {
+			d.StatusCode = http.StatusOK
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf.Bytes()) //nolint:errcheck
+	})
+}
+
+// defaultBucketWidth is the width of the buckets a board's dial values are
+// counted in, if no width is given.
+const defaultBucketWidth = 10
+
+// bucket counts the dials with values in [Min, Max). The last bucket also
+// counts dials at its Max.
+type bucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int     `json:"count"`
+}
+
+// maxBuckets is the most buckets a board's dial values are counted in.
+const maxBuckets = 100
+
+// bucketWidths returns the narrowest and widest buckets can be. The widest is
+// the width of the range of dial values, and the narrowest keeps the number
+// of buckets within maxBuckets, both rounded up to a whole number.
+func bucketWidths(min, max float64) (int, int) {
+	return int(math.Max(math.Ceil((max-min)/maxBuckets), 1)), int(math.Max(math.Ceil(max-min), 1))
+}
+
+// newDistribution counts the dials in buckets of the given width, covering
+// the whole range of dial values, from min to max. The last bucket is
+// narrower if the width doesn't divide the range.
+func newDistribution(dials []ooohh.Dial, width int, min, max float64) []bucket {
+	w := float64(width)
+	n := int(math.Max(math.Ceil((max-min)/w), 1))
+
+	buckets := make([]bucket, 0, n)
+	for i := 0; i < n; i++ {
+		lower := min + float64(i)*w
+		buckets = append(buckets, bucket{Min: lower, Max: math.Min(lower+w, max)})
+	}
+
+	for _, d := range dials {
+		i := int(math.Floor((d.Value - min) / w))
+		if i >= len(buckets) {
+			i = len(buckets) - 1
+		} else if i < 0 {
+			i = 0
+		}
+		buckets[i].Count++
+	}
+
+	return buckets
+}
+
+func (a *ooohhAPI) getBoardDistribution() http.Handler {
+	type response struct {
+		Width   int      `json:"width"`
+		Buckets []bucket `json:"buckets"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		width := defaultBucketWidth
+		if q := r.URL.Query().Get("width"); q != "" {
+			var err error
+			width, err = strconv.Atoi(q)
+			if minWidth, maxWidth := bucketWidths(a.minValue, a.maxValue); err != nil || width < minWidth || width > maxWidth {
+				api.Problem(w, r, "Validation Error", fmt.Sprintf("`width` must be between %d and %d.", minWidth, maxWidth), http.StatusBadRequest, withCode(codeValidation))
+				return
+			}
+		}
+
+		b, err := a.s.GetBoard(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		if !a.canReadBoard(w, r, b) {
+			return
+		}
+
+		a.maskBoard(r, b)
+
+		api.Respond(w, r, http.StatusOK, response{width, newDistribution(b.Dials, width, a.minValue, a.maxValue)})
+	})
+}
+
+// rankedDial is a dial's place on a board's leaderboard.
+type rankedDial struct {
+	Rank  int          `json:"rank"`
+	ID    ooohh.DialID `json:"id"`
+	Name  string       `json:"name"`
+	Value float64      `json:"value"`
+}
+
+// rankDials ranks the dials by highest value first. Dials with equal values
+// share a rank, and are ordered by name, then ID, so the order is stable.
+func rankDials(dials []ooohh.Dial) []rankedDial {
+	sorted := make([]ooohh.Dial, len(dials))
+	copy(sorted, dials)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Value != sorted[j].Value {
+			return sorted[i].Value > sorted[j].Value
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	ranked := make([]rankedDial, len(sorted))
+	for i, d := range sorted {
+		rank := i + 1
+		if i > 0 && d.Value == sorted[i-1].Value {
+			rank = ranked[i-1].Rank
+		}
+		ranked[i] = rankedDial{rank, d.ID, d.Name, d.Value}
+	}
+
+	return ranked
+}
+
+func (a *ooohhAPI) getBoardLeaderboard() http.Handler {
+	type response struct {
+		Dials  []rankedDial `json:"dials"`
+		Masked bool         `json:"masked,omitempty"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		b, err := a.s.GetBoard(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not retrieve board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		if !a.canReadBoard(w, r, b) {
+			return
+		}
+
+		// Masked dials are ranked after masking, so their order doesn't give
+		// their values away.
+		masked := a.maskBoard(r, b)
+
+		api.Respond(w, r, http.StatusOK, response{rankDials(b.Dials), masked})
+	})
+}
+
+// canReadBoard reports whether the board can be read by the request. Private
+// boards can only be read with their token, and a problem is written if the
+// request doesn't have it.
+func (a *ooohhAPI) canReadBoard(w http.ResponseWriter, r *http.Request, b *ooohh.Board) bool {
+	if a.boardReadable(r, b) {
+		return true
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		api.Problem(w, r, "Unauthorized", "Board token required", http.StatusUnauthorized, withCode(codeTokenRequired))
+		return false
+	}
+
+	api.Problem(w, r, "Forbidden", "Invalid token", http.StatusForbidden, withCode(codeForbidden))
+	return false
+}
+
+// boardReadable reports whether the board can be read by the request, without
+// writing a problem if it can't.
+func (a *ooohhAPI) boardReadable(r *http.Request, b *ooohh.Board) bool {
+	if !a.privateBoards {
+		return true
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return token != "" && ooohh.TokenMatches(b.Token, token)
+}
+
+// maskBoard masks the board's dials, if boards are masked and the request
+// doesn't have the board token. It reports whether the board was masked.
+func (a *ooohhAPI) maskBoard(r *http.Request, b *ooohh.Board) bool {
+	if !a.maskedBoards {
+		return false
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	if ooohh.TokenMatches(b.Token, token) {
+		return false
+	}
+
+	b.Mask()
+
+	return true
+}
+
+func (a *ooohhAPI) setBoardDials() http.Handler {
+	type request struct {
+		Token       string    `json:"token"`
+		Name        *string   `json:"name,omitempty"`
+		Description *string   `json:"description,omitempty"`
+		Dials       *[]string `json:"dials,omitempty"`
+		OwnsDials   *bool     `json:"owns_dials,omitempty"`
+		Frozen      *bool     `json:"frozen,omitempty"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Token == "" || (body.Name == nil && body.Description == nil && body.Dials == nil && body.OwnsDials == nil && body.Frozen == nil) {
+			api.Problem(w, r, "Validation Error", "`token` and at least one of `name`, `description`, `dials`, `owns_dials` or `frozen` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if body.Name != nil && *body.Name == "" {
+			api.Problem(w, r, "Validation Error", "`name` must not be empty.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		if body.Description != nil && !ooohh.ValidDescription(*body.Description) {
+			api.Problem(w, r, "Validation Error", fmt.Sprintf("`description` must be at most %d characters.", ooohh.MaxDescriptionLength), http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		// Guard against accidentally wiping a board, clearing has its own endpoint.
+		if body.Dials != nil && len(*body.Dials) == 0 {
+			api.Problem(w, r, "Validation Error", "`dials` must not be empty, use DELETE /api/boards/:id/dials to remove all dials.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		u := ooohh.BoardUpdate{
+			Name:        body.Name,
+			Description: body.Description,
+			OwnsDials:   body.OwnsDials,
+			Frozen:      body.Frozen,
+		}
+		if body.Dials != nil {
+			dials := make([]ooohh.DialID, len(*body.Dials))
+			for i := range dials {
+				dials[i] = ooohh.DialID((*body.Dials)[i])
+			}
+			u.Dials = &dials
+		}
+
+		// The changes are made at once, so a failed update changes nothing.
+		err = a.s.UpdateBoard(r.Context(), id, body.Token, u)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			} else if errors.Is(err, ooohh.ErrBoardFrozen) {
+				api.Problem(w, r, "Conflict", "Board is frozen", http.StatusConflict, withCode(codeBoardFrozen))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not update board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not update board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		// Read the board from where it was written, so the update is seen.
+		b, err := a.s.GetBoard(ooohh.WithPrimaryReads(r.Context()), id)
+		if err != nil {
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not update board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		api.Respond(w, r, http.StatusOK, a.newBoardResponse(*b))
+	})
+}
+
+func (a *ooohhAPI) deleteBoard() http.Handler {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Token == "" {
+			api.Problem(w, r, "Validation Error", "`token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		err = a.s.DeleteBoard(r.Context(), id, body.Token)
+		if err != nil {
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not delete board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not delete board", http.StatusInternalServerError, withCode(codeInternal))
+			return
+		}
+
+		api.Respond(w, r, http.StatusNoContent, nil)
+	})
+}
+
+func (a *ooohhAPI) clearBoardDials() http.Handler {
+	type request struct {
 		Token string `json:"token"`
 	}
-	type response ooohh.Dial
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
 		var body request
 		err := api.Decode(w, r, &body)
 		if err != nil {
-			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest)
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
 			return
 		}
 
-		if body.Name == "" || body.Token == "" {
-			api.Problem(w, r, "Validation Error", "Both `name` and `token` must be provided.", http.StatusBadRequest)
+		if body.Token == "" {
+			api.Problem(w, r, "Validation Error", "`token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
 			return
 		}
 
-		d, err := a.s.CreateDial(r.Context(), body.Name, body.Token)
+		err = a.s.SetBoard(r.Context(), id, body.Token, []ooohh.DialID{})
 		if err != nil {
-			a.logger.Errorw("could not create dial", "err", err)
-			api.Problem(w, r, "Internal Server Error", "Could not create dial", http.StatusInternalServerError)
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
+				return
+			} else if errors.Is(err, ooohh.ErrUnauthorized) {
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			} else if errors.Is(err, ooohh.ErrBoardFrozen) {
+				api.Problem(w, r, "Conflict", "Board is frozen", http.StatusConflict, withCode(codeBoardFrozen))
+				return
+			}
+
+			a.requestLogger(r).Errorw("could not clear board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not clear board", http.StatusInternalServerError, withCode(codeInternal))
 			return
 		}
 
-		api.Respond(w, r, http.StatusCreated, response(*d))
-	})
-}
-
-func (a *ooohhAPI) getDial() http.Handler {
-	type response ooohh.Dial
-
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id := ooohh.DialID(api.URLParam(r, "id"))
-
-		d, err := a.s.GetDial(r.Context(), id)
+		// Read the board from where it was written, so the update is seen.
+		b, err := a.s.GetBoard(ooohh.WithPrimaryReads(r.Context()), id)
 		if err != nil {
-			if errors.Is(err, ooohh.ErrDialNotFound) {
-				api.NotFound(w, r)
-				return
-			}
-
-			a.logger.Errorw("could not retrieve dial", "err", err, "id", id)
-			api.Problem(w, r, "Internal Server Error", "Could not retrieve dial", http.StatusInternalServerError)
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not clear board", http.StatusInternalServerError, withCode(codeInternal))
 			return
 		}
 
-		api.Respond(w, r, http.StatusOK, response(*d))
+		api.Respond(w, r, http.StatusOK, a.newBoardResponse(*b))
 	})
 }
 
-func (a *ooohhAPI) setDialValue() http.Handler {
+func (a *ooohhAPI) addBoardDial() http.Handler {
 	type request struct {
-		Token string   `json:"token"`
-		Value *float64 `json:"value,omitempty"`
+		Token  string `json:"token"`
+		DialID string `json:"dial_id"`
 	}
-	type response ooohh.Dial
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id := ooohh.DialID(api.URLParam(r, "id"))
+		id := ooohh.BoardID(api.URLParam(r, "id"))
 
 		var body request
 		err := api.Decode(w, r, &body)
 		if err != nil {
-			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest)
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
 			return
 		}
 
-		if body.Token == "" || body.Value == nil {
-			api.Problem(w, r, "Validation Error", "Both `token` and `value` must be provided.", http.StatusBadRequest)
+		if body.Token == "" || body.DialID == "" {
+			api.Problem(w, r, "Validation Error", "Both `token` and `dial_id` must be provided.", http.StatusBadRequest, withCode(codeValidation))
 			return
 		}
 
-		err = a.s.SetDial(r.Context(), id, body.Token, *body.Value)
+		err = a.s.AddBoardDial(r.Context(), id, body.Token, ooohh.DialID(body.DialID))
 		if err != nil {
-			if errors.Is(err, ooohh.ErrDialNotFound) {
-				api.NotFound(w, r)
-				return
-			} else if errors.Is(err, ooohh.ErrDialValueInvalid) {
-				api.Problem(w, r, "Bad Request", "Invalid value", http.StatusBadRequest)
+			if errors.Is(err, ooohh.ErrBoardNotFound) {
+				api.NotFound(w, r, withCode(codeBoardNotFound))
 				return
 			} else if errors.Is(err, ooohh.ErrUnauthorized) {
-				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized)
+				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+				return
+			} else if errors.Is(err, ooohh.ErrBoardFrozen) {
+				api.Problem(w, r, "Conflict", "Board is frozen", http.StatusConflict, withCode(codeBoardFrozen))
 				return
 			}
 
-			a.logger.Errorw("could not update dial", "err", err, "id", id)
-			api.Problem(w, r, "Internal Server Error", "Could not update dial", http.StatusInternalServerError)
+			a.requestLogger(r).Errorw("could not add dial to board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not add dial to board", http.StatusInternalServerError, withCode(codeInternal))
 			return
 		}
 
-		d, err := a.s.GetDial(r.Context(), id)
+		// Read the board from where it was written, so the update is seen.
+		b, err := a.s.GetBoard(ooohh.WithPrimaryReads(r.Context()), id)
 		if err != nil {
-			a.logger.Errorw("could not retrieve dial", "err", err, "id", id)
-			api.Problem(w, r, "Internal Server Error", "Could not update dial", http.StatusInternalServerError)
+			a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+			api.Problem(w, r, "Internal Server Error", "Could not add dial to board", http.StatusInternalServerError, withCode(codeInternal))
 			return
 		}
 
-		api.Respond(w, r, http.StatusOK, response(*d))
+		api.Respond(w, r, http.StatusOK, a.newBoardResponse(*b))
 	})
 }
 
-func (a *ooohhAPI) createBoard() http.Handler {
+func (a *ooohhAPI) leaderboard() http.Handler {
 	type request struct {
-		Name  string `json:"name"`
-		Token string `json:"token"`
+		Boards []string `json:"boards"`
 	}
-	type response ooohh.Board
+	type entry struct {
+		ID      ooohh.BoardID `json:"id"`
+		Name    string        `json:"name"`
+		Average float64       `json:"average"`
+		Dials   int           `json:"dials"`
+		Masked  bool          `json:"masked,omitempty"`
+	}
+	type response []entry
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var body request
 		err := api.Decode(w, r, &body)
 		if err != nil {
-			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest)
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
 			return
 		}
 
-		if body.Name == "" || body.Token == "" {
-			api.Problem(w, r, "Validation Error", "Both `name` and `token` must be provided.", http.StatusBadRequest)
+		if len(body.Boards) == 0 {
+			api.Problem(w, r, "Validation Error", "`boards` must be provided.", http.StatusBadRequest, withCode(codeValidation))
 			return
 		}
 
-		b, err := a.s.CreateBoard(r.Context(), body.Name, body.Token)
-		if err != nil {
-			a.logger.Errorw("could not create board", "err", err)
-			api.Problem(w, r, "Internal Server Error", "Could not create board", http.StatusInternalServerError)
+		if len(body.Boards) > maxBatchSize {
+			api.Problem(w, r, "Validation Error", fmt.Sprintf("At most %d `boards` can be provided.", maxBatchSize), http.StatusBadRequest, withCode(codeValidation))
 			return
 		}
 
-		api.Respond(w, r, http.StatusCreated, response(*b))
-	})
-}
-
-func (a *ooohhAPI) getBoard() http.Handler {
-	type response ooohh.Board
-
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id := ooohh.BoardID(api.URLParam(r, "id"))
+		// Boards that are not found, or can't be read by the request, are
+		// omitted.
+		resp := make(response, 0, len(body.Boards))
+		for _, id := range body.Boards {
+			b, err := a.s.GetBoard(r.Context(), ooohh.BoardID(id))
+			if err != nil {
+				if errors.Is(err, ooohh.ErrBoardNotFound) {
+					continue
+				}
 
-		b, err := a.s.GetBoard(r.Context(), id)
-		if err != nil {
-			if errors.Is(err, ooohh.ErrBoardNotFound) {
-				api.NotFound(w, r)
+				a.requestLogger(r).Errorw("could not retrieve board", "err", err, "id", id)
+				api.Problem(w, r, "Internal Server Error", "Could not retrieve boards", http.StatusInternalServerError, withCode(codeInternal))
 				return
 			}
 
-			a.logger.Errorw("could not retrieve board", "err", err, "id", id)
-			api.Problem(w, r, "Internal Server Error", "Could not retrieve board", http.StatusInternalServerError)
-			return
+			if !a.boardReadable(r, b) {
+				continue
+			}
+
+			// Masked boards are ranked by their masked average.
+			masked := a.maskBoard(r, b)
+
+			resp = append(resp, entry{b.ID, b.Name, b.Average(), len(b.Dials), masked})
 		}
 
-		api.Respond(w, r, http.StatusOK, response(*b))
+		// Rank by highest average first.
+		sort.SliceStable(resp, func(i, j int) bool {
+			return resp[i].Average > resp[j].Average
+		})
+
+		api.Respond(w, r, http.StatusOK, resp)
 	})
 }
 
-func (a *ooohhAPI) setBoardDials() http.Handler {
+func (a *ooohhAPI) addWebhook() http.Handler {
 	type request struct {
-		Token string    `json:"token"`
-		Dials *[]string `json:"dials,omitempty"`
+		Token string `json:"token"`
+		URL   string `json:"url"`
 	}
-	type response ooohh.Board
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Webhooks are only available if configured.
+		if a.ws == nil {
+			api.NotFound(w, r, withCode(codeNotFound))
+			return
+		}
+
 		id := ooohh.BoardID(api.URLParam(r, "id"))
 
 		var body request
 		err := api.Decode(w, r, &body)
 		if err != nil {
-			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest)
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
 			return
 		}
 
-		if body.Token == "" || body.Dials == nil {
-			api.Problem(w, r, "Validation Error", "Both `token` and `dials` must be provided.", http.StatusBadRequest)
+		if body.Token == "" || body.URL == "" {
+			api.Problem(w, r, "Validation Error", "Both `token` and `url` must be provided.", http.StatusBadRequest, withCode(codeValidation))
 			return
 		}
 
-		dials := make([]ooohh.DialID, len(*body.Dials))
-		for i := range dials {
-			dials[i] = ooohh.DialID((*body.Dials)[i])
-		}
-
-		err = a.s.SetBoard(r.Context(), id, body.Token, dials)
+		wh, err := a.ws.AddWebhook(r.Context(), id, body.Token, body.URL)
 		if err != nil {
-			if errors.Is(err, ooohh.ErrBoardNotFound) {
-				api.NotFound(w, r)
+			if errors.Is(err, webhook.ErrURLInvalid) {
+				api.Problem(w, r, "Validation Error", "`url` must be an absolute http or https URL.", http.StatusBadRequest, withCode(codeValidation))
 				return
-			} else if errors.Is(err, ooohh.ErrUnauthorized) {
-				api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized)
+			} else if errors.Is(err, webhook.ErrURLPrivate) {
+				api.Problem(w, r, "Validation Error", "`url` must not be for a private address.", http.StatusBadRequest, withCode(codeValidation))
 				return
 			}
+			a.webhookError(w, r, err, id, "Could not add webhook")
+			return
+		}
+
+		api.Respond(w, r, http.StatusCreated, a.newWebhookResponse(*wh))
+	})
+}
 
-			a.logger.Errorw("could not update board", "err", err, "id", id)
-			api.Problem(w, r, "Internal Server Error", "Could not update board", http.StatusInternalServerError)
+// listWebhooks lists the board's webhooks. As GET requests have no body, the
+// board token is given as a bearer token.
+func (a *ooohhAPI) listWebhooks() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Webhooks are only available if configured.
+		if a.ws == nil {
+			api.NotFound(w, r, withCode(codeNotFound))
 			return
 		}
 
-		b, err := a.s.GetBoard(r.Context(), id)
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+			return
+		}
+
+		webhooks, err := a.ws.ListWebhooks(r.Context(), id, token)
+		if err != nil {
+			a.webhookError(w, r, err, id, "Could not retrieve webhooks")
+			return
+		}
+
+		resp := make([]webhookResponse, len(webhooks))
+		for i := range webhooks {
+			resp[i] = a.newWebhookResponse(webhooks[i])
+		}
+
+		api.Respond(w, r, http.StatusOK, resp)
+	})
+}
+
+func (a *ooohhAPI) deleteWebhook() http.Handler {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Webhooks are only available if configured.
+		if a.ws == nil {
+			api.NotFound(w, r, withCode(codeNotFound))
+			return
+		}
+
+		id := ooohh.BoardID(api.URLParam(r, "id"))
+
+		var body request
+		err := api.Decode(w, r, &body)
+		if err != nil {
+			api.Problem(w, r, "Validation Error", "Invalid JSON", http.StatusBadRequest, withCode(codeInvalidJSON))
+			return
+		}
+
+		if body.Token == "" {
+			api.Problem(w, r, "Validation Error", "`token` must be provided.", http.StatusBadRequest, withCode(codeValidation))
+			return
+		}
+
+		err = a.ws.DeleteWebhook(r.Context(), id, body.Token, api.URLParam(r, "webhookID"))
 		if err != nil {
-			a.logger.Errorw("could not retrieve board", "err", err, "id", id)
-			api.Problem(w, r, "Internal Server Error", "Could not update board", http.StatusInternalServerError)
+			a.webhookError(w, r, err, id, "Could not delete webhook")
 			return
 		}
 
-		api.Respond(w, r, http.StatusOK, response(*b))
+		api.Respond(w, r, http.StatusNoContent, nil)
 	})
 }
 
+// webhookError responds with the problem matching an error from the webhook
+// service, using the given detail for unexpected errors.
+func (a *ooohhAPI) webhookError(w http.ResponseWriter, r *http.Request, err error, id ooohh.BoardID, detail string) {
+	if errors.Is(err, ooohh.ErrBoardNotFound) {
+		api.NotFound(w, r, withCode(codeBoardNotFound))
+		return
+	} else if errors.Is(err, webhook.ErrWebhookNotFound) {
+		api.NotFound(w, r, withCode(codeWebhookNotFound))
+		return
+	} else if errors.Is(err, ooohh.ErrUnauthorized) {
+		api.Problem(w, r, "Unauthorized", "Invalid token", http.StatusUnauthorized, withCode(codeUnauthorized))
+		return
+	}
+
+	a.requestLogger(r).Errorw("could not manage webhooks", "err", err, "id", id)
+	api.Problem(w, r, "Internal Server Error", detail, http.StatusInternalServerError, withCode(codeInternal))
+}
+
 func (a *ooohhAPI) slackCommand() http.Handler {
 	type request struct {
 		Command  string
@@ -330,26 +2628,60 @@ func (a *ooohhAPI) slackCommand() http.Handler {
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-		err := r.ParseForm()
+		// Slack always sends a form body.
+		if r.Body == nil {
+			a.requestLogger(r).Errorw("could not parse form", "err", "missing form body")
+			// Return with a 500 to tell slack that we couldn't process this request.
+			api.Problem(w, r, "Invalid Request", "Could not parse form", http.StatusInternalServerError, withCode(codeInvalidRequest))
+			return
+		}
+
+		// Read the raw body once, so the signature is verified over exactly the
+		// bytes that the form is then parsed from.
+		raw, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, a.slackMaxBody))
+		if err != nil {
+			a.requestLogger(r).Infow("could not read slack request", "err", err)
+			api.Problem(w, r, "Invalid Request", "Could not read body", http.StatusBadRequest, withCode(codeInvalidRequest))
+			return
+		}
+
+		err = a.verifySlackRequest(r, raw)
 		if err != nil {
-			a.logger.Errorw("could not parse form", "err", err)
+			a.requestLogger(r).Infow("could not verify slack request", "err", err)
+			api.Problem(w, r, "Unauthorized", "Could not verify request", http.StatusUnauthorized, withCode(codeUnauthorized))
+			return
+		}
+
+		form, err := url.ParseQuery(string(raw))
+		if err != nil {
+			a.requestLogger(r).Errorw("could not parse form", "err", err)
 			// Return with a 500 to tell slack that we couldn't process this request.
-			api.Problem(w, r, "Invalid Request", "Could not parse form", http.StatusInternalServerError)
+			api.Problem(w, r, "Invalid Request", "Could not parse form", http.StatusInternalServerError, withCode(codeInvalidRequest))
 			return
 		}
 
 		body := request{
-			Command:  r.FormValue("command"),
-			Text:     r.FormValue("text"),
-			UserID:   r.FormValue("user_id"),
-			UserName: r.FormValue("user_name"),
-			TeamID:   r.FormValue("team_id"),
+			Command:  form.Get("command"),
+			Text:     form.Get("text"),
+			UserID:   form.Get("user_id"),
+			UserName: form.Get("user_name"),
+			TeamID:   form.Get("team_id"),
 		}
 
 		if body.Command == "" || body.UserID == "" || body.TeamID == "" {
-			a.logger.Errorw("could not parse request", "body", body)
+			a.requestLogger(r).Errorw("could not parse request", "body", body)
 			// Return with a 500 to tell slack that we couldn't process this request.
-			api.Problem(w, r, "Invalid Request", "Could not parse form values", http.StatusInternalServerError)
+			api.Problem(w, r, "Invalid Request", "Could not parse form values", http.StatusInternalServerError, withCode(codeInvalidRequest))
+			return
+		}
+
+		// Check the workspace is allowed to use this instance.
+		if a.slackTeams != nil && !a.slackTeams[body.TeamID] {
+			a.requestLogger(r).Infow("slack team not allowed", "team", body.TeamID)
+			api.Respond(w, r, http.StatusOK, response{
+				Type: "ephemeral",
+				Text: "Sorry, this workspace isn't set up to use ooohh.",
+			})
 			return
 		}
 
@@ -368,19 +2700,73 @@ func (a *ooohhAPI) slackCommand() http.Handler {
 		if t == "help" {
 			api.Respond(w, r, http.StatusOK, response{
 				Type: "ephemeral",
-				Text: "Use the following format to set a value: `/wtf <number>`",
+				Text: "Use the following format to set a value: `/wtf <number>`, or `/wtf <dial name> <number>` to set one of your named dials.",
+			})
+			return
+		}
+
+		// Add the user's dial to a board, e.g. `board <board id> <board token>`.
+		if args := strings.Fields(t); len(args) > 0 && args[0] == "board" {
+			if len(args) != 3 {
+				api.Respond(w, r, http.StatusOK, response{
+					Type: "ephemeral",
+					Text: "Use the following format to add your dial to a board: `/wtf board <board id> <board token>`",
+				})
+				return
+			}
+
+			err := a.ss.AddToBoard(r.Context(), body.TeamID, body.UserID, body.UserName, args[1], args[2])
+			if err != nil {
+
+				// Calculate the response text based on the error value.
+				text := "Oops, something didn't quite work out. Please, try again."
+				if errors.Is(err, ooohh.ErrUnauthorized) {
+					text = "That board token isn't right. Please check it with whoever created the board, and try again."
+				} else if errors.Is(err, ooohh.ErrBoardNotFound) {
+					text = fmt.Sprintf("Board %s wasn't found. Please check the board id, and try again.", args[1])
+				} else if errors.Is(err, ooohh.ErrBoardFrozen) {
+					text = "That board is frozen, so dials can't be added to it right now."
+				}
+
+				api.Respond(w, r, http.StatusOK, response{
+					Type: "ephemeral",
+					Text: text,
+				})
+				return
+			}
+
+			api.Respond(w, r, http.StatusOK, response{
+				Type: "ephemeral",
+				Text: fmt.Sprintf("Your dial is on board %s.", args[1]),
 			})
 			return
 		}
 
+		// Use a named dial if the text starts with its name, followed by a
+		// value or query, e.g. `prod 80` or `prod ?`. Otherwise, the user's
+		// default dial is used.
+		dialName := slack.DefaultDial
+		if first, rest := splitNote(t); rest != "" && !isNumber(first) {
+			if next, _ := splitNote(rest); next == "?" || isNumber(next) {
+				dialName, t = strings.ToLower(first), rest
+			}
+		}
+
+		yourDial := "Your dial"
+		if dialName != slack.DefaultDial {
+			yourDial = fmt.Sprintf("Your %s dial", dialName)
+		}
+
 		// Query for value.
 		if t == "?" {
-			d, err := a.ss.GetDial(r.Context(), body.TeamID, body.UserID)
+			d, err := a.ss.GetDial(r.Context(), body.TeamID, body.UserID, dialName)
 			if err != nil {
 
 				// Calculate the response text based on the error value.
 				text := "Oops, something didn't quite work out. Please, try again."
-				if errors.Is(err, slack.ErrDialNotFound) {
+				if errors.Is(err, slack.ErrDialNotFound) && dialName != slack.DefaultDial {
+					text = fmt.Sprintf("You don't have a %s dial yet. Use the following format to set it: `/wtf %s <number>`", dialName, dialName)
+				} else if errors.Is(err, slack.ErrDialNotFound) {
 					text = "Use the following format to set a value: `/wtf <number>`"
 				}
 
@@ -393,11 +2779,14 @@ func (a *ooohhAPI) slackCommand() http.Handler {
 
 			api.Respond(w, r, http.StatusOK, response{
 				Type: "ephemeral",
-				Text: fmt.Sprintf("Your dial (%s) is set to %.1f.", d.ID, d.Value),
+				Text: fmt.Sprintf("%s (%s) is set to %s.", yourDial, d.ID, ooohh.FormatValue(d.Value, a.precision)),
 			})
 			return
 		}
 
+		// Split off any note given after the value, e.g. `80 prod is on fire`.
+		t, note := splitNote(t)
+
 		// Parse text into a float64. Respond with message if not ok.
 		value, err := strconv.ParseFloat(t, 64)
 		if err != nil {
@@ -426,12 +2815,28 @@ func (a *ooohhAPI) slackCommand() http.Handler {
 			return
 		}
 
+		// Check number is within bounds, before a dial is created for it.
+		outOfBounds := fmt.Sprintf("Value out of bounds. Please supply a number between %s and %s.",
+			strconv.FormatFloat(a.minValue, 'f', -1, 64),
+			strconv.FormatFloat(a.maxValue, 'f', -1, 64),
+		)
+		if value < a.minValue || value > a.maxValue {
+			a.requestLogger(r).Infow("slack value out of bounds", "value", value, "team", body.TeamID, "user", body.UserID)
+			api.Respond(w, r, http.StatusOK, response{
+				Type: "ephemeral",
+				Text: outOfBounds,
+			})
+			return
+		}
+
 		// Set value.
-		err = a.ss.SetDialValue(r.Context(), body.TeamID, body.UserID, body.UserName, value)
+		d, created, err := a.ss.SetDialValue(r.Context(), body.TeamID, body.UserID, body.UserName, dialName, value, note)
 		if err != nil {
 			text := "Oops, something didn't quite work out. Please, try again."
 			if errors.Is(err, ooohh.ErrDialValueInvalid) {
-				text = "Value out of bounds. Please upply number between 0 and 100."
+				text = outOfBounds
+			} else if errors.Is(err, ooohh.ErrBoardFrozen) {
+				text = fmt.Sprintf("%s is on a frozen board, so can't be set right now.", yourDial)
 			}
 
 			api.Respond(w, r, http.StatusOK, response{
@@ -442,11 +2847,19 @@ func (a *ooohhAPI) slackCommand() http.Handler {
 		}
 
 		// Calculate response text.
-		text := "Ooohh, I wish I felt like that."
-		if value > 75 {
-			text = "Ooohh, make sure you check in with someone, maybe they can help."
-		} else if value > 50 {
-			text = "Ooohh, make sure you take a break!"
+		text := a.valueMessages.message(value)
+
+		// Welcome the user if this is the first time they've set their dial.
+		// Named dials are created once the user is already welcome.
+		if created && dialName != slack.DefaultDial {
+			text = fmt.Sprintf("%s (%s) has been created. %s", yourDial, d.ID, text)
+		} else if created {
+			text = fmt.Sprintf("Welcome to ooohh! Your dial (%s) has been created. %s", d.ID, text)
+		}
+
+		// Acknowledge the note.
+		if note != "" {
+			text = fmt.Sprintf("%s Noted: %q.", text, note)
 		}
 
 		// Respond with ok.
@@ -456,3 +2869,243 @@ func (a *ooohhAPI) slackCommand() http.Handler {
 		})
 	})
 }
+
+// isNumber reports whether the word of a Slack command is a number.
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// splitNote splits the text of a Slack command into its first word, and the
+// note made by the rest of the text, if any.
+func splitNote(t string) (string, string) {
+	i := strings.IndexFunc(t, unicode.IsSpace)
+	if i < 0 {
+		return t, ""
+	}
+
+	return t[:i], strings.TrimSpace(t[i:])
+}
+
+// requireAdmin is middleware that guards admin endpoints, which list or export
+// everything, so must not be open to anyone. Requests must be authorized with
+// the admin token, otherwise a 401 is returned. If no admin token is
+// configured, the endpoints are disabled, so a 404 is returned.
+func (a *ooohhAPI) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.adminToken == "" {
+			api.NotFound(w, r, withCode(codeNotFound))
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+a.adminToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			api.Problem(w, r, "Unauthorized", "Admin token required", http.StatusUnauthorized, withCode(codeUnauthorized))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// queryPrecision returns the number of decimal places requested with the
+// `precision` query parameter, or -1 if none was. A problem is written, and
+// false returned, if the parameter is invalid.
+func (a *ooohhAPI) queryPrecision(w http.ResponseWriter, r *http.Request) (int, bool) {
+	q := r.URL.Query().Get("precision")
+	if q == "" {
+		return -1, true
+	}
+
+	p, err := strconv.Atoi(q)
+	if err != nil || p < 0 || p > maxPrecision {
+		api.Problem(w, r, "Validation Error", fmt.Sprintf("`precision` must be between 0 and %d.", maxPrecision), http.StatusBadRequest, withCode(codeValidation))
+		return 0, false
+	}
+
+	return p, true
+}
+
+// callbackRegexp matches safe JSONP callback names, i.e. JavaScript
+// identifiers, optionally namespaced with dots, e.g. `fn` or `app.render`.
+var callbackRegexp = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*(\.[a-zA-Z_$][a-zA-Z0-9_$]*)*$`)
+
+// queryCallback returns the JSONP callback requested with the `callback` query
+// parameter, or the empty string if none was, or JSONP isn't enabled. A problem
+// is written, and false returned, if the callback name isn't safe.
+func (a *ooohhAPI) queryCallback(w http.ResponseWriter, r *http.Request) (string, bool) {
+	q := r.URL.Query().Get("callback")
+	if !a.jsonp || q == "" {
+		return "", true
+	}
+
+	if len(q) > maxCallbackLength || !callbackRegexp.MatchString(q) {
+		api.Problem(w, r, "Validation Error", "`callback` must be a JavaScript identifier.", http.StatusBadRequest, withCode(codeValidation))
+		return "", false
+	}
+
+	return q, true
+}
+
+// respondJSONP responds with the data as JSON, wrapped in a call to the given
+// callback. If the callback is empty, the data is responded with as plain JSON.
+func respondJSONP(w http.ResponseWriter, r *http.Request, callback string, code int, data interface{}) {
+	if callback == "" {
+		api.Respond(w, r, code, data)
+		return
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		api.Problem(w, r, "Internal Server Error", "Could not encode response", http.StatusInternalServerError, withCode(codeInternal))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/javascript")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	// Record the status code for logging and metrics, as api.Respond does.
+	if d := api.GetDetails(r); d != nil {
+		d.StatusCode = code
+	}
+	w.WriteHeader(code)
+
+	// The leading comment stops the response being interpreted as anything
+	// other than a function call.
+	fmt.Fprintf(w, "/**/%s(%s);", callback, b)
+}
+
+// respondWithETag responds like respondJSONP, with a 200, but also sets a weak
+// ETag computed from the response. If the request's If-None-Match header
+// matches the ETag, a 304 is responded with instead, without a body, so
+// polling clients only download the response when it changes. The ETag is a
+// hash of the response, so it changes with the dials' values and update times,
+// and with anything else the response depends on, such as masking.
+func respondWithETag(w http.ResponseWriter, r *http.Request, callback string, data interface{}) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		api.Problem(w, r, "Internal Server Error", "Could not encode response", http.StatusInternalServerError, withCode(codeInternal))
+		return
+	}
+
+	h := sha256.New()
+	h.Write([]byte(callback)) //nolint:errcheck
+	h.Write(b)                //nolint:errcheck
+	etag := fmt.Sprintf("W/%q", hex.EncodeToString(h.Sum(nil)[:16]))
+
+	w.Header().Set("ETag", etag)
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		// Record the status code for logging and metrics, as api.Respond does.
+		if d := api.GetDetails(r); d != nil {
+			d.StatusCode = http.StatusNotModified
+		}
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	respondJSONP(w, r, callback, http.StatusOK, data)
+}
+
+// etagMatches reports whether the If-None-Match header matches the ETag, using
+// the weak comparison, so the W/ prefix is ignored.
+func etagMatches(header, etag string) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, t := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(t), "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// roundDials rounds the values of the dials to the given number of decimal
+// places, if it isn't negative.
+func roundDials(precision int, dials ...*ooohh.Dial) {
+	if precision < 0 {
+		return
+	}
+
+	for _, d := range dials {
+		d.Value = ooohh.RoundValue(d.Value, precision)
+	}
+}
+
+// streamJSONArray writes the values given to write by fn as a JSON array,
+// encoding each as it is given rather than holding them all in memory. The
+// response is flushed periodically.
+func streamJSONArray(w http.ResponseWriter, fn func(write func(v interface{}) error) error) error {
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	n := 0
+	err := fn(func(v interface{}) error {
+		if n > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+
+		n++
+		if flusher != nil && n%streamFlushInterval == 0 {
+			flusher.Flush()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]")
+
+	return err
+}
+
+// verifySlackRequest checks the request was signed by Slack recently, as
+// described at https://api.slack.com/authentication/verifying-requests-from-slack.
+// The signature is checked over the given raw request body. Requests can't be
+// verified without a signing secret, so are rejected, unless insecure requests
+// are allowed.
+func (a *ooohhAPI) verifySlackRequest(r *http.Request, body []byte) error {
+	if a.slackSecret == "" {
+		if a.slackInsecure {
+			return nil
+		}
+		return errors.New("no signing secret configured")
+	}
+
+	ts := r.Header.Get("X-Slack-Request-Timestamp")
+	secs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return errors.New("invalid timestamp")
+	}
+
+	// Reject requests outside of the tolerance, to prevent replays.
+	skew := a.now().Sub(time.Unix(secs, 0))
+	if skew > a.slackTolerance || skew < -a.slackTolerance {
+		return errors.New("timestamp outside of tolerance")
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.slackSecret))
+	fmt.Fprintf(mac, "v0:%s:%s", ts, body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(r.Header.Get("X-Slack-Signature"))) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
@@ -3,17 +3,25 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"image/png"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/boltdb/bolt"
 	"github.com/dlmiddlecote/kit/api"
 	"github.com/julienschmidt/httprouter"
 	"github.com/matryer/is"
@@ -23,8 +31,11 @@ import (
 
 	"github.com/dlmiddlecote/ooohh"
 	"github.com/dlmiddlecote/ooohh/pkg/mock"
+	"github.com/dlmiddlecote/ooohh/pkg/service"
 	"github.com/dlmiddlecote/ooohh/pkg/slack"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
 	"github.com/dlmiddlecote/ooohh/pkg/ui"
+	"github.com/dlmiddlecote/ooohh/pkg/webhook"
 )
 
 // newTestLogger returns a logger usable in tests, and also a struct that captures log lines
@@ -35,6 +46,41 @@ func newTestLogger(level zapcore.LevelEnabler) (*zap.SugaredLogger, *observer.Ob
 	return zap.New(core).Sugar(), recorded
 }
 
+// boolPtr returns a pointer to the given bool.
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// stringPtr returns a pointer to the given string.
+func stringPtr(s string) *string {
+	return &s
+}
+
+// newTestRouter returns a router serving all of the endpoints of the given API,
+// without any of the server middleware.
+func newTestRouter(a *ooohhAPI) http.Handler {
+	router := httprouter.New()
+
+	// Ensure paths are never redirected, so tests show them being rewritten.
+	router.RedirectTrailingSlash = false
+
+	for _, e := range a.Endpoints() {
+		e := e
+
+		// Wrap the handler in its middleware, the first running first.
+		h := e.Handler
+		for i := len(e.Middlewares) - 1; i >= 0; i-- {
+			h = e.Middlewares[i](h)
+		}
+
+		router.Handle(e.Method, e.Path, func(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+			h.ServeHTTP(w, api.SetDetails(r, e.Path, params))
+		})
+	}
+
+	return router
+}
+
 func newRequest(method, path string, body io.Reader, params httprouter.Params) (*http.Request, error) {
 	r, err := http.NewRequest(method, path, body)
 	if err != nil {
@@ -55,6 +101,300 @@ func TestOoohhAPIIsKitAPI(t *testing.T) {
 	is.True(ok) // ooohh api is kit api.
 }
 
+func TestHealthAndReadiness(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API, with readiness.
+	readiness := &Readiness{}
+	a := NewAPI(logger, s, ss, ui, WithReadiness(readiness))
+
+	// Route requests as the server would.
+	router := newTestRouter(a)
+
+	status := func(path string) int {
+		r, err := http.NewRequest("GET", path, nil)
+		is.NoErr(err)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, r)
+		return rr.Code
+	}
+
+	// Check the API is live, but not ready, during startup.
+	is.Equal(status("/api/health"), http.StatusOK)                // live during startup.
+	is.Equal(status("/api/ready"), http.StatusServiceUnavailable) // not ready during startup.
+
+	// Complete startup.
+	readiness.SetReady()
+
+	is.Equal(status("/api/health"), http.StatusOK) // live after startup.
+	is.Equal(status("/api/ready"), http.StatusOK)  // ready after startup.
+
+	// Check the API is always ready without readiness.
+	a = NewAPI(logger, s, ss, ui)
+	router = newTestRouter(a)
+	is.Equal(status("/api/ready"), http.StatusOK) // ready without readiness.
+
+	// Check the aliases.
+	is.Equal(status("/healthz"), http.StatusOK) // live at alias.
+	is.Equal(status("/readyz"), http.StatusOK)  // ready at alias.
+}
+
+func TestRequestLogging(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, logs := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, that fails to get dials.
+	s := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return nil, errors.New("oops")
+		},
+	}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+	// Route requests as the server would.
+	router := newTestRouter(a)
+
+	do := func(path, id string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest("GET", path, nil)
+		is.NoErr(err)
+		if id != "" {
+			r.Header.Set("X-Request-ID", id)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, r)
+		return rr
+	}
+
+	// Check a request ID is generated for requests without one.
+	rr := do("/api/dials/dial", "")
+	is.Equal(rr.Code, http.StatusInternalServerError)     // dial isn't retrieved.
+	is.Equal(len(rr.Header().Get("X-Request-ID")), 27)    // generated request ID is responded with.
+	is.Equal(len(logs.FilterMessage("request").All()), 1) // request is logged once.
+
+	entry := logs.FilterMessage("request").All()[0].ContextMap()
+	is.Equal(entry["request_id"], rr.Header().Get("X-Request-ID"))   // request ID is logged.
+	is.Equal(entry["method"], "GET")                                 // method is logged.
+	is.Equal(entry["path"], "/api/dials/dial")                       // path is logged.
+	is.Equal(entry["status"], int64(http.StatusInternalServerError)) // status is logged.
+	is.True(entry["duration"] != "")                                 // duration is logged.
+
+	// Check a given request ID is propagated.
+	rr = do("/api/dials/dial", "abc-123")
+	is.Equal(rr.Header().Get("X-Request-ID"), "abc-123") // given request ID is responded with.
+
+	requests := logs.FilterMessage("request").All()
+	is.Equal(len(requests), 2)                                  // second request is logged.
+	is.Equal(requests[1].ContextMap()["request_id"], "abc-123") // given request ID is logged.
+	failures := logs.FilterMessage("could not retrieve dial").All()
+	is.Equal(len(failures), 2)                                  // handler errors are logged.
+	is.Equal(failures[1].ContextMap()["request_id"], "abc-123") // handler errors include the request ID.
+
+	// Check invalid request IDs are replaced.
+	rr = do("/api/dials/dial", "not an id")
+	is.Equal(len(rr.Header().Get("X-Request-ID")), 27) // invalid request ID is replaced.
+
+	// Check requests to endpoints that suppress logs are identified, but not logged.
+	rr = do("/api/health", "health-1")
+	is.Equal(rr.Code, http.StatusOK)                      // health is ok.
+	is.Equal(rr.Header().Get("X-Request-ID"), "health-1") // request ID is responded with.
+	is.Equal(len(logs.FilterMessage("request").All()), 3) // health request isn't logged.
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+	// Route requests as the server would.
+	router := newTestRouter(a)
+
+	r, err := http.NewRequest("GET", "/api/openapi.json", nil)
+	is.NoErr(err)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, r)
+
+	is.Equal(rr.Code, http.StatusOK)                              // document is served.
+	is.Equal(rr.Header().Get("Content-Type"), "application/json") // document is JSON.
+
+	var doc struct {
+		OpenAPI string                     `json:"openapi"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	err = json.NewDecoder(rr.Body).Decode(&doc)
+	is.NoErr(err)                                 // document parses.
+	is.True(strings.HasPrefix(doc.OpenAPI, "3.")) // document is OpenAPI 3.
+
+	for _, path := range []string{"/api/dials", "/api/dials/{id}", "/api/boards", "/api/boards/{id}", "/api/slack/command"} {
+		_, ok := doc.Paths[path]
+		is.True(ok) // core paths are documented.
+	}
+
+	// Check every API endpoint is documented.
+	param := regexp.MustCompile(`:(\w+)`)
+	for _, e := range a.Endpoints() {
+		if e.Path != "/healthz" && e.Path != "/readyz" && !strings.HasPrefix(e.Path, "/api/") {
+			continue
+		}
+		path := param.ReplaceAllString(e.Path, "{$1}")
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("%s %s is not documented", e.Method, path)
+		}
+	}
+}
+
+func TestReadinessHealthCheck(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, logs := newTestLogger(zap.InfoLevel)
+
+	// Get a Bolt DB.
+	f, err := ioutil.TempFile("", "ooohh-bolt-api-")
+	is.NoErr(err)
+	f.Close()
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	db, err := bolt.Open(f.Name(), 0600, nil)
+	is.NoErr(err)
+
+	// Create a service, and an API that checks it can be read from.
+	s, err := service.NewService(store.NewBolt(db), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithHealthCheck(s.Ping))
+
+	// Route requests as the server would.
+	router := newTestRouter(a)
+
+	get := func(path string) (int, string) {
+		r, err := http.NewRequest("GET", path, nil)
+		is.NoErr(err)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, r)
+
+		var body struct {
+			Status string `json:"status"`
+		}
+		is.NoErr(json.Unmarshal(rr.Body.Bytes(), &body)) // body is json.
+		return rr.Code, body.Status
+	}
+
+	// Check the API is ready while the db can be read from.
+	code, status := get("/readyz")
+	is.Equal(code, http.StatusOK) // ready with a healthy db.
+	is.Equal(status, "ok")        // status is ok.
+
+	// Close the db, so it can't be read from.
+	is.NoErr(db.Close())
+
+	code, status = get("/readyz")
+	is.Equal(code, http.StatusServiceUnavailable)                     // not ready with a closed db.
+	is.Equal(status, "unavailable")                                   // status is unavailable.
+	is.Equal(len(logs.FilterMessage("health check failed").All()), 1) // failure is logged.
+	code, _ = get("/healthz")
+	is.Equal(code, http.StatusOK) // still live with a closed db.
+}
+
+func TestReadsAfterWritesWithReadReplica(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Get a Bolt DB.
+	f, err := ioutil.TempFile("", "ooohh-bolt-api-")
+	is.NoErr(err)
+	f.Close()
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	db, err := bolt.Open(f.Name(), 0600, nil)
+	is.NoErr(err)
+	defer db.Close()
+
+	// Create a replica of the db, that isn't refreshed, and services that
+	// read from it.
+	replica, err := store.NewReplica(db, f.Name()+".replica")
+	is.NoErr(err)                          // replica is created.
+	defer os.Remove(f.Name() + ".replica") //nolint:errcheck
+	defer replica.Close()
+
+	s, err := service.NewService(store.NewBolt(db), logger, time.Now, service.WithReadStore(replica))
+	is.NoErr(err) // service initializes correctly.
+	ss, err := slack.NewService(logger, db, s, "salt")
+	is.NoErr(err) // slack service initializes correctly.
+
+	// Route requests as the server would.
+	router := newTestRouter(NewAPI(logger, s, ss, ui.NewUI(s), WithInsecureSlackRequests()))
+
+	do := func(method, path, contentType, body string) (int, map[string]interface{}) {
+		r, err := http.NewRequest(method, path, strings.NewReader(body))
+		is.NoErr(err)
+		r.Header.Set("Content-Type", contentType)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, r)
+
+		var resp map[string]interface{}
+		is.NoErr(json.Unmarshal(rr.Body.Bytes(), &resp)) // body is json.
+		return rr.Code, resp
+	}
+
+	// Check a dial can be set as soon as it is created.
+	code, dial := do("POST", "/api/dials", "application/json", `{"name": "dial", "token": "token"}`)
+	is.Equal(code, http.StatusCreated) // dial is created.
+	id := dial["id"].(string)
+
+	code, _ = do("GET", "/api/dials/"+id, "", "")
+	is.Equal(code, http.StatusNotFound) // replica hasn't seen the dial yet.
+
+	code, dial = do("PATCH", "/api/dials/"+id, "application/json", `{"token": "token", "value": 42}`)
+	is.Equal(code, http.StatusOK) // dial is set.
+	is.Equal(dial["value"], 42.0) // updated dial is returned.
+
+	// Check a board can be set, and cloned, as soon as it is created.
+	code, board := do("POST", "/api/boards", "application/json", `{"name": "board", "token": "token"}`)
+	is.Equal(code, http.StatusCreated) // board is created.
+	boardID := board["id"].(string)
+
+	code, board = do("PATCH", "/api/boards/"+boardID, "application/json", `{"token": "token", "dials": ["`+id+`"]}`)
+	is.Equal(code, http.StatusOK)                    // board is set.
+	is.Equal(len(board["dials"].([]interface{})), 1) // updated board is returned.
+
+	code, _ = do("POST", "/api/boards/"+boardID+"/clone", "application/json", `{"name": "clone", "token": "token"}`)
+	is.Equal(code, http.StatusCreated) // board is cloned.
+
+	// Check a user's first value can be set from Slack.
+	code, msg := do("POST", "/api/slack/command", "application/x-www-form-urlencoded", "command=%2Fwtf&team_id=T1&user_id=U1&user_name=alice&text=10")
+	is.Equal(code, http.StatusOK)                                                       // slack command is handled.
+	is.True(strings.HasSuffix(msg["text"].(string), "Ooohh, I wish I felt like that.")) // value is set.
+}
+
 func TestCreateDial(t *testing.T) {
 
 	is := is.New(t)
@@ -64,13 +404,13 @@ func TestCreateDial(t *testing.T) {
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with CreateDial implemented.
+	// Create a mock service, with CreateDialFrom implemented.
 	s := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
+		CreateDialFromFn: func(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
 			return &ooohh.Dial{
 				ID:        ooohh.DialID("dial"),
-				Token:     token,
-				Name:      name,
+				Token:     nd.Token,
+				Name:      nd.Name,
 				Value:     0.0,
 				UpdatedAt: now,
 			}, nil
@@ -96,8 +436,8 @@ func TestCreateDial(t *testing.T) {
 	// Invoke the create dial handler.
 	a.createDial().ServeHTTP(rr, r)
 
-	// Check that the CreateDial function has been invoked.
-	is.True(s.CreateDialInvoked)
+	// Check that the CreateDialFrom function has been invoked.
+	is.True(s.CreateDialFromInvoked)
 
 	// Check the response status code is correct.
 	is.Equal(rr.Code, http.StatusCreated)
@@ -114,65 +454,49 @@ func TestCreateDial(t *testing.T) {
 	is.Equal(actualBody.Token, "")                    // token is not in response body.
 }
 
-func TestCreateDialValidation(t *testing.T) {
-
-	now := time.Now().Truncate(time.Second)
+func TestCreateDialWithValue(t *testing.T) {
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with CreateDial implemented.
-	s := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
-			return &ooohh.Dial{
-				ID:        ooohh.DialID("dial"),
-				Token:     token,
-				Name:      name,
-				Value:     0.0,
-				UpdatedAt: now,
-			}, nil
-		},
+	// Create a real service, so values are validated and stored.
+	s, err := service.NewService(store.NewMemory(), logger, time.Now)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	// Create a mock slack service.
-	ss := &mock.SlackService{}
-
-	// Create UI.
-	ui := ui.NewUI(s)
-
 	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
 
 	for _, tt := range []struct {
-		msg       string
-		body      string
-		expTitle  string
-		expDetail string
+		msg      string
+		body     string
+		expCode  int
+		expValue float64
+		expDials int
 	}{{
-		msg:       "invalid json body",
-		body:      `{"name": "test", "token": "token"`,
-		expTitle:  "Validation Error",
-		expDetail: "Invalid JSON",
-	}, {
-		msg:       "missing name",
-		body:      `{"token": "token"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
+		msg:      "with value",
+		body:     `{"name": "test", "token": "token", "value": 42}`,
+		expCode:  http.StatusCreated,
+		expValue: 42,
+		expDials: 1,
 	}, {
-		msg:       "missing token",
-		body:      `{"name": "test"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
+		msg:      "without value",
+		body:     `{"name": "test", "token": "token"}`,
+		expCode:  http.StatusCreated,
+		expValue: 0,
+		expDials: 2,
 	}, {
-		msg:       "missing name & token",
-		body:      `{}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
+		msg:      "with zero value",
+		body:     `{"name": "test", "token": "token", "value": 0}`,
+		expCode:  http.StatusCreated,
+		expValue: 0,
+		expDials: 3,
 	}, {
-		msg:       "extra field passed",
-		body:      `{"extra": "field"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
+		msg:      "with invalid value",
+		body:     `{"name": "test", "token": "token", "value": 150}`,
+		expCode:  http.StatusBadRequest,
+		expDials: 3,
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
@@ -189,102 +513,156 @@ func TestCreateDialValidation(t *testing.T) {
 			// Invoke the create dial handler.
 			a.createDial().ServeHTTP(rr, r)
 
-			// Check that the CreateDial function has not been invoked.
-			is.True(!s.CreateDialInvoked)
-
 			// Check the response status code is correct.
-			is.Equal(rr.Code, http.StatusBadRequest)
+			is.Equal(rr.Code, tt.expCode)
 
-			// Check the response body is correct
-			type body struct {
-				Title  string `json:"title"`
-				Detail string `json:"detail"`
+			if tt.expCode == http.StatusCreated {
+				var actualBody ooohh.Dial
+				err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+				is.NoErr(err)                           // actual body is json.
+				is.Equal(actualBody.Value, tt.expValue) // dial is returned at its value.
+
+				d, err := s.GetDial(context.TODO(), actualBody.ID)
+				is.NoErr(err)                  // dial is stored.
+				is.Equal(d.Value, tt.expValue) // dial is stored at its value.
 			}
-			var actualBody body
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
 
-			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
-			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+			// Check dials are only created for valid requests.
+			var dials int
+			err = s.ListDials(context.TODO(), func(ooohh.Dial) error {
+				dials++
+				return nil
+			})
+			is.NoErr(err)                // dials are listed.
+			is.Equal(dials, tt.expDials) // correct number of dials exist.
 		})
 	}
 }
 
-func TestCreateDialError(t *testing.T) {
+func TestCreateWithGeneratedToken(t *testing.T) {
 
 	is := is.New(t)
 
 	// Get a logger.
-	logger, logs := newTestLogger(zap.InfoLevel)
+	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with CreateDial implemented, that returns an error.
-	s := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
-			return nil, errors.New("error message")
-		},
-	}
+	// Create a real service, generating tokens.
+	s, err := service.NewService(store.NewMemory(), logger, time.Now, service.WithGeneratedTokens())
+	is.NoErr(err) // service initializes correctly.
 
-	// Create a mock slack service.
-	ss := &mock.SlackService{}
+	// Get an API, generating tokens.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithGeneratedTokens())
+	router := newTestRouter(a)
 
-	// Create UI.
-	ui := ui.NewUI(s)
+	for _, tt := range []struct {
+		msg  string
+		path string
+		body string
+		get  string
+	}{{
+		msg:  "dial",
+		path: "/api/dials",
+		body: `{"name": "test"}`,
+		get:  "/api/dials/",
+	}, {
+		msg:  "board",
+		path: "/api/boards",
+		body: `{"name": "test"}`,
+		get:  "/api/boards/",
+	}, {
+		msg:  "board with dials",
+		path: "/api/boards/with-dials",
+		body: `{"name": "test", "dials": ["dial"]}`,
+		get:  "/api/boards/",
+	}} {
 
-	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+		t.Run(tt.msg, func(t *testing.T) {
 
-	// Create a new request.
+			is := is.New(t)
+
+			// Create without a token.
+			r, err := http.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			is.NoErr(err)
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, http.StatusCreated) // created without a token.
+
+			var created struct {
+				ID    string `json:"id"`
+				Token string `json:"token"`
+			}
+			is.NoErr(json.Unmarshal(rr.Body.Bytes(), &created)) // actual body is json.
+			is.True(created.Token != "")                        // generated token is returned.
+
+			// Get it again.
+			r, err = http.NewRequest("GET", tt.get+created.ID, nil)
+			is.NoErr(err)
+
+			rr = httptest.NewRecorder()
+			router.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, http.StatusOK)                            // retrieved.
+			is.True(!strings.Contains(rr.Body.String(), "token"))       // token is never returned on get.
+			is.True(!strings.Contains(rr.Body.String(), created.Token)) // token is never returned on get.
+		})
+	}
+
+	// A given token is never returned.
 	r, err := http.NewRequest("POST", "/api/dials", strings.NewReader(`{"name": "test", "token": "token"}`))
 	is.NoErr(err)
 
-	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, r)
 
-	// Invoke the create dial handler.
-	a.createDial().ServeHTTP(rr, r)
+	is.Equal(rr.Code, http.StatusCreated)                 // created with a token.
+	is.True(!strings.Contains(rr.Body.String(), "token")) // given token is not returned.
+}
 
-	// Check that the CreateDial function has been invoked.
-	is.True(s.CreateDialInvoked)
+func TestCreateWithoutTokenNotAllowedByDefault(t *testing.T) {
 
-	// Check the response status code is correct.
-	is.Equal(rr.Code, http.StatusInternalServerError)
+	is := is.New(t)
 
-	// Check the response body is correct
-	type body struct {
-		Title  string `json:"title"`
-		Detail string `json:"detail"`
-	}
-	var actualBody body
-	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-	is.NoErr(err) // actual body is json.
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
 
-	is.Equal(actualBody.Title, "Internal Server Error")  // title is correct.
-	is.Equal(actualBody.Detail, "Could not create dial") // detail is correct.
+	// Create a mock service.
+	s := &mock.Service{}
 
-	// Check logs are correct.
-	is.Equal(len(logs.FilterMessage("could not create dial").All()), 1)                                          // error is logged.
-	is.Equal(logs.FilterMessage("could not create dial").All()[0].ContextMap()["err"].(string), "error message") // error message is logged under error key.
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+	router := newTestRouter(a)
+
+	for _, path := range []string{"/api/dials", "/api/boards"} {
+		r, err := http.NewRequest("POST", path, strings.NewReader(`{"name": "test"}`))
+		is.NoErr(err)
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, r)
+
+		is.Equal(rr.Code, http.StatusBadRequest) // token is required.
+	}
+
+	is.True(!s.CreateDialFromInvoked)             // dial is not created.
+	is.True(!s.CreateBoardWithDescriptionInvoked) // board is not created.
 }
 
-func TestGetDial(t *testing.T) {
+func TestCreateDialWithColor(t *testing.T) {
 
 	is := is.New(t)
 
-	now := time.Now().Truncate(time.Second)
-
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with GetDial implemented.
+	// The dial that will be created by the service.
+	var created ooohh.NewDial
+
+	// Create a mock service, with CreateDialFrom implemented.
 	s := &mock.Service{
-		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
-			return &ooohh.Dial{
-				ID:        id,
-				Token:     "token",
-				Name:      "test",
-				Value:     66.6,
-				UpdatedAt: now,
-			}, nil
+		CreateDialFromFn: func(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+			created = nd
+			return &ooohh.Dial{ID: ooohh.DialID("dial"), Token: nd.Token, Name: nd.Name, Color: nd.Color, Group: nd.Group}, nil
 		},
 	}
 
@@ -298,217 +676,89 @@ func TestGetDial(t *testing.T) {
 	a := NewAPI(logger, s, ss, ui)
 
 	// Create a new request.
-	r, err := newRequest("GET", "/api/dials/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+	r, err := http.NewRequest("POST", "/api/dials", strings.NewReader(`{"name": "test", "token": "token", "color": "#00ff00", "group": "backend"}`))
 	is.NoErr(err)
 
 	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 	rr := httptest.NewRecorder()
 
-	// Invoke the get dial handler.
-	a.getDial().ServeHTTP(rr, r)
+	// Invoke the create dial handler.
+	a.createDial().ServeHTTP(rr, r)
 
-	// Check that the GetDial function has been invoked.
-	is.True(s.GetDialInvoked)
+	// Check that the dial is created with its color and group, at once.
+	is.True(s.CreateDialFromInvoked)
+	is.Equal(created.Token, "token")   // correct token is used.
+	is.Equal(created.Color, "#00ff00") // correct color is set.
+	is.Equal(created.Group, "backend") // correct group is set.
 
 	// Check the response status code is correct.
-	is.Equal(rr.Code, http.StatusOK)
+	is.Equal(rr.Code, http.StatusCreated)
 
 	// Check the response body is correct
 	var actualBody ooohh.Dial
 	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
 	is.NoErr(err) // actual body is json.
 
-	is.Equal(actualBody.ID, ooohh.DialID("1234"))     // id is correct.
-	is.Equal(actualBody.Name, "test")                 // name is correct.
-	is.Equal(actualBody.Value, 66.6)                  // value is correct.
-	is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
-	is.Equal(actualBody.Token, "")                    // token is not in response body.
+	is.Equal(actualBody.Color, "#00ff00") // color is in response body.
+	is.Equal(actualBody.Group, "backend") // group is in response body.
 }
 
-func TestGetDialErrors(t *testing.T) {
+func TestCreateDialInvalidColor(t *testing.T) {
+
+	is := is.New(t)
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	for _, tt := range []struct {
-		msg       string
-		err       error
-		expStatus int
-		expTitle  string
-		expDetail string
-	}{{
-		msg:       "dial not found",
-		err:       ooohh.ErrDialNotFound,
-		expStatus: http.StatusNotFound,
-		expTitle:  "Not Found",
-		expDetail: "Not Found",
-	}, {
-		msg:       "unknown error",
-		err:       errors.New("uh-oh"),
-		expStatus: http.StatusInternalServerError,
-		expTitle:  "Internal Server Error",
-		expDetail: "Could not retrieve dial",
-	}} {
-
-		t.Run(tt.msg, func(t *testing.T) {
-
-			is := is.New(t)
-
-			// Create a mock service, with GetDial implemented.
-			s := &mock.Service{
-				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
-					return nil, tt.err
-				},
-			}
-
-			// Create a mock slack service.
-			ss := &mock.SlackService{}
-
-			// Create UI.
-			ui := ui.NewUI(s)
+	// Create a mock service.
+	s := &mock.Service{}
 
-			// Get an API.
-			a := NewAPI(logger, s, ss, ui)
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
 
-			// Create a new request.
-			r, err := newRequest("GET", "/api/dials/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
-			is.NoErr(err)
+	// Create UI.
+	ui := ui.NewUI(s)
 
-			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
-			rr := httptest.NewRecorder()
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
 
-			// Invoke the get dial handler.
-			a.getDial().ServeHTTP(rr, r)
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/dials", strings.NewReader(`{"name": "test", "token": "token", "color": "green"}`))
+	is.NoErr(err)
 
-			// Check that the GetDial function has been invoked.
-			is.True(s.GetDialInvoked)
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
 
-			// Check the response status code is correct.
-			is.Equal(rr.Code, tt.expStatus)
+	// Invoke the create dial handler.
+	a.createDial().ServeHTTP(rr, r)
 
-			// Check the response body is correct
-			type body struct {
-				Title  string `json:"title"`
-				Detail string `json:"detail"`
-			}
-			var actualBody body
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
+	// Check that the dial wasn't created.
+	is.True(!s.CreateDialFromInvoked)
 
-			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
-			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
-		})
-	}
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusBadRequest)
 }
 
-func TestSetDial(t *testing.T) {
+func TestCreateDialValidation(t *testing.T) {
 
 	now := time.Now().Truncate(time.Second)
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	for _, tt := range []struct {
-		msg   string
-		value float64
-	}{{
-		msg:   "non-zero value",
-		value: 66.6,
-	}, {
-		msg:   "zero value",
-		value: 0,
-	}} {
-
-		t.Run(tt.msg, func(t *testing.T) {
-
-			is := is.New(t)
-
-			// Variables that will be assigned to within the SetDial function.
-			var setID ooohh.DialID
-			var setToken string
-			var setValue *float64
-
-			// Create a mock service, with GetDial and SetDial implemented.
-			s := &mock.Service{
-				SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
-
-					// Capture what was set.
-					setID = id
-					setToken = token
-					setValue = &value
-
-					return nil
-				},
-				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
-					return &ooohh.Dial{
-						ID:        id,
-						Token:     setToken,
-						Name:      "test",
-						Value:     *setValue,
-						UpdatedAt: now,
-					}, nil
-				},
-			}
-
-			// Create a mock slack service.
-			ss := &mock.SlackService{}
-
-			// Create UI.
-			ui := ui.NewUI(s)
-
-			// Get an API.
-			a := NewAPI(logger, s, ss, ui)
-
-			// Create a new request.
-			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(fmt.Sprintf(`{"token": "token", "value": %f}`, tt.value)), httprouter.Params{{Key: "id", Value: "1234"}})
-			is.NoErr(err)
-
-			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
-			rr := httptest.NewRecorder()
-
-			// Invoke the set dial handler.
-			a.setDialValue().ServeHTTP(rr, r)
-
-			// Check that the SetDial function has been invoked.
-			is.True(s.SetDialInvoked)
-
-			// Check that the SetDial function was invoked with the correct params.
-			is.Equal(setID, ooohh.DialID("1234")) // correct dial was set.
-			is.Equal(setToken, "token")           // correct token was used for the set.
-			is.True(setValue != nil)              // value was set.
-			if setValue != nil {
-				is.Equal(*setValue, tt.value) // correct value was set.
-			}
-
-			// Check that the GetDial function has been invoked.
-			is.True(s.GetDialInvoked)
-
-			// Check the response status code is correct.
-			is.Equal(rr.Code, http.StatusOK)
-
-			// Check the response body is correct
-			var actualBody ooohh.Dial
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
-
-			is.Equal(actualBody.ID, ooohh.DialID("1234"))     // id is correct.
-			is.Equal(actualBody.Name, "test")                 // name is correct.
-			is.Equal(actualBody.Value, tt.value)              // value is correct.
-			is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
-			is.Equal(actualBody.Token, "")                    // token is not in response body.
-		})
+	// Create a mock service, with CreateDialFrom implemented.
+	s := &mock.Service{
+		CreateDialFromFn: func(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+			return &ooohh.Dial{
+				ID:        ooohh.DialID("dial"),
+				Token:     nd.Token,
+				Name:      nd.Name,
+				Value:     0.0,
+				UpdatedAt: now,
+			}, nil
+		},
 	}
 
-}
-
-func TestSetDialValidation(t *testing.T) {
-
-	// Get a logger.
-	logger, _ := newTestLogger(zap.InfoLevel)
-
-	// Create a mock service.
-	s := &mock.Service{}
-
 	// Create a mock slack service.
 	ss := &mock.SlackService{}
 
@@ -525,29 +775,29 @@ func TestSetDialValidation(t *testing.T) {
 		expDetail string
 	}{{
 		msg:       "invalid json body",
-		body:      `{"value": 66.6, "token": "token"`,
+		body:      `{"name": "test", "token": "token"`,
 		expTitle:  "Validation Error",
 		expDetail: "Invalid JSON",
 	}, {
-		msg:       "missing value",
+		msg:       "missing name",
 		body:      `{"token": "token"}`,
 		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `value` must be provided.",
+		expDetail: "Both `name` and `token` must be provided.",
 	}, {
 		msg:       "missing token",
-		body:      `{"value": 66.6}`,
+		body:      `{"name": "test"}`,
 		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `value` must be provided.",
+		expDetail: "Both `name` and `token` must be provided.",
 	}, {
-		msg:       "missing value & token",
+		msg:       "missing name & token",
 		body:      `{}`,
 		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `value` must be provided.",
+		expDetail: "Both `name` and `token` must be provided.",
 	}, {
 		msg:       "extra field passed",
 		body:      `{"extra": "field"}`,
 		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `value` must be provided.",
+		expDetail: "Both `name` and `token` must be provided.",
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
@@ -555,17 +805,17 @@ func TestSetDialValidation(t *testing.T) {
 			is := is.New(t)
 
 			// Create a new request.
-			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			r, err := http.NewRequest("POST", "/api/dials", strings.NewReader(tt.body))
 			is.NoErr(err)
 
 			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 			rr := httptest.NewRecorder()
 
-			// Invoke the set dial handler.
-			a.setDialValue().ServeHTTP(rr, r)
+			// Invoke the create dial handler.
+			a.createDial().ServeHTTP(rr, r)
 
-			// Check that the SetDial function has not been invoked.
-			is.True(!s.SetDialInvoked)
+			// Check that the CreateDialFrom function has not been invoked.
+			is.True(!s.CreateDialFromInvoked)
 
 			// Check the response status code is correct.
 			is.Equal(rr.Code, http.StatusBadRequest)
@@ -585,80 +835,131 @@ func TestSetDialValidation(t *testing.T) {
 	}
 }
 
-func TestSetDialErrors(t *testing.T) {
+func TestCreateDialError(t *testing.T) {
 
-	now := time.Now().Truncate(time.Second)
+	is := is.New(t)
 
 	// Get a logger.
-	logger, _ := newTestLogger(zap.InfoLevel)
-
-	for _, tt := range []struct {
-		msg           string
-		setErr        error
-		getErr        error
-		expGetInvoked bool
-		expStatus     int
-		expTitle      string
-		expDetail     string
-	}{{
-		msg:           "set with wrong token",
-		setErr:        ooohh.ErrUnauthorized,
-		getErr:        nil,
-		expGetInvoked: false,
-		expStatus:     http.StatusUnauthorized,
-		expTitle:      "Unauthorized",
-		expDetail:     "Invalid token",
-	}, {
-		msg:           "set with missing dial",
-		setErr:        ooohh.ErrDialNotFound,
-		getErr:        ooohh.ErrDialNotFound,
-		expGetInvoked: false,
-		expStatus:     http.StatusNotFound,
-		expTitle:      "Not Found",
-		expDetail:     "Not Found",
-	}, {
-		msg:           "set with invalid value",
-		setErr:        ooohh.ErrDialValueInvalid,
-		getErr:        nil,
-		expGetInvoked: false,
-		expStatus:     http.StatusBadRequest,
-		expTitle:      "Bad Request",
-		expDetail:     "Invalid value",
-	}, {
-		msg:           "set with unknown error",
-		setErr:        errors.New("set error"),
-		getErr:        nil,
-		expGetInvoked: false,
-		expStatus:     http.StatusInternalServerError,
-		expTitle:      "Internal Server Error",
-		expDetail:     "Could not update dial",
-	}, {
-		msg:           "get with unknown error",
-		setErr:        nil,
-		getErr:        errors.New("get error"),
-		expGetInvoked: true,
-		expStatus:     http.StatusInternalServerError,
-		expTitle:      "Internal Server Error",
-		expDetail:     "Could not update dial",
-	}} {
+	logger, logs := newTestLogger(zap.InfoLevel)
 
-		t.Run(tt.msg, func(t *testing.T) {
+	// Create a mock service, with CreateDialFrom implemented, that returns an error.
+	s := &mock.Service{
+		CreateDialFromFn: func(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+			return nil, errors.New("error message")
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/dials", strings.NewReader(`{"name": "test", "token": "token"}`))
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the create dial handler.
+	a.createDial().ServeHTTP(rr, r)
+
+	// Check that the CreateDialFrom function has been invoked.
+	is.True(s.CreateDialFromInvoked)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusInternalServerError)
+
+	// Check the response body is correct
+	type body struct {
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	}
+	var actualBody body
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.Title, "Internal Server Error")  // title is correct.
+	is.Equal(actualBody.Detail, "Could not create dial") // detail is correct.
+
+	// Check logs are correct.
+	is.Equal(len(logs.FilterMessage("could not create dial").All()), 1)                                          // error is logged.
+	is.Equal(logs.FilterMessage("could not create dial").All()[0].ContextMap()["err"].(string), "error message") // error message is logged under error key.
+}
+
+func TestEnsureDial(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		created   bool
+		err       error
+		expStatus int
+		expEnsure bool
+	}{{
+		msg:       "created",
+		body:      `{"name": "test", "token": "token"}`,
+		created:   true,
+		expStatus: http.StatusCreated,
+		expEnsure: true,
+	}, {
+		msg:       "existing",
+		body:      `{"name": "test", "token": "token"}`,
+		created:   false,
+		expStatus: http.StatusOK,
+		expEnsure: true,
+	}, {
+		msg:       "wrong token",
+		body:      `{"name": "test", "token": "token"}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expEnsure: true,
+	}, {
+		msg:       "locked out",
+		body:      `{"name": "test", "token": "token"}`,
+		err:       ooohh.ErrLockedOut,
+		expStatus: http.StatusTooManyRequests,
+		expEnsure: true,
+	}, {
+		msg:       "service error",
+		body:      `{"name": "test", "token": "token"}`,
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expEnsure: true,
+	}, {
+		msg:       "missing name",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusBadRequest,
+		expEnsure: false,
+	}, {
+		msg:       "invalid json",
+		body:      `{"name": "test", "token": `,
+		expStatus: http.StatusBadRequest,
+		expEnsure: false,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
 
 			is := is.New(t)
 
-			// Create a mock service, with GetDial and SetDial implemented.
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the EnsureDial function.
+			var ensuredExtID, ensuredName, ensuredToken string
+
+			// Create a mock service, with EnsureDial implemented.
 			s := &mock.Service{
-				SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
-					return tt.setErr
-				},
-				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
-					return &ooohh.Dial{
-						ID:        id,
-						Token:     "token",
-						Name:      "test",
-						Value:     66.6,
-						UpdatedAt: now,
-					}, tt.getErr
+				EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+					ensuredExtID, ensuredName, ensuredToken = externalID, name, token
+					if tt.err != nil {
+						return nil, false, tt.err
+					}
+					return &ooohh.Dial{ID: "dial", Token: token, Name: name}, tt.created, nil
 				},
 			}
 
@@ -672,40 +973,39 @@ func TestSetDialErrors(t *testing.T) {
 			a := NewAPI(logger, s, ss, ui)
 
 			// Create a new request.
-			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "value": 66.6}`), httprouter.Params{{Key: "id", Value: "1234"}})
+			r, err := newRequest("PUT", "/api/dials/by-external/:extID", strings.NewReader(tt.body), httprouter.Params{{Key: "extID", Value: "ext-1"}})
 			is.NoErr(err)
 
 			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 			rr := httptest.NewRecorder()
 
-			// Invoke the set dial handler.
-			a.setDialValue().ServeHTTP(rr, r)
-
-			// Check that the SetDial function has been invoked.
-			is.True(s.SetDialInvoked)
+			// Invoke the ensure dial handler.
+			a.ensureDial().ServeHTTP(rr, r)
 
-			// Check that the GetDial function has (not) been invoked.
-			is.Equal(s.GetDialInvoked, tt.expGetInvoked)
+			// Check whether the EnsureDial function has been invoked.
+			is.Equal(s.EnsureDialInvoked, tt.expEnsure)
 
 			// Check the response status code is correct.
 			is.Equal(rr.Code, tt.expStatus)
 
-			// Check the response body is correct
-			type body struct {
-				Title  string `json:"title"`
-				Detail string `json:"detail"`
-			}
-			var actualBody body
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
+			if tt.expStatus == http.StatusOK || tt.expStatus == http.StatusCreated {
+				is.Equal(ensuredExtID, "ext-1") // correct external id is used.
+				is.Equal(ensuredName, "test")   // correct name is used.
+				is.Equal(ensuredToken, "token") // correct token is used.
 
-			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
-			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+				// Check the response body is correct
+				var actualBody ooohh.Dial
+				err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+				is.NoErr(err) // actual body is json.
+
+				is.Equal(actualBody.ID, ooohh.DialID("dial")) // id is correct.
+				is.Equal(actualBody.Name, "test")             // name is correct.
+			}
 		})
 	}
 }
 
-func TestCreateBoard(t *testing.T) {
+func TestGetDial(t *testing.T) {
 
 	is := is.New(t)
 
@@ -714,14 +1014,14 @@ func TestCreateBoard(t *testing.T) {
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with CreateBoard implemented.
+	// Create a mock service, with GetDial implemented.
 	s := &mock.Service{
-		CreateBoardFn: func(ctx context.Context, name string, token string) (*ooohh.Board, error) {
-			return &ooohh.Board{
-				ID:        ooohh.BoardID("board"),
-				Token:     token,
-				Name:      name,
-				Dials:     []ooohh.Dial{},
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return &ooohh.Dial{
+				ID:        id,
+				Token:     "token",
+				Name:      "test",
+				Value:     66.6,
 				UpdatedAt: now,
 			}, nil
 		},
@@ -737,49 +1037,56 @@ func TestCreateBoard(t *testing.T) {
 	a := NewAPI(logger, s, ss, ui)
 
 	// Create a new request.
-	r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(`{"name": "test", "token": "token"}`))
+	r, err := newRequest("GET", "/api/dials/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
 	is.NoErr(err)
 
 	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 	rr := httptest.NewRecorder()
 
-	// Invoke the create board handler.
-	a.createBoard().ServeHTTP(rr, r)
+	// Invoke the get dial handler.
+	a.getDial().ServeHTTP(rr, r)
 
-	// Check that the CreateBoard function has been invoked.
-	is.True(s.CreateBoardInvoked)
+	// Check that the GetDial function has been invoked.
+	is.True(s.GetDialInvoked)
 
 	// Check the response status code is correct.
-	is.Equal(rr.Code, http.StatusCreated)
+	is.Equal(rr.Code, http.StatusOK)
 
 	// Check the response body is correct
-	var actualBody ooohh.Board
+	var actualBody ooohh.Dial
 	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
 	is.NoErr(err) // actual body is json.
 
-	is.Equal(actualBody.ID, ooohh.BoardID("board"))   // id is the same.
-	is.Equal(actualBody.Name, "test")                 // name is the same.
-	is.Equal(actualBody.Dials, []ooohh.Dial{})        // dials are the same.
-	is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is the same.
+	is.Equal(actualBody.ID, ooohh.DialID("1234"))     // id is correct.
+	is.Equal(actualBody.Name, "test")                 // name is correct.
+	is.Equal(actualBody.Value, 66.6)                  // value is correct.
+	is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
 	is.Equal(actualBody.Token, "")                    // token is not in response body.
 }
 
-func TestCreateBoardValidation(t *testing.T) {
+func TestGetDialWithStats(t *testing.T) {
 
-	now := time.Now().Truncate(time.Second)
+	now := time.Date(2020, time.February, 15, 12, 0, 0, 0, time.UTC)
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with CreateBoard implemented.
+	// Create a mock service, with GetDial and GetDialHistory implemented.
 	s := &mock.Service{
-		CreateBoardFn: func(ctx context.Context, name string, token string) (*ooohh.Board, error) {
-			return &ooohh.Board{
-				ID:        ooohh.BoardID("board"),
-				Token:     token,
-				Name:      name,
-				Dials:     []ooohh.Dial{},
-				UpdatedAt: now,
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return &ooohh.Dial{ID: id, Name: "test", Value: 40, UpdatedAt: now}, nil
+		},
+		GetDialHistoryFn: func(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+			if !since.Equal(now.Add(-time.Hour)) {
+				return nil, errors.New("unexpected since time")
+			}
+			// Return an older reading too, to check it is not counted.
+			return []ooohh.DialReading{
+				{Value: 10, At: now.Add(-3 * time.Hour)},
+				{Value: 20, At: now.Add(-time.Hour)},
+				{Value: 30, At: now.Add(-59 * time.Minute)},
+				{Value: 35, At: now.Add(-10 * time.Minute)},
+				{Value: 40, At: now},
 			}, nil
 		},
 	}
@@ -790,87 +1097,82 @@ func TestCreateBoardValidation(t *testing.T) {
 	// Create UI.
 	ui := ui.NewUI(s)
 
-	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
-
 	for _, tt := range []struct {
-		msg       string
-		body      string
-		expTitle  string
-		expDetail string
+		msg        string
+		query      string
+		expStatus  int
+		expHistory bool
+		expBody    string
 	}{{
-		msg:       "invalid json body",
-		body:      `{"name": "test", "token": "token"`,
-		expTitle:  "Validation Error",
-		expDetail: "Invalid JSON",
+		msg:        "without stats",
+		query:      "",
+		expStatus:  http.StatusOK,
+		expHistory: false,
+		expBody:    `{"id":"1234","name":"test","value":40,"updated_at":1581768000}`,
 	}, {
-		msg:       "missing name",
-		body:      `{"token": "token"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
-	}, {
-		msg:       "missing token",
-		body:      `{"name": "test"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
-	}, {
-		msg:       "missing name & token",
-		body:      `{}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
+		msg:        "with stats",
+		query:      "?include=stats",
+		expStatus:  http.StatusOK,
+		expHistory: true,
+		expBody:    `{"id":"1234","name":"test","value":40,"updated_at":1581768000,"stats":{"sets_last_hour":3}}`,
 	}, {
-		msg:       "extra field passed",
-		body:      `{"extra": "field"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `name` and `token` must be provided.",
+		msg:        "unknown include",
+		query:      "?include=history",
+		expStatus:  http.StatusBadRequest,
+		expHistory: false,
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
 
 			is := is.New(t)
 
+			s.Reset()
+
+			// Get an API, with a fixed time.
+			a := NewAPI(logger, s, ss, ui, WithTimeFormat(TimeFormatUnix), WithNow(func() time.Time { return now }))
+
 			// Create a new request.
-			r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(tt.body))
+			r, err := newRequest("GET", "/api/dials/:id"+tt.query, nil, httprouter.Params{{Key: "id", Value: "1234"}})
 			is.NoErr(err)
 
 			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 			rr := httptest.NewRecorder()
 
-			// Invoke the create board handler.
-			a.createBoard().ServeHTTP(rr, r)
-
-			// Check that the CreateBoard function has not been invoked.
-			is.True(!s.CreateBoardInvoked)
-
-			// Check the response status code is correct.
-			is.Equal(rr.Code, http.StatusBadRequest)
+			// Invoke the get dial handler.
+			a.getDial().ServeHTTP(rr, r)
 
-			// Check the response body is correct
-			type body struct {
-				Title  string `json:"title"`
-				Detail string `json:"detail"`
+			is.Equal(rr.Code, tt.expStatus)                  // status code is correct.
+			is.Equal(s.GetDialHistoryInvoked, tt.expHistory) // history is only retrieved for stats.
+			if tt.expBody != "" {
+				is.Equal(strings.TrimSpace(rr.Body.String()), tt.expBody) // body is correct.
 			}
-			var actualBody body
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
-
-			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
-			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
 		})
 	}
 }
 
-func TestCreateBoardError(t *testing.T) {
+func TestGetDialHistory(t *testing.T) {
 
 	is := is.New(t)
 
+	at := time.Date(2020, 5, 17, 10, 30, 15, 0, time.UTC)
+
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service, with CreateBoard implemented, that returns an error.
+	// Variables that will be assigned to within the GetDialHistory function.
+	var historySince time.Time
+
+	// Create a mock service, with GetDialHistory implemented.
 	s := &mock.Service{
-		CreateBoardFn: func(ctx context.Context, name string, token string) (*ooohh.Board, error) {
-			return nil, errors.New("error message")
+		GetDialHistoryFn: func(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+			historySince = since
+			if id != "1234" {
+				return nil, ooohh.ErrDialNotFound
+			}
+			return []ooohh.DialReading{
+				{Value: 10.0, At: at},
+				{Value: 80.0, Note: "prod is on fire", At: at.Add(time.Minute)},
+			}, nil
 		},
 	}
 
@@ -880,113 +1182,254 @@ func TestCreateBoardError(t *testing.T) {
 	// Create UI.
 	ui := ui.NewUI(s)
 
-	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+	// Get an API, with times formatted as unix timestamps.
+	a := NewAPI(logger, s, ss, ui, WithTimeFormat(TimeFormatUnix))
 
 	// Create a new request.
-	r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(`{"name": "test", "token": "token"}`))
+	r, err := newRequest("GET", "/api/dials/:id/history", nil, httprouter.Params{{Key: "id", Value: "1234"}})
 	is.NoErr(err)
 
 	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 	rr := httptest.NewRecorder()
 
-	// Invoke the create board handler.
-	a.createBoard().ServeHTTP(rr, r)
+	// Invoke the get dial history handler.
+	a.getDialHistory().ServeHTTP(rr, r)
 
-	// Check that the CreateBoard function has been invoked.
-	is.True(s.CreateBoardInvoked)
+	// Check the response is correct.
+	is.Equal(rr.Code, http.StatusOK) // status code is correct.
+	is.Equal(strings.TrimSpace(rr.Body.String()), `[{"value":10,"at":1589711415},{"value":80,"note":"prod is on fire","at":1589711475}]`)
+	is.True(historySince.IsZero()) // the whole history is retrieved.
 
-	// Check the response status code is correct.
-	is.Equal(rr.Code, http.StatusInternalServerError)
+	// The history can be retrieved since a time.
+	r, err = newRequest("GET", "/api/dials/:id/history?since=2020-05-17T10:30:15%2B01:00", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+	rr = httptest.NewRecorder()
+	a.getDialHistory().ServeHTTP(rr, r)
+	is.Equal(rr.Code, http.StatusOK)                // status code is correct.
+	is.True(historySince.Equal(at.Add(-time.Hour))) // since time is passed on.
 
-	// Check the response body is correct
-	type body struct {
-		Title  string `json:"title"`
-		Detail string `json:"detail"`
-	}
-	var actualBody body
-	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-	is.NoErr(err) // actual body is json.
+	// An invalid since time is rejected.
+	r, err = newRequest("GET", "/api/dials/:id/history?since=yesterday", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+	rr = httptest.NewRecorder()
+	a.getDialHistory().ServeHTTP(rr, r)
+	is.Equal(rr.Code, http.StatusBadRequest) // invalid since time is rejected.
 
-	is.Equal(actualBody.Title, "Internal Server Error")   // title is correct.
-	is.Equal(actualBody.Detail, "Could not create board") // detail is correct.
+	// A missing dial is not found.
+	r, err = newRequest("GET", "/api/dials/:id/history", nil, httprouter.Params{{Key: "id", Value: "missing"}})
+	is.NoErr(err)
+	rr = httptest.NewRecorder()
+	a.getDialHistory().ServeHTTP(rr, r)
+	is.Equal(rr.Code, http.StatusNotFound) // missing dial is not found.
 }
 
-func TestGetBoard(t *testing.T) {
+func TestTimeFormats(t *testing.T) {
 
-	is := is.New(t)
+	updatedAt := time.Date(2020, 5, 17, 10, 30, 15, 123456789, time.UTC)
 
-	now := time.Now().Truncate(time.Second)
+	for _, tt := range []struct {
+		msg     string
+		opts    []Option
+		expTime string
+	}{{
+		msg:     "default",
+		opts:    nil,
+		expTime: `"2020-05-17T10:30:15.123456789Z"`,
+	}, {
+		msg:     "rfc3339nano",
+		opts:    []Option{WithTimeFormat(TimeFormatRFC3339Nano)},
+		expTime: `"2020-05-17T10:30:15.123456789Z"`,
+	}, {
+		msg:     "rfc3339",
+		opts:    []Option{WithTimeFormat(TimeFormatRFC3339)},
+		expTime: `"2020-05-17T10:30:15Z"`,
+	}, {
+		msg:     "unix",
+		opts:    []Option{WithTimeFormat(TimeFormatUnix)},
+		expTime: `1589711415`,
+	}} {
 
-	// Get a logger.
-	logger, _ := newTestLogger(zap.InfoLevel)
+		t.Run(tt.msg, func(t *testing.T) {
 
-	// Create a mock service, with GetBoard implemented.
-	s := &mock.Service{
-		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
-			return &ooohh.Board{
-				ID:    id,
-				Token: "token",
-				Name:  "test",
-				Dials: []ooohh.Dial{{
-					ID:        "dial",
-					Token:     "token",
-					Name:      "test",
-					Value:     66.6,
-					UpdatedAt: now,
-				}},
-				UpdatedAt: now,
-			}, nil
-		},
-	}
+			is := is.New(t)
 
-	// Create a mock slack service.
-	ss := &mock.SlackService{}
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create UI.
-	ui := ui.NewUI(s)
+			// Create a mock service, with GetDial and GetBoard implemented.
+			dial := ooohh.Dial{ID: "dial", Name: "test", UpdatedAt: updatedAt}
+			s := &mock.Service{
+				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+					return &dial, nil
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{ID: id, Name: "test", Dials: []ooohh.Dial{dial}, UpdatedAt: updatedAt}, nil
+				},
+			}
 
-	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
 
-	// Create a new request.
-	r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui, tt.opts...)
+
+			// Get the dial.
+			r, err := newRequest("GET", "/api/dials/:id", nil, httprouter.Params{{Key: "id", Value: "dial"}})
+			is.NoErr(err)
+			rr := httptest.NewRecorder()
+			a.getDial().ServeHTTP(rr, r)
+			is.Equal(rr.Code, http.StatusOK)
+
+			var dialBody map[string]json.RawMessage
+			err = json.Unmarshal(rr.Body.Bytes(), &dialBody)
+			is.NoErr(err)                                        // dial body is json.
+			is.Equal(string(dialBody["updated_at"]), tt.expTime) // dial time is in the configured format.
+
+			// Get the board.
+			r, err = newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board"}})
+			is.NoErr(err)
+			rr = httptest.NewRecorder()
+			a.getBoard().ServeHTTP(rr, r)
+			is.Equal(rr.Code, http.StatusOK)
+
+			var boardBody struct {
+				UpdatedAt json.RawMessage `json:"updated_at"`
+				Dials     []struct {
+					UpdatedAt json.RawMessage `json:"updated_at"`
+				} `json:"dials"`
+				Groups []struct {
+					Dials []struct {
+						UpdatedAt json.RawMessage `json:"updated_at"`
+					} `json:"dials"`
+				} `json:"groups"`
+			}
+			err = json.Unmarshal(rr.Body.Bytes(), &boardBody)
+			is.NoErr(err)                                                        // board body is json.
+			is.Equal(string(boardBody.UpdatedAt), tt.expTime)                    // board time is in the configured format.
+			is.Equal(string(boardBody.Dials[0].UpdatedAt), tt.expTime)           // board dial time is in the configured format.
+			is.Equal(string(boardBody.Groups[0].Dials[0].UpdatedAt), tt.expTime) // grouped dial time is in the configured format.
+		})
+	}
+}
+
+func TestPrecision(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetDial and GetBoard implemented.
+	dial := ooohh.Dial{ID: "dial", Name: "test", Value: 66.6666}
+	s := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			d := dial
+			return &d, nil
+		},
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "test", Dials: []ooohh.Dial{dial}}, nil
+		},
+	}
+
+	// Create a mock slack service, with GetDial implemented.
+	ss := &mock.SlackService{
+		GetDialFn: func(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error) {
+			d := dial
+			return &d, nil
+		},
+	}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API, with a precision of 2.
+	a := NewAPI(logger, s, ss, ui, WithPrecision(2), WithInsecureSlackRequests())
+
+	// Check the Slack query response uses the precision.
+	formData := url.Values{
+		"command": {"/wtf"},
+		"user_id": {"user"},
+		"team_id": {"team"},
+		"text":    {"?"},
+	}
+	r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
 	is.NoErr(err)
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 	rr := httptest.NewRecorder()
+	a.slackCommand().ServeHTTP(rr, r)
+	is.Equal(rr.Code, http.StatusOK)
 
-	// Invoke the get board handler.
-	a.getBoard().ServeHTTP(rr, r)
+	var slackBody struct {
+		Text string `json:"text"`
+	}
+	err = json.Unmarshal(rr.Body.Bytes(), &slackBody)
+	is.NoErr(err)                                                 // slack body is json.
+	is.Equal(slackBody.Text, "Your dial (dial) is set to 66.67.") // slack text uses the precision.
 
-	// Check that the GetBoard function has been invoked.
-	is.True(s.GetBoardInvoked)
+	// Check the precision query param rounds dial values.
+	for _, tt := range []struct {
+		msg      string
+		query    string
+		expValue float64
+	}{{
+		msg:      "no precision",
+		query:    "",
+		expValue: 66.6666,
+	}, {
+		msg:      "precision 2",
+		query:    "?precision=2",
+		expValue: 66.67,
+	}, {
+		msg:      "precision 0",
+		query:    "?precision=0",
+		expValue: 67,
+	}} {
 
-	// Check the response status code is correct.
-	is.Equal(rr.Code, http.StatusOK)
+		t.Run(tt.msg, func(t *testing.T) {
 
-	// Check the response body is correct
-	var actualBody ooohh.Board
-	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-	is.NoErr(err) // actual body is json.
+			is := is.New(t)
 
-	is.Equal(actualBody.ID, ooohh.BoardID("1234"))    // id is correct.
-	is.Equal(actualBody.Name, "test")                 // name is correct.
-	is.Equal(len(actualBody.Dials), 1)                // dial length is correct.
-	is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
-	is.Equal(actualBody.Token, "")                    // token is not in response body.
+			// Get the dial.
+			r, err := newRequest("GET", "/api/dials/:id"+tt.query, nil, httprouter.Params{{Key: "id", Value: "dial"}})
+			is.NoErr(err)
+			rr := httptest.NewRecorder()
+			a.getDial().ServeHTTP(rr, r)
+			is.Equal(rr.Code, http.StatusOK)
 
-	// Check returned dial is correct.
-	dial := actualBody.Dials[0]
+			var dialBody ooohh.Dial
+			err = json.Unmarshal(rr.Body.Bytes(), &dialBody)
+			is.NoErr(err)                         // dial body is json.
+			is.Equal(dialBody.Value, tt.expValue) // dial value is rounded.
 
-	is.Equal(dial.ID, ooohh.DialID("dial"))     // dial id is correct.
-	is.Equal(dial.Name, "test")                 // dial name is correct.
-	is.Equal(dial.Value, 66.6)                  // dial value is correct.
-	is.Equal(dial.UpdatedAt.Unix(), now.Unix()) // dial updated at is correct.
-	is.Equal(dial.Token, "")                    // dial token is empty.
+			// Get the board.
+			r, err = newRequest("GET", "/api/boards/:id"+tt.query, nil, httprouter.Params{{Key: "id", Value: "board"}})
+			is.NoErr(err)
+			rr = httptest.NewRecorder()
+			a.getBoard().ServeHTTP(rr, r)
+			is.Equal(rr.Code, http.StatusOK)
+
+			var boardBody ooohh.Board
+			err = json.Unmarshal(rr.Body.Bytes(), &boardBody)
+			is.NoErr(err)                                   // board body is json.
+			is.Equal(boardBody.Dials[0].Value, tt.expValue) // board dial value is rounded.
+		})
+	}
+
+	// Check invalid precisions are rejected.
+	for _, q := range []string{"-1", "7", "two"} {
+		r, err := newRequest("GET", "/api/dials/:id?precision="+q, nil, httprouter.Params{{Key: "id", Value: "dial"}})
+		is.NoErr(err)
+		rr := httptest.NewRecorder()
+		a.getDial().ServeHTTP(rr, r)
+		is.Equal(rr.Code, http.StatusBadRequest) // invalid precision is rejected.
+	}
 }
 
-func TestGetBoardErrors(t *testing.T) {
+func TestGetDialErrors(t *testing.T) {
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
@@ -998,8 +1441,8 @@ func TestGetBoardErrors(t *testing.T) {
 		expTitle  string
 		expDetail string
 	}{{
-		msg:       "board not found",
-		err:       ooohh.ErrBoardNotFound,
+		msg:       "dial not found",
+		err:       ooohh.ErrDialNotFound,
 		expStatus: http.StatusNotFound,
 		expTitle:  "Not Found",
 		expDetail: "Not Found",
@@ -1008,16 +1451,16 @@ func TestGetBoardErrors(t *testing.T) {
 		err:       errors.New("uh-oh"),
 		expStatus: http.StatusInternalServerError,
 		expTitle:  "Internal Server Error",
-		expDetail: "Could not retrieve board",
+		expDetail: "Could not retrieve dial",
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
 
 			is := is.New(t)
 
-			// Create a mock service, with GetBoard implemented.
+			// Create a mock service, with GetDial implemented.
 			s := &mock.Service{
-				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
 					return nil, tt.err
 				},
 			}
@@ -1032,17 +1475,17 @@ func TestGetBoardErrors(t *testing.T) {
 			a := NewAPI(logger, s, ss, ui)
 
 			// Create a new request.
-			r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			r, err := newRequest("GET", "/api/dials/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
 			is.NoErr(err)
 
 			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 			rr := httptest.NewRecorder()
 
-			// Invoke the get board handler.
-			a.getBoard().ServeHTTP(rr, r)
+			// Invoke the get dial handler.
+			a.getDial().ServeHTTP(rr, r)
 
-			// Check that the GetBoard function has been invoked.
-			is.True(s.GetBoardInvoked)
+			// Check that the GetDial function has been invoked.
+			is.True(s.GetDialInvoked)
 
 			// Check the response status code is correct.
 			is.Equal(rr.Code, tt.expStatus)
@@ -1060,134 +1503,81 @@ func TestGetBoardErrors(t *testing.T) {
 			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
 		})
 	}
-
 }
 
-func TestSetBoard(t *testing.T) {
+func TestGetDials(t *testing.T) {
+
+	is := is.New(t)
 
 	now := time.Now().Truncate(time.Second)
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	for _, tt := range []struct {
-		msg   string
-		dials []string
-	}{{
-		msg:   "non-empty dials",
-		dials: []string{"4321"},
-	}, {
-		msg:   "empty dials",
-		dials: []string{},
-	}} {
-
-		t.Run(tt.msg, func(t *testing.T) {
-
-			is := is.New(t)
-
-			// Variables that will be assigned to within the SetBoard function.
-			var setID ooohh.BoardID
-			var setToken string
-			var setDials *[]ooohh.DialID
-
-			// Create a mock service, with GetBoard and SetBoard implemented.
-			s := &mock.Service{
-				SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
-
-					// Capture what was set.
-					setID = id
-					setToken = token
-					setDials = &dials
-
-					return nil
-				},
-				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
-
-					dials := make([]ooohh.Dial, len(*setDials))
-					for i := range *setDials {
-						dials[i] = ooohh.Dial{
-							ID:        (*setDials)[i],
-							Name:      fmt.Sprintf("dial-%d", i),
-							Token:     "token",
-							Value:     66.6,
-							UpdatedAt: now,
-						}
-					}
-
-					return &ooohh.Board{
-						ID:        id,
-						Token:     setToken,
-						Name:      "test",
-						Dials:     dials,
-						UpdatedAt: now,
-					}, nil
-				},
-			}
-
-			// Create a mock slack service.
-			ss := &mock.SlackService{}
-
-			// Create UI.
-			ui := ui.NewUI(s)
+	// Dials known to the service.
+	known := map[ooohh.DialID]ooohh.Dial{
+		"dial-1": {ID: "dial-1", Token: "token", Name: "one", Value: 10.0, UpdatedAt: now},
+		"dial-2": {ID: "dial-2", Token: "token", Name: "two", Value: 66.6, UpdatedAt: now},
+	}
 
-			// Get an API.
-			a := NewAPI(logger, s, ss, ui)
+	// Variables that will be set by the service.
+	var setIDs []ooohh.DialID
 
-			// Marshal json.
-			type request struct {
-				Token string   `json:"token"`
-				Dials []string `json:"dials"`
+	// Create a mock service, with GetDials implemented.
+	s := &mock.Service{
+		GetDialsFn: func(ctx context.Context, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+			setIDs = ids
+			dials := make(map[ooohh.DialID]ooohh.Dial)
+			for _, id := range ids {
+				if d, ok := known[id]; ok {
+					dials[id] = d
+				}
 			}
+			return dials, nil
+		},
+	}
 
-			b, err := json.Marshal(request{"token", tt.dials})
-			is.NoErr(err) // invalid request json.
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
 
-			// Create a new request.
-			r, err := newRequest("PATCH", "/api/boards/:id", bytes.NewReader(b), httprouter.Params{{Key: "id", Value: "1234"}})
-			is.NoErr(err)
+	// Create UI.
+	ui := ui.NewUI(s)
 
-			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
-			rr := httptest.NewRecorder()
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
 
-			// Invoke the set board handler.
-			a.setBoardDials().ServeHTTP(rr, r)
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/dials/batch-get", strings.NewReader(`{"ids": ["dial-1", "missing", "dial-2"]}`))
+	is.NoErr(err)
 
-			// Check that the SetBoard function has been invoked.
-			is.True(s.SetBoardInvoked)
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
 
-			// Check that the SetBoard function was invoked with the correct params.
-			is.Equal(setID, ooohh.BoardID("1234")) // correct board was set.
-			is.Equal(setToken, "token")            // correct token was used for the set.
-			is.True(setDials != nil)               // dials were set.
-			if setDials != nil {
-				dialIDs := make([]ooohh.DialID, len(tt.dials))
-				for i := range tt.dials {
-					dialIDs[i] = ooohh.DialID(tt.dials[i])
-				}
-				is.Equal(*setDials, dialIDs) // correct dials were set.
-			}
+	// Route the request, as the get dials handler matches its path itself.
+	newTestRouter(a).ServeHTTP(rr, r)
 
-			// Check that the GetBoard function has been invoked.
-			is.True(s.GetBoardInvoked)
+	// Check that the GetDials function has been invoked with all ids.
+	is.True(s.GetDialsInvoked)
+	is.Equal(setIDs, []ooohh.DialID{"dial-1", "missing", "dial-2"}) // all ids are requested.
 
-			// Check the response status code is correct.
-			is.Equal(rr.Code, http.StatusOK)
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
 
-			// Check the response body is correct
-			var actualBody ooohh.Board
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
+	// Check the response body is correct
+	var actualBody map[string]map[string]interface{}
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
 
-			is.Equal(actualBody.ID, ooohh.BoardID("1234"))    // id is correct.
-			is.Equal(actualBody.Name, "test")                 // name is correct.
-			is.Equal(len(actualBody.Dials), len(tt.dials))    // dial length is correct.
-			is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
-			is.Equal(actualBody.Token, "")                    // token is not in response body.
-		})
-	}
+	is.Equal(len(actualBody), 2)                  // only found dials are returned.
+	is.Equal(actualBody["dial-1"]["name"], "one") // first dial is returned.
+	is.Equal(actualBody["dial-2"]["value"], 66.6) // second dial is returned.
+	_, ok := actualBody["missing"]
+	is.True(!ok) // missing dial is omitted.
+	_, ok = actualBody["dial-1"]["token"]
+	is.True(!ok) // token is not in response body.
 }
 
-func TestSetBoardValidation(t *testing.T) {
+func TestGetDialsValidation(t *testing.T) {
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
@@ -1204,36 +1594,33 @@ func TestSetBoardValidation(t *testing.T) {
 	// Get an API.
 	a := NewAPI(logger, s, ss, ui)
 
+	// Build a list of too many ids.
+	tooMany := make([]string, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("dial-%d", i)
+	}
+	tooManyBody, _ := json.Marshal(map[string][]string{"ids": tooMany})
+
 	for _, tt := range []struct {
 		msg       string
 		body      string
-		expTitle  string
 		expDetail string
 	}{{
 		msg:       "invalid json body",
-		body:      `{"dials": ["4321"], "token": "token"`,
-		expTitle:  "Validation Error",
+		body:      `{"ids": [`,
 		expDetail: "Invalid JSON",
 	}, {
-		msg:       "missing value",
-		body:      `{"token": "token"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `dials` must be provided.",
-	}, {
-		msg:       "missing token",
-		body:      `{"dials": ["4321"]}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `dials` must be provided.",
-	}, {
-		msg:       "missing dials & token",
+		msg:       "missing ids",
 		body:      `{}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `dials` must be provided.",
+		expDetail: "`ids` must be provided.",
 	}, {
-		msg:       "extra field passed",
-		body:      `{"extra": "field"}`,
-		expTitle:  "Validation Error",
-		expDetail: "Both `token` and `dials` must be provided.",
+		msg:       "empty ids",
+		body:      `{"ids": []}`,
+		expDetail: "`ids` must be provided.",
+	}, {
+		msg:       "too many ids",
+		body:      string(tooManyBody),
+		expDetail: fmt.Sprintf("At most %d `ids` can be provided.", maxBatchSize),
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
@@ -1241,17 +1628,17 @@ func TestSetBoardValidation(t *testing.T) {
 			is := is.New(t)
 
 			// Create a new request.
-			r, err := newRequest("PATCH", "/api/boards/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			r, err := http.NewRequest("POST", "/api/dials/batch-get", strings.NewReader(tt.body))
 			is.NoErr(err)
 
 			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 			rr := httptest.NewRecorder()
 
-			// Invoke the set board handler.
-			a.setBoardDials().ServeHTTP(rr, r)
+			// Route the request, as the get dials handler matches its path itself.
+			newTestRouter(a).ServeHTTP(rr, r)
 
-			// Check that the SetBoard function has not been invoked.
-			is.True(!s.SetBoardInvoked)
+			// Check that the GetDials function has not been invoked.
+			is.True(!s.GetDialsInvoked)
 
 			// Check the response status code is correct.
 			is.Equal(rr.Code, http.StatusBadRequest)
@@ -1265,78 +1652,170 @@ func TestSetBoardValidation(t *testing.T) {
 			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
 			is.NoErr(err) // actual body is json.
 
-			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
-			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+			is.Equal(actualBody.Title, "Validation Error") // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail)      // detail is correct.
 		})
 	}
 }
 
-func TestSetBoardErrors(t *testing.T) {
+// listDials returns a ListDials implementation that lists n generated dials.
+func listDials(n int) func(ctx context.Context, fn func(ooohh.Dial) error) error {
+	return func(ctx context.Context, fn func(ooohh.Dial) error) error {
+		for i := 0; i < n; i++ {
+			d := ooohh.Dial{
+				ID:    ooohh.DialID(fmt.Sprintf("dial-%d", i)),
+				Token: "token",
+				Name:  fmt.Sprintf("Dial %d", i),
+				Value: float64(i % 100),
+			}
+			if err := fn(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
 
-	now := time.Now().Truncate(time.Second)
+func TestExportDials(t *testing.T) {
 
-	// Get a logger.
-	logger, _ := newTestLogger(zap.InfoLevel)
+	for _, tt := range []struct {
+		msg string
+		n   int
+	}{{
+		msg: "no dials",
+		n:   0,
+	}, {
+		msg: "one dial",
+		n:   1,
+	}, {
+		msg: "many dials",
+		n:   10000,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service, with ListDials implemented.
+			s := &mock.Service{
+				ListDialsFn: listDials(tt.n),
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui, WithAdminToken("admin"))
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", "/api/admin/dials", nil)
+			is.NoErr(err)
+			r.Header.Set("Authorization", "Bearer admin")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the export dials handler.
+			a.exportDials().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the response body is a full JSON array.
+			var actualBody []map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err)                   // actual body is a json array.
+			is.Equal(len(actualBody), tt.n) // all dials are exported.
+
+			for i, d := range actualBody {
+				is.Equal(d["id"], fmt.Sprintf("dial-%d", i)) // dials are in order.
+				_, ok := d["token"]
+				is.True(!ok) // token is not exported.
+			}
+		})
+	}
+}
+
+func TestExportDialsPaginated(t *testing.T) {
 
 	for _, tt := range []struct {
-		msg           string
-		setErr        error
-		getErr        error
-		expGetInvoked bool
-		expStatus     int
-		expTitle      string
-		expDetail     string
+		msg       string
+		query     string
+		page      ooohh.DialPage
+		expStatus int
+		expAfter  ooohh.DialID
+		expLimit  int
+		expLink   string
+		expTotal  string
 	}{{
-		msg:           "set with wrong token",
-		setErr:        ooohh.ErrUnauthorized,
-		getErr:        nil,
-		expGetInvoked: false,
-		expStatus:     http.StatusUnauthorized,
-		expTitle:      "Unauthorized",
-		expDetail:     "Invalid token",
+		msg:       "first page",
+		query:     "limit=2",
+		page:      ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-0"}, {ID: "dial-1"}}, Next: "dial-1", Total: 5},
+		expStatus: http.StatusOK,
+		expLimit:  2,
+		expLink:   `</api/admin/dials?after=dial-1&limit=2>; rel="next"`,
+		expTotal:  "5",
 	}, {
-		msg:           "set with missing board",
-		setErr:        ooohh.ErrBoardNotFound,
-		getErr:        ooohh.ErrBoardNotFound,
-		expGetInvoked: false,
-		expStatus:     http.StatusNotFound,
-		expTitle:      "Not Found",
-		expDetail:     "Not Found",
+		msg:       "middle page",
+		query:     "limit=2&after=dial-1",
+		page:      ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-2"}, {ID: "dial-3"}}, Next: "dial-3", Total: 5},
+		expStatus: http.StatusOK,
+		expAfter:  "dial-1",
+		expLimit:  2,
+		expLink:   `</api/admin/dials?after=dial-3&limit=2>; rel="next"`,
+		expTotal:  "5",
 	}, {
-		msg:           "set with unknown error",
-		setErr:        errors.New("set error"),
-		getErr:        nil,
-		expGetInvoked: false,
-		expStatus:     http.StatusInternalServerError,
-		expTitle:      "Internal Server Error",
-		expDetail:     "Could not update board",
+		msg:       "last page",
+		query:     "limit=2&after=dial-3",
+		page:      ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-4"}}, Total: 5},
+		expStatus: http.StatusOK,
+		expAfter:  "dial-3",
+		expLimit:  2,
+		expLink:   "",
+		expTotal:  "5",
 	}, {
-		msg:           "get with unknown error",
-		setErr:        nil,
-		getErr:        errors.New("get error"),
-		expGetInvoked: true,
-		expStatus:     http.StatusInternalServerError,
-		expTitle:      "Internal Server Error",
-		expDetail:     "Could not update board",
+		msg:       "invalid limit",
+		query:     "limit=none",
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "limit is clamped",
+		query:     fmt.Sprintf("limit=%d", maxBatchSize+1),
+		page:      ooohh.DialPage{Total: 0},
+		expStatus: http.StatusOK,
+		expLimit:  maxBatchSize,
+		expTotal:  "0",
+	}, {
+		msg:       "cursor is an alias of after",
+		query:     "limit=2&cursor=dial-3",
+		page:      ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-4"}}, Total: 5},
+		expStatus: http.StatusOK,
+		expAfter:  "dial-3",
+		expLimit:  2,
+		expTotal:  "5",
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
 
 			is := is.New(t)
 
-			// Create a mock service, with GetBoard and SetBoard implemented.
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the PageDials function.
+			var pagedAfter ooohh.DialID
+			var pagedLimit int
+
+			// Create a mock service, with PageDials implemented.
 			s := &mock.Service{
-				SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
-					return tt.setErr
-				},
-				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
-					return &ooohh.Board{
-						ID:        id,
-						Token:     "token",
-						Name:      "test",
-						Dials:     []ooohh.Dial{},
-						UpdatedAt: now,
-					}, tt.getErr
+				PageDialsFn: func(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
+					pagedAfter, pagedLimit = after, limit
+					return &tt.page, nil
 				},
 			}
 
@@ -1347,171 +1826,4693 @@ func TestSetBoardErrors(t *testing.T) {
 			ui := ui.NewUI(s)
 
 			// Get an API.
-			a := NewAPI(logger, s, ss, ui)
+			a := NewAPI(logger, s, ss, ui, WithAdminToken("admin"))
 
 			// Create a new request.
-			r, err := newRequest("PATCH", "/api/boards/:id", strings.NewReader(`{"token": "token", "dials": ["4321"]}`), httprouter.Params{{Key: "id", Value: "1234"}})
+			r, err := http.NewRequest("GET", "/api/admin/dials?"+tt.query, nil)
 			is.NoErr(err)
+			r.Header.Set("Authorization", "Bearer admin")
 
 			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 			rr := httptest.NewRecorder()
 
-			// Invoke the set board handler.
-			a.setBoardDials().ServeHTTP(rr, r)
+			// Invoke the export dials handler.
+			a.exportDials().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
 
-			// Check that the SetBoard function has been invoked.
-			is.True(s.SetBoardInvoked)
+			// Check whether the PageDials function has been invoked.
+			is.Equal(s.PageDialsInvoked, tt.expStatus == http.StatusOK)
+			if tt.expStatus != http.StatusOK {
+				return
+			}
 
-			// Check that the GetBoard function has (not) been invoked.
-			is.Equal(s.GetBoardInvoked, tt.expGetInvoked)
+			is.Equal(pagedAfter, tt.expAfter) // page starts after the given dial.
+			is.Equal(pagedLimit, tt.expLimit) // page has the given limit.
+
+			// Check the pagination headers are correct.
+			is.Equal(rr.Header().Get("Link"), tt.expLink)           // next link is correct.
+			is.Equal(rr.Header().Get("X-Total-Count"), tt.expTotal) // total count is correct.
+
+			// Check the response body is the page of dials.
+			var actualBody []ooohh.Dial
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err)                                 // actual body is a json array.
+			is.Equal(len(actualBody), len(tt.page.Dials)) // page dials are returned.
+		})
+	}
+}
+
+func TestAdminEndpoints(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg        string
+		adminToken string
+		header     string
+		expStatus  int
+	}{{
+		msg:        "admin disabled",
+		adminToken: "",
+		header:     "Bearer ",
+		expStatus:  http.StatusNotFound,
+	}, {
+		msg:        "admin disabled with a token",
+		adminToken: "",
+		header:     "Bearer admin",
+		expStatus:  http.StatusNotFound,
+	}, {
+		msg:        "valid token",
+		adminToken: "admin",
+		header:     "Bearer admin",
+		expStatus:  http.StatusOK,
+	}, {
+		msg:        "missing token",
+		adminToken: "admin",
+		header:     "",
+		expStatus:  http.StatusUnauthorized,
+	}, {
+		msg:        "wrong token",
+		adminToken: "admin",
+		header:     "Bearer wrong",
+		expStatus:  http.StatusUnauthorized,
+	}} {
+
+		for _, path := range []string{"/api/dials", "/api/admin/dials"} {
+
+			t.Run(tt.msg+" "+path, func(t *testing.T) {
+
+				is := is.New(t)
+
+				// Get a logger.
+				logger, _ := newTestLogger(zap.InfoLevel)
+
+				// Create a mock service, with the listing functions implemented.
+				s := &mock.Service{
+					ListDialsFn: listDials(1),
+					PageDialsFn: func(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
+						return &ooohh.DialPage{}, nil
+					},
+				}
+
+				// Get an API.
+				a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithAdminToken(tt.adminToken))
+
+				// Create a new request.
+				r, err := http.NewRequest("GET", path, nil)
+				is.NoErr(err)
+				r.Header.Set("Authorization", tt.header)
+
+				// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+				rr := httptest.NewRecorder()
+
+				// Route the request, so the endpoint's middleware is applied.
+				newTestRouter(a).ServeHTTP(rr, r)
+
+				// Check the response status code is correct.
+				is.Equal(rr.Code, tt.expStatus)
+
+				// Check that the dials are only listed for admins.
+				is.Equal(s.ListDialsInvoked || s.PageDialsInvoked, tt.expStatus == http.StatusOK)
+
+				// Check unauthorized requests are told how to authenticate.
+				if tt.expStatus == http.StatusUnauthorized {
+					is.Equal(rr.Header().Get("WWW-Authenticate"), "Bearer") // bearer token is required.
+				}
+			})
+		}
+	}
+}
+
+func TestListDials(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg           string
+		query         string
+		header        string
+		page          ooohh.DialPage
+		expStatus     int
+		expCursor     ooohh.DialID
+		expLimit      int
+		expNextCursor ooohh.DialID
+		expLink       string
+	}{{
+		msg:           "first page",
+		query:         "",
+		header:        "Bearer admin",
+		page:          ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-0", Token: "secret"}}, Next: "dial-0"},
+		expStatus:     http.StatusOK,
+		expLimit:      maxBatchSize,
+		expNextCursor: "dial-0",
+		expLink:       fmt.Sprintf(`</api/dials?after=dial-0&limit=%d>; rel="next"`, maxBatchSize),
+	}, {
+		msg:       "after is an alias of cursor",
+		query:     "after=dial-0&limit=2",
+		header:    "Bearer admin",
+		page:      ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-1", Token: "secret"}}},
+		expStatus: http.StatusOK,
+		expCursor: "dial-0",
+		expLimit:  2,
+	}, {
+		msg:       "last page",
+		query:     "cursor=dial-0&limit=2",
+		header:    "Bearer admin",
+		page:      ooohh.DialPage{Dials: []ooohh.Dial{{ID: "dial-1", Token: "secret"}}},
+		expStatus: http.StatusOK,
+		expCursor: "dial-0",
+		expLimit:  2,
+	}, {
+		msg:       "limit is clamped",
+		query:     fmt.Sprintf("limit=%d", maxBatchSize+1),
+		header:    "Bearer admin",
+		expStatus: http.StatusOK,
+		expLimit:  maxBatchSize,
+	}, {
+		msg:       "invalid limit",
+		query:     "limit=0",
+		header:    "Bearer admin",
+		expStatus: http.StatusBadRequest,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the PageDials function.
+			var pagedAfter ooohh.DialID
+			var pagedLimit int
+
+			// Create a mock service, with PageDials implemented.
+			s := &mock.Service{
+				PageDialsFn: func(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
+					pagedAfter, pagedLimit = after, limit
+					return &tt.page, nil
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithAdminToken("admin"))
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", "/api/dials?"+tt.query, nil)
+			is.NoErr(err)
+			r.Header.Set("Authorization", tt.header)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the list dials handler.
+			a.listDials().ServeHTTP(rr, r)
 
 			// Check the response status code is correct.
 			is.Equal(rr.Code, tt.expStatus)
 
-			// Check the response body is correct
-			type body struct {
-				Title  string `json:"title"`
-				Detail string `json:"detail"`
+			// Check whether the PageDials function has been invoked.
+			is.Equal(s.PageDialsInvoked, tt.expStatus == http.StatusOK)
+			if tt.expStatus != http.StatusOK {
+				return
 			}
-			var actualBody body
-			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
-			is.NoErr(err) // actual body is json.
 
-			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
-			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+			is.Equal(pagedAfter, tt.expCursor) // page starts after the cursor.
+			is.Equal(pagedLimit, tt.expLimit)  // page has the expected limit.
+
+			// Check the next page is also linked to, as for the admin export.
+			is.Equal(rr.Header().Get("Link"), tt.expLink) // next link is correct.
+
+			// Check the response body is the page of dials, without tokens.
+			var actualBody struct {
+				Dials      []map[string]interface{} `json:"dials"`
+				NextCursor ooohh.DialID             `json:"next_cursor"`
+			}
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err)                                       // actual body is json.
+			is.Equal(len(actualBody.Dials), len(tt.page.Dials)) // page dials are returned.
+			is.Equal(actualBody.NextCursor, tt.expNextCursor)   // next cursor is returned.
+			for _, d := range actualBody.Dials {
+				_, ok := d["token"]
+				is.True(!ok) // tokens are omitted.
+			}
 		})
 	}
 }
 
-func TestSlackCommand(t *testing.T) {
+// discardResponseWriter is a http.ResponseWriter that discards the response body.
+type discardResponseWriter struct {
+	h http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.h }
+func (w *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+
+// BenchmarkExportDials reports the allocations made per export. As dials are
+// streamed, the memory used per op shouldn't hold all of the dials at once.
+func BenchmarkExportDials(b *testing.B) {
 
 	// Get a logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create a mock service.
-	s := &mock.Service{}
+	// Create a mock service, with ListDials implemented.
+	s := &mock.Service{
+		ListDialsFn: listDials(100000),
+	}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithAdminToken("admin"))
+	h := a.exportDials()
+
+	r, err := http.NewRequest("GET", "/api/admin/dials", nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	r.Header.Set("Authorization", "Bearer admin")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h.ServeHTTP(&discardResponseWriter{h: make(http.Header)}, r)
+	}
+}
+
+func TestSetDial(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
 
 	for _, tt := range []struct {
-		msg               string
-		text              string
-		expType           string
-		expText           string
-		expServiceInvoked bool
-		expGetInvoked     bool
+		msg   string
+		value float64
+		note  string
 	}{{
-		msg:               "help command",
-		text:              "help",
-		expType:           "ephemeral",
-		expText:           "Use the following format to set a value: `/wtf <number>`",
-		expServiceInvoked: false,
-	}, {
-		msg:               "low level",
-		text:              "10",
-		expType:           "ephemeral",
-		expText:           "Ooohh, I wish I felt like that.",
-		expServiceInvoked: true,
-	}, {
-		msg:               "medium level",
-		text:              "55",
-		expType:           "ephemeral",
-		expText:           "Ooohh, make sure you take a break!",
-		expServiceInvoked: true,
-	}, {
-		msg:               "high level",
-		text:              "85",
-		expType:           "ephemeral",
-		expText:           "Ooohh, make sure you check in with someone, maybe they can help.",
-		expServiceInvoked: true,
+		msg:   "non-zero value",
+		value: 66.6,
 	}, {
-		msg:               "value too high",
-		text:              "101",
-		expType:           "ephemeral",
-		expText:           "Value out of bounds. Please upply number between 0 and 100.",
-		expServiceInvoked: true,
+		msg:   "zero value",
+		value: 0,
 	}, {
-		msg:               "value too low",
-		text:              "-1",
+		msg:   "with note",
+		value: 80,
+		note:  "prod is on fire",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be assigned to within the UpdateDial function.
+			var setID ooohh.DialID
+			var setToken string
+			var setValue *float64
+			var setNote string
+
+			// Create a mock service, with UpdateDial implemented.
+			s := &mock.Service{
+				UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+
+					// Capture what was set.
+					setID = id
+					setToken = token
+					setValue = u.Value
+					setNote = u.Note
+
+					return &ooohh.Dial{
+						ID:        id,
+						Token:     token,
+						Name:      "test",
+						Value:     *u.Value,
+						UpdatedAt: now,
+					}, nil
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(fmt.Sprintf(`{"token": "token", "value": %f, "note": %q}`, tt.value, tt.note)), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set dial handler.
+			a.setDialValue().ServeHTTP(rr, r)
+
+			// Check that the UpdateDial function has been invoked.
+			is.True(s.UpdateDialInvoked)
+
+			// Check that the UpdateDial function was invoked with the correct params.
+			is.Equal(setID, ooohh.DialID("1234")) // correct dial was set.
+			is.Equal(setToken, "token")           // correct token was used for the set.
+			is.True(setValue != nil)              // value was set.
+			if setValue != nil {
+				is.Equal(*setValue, tt.value) // correct value was set.
+			}
+			is.Equal(setNote, tt.note) // correct note was set.
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the response body is correct
+			var actualBody ooohh.Dial
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.ID, ooohh.DialID("1234"))     // id is correct.
+			is.Equal(actualBody.Name, "test")                 // name is correct.
+			is.Equal(actualBody.Value, tt.value)              // value is correct.
+			is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
+			is.Equal(actualBody.Token, "")                    // token is not in response body.
+		})
+	}
+
+}
+
+func TestSetDialColor(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Variables that will be assigned to within the UpdateDial function.
+	var setID ooohh.DialID
+	var setToken string
+	var set ooohh.DialUpdate
+
+	// Create a mock service, with UpdateDial implemented.
+	s := &mock.Service{
+		UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+			setID, setToken, set = id, token, u
+			return &ooohh.Dial{ID: id, Name: "test", Color: *u.Color}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "color": "#00ff00"}`), httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the set dial handler.
+	a.setDialValue().ServeHTTP(rr, r)
+
+	// Check that only the color was set.
+	is.True(s.UpdateDialInvoked)
+	is.True(!set.Valued())                // value is not set.
+	is.Equal(setID, ooohh.DialID("1234")) // correct dial was set.
+	is.Equal(setToken, "token")           // correct token was used for the set.
+	is.Equal(*set.Color, "#00ff00")       // correct color was set.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the response body is correct
+	var actualBody ooohh.Dial
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.Color, "#00ff00") // color is correct.
+}
+
+func TestSetDialDelta(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Variables that will be assigned to within the UpdateDial function.
+	var adjustedID ooohh.DialID
+	var adjustedToken string
+	var adjusted ooohh.DialUpdate
+
+	// Create a mock service, with UpdateDial implemented.
+	s := &mock.Service{
+		UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+			adjustedID, adjustedToken, adjusted = id, token, u
+			return &ooohh.Dial{ID: id, Name: "test", Value: 40}, nil
+		},
+	}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+	// Create a new request.
+	r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "delta": -10}`), httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the set dial handler.
+	a.setDialValue().ServeHTTP(rr, r)
+
+	// Check that the dial was adjusted, rather than set.
+	is.True(s.UpdateDialInvoked)
+	is.True(adjusted.Value == nil)             // value is not set.
+	is.Equal(adjustedID, ooohh.DialID("1234")) // correct dial was adjusted.
+	is.Equal(adjustedToken, "token")           // correct token was used for the adjustment.
+	is.Equal(*adjusted.Delta, -10.0)           // correct delta was added.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the response body is the adjusted dial.
+	var actualBody ooohh.Dial
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err)                    // actual body is json.
+	is.Equal(actualBody.Value, 40.0) // adjusted value is returned.
+}
+
+func TestSetDialGroup(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Variables that will be assigned to within the UpdateDial function.
+	var setID ooohh.DialID
+	var setToken string
+	var set ooohh.DialUpdate
+
+	// Create a mock service, with UpdateDial implemented.
+	s := &mock.Service{
+		UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+			setID, setToken, set = id, token, u
+			return &ooohh.Dial{ID: id, Name: "test", Group: *u.Group}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "group": "backend"}`), httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the set dial handler.
+	a.setDialValue().ServeHTTP(rr, r)
+
+	// Check that only the group was set.
+	is.True(s.UpdateDialInvoked)
+	is.True(!set.Valued())                // value is not set.
+	is.True(set.Color == nil)             // color is not set.
+	is.Equal(setID, ooohh.DialID("1234")) // correct dial was set.
+	is.Equal(setToken, "token")           // correct token was used for the set.
+	is.Equal(*set.Group, "backend")       // correct group was set.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the response body is correct
+	var actualBody ooohh.Dial
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.Group, "backend") // group is correct.
+}
+
+func TestSetDialToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Variables that will be assigned to within the UpdateDial function.
+	var setID ooohh.DialID
+	var setToken string
+	var set ooohh.DialUpdate
+
+	// Create a mock service, with UpdateDial implemented.
+	s := &mock.Service{
+		UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+			setID, setToken, set = id, token, u
+			return &ooohh.Dial{ID: id, Name: "test"}, nil
+		},
+	}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+	// Create a new request.
+	r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "value": 10, "new_token": "new"}`), httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the set dial handler.
+	a.setDialValue().ServeHTTP(rr, r)
+
+	// Check that the value was set, and the token replaced, in one update.
+	is.Equal(*set.Value, 10.0)            // value is set.
+	is.Equal(*set.Token, "new")           // correct token was set.
+	is.Equal(setID, ooohh.DialID("1234")) // correct dial was updated.
+	is.Equal(setToken, "token")           // current token was used for the update.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// An empty new token is rejected.
+	s.UpdateDialFn = func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+		return nil, ooohh.ErrDialTokenInvalid
+	}
+
+	r, err = newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "new_token": ""}`), httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+	rr = httptest.NewRecorder()
+	a.setDialValue().ServeHTTP(rr, r)
+
+	is.Equal(rr.Code, http.StatusBadRequest) // invalid new token is a bad request.
+}
+
+func TestRenameDial(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	for _, tt := range []struct {
+		msg      string
+		body     string
+		err      error
+		expName  string
+		expValue float64
+		expCode  int
+	}{{
+		msg:      "rename only",
+		body:     `{"token": "token", "name": "renamed"}`,
+		expName:  "renamed",
+		expValue: 10,
+		expCode:  http.StatusOK,
+	}, {
+		msg:      "value only",
+		body:     `{"token": "token", "value": 50}`,
+		expName:  "test",
+		expValue: 50,
+		expCode:  http.StatusOK,
+	}, {
+		msg:      "rename and value",
+		body:     `{"token": "token", "name": "renamed", "value": 50}`,
+		expName:  "renamed",
+		expValue: 50,
+		expCode:  http.StatusOK,
+	}, {
+		msg:      "unauthorized",
+		body:     `{"token": "wrong", "name": "renamed", "value": 50}`,
+		err:      ooohh.ErrUnauthorized,
+		expName:  "test",
+		expValue: 10,
+		expCode:  http.StatusUnauthorized,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service, that keeps a single dial.
+			d := ooohh.Dial{ID: "1234", Name: "test", Value: 10}
+			s := &mock.Service{
+				UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					if u.Name != nil {
+						d.Name = *u.Name
+					}
+					if u.Value != nil {
+						d.Value = *u.Value
+					}
+					return &d, nil
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set dial handler.
+			a.setDialValue().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expCode)
+
+			// Check the dial is updated correctly.
+			is.Equal(d.Name, tt.expName)   // dial has correct name.
+			is.Equal(d.Value, tt.expValue) // dial has correct value.
+
+			if tt.expCode == http.StatusOK {
+				var actualBody ooohh.Dial
+				err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+				is.NoErr(err)                         // actual body is json.
+				is.Equal(actualBody.Name, tt.expName) // updated dial is returned.
+			}
+		})
+	}
+}
+
+func TestSetDialInvalidColor(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with UpdateDial implemented.
+	s := &mock.Service{
+		UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+			return nil, ooohh.ErrDialColorInvalid
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "color": "green", "value": 10}`), httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the set dial handler.
+	a.setDialValue().ServeHTTP(rr, r)
+
+	// Check the color and value are updated together.
+	is.True(s.UpdateDialInvoked)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusBadRequest)
+}
+
+func TestSetDialValidation(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		expTitle  string
+		expDetail string
+	}{{
+		msg:       "invalid json body",
+		body:      `{"value": 66.6, "token": "token"`,
+		expTitle:  "Validation Error",
+		expDetail: "Invalid JSON",
+	}, {
+		msg:       "missing value and color",
+		body:      `{"token": "token"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `value`, `delta`, `color`, `group` or `new_token` must be provided.",
+	}, {
+		msg:       "empty name",
+		body:      `{"token": "token", "name": ""}`,
+		expTitle:  "Validation Error",
+		expDetail: "`name` must not be empty."}, {
+		msg:       "value and delta",
+		body:      `{"token": "token", "value": 50, "delta": 5}`,
+		expTitle:  "Validation Error",
+		expDetail: "Only one of `value` or `delta` can be provided.",
+	}, {
+		msg:       "note with delta",
+		body:      `{"token": "token", "delta": 5, "note": "note"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`note` can only be provided with `value`.",
+	}, {
+		msg:       "note without value",
+		body:      `{"token": "token", "color": "#fff", "note": "note"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`note` can only be provided with `value`.",
+	}, {
+		msg:       "missing token",
+		body:      `{"value": 66.6}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `value`, `delta`, `color`, `group` or `new_token` must be provided.",
+	}, {
+		msg:       "missing value & token",
+		body:      `{}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `value`, `delta`, `color`, `group` or `new_token` must be provided.",
+	}, {
+		msg:       "extra field passed",
+		body:      `{"extra": "field"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `value`, `delta`, `color`, `group` or `new_token` must be provided.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set dial handler.
+			a.setDialValue().ServeHTTP(rr, r)
+
+			// Check that the dial has not been updated.
+			is.True(!s.UpdateDialInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusBadRequest)
+
+			// Check the response body is correct
+			type body struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+}
+
+func TestSetDialErrors(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	for _, tt := range []struct {
+		msg       string
+		setErr    error
+		expStatus int
+		expTitle  string
+		expDetail string
+	}{{
+		msg:       "set with wrong token",
+		setErr:    ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expTitle:  "Unauthorized",
+		expDetail: "Invalid token",
+	}, {
+		msg:       "set when locked out",
+		setErr:    ooohh.ErrLockedOut,
+		expStatus: http.StatusTooManyRequests,
+		expTitle:  "Too Many Requests",
+		expDetail: "Too many invalid token attempts, try again later",
+	}, {
+		msg:       "set with missing dial",
+		setErr:    ooohh.ErrDialNotFound,
+		expStatus: http.StatusNotFound,
+		expTitle:  "Not Found",
+		expDetail: "Not Found",
+	}, {
+		msg:       "set with invalid value",
+		setErr:    ooohh.ErrDialValueInvalid,
+		expStatus: http.StatusBadRequest,
+		expTitle:  "Bad Request",
+		expDetail: "Invalid value",
+	}, {
+		msg:       "set on frozen board",
+		setErr:    ooohh.ErrBoardFrozen,
+		expStatus: http.StatusConflict,
+		expTitle:  "Conflict",
+		expDetail: "Dial is on a frozen board",
+	}, {
+		msg:       "set with unknown error",
+		setErr:    errors.New("set error"),
+		expStatus: http.StatusInternalServerError,
+		expTitle:  "Internal Server Error",
+		expDetail: "Could not update dial",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service, with UpdateDial implemented.
+			s := &mock.Service{
+				UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+					return nil, tt.setErr
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/dials/:id", strings.NewReader(`{"token": "token", "value": 66.6}`), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set dial handler.
+			a.setDialValue().ServeHTTP(rr, r)
+
+			// Check that the UpdateDial function has been invoked.
+			is.True(s.UpdateDialInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			// Check the response body is correct
+			type body struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+}
+
+func TestDeleteDial(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		err       error
+		expStatus int
+		expDelete bool
+	}{{
+		msg:       "deleted",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusNoContent,
+		expDelete: true,
+	}, {
+		msg:       "missing token",
+		body:      `{}`,
+		expStatus: http.StatusBadRequest,
+		expDelete: false,
+	}, {
+		msg:       "invalid json",
+		body:      `{"token": `,
+		expStatus: http.StatusBadRequest,
+		expDelete: false,
+	}, {
+		msg:       "dial not found",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrDialNotFound,
+		expStatus: http.StatusNotFound,
+		expDelete: true,
+	}, {
+		msg:       "wrong token",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expDelete: true,
+	}, {
+		msg:       "locked out",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrLockedOut,
+		expStatus: http.StatusTooManyRequests,
+		expDelete: true,
+	}, {
+		msg:       "service error",
+		body:      `{"token": "token"}`,
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expDelete: true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the DeleteDial function.
+			var deleteID ooohh.DialID
+			var deleteToken string
+
+			// Create a mock service, with DeleteDial implemented.
+			s := &mock.Service{
+				DeleteDialFn: func(ctx context.Context, id ooohh.DialID, token string) error {
+					deleteID, deleteToken = id, token
+					return tt.err
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("DELETE", "/api/dials/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the delete dial handler.
+			a.deleteDial().ServeHTTP(rr, r)
+
+			// Check whether the DeleteDial function has been invoked.
+			is.Equal(s.DeleteDialInvoked, tt.expDelete)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			if tt.expDelete {
+				is.Equal(deleteID, ooohh.DialID("1234")) // correct dial was deleted.
+				is.Equal(deleteToken, "token")           // correct token was used.
+			}
+
+			if tt.expStatus == http.StatusNoContent {
+				is.Equal(rr.Body.Len(), 0) // no content is returned.
+			}
+		})
+	}
+}
+
+func TestDeleteBoard(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		err       error
+		expStatus int
+		expDelete bool
+	}{{
+		msg:       "deleted",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusNoContent,
+		expDelete: true,
+	}, {
+		msg:       "missing token",
+		body:      `{}`,
+		expStatus: http.StatusBadRequest,
+		expDelete: false,
+	}, {
+		msg:       "invalid json",
+		body:      `{"token": `,
+		expStatus: http.StatusBadRequest,
+		expDelete: false,
+	}, {
+		msg:       "board not found",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrBoardNotFound,
+		expStatus: http.StatusNotFound,
+		expDelete: true,
+	}, {
+		msg:       "wrong token",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expDelete: true,
+	}, {
+		msg:       "service error",
+		body:      `{"token": "token"}`,
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expDelete: true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the DeleteBoard function.
+			var deleteID ooohh.BoardID
+			var deleteToken string
+
+			// Create a mock service, with DeleteBoard implemented.
+			s := &mock.Service{
+				DeleteBoardFn: func(ctx context.Context, id ooohh.BoardID, token string) error {
+					deleteID, deleteToken = id, token
+					return tt.err
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("DELETE", "/api/boards/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the delete board handler.
+			a.deleteBoard().ServeHTTP(rr, r)
+
+			// Check whether the DeleteBoard function has been invoked.
+			is.Equal(s.DeleteBoardInvoked, tt.expDelete)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			if tt.expDelete {
+				is.Equal(deleteID, ooohh.BoardID("1234")) // correct board was deleted.
+				is.Equal(deleteToken, "token")            // correct token was used.
+			}
+
+			if tt.expStatus == http.StatusNoContent {
+				is.Equal(rr.Body.Len(), 0) // no content is returned.
+			}
+		})
+	}
+}
+
+func TestVerifyDialToken(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		err       error
+		expStatus int
+		expVerify bool
+	}{{
+		msg:       "valid",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusNoContent,
+		expVerify: true,
+	}, {
+		msg:       "missing token",
+		body:      `{}`,
+		expStatus: http.StatusBadRequest,
+		expVerify: false,
+	}, {
+		msg:       "invalid json",
+		body:      `{"token": `,
+		expStatus: http.StatusBadRequest,
+		expVerify: false,
+	}, {
+		msg:       "dial not found",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrDialNotFound,
+		expStatus: http.StatusNotFound,
+		expVerify: true,
+	}, {
+		msg:       "invalid",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expVerify: true,
+	}, {
+		msg:       "locked out",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrLockedOut,
+		expStatus: http.StatusTooManyRequests,
+		expVerify: true,
+	}, {
+		msg:       "service error",
+		body:      `{"token": "token"}`,
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expVerify: true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the VerifyDialToken function.
+			var verifyID ooohh.DialID
+			var verifyToken string
+
+			// Create a mock service, with VerifyDialToken implemented.
+			s := &mock.Service{
+				VerifyDialTokenFn: func(ctx context.Context, id ooohh.DialID, token string) error {
+					verifyID, verifyToken = id, token
+					return tt.err
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+			// Create a new request.
+			r, err := http.NewRequest("POST", "/api/dials/1234/verify-token", strings.NewReader(tt.body))
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Route the request, to check the endpoint is routed.
+			newTestRouter(a).ServeHTTP(rr, r)
+
+			// Check whether the VerifyDialToken function has been invoked.
+			is.Equal(s.VerifyDialTokenInvoked, tt.expVerify)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			if tt.expVerify {
+				is.Equal(verifyID, ooohh.DialID("1234")) // correct dial was verified.
+				is.Equal(verifyToken, "token")           // correct token was used.
+			}
+		})
+	}
+}
+
+func TestListBoards(t *testing.T) {
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		msg       string
+		query     string
+		boards    []ooohh.Board
+		err       error
+		expStatus int
+		expList   bool
+		expBody   string
+	}{{
+		msg:   "boards",
+		query: "?token=token",
+		boards: []ooohh.Board{
+			{ID: "board-1", Code: "ABC123", Token: "token", Name: "one", Dials: []ooohh.Dial{{ID: "dial-1"}, {ID: "dial-2"}}, UpdatedAt: now},
+			{ID: "board-2", Code: "DEF456", Token: "token", Name: "two", Dials: []ooohh.Dial{}, UpdatedAt: now},
+		},
+		expStatus: http.StatusOK,
+		expList:   true,
+		expBody: `[{"id":"board-1","code":"ABC123","name":"one","dials":["dial-1","dial-2"],"updated_at":"2020-10-01T12:00:00Z"},` +
+			`{"id":"board-2","code":"DEF456","name":"two","dials":[],"updated_at":"2020-10-01T12:00:00Z"}]`,
+	}, {
+		msg:       "no boards",
+		query:     "?token=token",
+		boards:    []ooohh.Board{},
+		expStatus: http.StatusOK,
+		expList:   true,
+		expBody:   `[]`,
+	}, {
+		msg:       "missing token",
+		query:     "",
+		expStatus: http.StatusBadRequest,
+		expList:   false,
+	}, {
+		msg:       "service error",
+		query:     "?token=token",
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expList:   true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the ListBoardsByToken function.
+			var listToken string
+
+			// Create a mock service, with ListBoardsByToken implemented.
+			s := &mock.Service{
+				ListBoardsByTokenFn: func(ctx context.Context, token string) ([]ooohh.Board, error) {
+					listToken = token
+					return tt.boards, tt.err
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", "/api/boards"+tt.query, nil)
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Route the request, to check the endpoint is routed.
+			newTestRouter(a).ServeHTTP(rr, r)
+
+			// Check whether the ListBoardsByToken function has been invoked.
+			is.Equal(s.ListBoardsByTokenInvoked, tt.expList)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			if tt.expList {
+				is.Equal(listToken, "token") // boards are listed by the given token.
+			}
+
+			if tt.expBody != "" {
+				is.Equal(strings.TrimSpace(rr.Body.String()), tt.expBody) // boards are listed, without tokens.
+			}
+		})
+	}
+}
+
+func TestListBoardsLockout(t *testing.T) {
+
+	is := is.New(t)
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with a board for the "token" token.
+	s := &mock.Service{
+		ListBoardsByTokenFn: func(ctx context.Context, token string) ([]ooohh.Board, error) {
+			if token != "token" {
+				return []ooohh.Board{}, nil
+			}
+			return []ooohh.Board{{ID: "board-1", Token: "token", Dials: []ooohh.Dial{}}}, nil
+		},
+	}
+
+	// Get an API, that locks clients out after 2 bad tokens.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s),
+		WithNow(func() time.Time { return now }),
+		WithListLockout(2, time.Minute),
+	)
+	h := newTestRouter(a)
+
+	// list lists boards by the token, from the client with the given address.
+	list := func(token, addr string) int {
+		r, err := http.NewRequest("GET", "/api/boards?token="+token, nil)
+		is.NoErr(err)
+		r.RemoteAddr = addr
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+
+		return rr.Code
+	}
+
+	is.Equal(list("guess-1", "192.0.2.1:1234"), http.StatusOK) // first bad token is allowed.
+	is.Equal(list("token", "192.0.2.1:1234"), http.StatusOK)   // good token resets the lockout.
+	is.Equal(list("guess-2", "192.0.2.1:1234"), http.StatusOK) // bad token is allowed again.
+	is.Equal(list("guess-3", "192.0.2.1:1234"), http.StatusOK) // second bad token is allowed.
+
+	s.Reset()
+	is.Equal(list("token", "192.0.2.1:5678"), http.StatusTooManyRequests) // client is locked out, whatever its port.
+	is.True(!s.ListBoardsByTokenInvoked)                                  // boards aren't listed while locked out.
+	is.Equal(list("token", "192.0.2.2:1234"), http.StatusOK)              // other clients aren't locked out.
+
+	// The lockout ends after the window.
+	now = now.Add(2 * time.Minute)
+	is.Equal(list("token", "192.0.2.1:1234"), http.StatusOK) // client is no longer locked out.
+}
+
+func TestCreateBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with CreateBoardWithDescription implemented.
+	s := &mock.Service{
+		CreateBoardWithDescriptionFn: func(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:        ooohh.BoardID("board"),
+				Token:     token,
+				Name:      name,
+				Dials:     []ooohh.Dial{},
+				UpdatedAt: now,
+			}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(`{"name": "test", "token": "token"}`))
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the create board handler.
+	a.createBoard().ServeHTTP(rr, r)
+
+	// Check that the CreateBoardWithDescription function has been invoked.
+	is.True(s.CreateBoardWithDescriptionInvoked)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusCreated)
+
+	// Check the response body is correct
+	var actualBody ooohh.Board
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.ID, ooohh.BoardID("board"))   // id is the same.
+	is.Equal(actualBody.Name, "test")                 // name is the same.
+	is.Equal(actualBody.Dials, []ooohh.Dial{})        // dials are the same.
+	is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is the same.
+	is.Equal(actualBody.Token, "")                    // token is not in response body.
+}
+
+func TestCreateBoardWithDescription(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Variables that will be set by the service.
+	var setToken, setDescription string
+
+	// Create a mock service, with CreateBoardWithDescription implemented.
+	s := &mock.Service{
+		CreateBoardWithDescriptionFn: func(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+			setToken, setDescription = token, description
+			return &ooohh.Board{ID: ooohh.BoardID("board"), Token: token, Name: name, Description: strings.TrimSpace(description), Dials: []ooohh.Dial{}}, nil
+		},
+	}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(`{"name": "test", "token": "token", "description": " Q3 stress tracking "}`))
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the create board handler.
+	a.createBoard().ServeHTTP(rr, r)
+
+	// Check that the board is created with its description, at once.
+	is.True(s.CreateBoardWithDescriptionInvoked)
+	is.Equal(setToken, "token")                      // correct token is used.
+	is.Equal(setDescription, " Q3 stress tracking ") // description is given to the service to trim.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusCreated)
+
+	// Check the response body is correct
+	var actualBody ooohh.Board
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.Description, "Q3 stress tracking") // description is in response body.
+
+	// Check a too long description is rejected, before the board is created.
+	s.Reset()
+	r, err = http.NewRequest("POST", "/api/boards", strings.NewReader(`{"name": "test", "token": "token", "description": "`+strings.Repeat("a", ooohh.MaxDescriptionLength+1)+`"}`))
+	is.NoErr(err)
+
+	rr = httptest.NewRecorder()
+	a.createBoard().ServeHTTP(rr, r)
+
+	is.Equal(rr.Code, http.StatusBadRequest)      // description is too long.
+	is.True(!s.CreateBoardWithDescriptionInvoked) // board is not created.
+}
+
+func TestCreateBoardValidation(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with CreateBoardWithDescription implemented.
+	s := &mock.Service{
+		CreateBoardWithDescriptionFn: func(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:        ooohh.BoardID("board"),
+				Token:     token,
+				Name:      name,
+				Dials:     []ooohh.Dial{},
+				UpdatedAt: now,
+			}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		expTitle  string
+		expDetail string
+	}{{
+		msg:       "invalid json body",
+		body:      `{"name": "test", "token": "token"`,
+		expTitle:  "Validation Error",
+		expDetail: "Invalid JSON",
+	}, {
+		msg:       "missing name",
+		body:      `{"token": "token"}`,
+		expTitle:  "Validation Error",
+		expDetail: "Both `name` and `token` must be provided.",
+	}, {
+		msg:       "missing token",
+		body:      `{"name": "test"}`,
+		expTitle:  "Validation Error",
+		expDetail: "Both `name` and `token` must be provided.",
+	}, {
+		msg:       "missing name & token",
+		body:      `{}`,
+		expTitle:  "Validation Error",
+		expDetail: "Both `name` and `token` must be provided.",
+	}, {
+		msg:       "extra field passed",
+		body:      `{"extra": "field"}`,
+		expTitle:  "Validation Error",
+		expDetail: "Both `name` and `token` must be provided.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a new request.
+			r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(tt.body))
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the create board handler.
+			a.createBoard().ServeHTTP(rr, r)
+
+			// Check that the CreateBoardWithDescription function has not been invoked.
+			is.True(!s.CreateBoardWithDescriptionInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusBadRequest)
+
+			// Check the response body is correct
+			type body struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+}
+
+func TestCreateBoardError(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with CreateBoardWithDescription implemented, that returns an error.
+	s := &mock.Service{
+		CreateBoardWithDescriptionFn: func(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+			return nil, errors.New("error message")
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/boards", strings.NewReader(`{"name": "test", "token": "token"}`))
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the create board handler.
+	a.createBoard().ServeHTTP(rr, r)
+
+	// Check that the CreateBoardWithDescription function has been invoked.
+	is.True(s.CreateBoardWithDescriptionInvoked)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusInternalServerError)
+
+	// Check the response body is correct
+	type body struct {
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	}
+	var actualBody body
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.Title, "Internal Server Error")   // title is correct.
+	is.Equal(actualBody.Detail, "Could not create board") // detail is correct.
+}
+
+func TestCreateBoardWithDials(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Variables that will be assigned to within the CreateBoardWithDials function.
+	var createName, createToken string
+	var createDials []string
+
+	// Create a mock service, with CreateBoardWithDials implemented.
+	s := &mock.Service{
+		CreateBoardWithDialsFn: func(ctx context.Context, name, token string, dials []string) (*ooohh.Board, error) {
+			createName, createToken, createDials = name, token, dials
+
+			b := &ooohh.Board{ID: ooohh.BoardID("board"), Token: token, Name: name, Dials: []ooohh.Dial{}, UpdatedAt: now}
+			for i, name := range dials {
+				b.Dials = append(b.Dials, ooohh.Dial{ID: ooohh.DialID(fmt.Sprintf("dial-%d", i)), Name: name, UpdatedAt: now})
+			}
+			return b, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	for _, tt := range []struct {
+		msg       string
+		path      string
+		body      string
+		expStatus int
+		expCreate bool
+	}{{
+		msg:       "created",
+		path:      "/api/boards/with-dials",
+		body:      `{"name": "test", "token": "token", "dials": ["one", "two"]}`,
+		expStatus: http.StatusCreated,
+		expCreate: true,
+	}, {
+		msg:       "missing dials",
+		path:      "/api/boards/with-dials",
+		body:      `{"name": "test", "token": "token"}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "empty dial name",
+		path:      "/api/boards/with-dials",
+		body:      `{"name": "test", "token": "token", "dials": ["one", ""]}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "missing token",
+		path:      "/api/boards/with-dials",
+		body:      `{"name": "test", "dials": ["one"]}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "invalid json",
+		path:      "/api/boards/with-dials",
+		body:      `{"name": `,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "other path",
+		path:      "/api/boards/1234",
+		body:      `{"name": "test", "token": "token", "dials": ["one"]}`,
+		expStatus: http.StatusNotFound,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			s.Reset()
+
+			// Get an API.
+			h := newTestRouter(NewAPI(logger, s, ss, ui))
+
+			// Create a new request.
+			r, err := http.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, r)
+
+			is.Equal(s.CreateBoardWithDialsInvoked, tt.expCreate) // service is only called when valid.
+			is.Equal(rr.Code, tt.expStatus)                       // status code is correct.
+
+			if tt.expCreate {
+				is.Equal(createName, "test")                  // correct name is used.
+				is.Equal(createToken, "token")                // correct token is used.
+				is.Equal(createDials, []string{"one", "two"}) // correct dial names are used.
+
+				var actualBody ooohh.Board
+				err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+				is.NoErr(err) // actual body is json.
+
+				is.Equal(actualBody.ID, ooohh.BoardID("board")) // id is the same.
+				is.Equal(len(actualBody.Dials), 2)              // board has the created dials.
+				is.Equal(actualBody.Dials[1].Name, "two")       // dials are in order.
+			}
+		})
+	}
+}
+
+func TestCloneBoard(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Hash the source board's token, as the service stores it.
+	hashed, err := ooohh.HashToken("srctoken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Variables that will be assigned to within the CloneBoard function.
+	var cloneID ooohh.BoardID
+	var cloneName, cloneToken string
+
+	// Create a mock service, with GetBoard and CloneBoard implemented.
+	dials := []ooohh.Dial{{ID: "dial-1", Name: "one", Value: 10, UpdatedAt: now}, {ID: "dial-2", Name: "two", Value: 20, UpdatedAt: now}}
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			if id != "board" {
+				return nil, ooohh.ErrBoardNotFound
+			}
+			return &ooohh.Board{ID: id, Token: hashed, Name: "source", Dials: append([]ooohh.Dial(nil), dials...), UpdatedAt: now}, nil
+		},
+		CloneBoardFn: func(ctx context.Context, id ooohh.BoardID, name, token string) (*ooohh.Board, error) {
+			cloneID, cloneName, cloneToken = id, name, token
+			return &ooohh.Board{ID: "clone", Token: token, Name: name, Dials: append([]ooohh.Dial(nil), dials...), UpdatedAt: now}, nil
+		},
+	}
+
+	for _, tt := range []struct {
+		msg       string
+		opts      []Option
+		path      string
+		body      string
+		auth      string
+		expStatus int
+		expClone  bool
+	}{{
+		msg:       "cloned",
+		path:      "/api/boards/board/clone",
+		body:      `{"name": "sprint 2", "token": "token"}`,
+		expStatus: http.StatusCreated,
+		expClone:  true,
+	}, {
+		msg:       "missing name",
+		path:      "/api/boards/board/clone",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "missing token",
+		path:      "/api/boards/board/clone",
+		body:      `{"name": "sprint 2"}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "invalid json",
+		path:      "/api/boards/board/clone",
+		body:      `{"name": `,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "not found",
+		path:      "/api/boards/other/clone",
+		body:      `{"name": "sprint 2", "token": "token"}`,
+		expStatus: http.StatusNotFound,
+	}, {
+		msg:       "private without token",
+		opts:      []Option{WithPrivateBoards()},
+		path:      "/api/boards/board/clone",
+		body:      `{"name": "sprint 2", "token": "token"}`,
+		expStatus: http.StatusUnauthorized,
+	}, {
+		msg:       "private with token",
+		opts:      []Option{WithPrivateBoards()},
+		path:      "/api/boards/board/clone",
+		body:      `{"name": "sprint 2", "token": "token"}`,
+		auth:      "Bearer srctoken",
+		expStatus: http.StatusCreated,
+		expClone:  true,
+	}, {
+		msg:       "masked without token",
+		opts:      []Option{WithMaskedBoards()},
+		path:      "/api/boards/board/clone",
+		body:      `{"name": "sprint 2", "token": "token"}`,
+		expStatus: http.StatusForbidden,
+	}, {
+		msg:       "masked with token",
+		opts:      []Option{WithMaskedBoards()},
+		path:      "/api/boards/board/clone?token=srctoken",
+		body:      `{"name": "sprint 2", "token": "token"}`,
+		expStatus: http.StatusCreated,
+		expClone:  true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			s.Reset()
+
+			// Get an API.
+			h := newTestRouter(NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), tt.opts...))
+
+			// Create a new request.
+			r, err := http.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			is.NoErr(err)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, r)
+
+			is.Equal(s.CloneBoardInvoked, tt.expClone) // service is only called when valid.
+			is.Equal(rr.Code, tt.expStatus)            // status code is correct.
+
+			if tt.expClone {
+				is.Equal(cloneID, ooohh.BoardID("board")) // source board is cloned.
+				is.Equal(cloneName, "sprint 2")           // correct name is used.
+				is.Equal(cloneToken, "token")             // correct token is used.
+
+				var actualBody ooohh.Board
+				err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+				is.NoErr(err) // actual body is json.
+
+				is.Equal(actualBody.ID, ooohh.BoardID("clone")) // clone has its own id.
+				is.Equal(actualBody.Name, "sprint 2")           // clone has its name.
+				is.Equal(len(actualBody.Dials), 2)              // clone has the board's dials.
+				is.Equal(actualBody.Dials[1].Value, 20.0)       // dials are populated.
+			}
+		})
+	}
+}
+
+func TestGetBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:    id,
+				Token: "token",
+				Name:  "test",
+				Dials: []ooohh.Dial{{
+					ID:        "dial",
+					Token:     "token",
+					Name:      "test",
+					Value:     66.6,
+					UpdatedAt: now,
+				}},
+				UpdatedAt: now,
+			}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	a.getBoard().ServeHTTP(rr, r)
+
+	// Check that the GetBoard function has been invoked.
+	is.True(s.GetBoardInvoked)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the response body is correct
+	var actualBody ooohh.Board
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(actualBody.ID, ooohh.BoardID("1234"))    // id is correct.
+	is.Equal(actualBody.Name, "test")                 // name is correct.
+	is.Equal(len(actualBody.Dials), 1)                // dial length is correct.
+	is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
+	is.Equal(actualBody.Token, "")                    // token is not in response body.
+
+	// Check returned dial is correct.
+	dial := actualBody.Dials[0]
+
+	is.Equal(dial.ID, ooohh.DialID("dial"))     // dial id is correct.
+	is.Equal(dial.Name, "test")                 // dial name is correct.
+	is.Equal(dial.Value, 66.6)                  // dial value is correct.
+	is.Equal(dial.UpdatedAt.Unix(), now.Unix()) // dial updated at is correct.
+	is.Equal(dial.Token, "")                    // dial token is empty.
+}
+
+func TestGetBoardAndDialETags(t *testing.T) {
+
+	for _, path := range []string{"/api/boards/1234", "/api/dials/dial-1", "/api/dials/dial-1?include=stats"} {
+
+		t.Run(path, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service, with a dial whose value can be changed.
+			updatedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			dial := ooohh.Dial{ID: "dial-1", Name: "dial", Value: 10, UpdatedAt: updatedAt}
+			s := &mock.Service{
+				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+					d := dial
+					return &d, nil
+				},
+				GetDialHistoryFn: func(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+					return nil, nil
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{ID: id, Name: "board", Dials: []ooohh.Dial{dial}, UpdatedAt: updatedAt}, nil
+				},
+			}
+
+			h := newTestRouter(NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithNow(func() time.Time { return updatedAt })))
+
+			get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+				r, err := http.NewRequest("GET", path, nil)
+				is.NoErr(err) // request is created.
+				if ifNoneMatch != "" {
+					r.Header.Set("If-None-Match", ifNoneMatch)
+				}
+
+				rr := httptest.NewRecorder()
+				h.ServeHTTP(rr, r)
+				return rr
+			}
+
+			// The ETag is set on the first request.
+			rr := get("")
+			etag := rr.Header().Get("ETag")
+			is.Equal(rr.Code, http.StatusOK)        // response is ok.
+			is.True(strings.HasPrefix(etag, `W/"`)) // weak etag is set.
+
+			// A matching conditional request isn't sent the body.
+			rr = get(etag)
+			is.Equal(rr.Code, http.StatusNotModified) // response is not modified.
+			is.Equal(rr.Body.Len(), 0)                // body is empty.
+			is.Equal(rr.Header().Get("ETag"), etag)   // etag is set.
+
+			// Matching ETags can be given in a list, or with a different weakness.
+			is.Equal(get(`"other", `+etag).Code, http.StatusNotModified)               // etag in list matches.
+			is.Equal(get(strings.TrimPrefix(etag, "W/")).Code, http.StatusNotModified) // strong form matches.
+
+			// Once the dial changes, a stale conditional request is sent the body.
+			dial.Value, dial.UpdatedAt = 20, updatedAt.Add(time.Minute)
+			rr = get(etag)
+			is.Equal(rr.Code, http.StatusOK)         // response is ok.
+			is.True(rr.Body.Len() > 0)               // body is sent.
+			is.True(rr.Header().Get("ETag") != etag) // etag changes.
+			is.True(rr.Header().Get("ETag") != "")   // new etag is set.
+		})
+	}
+}
+
+func TestGetBoardStaleDials(t *testing.T) {
+
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		msg      string
+		opts     []Option
+		expStale []bool
+	}{{
+		msg:      "default threshold",
+		expStale: []bool{false, false, true},
+	}, {
+		msg:      "configured threshold",
+		opts:     []Option{WithStaleAfter(time.Hour)},
+		expStale: []bool{false, true, true},
+	}, {
+		msg:      "disabled",
+		opts:     []Option{WithStaleAfter(0)},
+		expStale: []bool{false, false, false},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service, with a fresh dial, a dial set hours ago,
+			// and a dial set days ago, in a group.
+			s := &mock.Service{
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{
+						ID:   id,
+						Name: "test",
+						Dials: []ooohh.Dial{
+							{ID: "fresh", UpdatedAt: now.Add(-time.Minute)},
+							{ID: "hours", UpdatedAt: now.Add(-2 * time.Hour)},
+							{ID: "days", UpdatedAt: now.Add(-48 * time.Hour), Group: "group"},
+						},
+						UpdatedAt: now,
+					}, nil
+				},
+			}
+
+			// Get an API, at a fixed time.
+			opts := append([]Option{WithNow(func() time.Time { return now })}, tt.opts...)
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), opts...)
+
+			// Create a new request.
+			r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			a.getBoard().ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, http.StatusOK) // board is retrieved.
+
+			type dial struct {
+				ID    string `json:"id"`
+				Stale *bool  `json:"stale"`
+			}
+			var body struct {
+				Dials  []dial `json:"dials"`
+				Groups []struct {
+					Dials []dial `json:"dials"`
+				} `json:"groups"`
+			}
+			err = json.Unmarshal(rr.Body.Bytes(), &body)
+			is.NoErr(err) // body is json.
+
+			is.Equal(len(body.Dials), 3) // all dials are returned.
+			for i, d := range body.Dials {
+				is.True(d.Stale != nil)            // staleness is always returned.
+				is.Equal(*d.Stale, tt.expStale[i]) // dials are stale when older than the threshold.
+			}
+
+			is.Equal(body.Groups[0].Dials[0].ID, "days")             // grouped dial is returned.
+			is.Equal(*body.Groups[0].Dials[0].Stale, tt.expStale[2]) // grouped dials are marked too.
+		})
+	}
+}
+
+func TestGetBoardPrivate(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Token: "token", Name: "board", Dials: []ooohh.Dial{}}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	for _, tt := range []struct {
+		msg       string
+		private   bool
+		auth      string
+		expStatus int
+	}{{
+		msg:       "open without token",
+		expStatus: http.StatusOK,
+	}, {
+		msg:       "open with wrong token",
+		auth:      "Bearer wrong",
+		expStatus: http.StatusOK,
+	}, {
+		msg:       "private without token",
+		private:   true,
+		expStatus: http.StatusUnauthorized,
+	}, {
+		msg:       "private with wrong token",
+		private:   true,
+		auth:      "Bearer wrong",
+		expStatus: http.StatusForbidden,
+	}, {
+		msg:       "private with token",
+		private:   true,
+		auth:      "Bearer token",
+		expStatus: http.StatusOK,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get an API.
+			var opts []Option
+			if tt.private {
+				opts = append(opts, WithPrivateBoards())
+			}
+			a := NewAPI(logger, s, ss, ui, opts...)
+
+			// Create a new request.
+			r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			a.getBoard().ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus) // status code is correct.
+		})
+	}
+}
+
+func TestGetBoardMasked(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Token: "token", Name: "board", Dials: []ooohh.Dial{
+				{ID: "dial-1", Name: "one", Value: 25},
+				{ID: "dial-2", Name: "two", Value: 75},
+			}}, nil
+		},
+	}
+
+	for _, tt := range []struct {
+		msg       string
+		masked    bool
+		query     string
+		auth      string
+		expMasked bool
+		expValues []float64
+	}{{
+		msg:       "unmasked without token",
+		expValues: []float64{25, 75},
+	}, {
+		msg:       "masked without token",
+		masked:    true,
+		expMasked: true,
+		expValues: []float64{0, 0},
+	}, {
+		msg:       "masked with wrong token",
+		masked:    true,
+		query:     "?token=wrong",
+		expMasked: true,
+		expValues: []float64{0, 0},
+	}, {
+		msg:       "masked with token",
+		masked:    true,
+		query:     "?token=token",
+		expValues: []float64{25, 75},
+	}, {
+		msg:       "masked with bearer token",
+		masked:    true,
+		auth:      "Bearer token",
+		expValues: []float64{25, 75},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get an API.
+			var opts []Option
+			if tt.masked {
+				opts = append(opts, WithMaskedBoards())
+			}
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), opts...)
+
+			// Create a new request.
+			r, err := newRequest("GET", "/api/boards/:id"+tt.query, nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			a.getBoard().ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, http.StatusOK) // status code is correct.
+
+			// Check the response body is correct.
+			var actualBody struct {
+				Dials []struct {
+					ID    string  `json:"id"`
+					Name  string  `json:"name"`
+					Value float64 `json:"value"`
+				} `json:"dials"`
+				Masked bool `json:"masked"`
+			}
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Masked, tt.expMasked) // board is marked as masked correctly.
+			is.Equal(len(actualBody.Dials), 2)        // dials are returned.
+			is.Equal(actualBody.Dials[0].Name, "one") // dial names are returned.
+			is.Equal(actualBody.Dials[1].Name, "two") // dial names are returned.
+			for i, v := range tt.expValues {
+				is.Equal(actualBody.Dials[i].Value, v) // dial value is correct.
+			}
+			is.Equal(actualBody.Dials[0].ID == "", tt.expMasked) // dial IDs are only returned unmasked.
+		})
+	}
+}
+
+func TestGetBoardJSONP(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "board", Dials: []ooohh.Dial{}}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	for _, tt := range []struct {
+		msg            string
+		jsonp          bool
+		callback       string
+		expStatus      int
+		expContentType string
+		expPrefix      string
+	}{{
+		msg:            "valid callback",
+		jsonp:          true,
+		callback:       "render",
+		expStatus:      http.StatusOK,
+		expContentType: "application/javascript",
+		expPrefix:      `/**/render({"id":"1234",`,
+	}, {
+		msg:            "namespaced callback",
+		jsonp:          true,
+		callback:       "app.$render_1",
+		expStatus:      http.StatusOK,
+		expContentType: "application/javascript",
+		expPrefix:      `/**/app.$render_1({"id":"1234",`,
+	}, {
+		msg:            "no callback",
+		jsonp:          true,
+		expStatus:      http.StatusOK,
+		expContentType: "application/json",
+		expPrefix:      `{"id":"1234",`,
+	}, {
+		msg:            "unsafe callback",
+		jsonp:          true,
+		callback:       "alert(1);render",
+		expStatus:      http.StatusBadRequest,
+		expContentType: "application/problem+json",
+	}, {
+		msg:            "callback starting with a number",
+		jsonp:          true,
+		callback:       "1render",
+		expStatus:      http.StatusBadRequest,
+		expContentType: "application/problem+json",
+	}, {
+		msg:            "callback too long",
+		jsonp:          true,
+		callback:       strings.Repeat("a", maxCallbackLength+1),
+		expStatus:      http.StatusBadRequest,
+		expContentType: "application/problem+json",
+	}, {
+		msg:            "jsonp disabled",
+		jsonp:          false,
+		callback:       "render",
+		expStatus:      http.StatusOK,
+		expContentType: "application/json",
+		expPrefix:      `{"id":"1234",`,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get an API.
+			var opts []Option
+			if tt.jsonp {
+				opts = append(opts, WithJSONP())
+			}
+			a := NewAPI(logger, s, ss, ui, opts...)
+
+			// Create a new request.
+			r, err := newRequest("GET", "/api/boards/:id?callback="+url.QueryEscape(tt.callback), nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			a.getBoard().ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus)                              // status code is correct.
+			is.Equal(rr.Header().Get("Content-Type"), tt.expContentType) // content type is correct.
+			is.True(strings.HasPrefix(rr.Body.String(), tt.expPrefix))   // body is correct.
+		})
+	}
+}
+
+func TestGetBoardImage(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			if id != "1234" {
+				return nil, ooohh.ErrBoardNotFound
+			}
+			return &ooohh.Board{
+				ID:    id,
+				Token: "token",
+				Name:  "test",
+				Dials: []ooohh.Dial{{ID: "one", Value: 10}, {ID: "two", Value: 90}},
+			}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI. It isn't named ui, so the package's image dimensions can be used.
+	u := ui.NewUI(s)
+
+	for _, tt := range []struct {
+		msg       string
+		path      string
+		opts      []Option
+		auth      string
+		expStatus int
+	}{{
+		msg:       "rendered",
+		path:      "/api/boards/1234/board.png",
+		expStatus: http.StatusOK,
+	}, {
+		msg:       "board not found",
+		path:      "/api/boards/missing/board.png",
+		expStatus: http.StatusNotFound,
+	}, {
+		msg:       "private board without token",
+		path:      "/api/boards/1234/board.png",
+		opts:      []Option{WithPrivateBoards()},
+		expStatus: http.StatusUnauthorized,
+	}, {
+		msg:       "private board with token",
+		path:      "/api/boards/1234/board.png",
+		opts:      []Option{WithPrivateBoards()},
+		auth:      "Bearer token",
+		expStatus: http.StatusOK,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			h := newTestRouter(NewAPI(logger, s, ss, u, tt.opts...))
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", tt.path, nil)
+			is.NoErr(err)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus) // status code is correct.
+
+			if tt.expStatus == http.StatusOK {
+				is.Equal(rr.Header().Get("Content-Type"), "image/png") // content type is png.
+
+				img, err := png.Decode(rr.Body)
+				is.NoErr(err)                                  // body is a png.
+				is.Equal(img.Bounds().Dx(), ui.ImageWidth)     // image has the expected width.
+				is.Equal(img.Bounds().Dy(), ui.ImageHeight(2)) // image has a bar per dial.
+			}
+		})
+	}
+}
+
+func TestGetBoardDistribution(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented, returning dials with known values.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			if id != "1234" {
+				return nil, ooohh.ErrBoardNotFound
+			}
+
+			var dials []ooohh.Dial
+			for _, v := range []float64{0, 5, 9.9, 10, 42, 49.5, 75, 99, 100} {
+				dials = append(dials, ooohh.Dial{ID: "dial", Value: v})
+			}
+			return &ooohh.Board{ID: id, Token: "token", Name: "test", Dials: dials}, nil
+		},
+	}
+
+	for _, tt := range []struct {
+		msg       string
+		path      string
+		opts      []Option
+		expStatus int
+		expBody   string
+	}{{
+		msg:       "default width",
+		path:      "/api/boards/1234/distribution",
+		expStatus: http.StatusOK,
+		expBody: `{"width":10,"buckets":[` +
+			`{"min":0,"max":10,"count":3},{"min":10,"max":20,"count":1},{"min":20,"max":30,"count":0},` +
+			`{"min":30,"max":40,"count":0},{"min":40,"max":50,"count":2},{"min":50,"max":60,"count":0},` +
+			`{"min":60,"max":70,"count":0},{"min":70,"max":80,"count":1},{"min":80,"max":90,"count":0},` +
+			`{"min":90,"max":100,"count":2}]}`,
+	}, {
+		msg:       "given width",
+		path:      "/api/boards/1234/distribution?width=25",
+		expStatus: http.StatusOK,
+		expBody: `{"width":25,"buckets":[` +
+			`{"min":0,"max":25,"count":4},{"min":25,"max":50,"count":2},` +
+			`{"min":50,"max":75,"count":0},{"min":75,"max":100,"count":3}]}`,
+	}, {
+		msg:       "uneven width",
+		path:      "/api/boards/1234/distribution?width=40",
+		expStatus: http.StatusOK,
+		expBody: `{"width":40,"buckets":[` +
+			`{"min":0,"max":40,"count":4},{"min":40,"max":80,"count":3},{"min":80,"max":100,"count":2}]}`,
+	}, {
+		msg:       "configured value bounds",
+		path:      "/api/boards/1234/distribution?width=25",
+		opts:      []Option{WithValueBounds(-50, 50)},
+		expStatus: http.StatusOK,
+		expBody: `{"width":25,"buckets":[` +
+			`{"min":-50,"max":-25,"count":0},{"min":-25,"max":0,"count":0},` +
+			`{"min":0,"max":25,"count":4},{"min":25,"max":50,"count":5}]}`,
+	}, {
+		msg:       "too many buckets for the configured value bounds",
+		path:      "/api/boards/1234/distribution?width=1",
+		opts:      []Option{WithValueBounds(0, 1000)},
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "width wider than the configured value bounds",
+		path:      "/api/boards/1234/distribution?width=30",
+		opts:      []Option{WithValueBounds(0, 20)},
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "invalid width",
+		path:      "/api/boards/1234/distribution?width=0",
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "non-numeric width",
+		path:      "/api/boards/1234/distribution?width=ten",
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "board not found",
+		path:      "/api/boards/missing/distribution",
+		expStatus: http.StatusNotFound,
+	}, {
+		msg:       "private board without token",
+		path:      "/api/boards/1234/distribution",
+		opts:      []Option{WithPrivateBoards()},
+		expStatus: http.StatusUnauthorized,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			h := newTestRouter(NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), tt.opts...))
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", tt.path, nil)
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus) // status code is correct.
+
+			if tt.expBody != "" {
+				is.Equal(strings.TrimSpace(rr.Body.String()), tt.expBody) // bucket counts are correct.
+			}
+		})
+	}
+}
+
+func TestGetBoardLeaderboard(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented, returning dials with
+	// varying and equal values, out of order.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			if id != "1234" {
+				return nil, ooohh.ErrBoardNotFound
+			}
+
+			return &ooohh.Board{ID: id, Token: "token", Name: "test", Dials: []ooohh.Dial{
+				{ID: "d1", Name: "carol", Value: 20},
+				{ID: "d2", Name: "bob", Value: 80},
+				{ID: "d3", Name: "alice", Value: 50},
+				{ID: "d5", Name: "dave", Value: 50},
+				{ID: "d4", Name: "dave", Value: 50},
+				{ID: "d6", Name: "erin", Value: 99.5},
+			}}, nil
+		},
+	}
+
+	for _, tt := range []struct {
+		msg       string
+		path      string
+		opts      []Option
+		expStatus int
+		expBody   string
+	}{{
+		msg:       "ranked by value",
+		path:      "/api/boards/1234/leaderboard",
+		expStatus: http.StatusOK,
+		expBody: `{"dials":[` +
+			`{"rank":1,"id":"d6","name":"erin","value":99.5},` +
+			`{"rank":2,"id":"d2","name":"bob","value":80},` +
+			`{"rank":3,"id":"d3","name":"alice","value":50},` +
+			`{"rank":3,"id":"d4","name":"dave","value":50},` +
+			`{"rank":3,"id":"d5","name":"dave","value":50},` +
+			`{"rank":6,"id":"d1","name":"carol","value":20}]}`,
+	}, {
+		msg:       "masked without token",
+		path:      "/api/boards/1234/leaderboard",
+		opts:      []Option{WithMaskedBoards()},
+		expStatus: http.StatusOK,
+		expBody: `{"dials":[` +
+			`{"rank":1,"id":"","name":"alice","value":0},` +
+			`{"rank":1,"id":"","name":"bob","value":0},` +
+			`{"rank":1,"id":"","name":"carol","value":0},` +
+			`{"rank":1,"id":"","name":"dave","value":0},` +
+			`{"rank":1,"id":"","name":"dave","value":0},` +
+			`{"rank":1,"id":"","name":"erin","value":0}],"masked":true}`,
+	}, {
+		msg:       "masked with token",
+		path:      "/api/boards/1234/leaderboard?token=token",
+		opts:      []Option{WithMaskedBoards()},
+		expStatus: http.StatusOK,
+		expBody: `{"dials":[` +
+			`{"rank":1,"id":"d6","name":"erin","value":99.5},` +
+			`{"rank":2,"id":"d2","name":"bob","value":80},` +
+			`{"rank":3,"id":"d3","name":"alice","value":50},` +
+			`{"rank":3,"id":"d4","name":"dave","value":50},` +
+			`{"rank":3,"id":"d5","name":"dave","value":50},` +
+			`{"rank":6,"id":"d1","name":"carol","value":20}]}`,
+	}, {
+		msg:       "board not found",
+		path:      "/api/boards/missing/leaderboard",
+		expStatus: http.StatusNotFound,
+	}, {
+		msg:       "private board without token",
+		path:      "/api/boards/1234/leaderboard",
+		opts:      []Option{WithPrivateBoards()},
+		expStatus: http.StatusUnauthorized,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			h := newTestRouter(NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), tt.opts...))
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", tt.path, nil)
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus) // status code is correct.
+
+			if tt.expBody != "" {
+				is.Equal(strings.TrimSpace(rr.Body.String()), tt.expBody) // dials are ranked.
+			}
+		})
+	}
+}
+
+func TestGetBoardGroupsDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented, returning grouped and ungrouped dials.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:   id,
+				Name: "test",
+				Dials: []ooohh.Dial{
+					{ID: "dial-1", Name: "one", Group: "backend"},
+					{ID: "dial-2", Name: "two"},
+					{ID: "dial-3", Name: "three", Group: "frontend"},
+					{ID: "dial-4", Name: "four", Group: "backend"},
+					{ID: "dial-5", Name: "five"},
+				},
+			}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	a.getBoard().ServeHTTP(rr, r)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the response body is correct
+	var actualBody struct {
+		Dials  []ooohh.Dial      `json:"dials"`
+		Groups []ooohh.DialGroup `json:"groups"`
+	}
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(len(actualBody.Dials), 5) // all dials are still listed.
+
+	groups := actualBody.Groups
+	is.Equal(len(groups), 3)                     // dials are split into groups.
+	is.Equal(groups[0].Name, "backend")          // groups are in order of first appearance.
+	is.Equal(groups[1].Name, "frontend")         // groups are in order of first appearance.
+	is.Equal(groups[2].Name, ooohh.DefaultGroup) // ungrouped dials are last.
+
+	ids := func(g ooohh.DialGroup) []ooohh.DialID {
+		var ids []ooohh.DialID
+		for _, d := range g.Dials {
+			ids = append(ids, d.ID)
+		}
+		return ids
+	}
+	is.Equal(ids(groups[0]), []ooohh.DialID{"dial-1", "dial-4"}) // backend dials are grouped, in order.
+	is.Equal(ids(groups[1]), []ooohh.DialID{"dial-3"})           // frontend dials are grouped.
+	is.Equal(ids(groups[2]), []ooohh.DialID{"dial-2", "dial-5"}) // ungrouped dials are in the default group.
+}
+
+func TestGetBoardErrors(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	for _, tt := range []struct {
+		msg       string
+		err       error
+		expStatus int
+		expTitle  string
+		expDetail string
+	}{{
+		msg:       "board not found",
+		err:       ooohh.ErrBoardNotFound,
+		expStatus: http.StatusNotFound,
+		expTitle:  "Not Found",
+		expDetail: "Not Found",
+	}, {
+		msg:       "unknown error",
+		err:       errors.New("uh-oh"),
+		expStatus: http.StatusInternalServerError,
+		expTitle:  "Internal Server Error",
+		expDetail: "Could not retrieve board",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service, with GetBoard implemented.
+			s := &mock.Service{
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return nil, tt.err
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("GET", "/api/boards/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			a.getBoard().ServeHTTP(rr, r)
+
+			// Check that the GetBoard function has been invoked.
+			is.True(s.GetBoardInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			// Check the response body is correct
+			type body struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+
+}
+
+func TestSetBoard(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	for _, tt := range []struct {
+		msg   string
+		dials []string
+	}{{
+		msg:   "non-empty dials",
+		dials: []string{"4321"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be assigned to within the UpdateBoard function.
+			var setID ooohh.BoardID
+			var setToken string
+			var setDials *[]ooohh.DialID
+
+			// Create a mock service, with GetBoard and UpdateBoard implemented.
+			s := &mock.Service{
+				UpdateBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+
+					// Capture what was set.
+					setID = id
+					setToken = token
+					setDials = u.Dials
+
+					return nil
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+
+					dials := make([]ooohh.Dial, len(*setDials))
+					for i := range *setDials {
+						dials[i] = ooohh.Dial{
+							ID:        (*setDials)[i],
+							Name:      fmt.Sprintf("dial-%d", i),
+							Token:     "token",
+							Value:     66.6,
+							UpdatedAt: now,
+						}
+					}
+
+					return &ooohh.Board{
+						ID:        id,
+						Token:     setToken,
+						Name:      "test",
+						Dials:     dials,
+						UpdatedAt: now,
+					}, nil
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Marshal json.
+			type request struct {
+				Token string   `json:"token"`
+				Dials []string `json:"dials"`
+			}
+
+			b, err := json.Marshal(request{"token", tt.dials})
+			is.NoErr(err) // invalid request json.
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/boards/:id", bytes.NewReader(b), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set board handler.
+			a.setBoardDials().ServeHTTP(rr, r)
+
+			// Check that the UpdateBoard function has been invoked.
+			is.True(s.UpdateBoardInvoked)
+
+			// Check that the UpdateBoard function was invoked with the correct params.
+			is.Equal(setID, ooohh.BoardID("1234")) // correct board was set.
+			is.Equal(setToken, "token")            // correct token was used for the set.
+			is.True(setDials != nil)               // dials were set.
+			if setDials != nil {
+				dialIDs := make([]ooohh.DialID, len(tt.dials))
+				for i := range tt.dials {
+					dialIDs[i] = ooohh.DialID(tt.dials[i])
+				}
+				is.Equal(*setDials, dialIDs) // correct dials were set.
+			}
+
+			// Check that the GetBoard function has been invoked.
+			is.True(s.GetBoardInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the response body is correct
+			var actualBody ooohh.Board
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.ID, ooohh.BoardID("1234"))    // id is correct.
+			is.Equal(actualBody.Name, "test")                 // name is correct.
+			is.Equal(len(actualBody.Dials), len(tt.dials))    // dial length is correct.
+			is.Equal(actualBody.UpdatedAt.Unix(), now.Unix()) // updated at time is correct.
+			is.Equal(actualBody.Token, "")                    // token is not in response body.
+		})
+	}
+}
+
+func TestSetBoardNameAndDials(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg            string
+		body           string
+		expName        string
+		expDescription *string
+		expDials       *[]ooohh.DialID
+		expOwnsDials   *bool
+		expFreeze      bool
+		expUnfreeze    bool
+	}{{
+		msg:     "name only",
+		body:    `{"token": "token", "name": "renamed"}`,
+		expName: "renamed",
+	}, {
+		msg:            "description only",
+		body:           `{"token": "token", "description": "Q3 stress tracking"}`,
+		expDescription: stringPtr("Q3 stress tracking"),
+	}, {
+		msg:            "cleared description",
+		body:           `{"token": "token", "description": ""}`,
+		expDescription: stringPtr(""),
+	}, {
+		msg:      "dials only",
+		body:     `{"token": "token", "dials": ["4321"]}`,
+		expDials: &[]ooohh.DialID{"4321"},
+	}, {
+		msg:      "name and dials",
+		body:     `{"token": "token", "name": "renamed", "dials": ["4321", "5678"]}`,
+		expName:  "renamed",
+		expDials: &[]ooohh.DialID{"4321", "5678"},
+	}, {
+		msg:          "owns dials only",
+		body:         `{"token": "token", "owns_dials": true}`,
+		expOwnsDials: boolPtr(true),
+	}, {
+		msg:          "disowns dials",
+		body:         `{"token": "token", "owns_dials": false}`,
+		expOwnsDials: boolPtr(false),
+	}, {
+		msg:       "freezes",
+		body:      `{"token": "token", "frozen": true}`,
+		expFreeze: true,
+	}, {
+		msg:         "unfreezes",
+		body:        `{"token": "token", "frozen": false}`,
+		expUnfreeze: true,
+	}, {
+		msg:         "unfreezes and sets dials",
+		body:        `{"token": "token", "frozen": false, "dials": ["4321"]}`,
+		expDials:    &[]ooohh.DialID{"4321"},
+		expUnfreeze: true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// The update that will be assigned to within the UpdateBoard function.
+			var set ooohh.BoardUpdate
+
+			// Create a mock service, with GetBoard and UpdateBoard implemented.
+			s := &mock.Service{
+				UpdateBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+					set = u
+					return nil
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{ID: id, Name: "test"}, nil
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/boards/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set board handler.
+			a.setBoardDials().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check only the provided fields were set, in one update.
+			is.True(s.UpdateBoardInvoked)                               // board is updated.
+			is.Equal(set.Name != nil, tt.expName != "")                 // name is only set when provided.
+			is.Equal(set.Description != nil, tt.expDescription != nil)  // description is only set when provided.
+			is.Equal(set.Dials != nil, tt.expDials != nil)              // dials are only set when provided.
+			is.Equal(set.OwnsDials != nil, tt.expOwnsDials != nil)      // ownership is only set when provided.
+			is.Equal(set.Frozen != nil && *set.Frozen, tt.expFreeze)    // board is only frozen when asked.
+			is.Equal(set.Frozen != nil && !*set.Frozen, tt.expUnfreeze) // board is only unfrozen when asked.
+			if tt.expName != "" {
+				is.Equal(*set.Name, tt.expName) // correct name was set.
+			}
+			if tt.expDescription != nil {
+				is.Equal(*set.Description, *tt.expDescription) // correct description was set.
+			}
+			if tt.expDials != nil {
+				is.Equal(*set.Dials, *tt.expDials) // correct dials were set.
+			}
+			if tt.expOwnsDials != nil {
+				is.Equal(*set.OwnsDials, *tt.expOwnsDials) // correct ownership was set.
+			}
+		})
+	}
+}
+
+func TestSetBoardValidation(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		expTitle  string
+		expDetail string
+	}{{
+		msg:       "invalid json body",
+		body:      `{"dials": ["4321"], "token": "token"`,
+		expTitle:  "Validation Error",
+		expDetail: "Invalid JSON",
+	}, {
+		msg:       "missing value",
+		body:      `{"token": "token"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `description`, `dials`, `owns_dials` or `frozen` must be provided.",
+	}, {
+		msg:       "missing token",
+		body:      `{"dials": ["4321"]}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `description`, `dials`, `owns_dials` or `frozen` must be provided.",
+	}, {
+		msg:       "missing dials & token",
+		body:      `{}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `description`, `dials`, `owns_dials` or `frozen` must be provided.",
+	}, {
+		msg:       "extra field passed",
+		body:      `{"extra": "field"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`token` and at least one of `name`, `description`, `dials`, `owns_dials` or `frozen` must be provided.",
+	}, {
+		msg:       "empty name",
+		body:      `{"token": "token", "name": ""}`,
+		expTitle:  "Validation Error",
+		expDetail: "`name` must not be empty.",
+	}, {
+		msg:       "too long description",
+		body:      `{"token": "token", "description": "` + strings.Repeat("a", ooohh.MaxDescriptionLength+1) + `"}`,
+		expTitle:  "Validation Error",
+		expDetail: "`description` must be at most 280 characters.",
+	}, {
+		msg:       "empty dials",
+		body:      `{"token": "token", "dials": []}`,
+		expTitle:  "Validation Error",
+		expDetail: "`dials` must not be empty, use DELETE /api/boards/:id/dials to remove all dials.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/boards/:id", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set board handler.
+			a.setBoardDials().ServeHTTP(rr, r)
+
+			// Check that the UpdateBoard function has not been invoked.
+			is.True(!s.UpdateBoardInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusBadRequest)
+
+			// Check the response body is correct
+			type body struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+}
+
+func TestSetBoardErrors(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	for _, tt := range []struct {
+		msg           string
+		setErr        error
+		getErr        error
+		expGetInvoked bool
+		expStatus     int
+		expTitle      string
+		expDetail     string
+	}{{
+		msg:           "set with wrong token",
+		setErr:        ooohh.ErrUnauthorized,
+		getErr:        nil,
+		expGetInvoked: false,
+		expStatus:     http.StatusUnauthorized,
+		expTitle:      "Unauthorized",
+		expDetail:     "Invalid token",
+	}, {
+		msg:           "set with missing board",
+		setErr:        ooohh.ErrBoardNotFound,
+		getErr:        ooohh.ErrBoardNotFound,
+		expGetInvoked: false,
+		expStatus:     http.StatusNotFound,
+		expTitle:      "Not Found",
+		expDetail:     "Not Found",
+	}, {
+		msg:           "set on frozen board",
+		setErr:        ooohh.ErrBoardFrozen,
+		getErr:        nil,
+		expGetInvoked: false,
+		expStatus:     http.StatusConflict,
+		expTitle:      "Conflict",
+		expDetail:     "Board is frozen",
+	}, {
+		msg:           "set with unknown error",
+		setErr:        errors.New("set error"),
+		getErr:        nil,
+		expGetInvoked: false,
+		expStatus:     http.StatusInternalServerError,
+		expTitle:      "Internal Server Error",
+		expDetail:     "Could not update board",
+	}, {
+		msg:           "get with unknown error",
+		setErr:        nil,
+		getErr:        errors.New("get error"),
+		expGetInvoked: true,
+		expStatus:     http.StatusInternalServerError,
+		expTitle:      "Internal Server Error",
+		expDetail:     "Could not update board",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service, with GetBoard and UpdateBoard implemented.
+			s := &mock.Service{
+				UpdateBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+					return tt.setErr
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{
+						ID:        id,
+						Token:     "token",
+						Name:      "test",
+						Dials:     []ooohh.Dial{},
+						UpdatedAt: now,
+					}, tt.getErr
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("PATCH", "/api/boards/:id", strings.NewReader(`{"token": "token", "dials": ["4321"]}`), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the set board handler.
+			a.setBoardDials().ServeHTTP(rr, r)
+
+			// Check that the UpdateBoard function has been invoked.
+			is.True(s.UpdateBoardInvoked)
+
+			// Check that the GetBoard function has (not) been invoked.
+			is.Equal(s.GetBoardInvoked, tt.expGetInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			// Check the response body is correct
+			type body struct {
+				Title  string `json:"title"`
+				Detail string `json:"detail"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Title, tt.expTitle)   // title is correct.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+}
+
+func TestClearBoardDials(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		err       error
+		expStatus int
+		expSet    bool
+	}{{
+		msg:       "cleared",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusOK,
+		expSet:    true,
+	}, {
+		msg:       "missing token",
+		body:      `{}`,
+		expStatus: http.StatusBadRequest,
+		expSet:    false,
+	}, {
+		msg:       "invalid json",
+		body:      `{"token": `,
+		expStatus: http.StatusBadRequest,
+		expSet:    false,
+	}, {
+		msg:       "board not found",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrBoardNotFound,
+		expStatus: http.StatusNotFound,
+		expSet:    true,
+	}, {
+		msg:       "wrong token",
+		body:      `{"token": "token"}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expSet:    true,
+	}, {
+		msg:       "service error",
+		body:      `{"token": "token"}`,
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expSet:    true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the SetBoard function.
+			var setID ooohh.BoardID
+			var setToken string
+			var setDials []ooohh.DialID
+
+			// Create a mock service, with GetBoard and SetBoard implemented.
+			s := &mock.Service{
+				SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+					setID, setToken, setDials = id, token, dials
+					return tt.err
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{ID: id, Name: "test", Dials: []ooohh.Dial{}}, nil
+				},
+			}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui)
+
+			// Create a new request.
+			r, err := newRequest("DELETE", "/api/boards/:id/dials", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the clear board dials handler.
+			a.clearBoardDials().ServeHTTP(rr, r)
+
+			// Check whether the SetBoard function has been invoked.
+			is.Equal(s.SetBoardInvoked, tt.expSet)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			if tt.expSet {
+				is.Equal(setID, ooohh.BoardID("1234")) // correct board was cleared.
+				is.Equal(setToken, "token")            // correct token was used.
+				is.Equal(len(setDials), 0)             // all dials were removed.
+			}
+		})
+	}
+}
+
+func TestAddBoardDial(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		err       error
+		expStatus int
+		expAdd    bool
+	}{{
+		msg:       "added",
+		body:      `{"token": "token", "dial_id": "dial"}`,
+		expStatus: http.StatusOK,
+		expAdd:    true,
+	}, {
+		msg:       "missing token",
+		body:      `{"dial_id": "dial"}`,
+		expStatus: http.StatusBadRequest,
+		expAdd:    false,
+	}, {
+		msg:       "missing dial",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusBadRequest,
+		expAdd:    false,
+	}, {
+		msg:       "invalid json",
+		body:      `{"token": `,
+		expStatus: http.StatusBadRequest,
+		expAdd:    false,
+	}, {
+		msg:       "board not found",
+		body:      `{"token": "token", "dial_id": "dial"}`,
+		err:       ooohh.ErrBoardNotFound,
+		expStatus: http.StatusNotFound,
+		expAdd:    true,
+	}, {
+		msg:       "wrong token",
+		body:      `{"token": "token", "dial_id": "dial"}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expAdd:    true,
+	}, {
+		msg:       "board frozen",
+		body:      `{"token": "token", "dial_id": "dial"}`,
+		err:       ooohh.ErrBoardFrozen,
+		expStatus: http.StatusConflict,
+		expAdd:    true,
+	}, {
+		msg:       "service error",
+		body:      `{"token": "token", "dial_id": "dial"}`,
+		err:       errors.New("oops"),
+		expStatus: http.StatusInternalServerError,
+		expAdd:    true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be assigned to within the AddBoardDial function.
+			var addedID ooohh.BoardID
+			var addedToken string
+			var addedDial ooohh.DialID
+
+			// Create a mock service, with GetBoard and AddBoardDial implemented.
+			s := &mock.Service{
+				AddBoardDialFn: func(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error {
+					addedID, addedToken, addedDial = id, token, dialID
+					return tt.err
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{ID: id, Name: "test", Dials: []ooohh.Dial{{ID: "dial"}}}, nil
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+			// Create a new request.
+			r, err := newRequest("POST", "/api/boards/:id/dials", strings.NewReader(tt.body), httprouter.Params{{Key: "id", Value: "1234"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the add board dial handler.
+			a.addBoardDial().ServeHTTP(rr, r)
+
+			// Check whether the AddBoardDial function has been invoked.
+			is.Equal(s.AddBoardDialInvoked, tt.expAdd)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			if tt.expAdd {
+				is.Equal(addedID, ooohh.BoardID("1234"))  // dial was added to the correct board.
+				is.Equal(addedToken, "token")             // correct token was used.
+				is.Equal(addedDial, ooohh.DialID("dial")) // correct dial was added.
+			}
+		})
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+
+	createdAt := time.Date(2020, 5, 17, 10, 30, 15, 0, time.UTC)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create a mock webhook service, that accepts the "token" token for board "1234".
+	authorize := func(board ooohh.BoardID, token string) error {
+		if board != "1234" {
+			return ooohh.ErrBoardNotFound
+		} else if token != "token" {
+			return ooohh.ErrUnauthorized
+		}
+		return nil
+	}
+	ws := &mock.WebhookService{
+		AddWebhookFn: func(ctx context.Context, board ooohh.BoardID, token, url string) (*webhook.Webhook, error) {
+			if url == "invalid" {
+				return nil, webhook.ErrURLInvalid
+			} else if url == "http://localhost" {
+				return nil, webhook.ErrURLPrivate
+			}
+			if err := authorize(board, token); err != nil {
+				return nil, err
+			}
+			return &webhook.Webhook{ID: "wh", Board: board, URL: url, CreatedAt: createdAt}, nil
+		},
+		ListWebhooksFn: func(ctx context.Context, board ooohh.BoardID, token string) ([]webhook.Webhook, error) {
+			if err := authorize(board, token); err != nil {
+				return nil, err
+			}
+			return []webhook.Webhook{{ID: "wh", Board: board, URL: "https://example.com", CreatedAt: createdAt}}, nil
+		},
+		DeleteWebhookFn: func(ctx context.Context, board ooohh.BoardID, token, id string) error {
+			if err := authorize(board, token); err != nil {
+				return err
+			}
+			if id != "wh" {
+				return webhook.ErrWebhookNotFound
+			}
+			return nil
+		},
+	}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	for _, tt := range []struct {
+		msg       string
+		disabled  bool
+		method    string
+		path      string
+		auth      string
+		body      string
+		expStatus int
+		expBody   string
+	}{{
+		msg:       "add",
+		method:    "POST",
+		path:      "/api/boards/1234/webhooks",
+		body:      `{"token": "token", "url": "https://example.com"}`,
+		expStatus: http.StatusCreated,
+		expBody:   `{"id":"wh","board_id":"1234","url":"https://example.com","failures":0,"disabled":false,"created_at":1589711415}`,
+	}, {
+		msg:       "add missing url",
+		method:    "POST",
+		path:      "/api/boards/1234/webhooks",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "add invalid url",
+		method:    "POST",
+		path:      "/api/boards/1234/webhooks",
+		body:      `{"token": "token", "url": "invalid"}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "add private url",
+		method:    "POST",
+		path:      "/api/boards/1234/webhooks",
+		body:      `{"token": "token", "url": "http://localhost"}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "add wrong token",
+		method:    "POST",
+		path:      "/api/boards/1234/webhooks",
+		body:      `{"token": "wrong", "url": "https://example.com"}`,
+		expStatus: http.StatusUnauthorized,
+	}, {
+		msg:       "add missing board",
+		method:    "POST",
+		path:      "/api/boards/missing/webhooks",
+		body:      `{"token": "token", "url": "https://example.com"}`,
+		expStatus: http.StatusNotFound,
+	}, {
+		msg:       "list",
+		method:    "GET",
+		path:      "/api/boards/1234/webhooks",
+		auth:      "Bearer token",
+		expStatus: http.StatusOK,
+		expBody:   `[{"id":"wh","board_id":"1234","url":"https://example.com","failures":0,"disabled":false,"created_at":1589711415}]`,
+	}, {
+		msg:       "list without token",
+		method:    "GET",
+		path:      "/api/boards/1234/webhooks",
+		expStatus: http.StatusUnauthorized,
+	}, {
+		msg:       "list wrong token",
+		method:    "GET",
+		path:      "/api/boards/1234/webhooks",
+		auth:      "Bearer wrong",
+		expStatus: http.StatusUnauthorized,
+	}, {
+		msg:       "delete",
+		method:    "DELETE",
+		path:      "/api/boards/1234/webhooks/wh",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusNoContent,
+	}, {
+		msg:       "delete missing webhook",
+		method:    "DELETE",
+		path:      "/api/boards/1234/webhooks/missing",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusNotFound,
+	}, {
+		msg:       "delete missing token",
+		method:    "DELETE",
+		path:      "/api/boards/1234/webhooks/wh",
+		body:      `{}`,
+		expStatus: http.StatusBadRequest,
+	}, {
+		msg:       "not configured",
+		disabled:  true,
+		method:    "POST",
+		path:      "/api/boards/1234/webhooks",
+		body:      `{"token": "token", "url": "https://example.com"}`,
+		expStatus: http.StatusNotFound,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get an API, with times formatted as unix timestamps.
+			opts := []Option{WithTimeFormat(TimeFormatUnix)}
+			if !tt.disabled {
+				opts = append(opts, WithWebhooks(ws))
+			}
+			h := newTestRouter(NewAPI(logger, s, ss, ui, opts...))
+
+			// Create a new request.
+			r, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			is.NoErr(err)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus) // status code is correct.
+			if tt.expBody != "" {
+				is.Equal(strings.TrimSpace(rr.Body.String()), tt.expBody) // body is correct.
+			}
+		})
+	}
+}
+
+func TestLeaderboard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Boards that will be returned by the service, with averages 20, 80 and 50.
+	boards := map[ooohh.BoardID]ooohh.Board{
+		"a": {ID: "a", Name: "Team A", Dials: []ooohh.Dial{{Value: 10}, {Value: 30}}},
+		"b": {ID: "b", Name: "Team B", Dials: []ooohh.Dial{{Value: 80}}},
+		"c": {ID: "c", Name: "Team C", Dials: []ooohh.Dial{{Value: 40}, {Value: 50}, {Value: 60}}},
+	}
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			b, ok := boards[id]
+			if !ok {
+				return nil, ooohh.ErrBoardNotFound
+			}
+			return &b, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request.
+	r, err := http.NewRequest("POST", "/api/leaderboard", strings.NewReader(`{"boards": ["a", "b", "missing", "c"]}`))
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the leaderboard handler.
+	a.leaderboard().ServeHTTP(rr, r)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the response body is correct
+	var actualBody []struct {
+		ID      string  `json:"id"`
+		Name    string  `json:"name"`
+		Average float64 `json:"average"`
+		Dials   int     `json:"dials"`
+	}
+	err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+	is.NoErr(err) // actual body is json.
+
+	is.Equal(len(actualBody), 3) // missing board is omitted.
+
+	is.Equal(actualBody[0].Name, "Team B") // highest average is first.
+	is.Equal(actualBody[0].Average, 80.0)  // average is correct.
+	is.Equal(actualBody[0].Dials, 1)       // dial count is correct.
+	is.Equal(actualBody[1].Name, "Team C") // middle average is second.
+	is.Equal(actualBody[1].Average, 50.0)  // average is correct.
+	is.Equal(actualBody[2].Name, "Team A") // lowest average is last.
+	is.Equal(actualBody[2].Average, 20.0)  // average is correct.
+}
+
+func TestLeaderboardPrivateBoards(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Boards that will be returned by the service, with different tokens.
+	boards := map[ooohh.BoardID]ooohh.Board{
+		"a": {ID: "a", Name: "Team A", Token: "token-a", Dials: []ooohh.Dial{{Value: 10}}},
+		"b": {ID: "b", Name: "Team B", Token: "token-b", Dials: []ooohh.Dial{{Value: 80}}},
+	}
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			b, ok := boards[id]
+			if !ok {
+				return nil, ooohh.ErrBoardNotFound
+			}
+			return &b, nil
+		},
+	}
+
+	// Get an API, with private boards.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithPrivateBoards())
+
+	leaderboard := func(token string) []string {
+		r, err := http.NewRequest("POST", "/api/leaderboard", strings.NewReader(`{"boards": ["a", "b"]}`))
+		is.NoErr(err)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		rr := httptest.NewRecorder()
+		a.leaderboard().ServeHTTP(rr, r)
+		is.Equal(rr.Code, http.StatusOK) // leaderboard is returned.
+
+		var body []struct {
+			Name string `json:"name"`
+		}
+		is.NoErr(json.Unmarshal(rr.Body.Bytes(), &body)) // body is json.
+
+		names := []string{}
+		for _, e := range body {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	is.Equal(leaderboard(""), []string{})                // boards aren't readable without a token.
+	is.Equal(leaderboard("wrong"), []string{})           // boards aren't readable with the wrong token.
+	is.Equal(leaderboard("token-a"), []string{"Team A"}) // only the board with the token is readable.
+}
+
+func TestLeaderboardMaskedBoards(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service, with GetBoard implemented.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "Team A", Token: "token", Dials: []ooohh.Dial{{Value: 10}, {Value: 30}}}, nil
+		},
+	}
+
+	// Get an API, with masked boards.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithMaskedBoards())
+
+	leaderboard := func(token string) (float64, bool) {
+		r, err := http.NewRequest("POST", "/api/leaderboard", strings.NewReader(`{"boards": ["a"]}`))
+		is.NoErr(err)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		rr := httptest.NewRecorder()
+		a.leaderboard().ServeHTTP(rr, r)
+		is.Equal(rr.Code, http.StatusOK) // leaderboard is returned.
+
+		var body []struct {
+			Average float64 `json:"average"`
+			Masked  bool    `json:"masked"`
+		}
+		is.NoErr(json.Unmarshal(rr.Body.Bytes(), &body)) // body is json.
+		is.Equal(len(body), 1)                           // board is returned.
+		return body[0].Average, body[0].Masked
+	}
+
+	average, masked := leaderboard("")
+	is.Equal(average, 0.0) // average is masked without the token.
+	is.True(masked)        // board is marked as masked.
+
+	average, masked = leaderboard("token")
+	is.Equal(average, 20.0) // average is returned with the token.
+	is.True(!masked)        // board isn't marked as masked.
+}
+
+func TestLeaderboardValidation(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Build a list of too many boards.
+	tooMany := make([]string, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("board-%d", i)
+	}
+	tooManyBody, _ := json.Marshal(map[string][]string{"boards": tooMany})
+
+	for _, tt := range []struct {
+		msg       string
+		body      string
+		expDetail string
+	}{{
+		msg:       "invalid json body",
+		body:      `{"boards": [`,
+		expDetail: "Invalid JSON",
+	}, {
+		msg:       "missing boards",
+		body:      `{}`,
+		expDetail: "`boards` must be provided.",
+	}, {
+		msg:       "too many boards",
+		body:      string(tooManyBody),
+		expDetail: fmt.Sprintf("At most %d `boards` can be provided.", maxBatchSize),
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a new request.
+			r, err := http.NewRequest("POST", "/api/leaderboard", strings.NewReader(tt.body))
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the leaderboard handler.
+			a.leaderboard().ServeHTTP(rr, r)
+
+			// Check that the GetBoard function has not been invoked.
+			is.True(!s.GetBoardInvoked)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusBadRequest)
+
+			// Check the response body is correct
+			var actualBody struct {
+				Detail string `json:"detail"`
+			}
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err)                             // actual body is json.
+			is.Equal(actualBody.Detail, tt.expDetail) // detail is correct.
+		})
+	}
+}
+
+func TestSlackCommand(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	for _, tt := range []struct {
+		msg               string
+		text              string
+		expType           string
+		expText           string
+		expServiceInvoked bool
+		expGetInvoked     bool
+		expNote           string
+		expDialName       string
+	}{{
+		msg:               "help command",
+		text:              "help",
+		expType:           "ephemeral",
+		expText:           "Use the following format to set a value: `/wtf <number>`, or `/wtf <dial name> <number>` to set one of your named dials.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "low level",
+		text:              "10",
+		expType:           "ephemeral",
+		expText:           "Ooohh, I wish I felt like that.",
+		expServiceInvoked: true,
+	}, {
+		msg:               "medium level",
+		text:              "55",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you take a break!",
+		expServiceInvoked: true,
+	}, {
+		msg:               "high level",
+		text:              "85",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help.",
+		expServiceInvoked: true,
+	}, {
+		msg:               "value too high",
+		text:              "101",
+		expType:           "ephemeral",
+		expText:           "Value out of bounds. Please supply a number between 0 and 100.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "value too low",
+		text:              "-1",
+		expType:           "ephemeral",
+		expText:           "Value out of bounds. Please supply a number between 0 and 100.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "bounds are inclusive",
+		text:              "100",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help.",
+		expServiceInvoked: true,
+	}, {
+		msg:               "with spaces",
+		text:              "           85       ",
 		expType:           "ephemeral",
-		expText:           "Value out of bounds. Please upply number between 0 and 100.",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help.",
+		expServiceInvoked: true,
+	}, {
+		msg:               "with note",
+		text:              "80 prod is on fire",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help. Noted: \"prod is on fire\".",
+		expServiceInvoked: true,
+		expNote:           "prod is on fire",
+	}, {
+		msg:               "with note and spaces",
+		text:              "  80    prod is on fire  ",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help. Noted: \"prod is on fire\".",
+		expServiceInvoked: true,
+		expNote:           "prod is on fire",
+	}, {
+		msg:               "query command",
+		text:              "?",
+		expType:           "ephemeral",
+		expText:           "Your dial (id) is set to 10.0.",
+		expServiceInvoked: false,
+		expGetInvoked:     true,
+	}, {
+		msg:               "query command with spaces",
+		text:              "  ?  ",
+		expType:           "ephemeral",
+		expText:           "Your dial (id) is set to 10.0.",
+		expServiceInvoked: false,
+		expGetInvoked:     true,
+	}, {
+		msg:               "named dial",
+		text:              "Prod 85",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help.",
+		expServiceInvoked: true,
+		expDialName:       "prod",
+	}, {
+		msg:               "named dial with note",
+		text:              "prod 80 it is on fire",
+		expType:           "ephemeral",
+		expText:           "Ooohh, make sure you check in with someone, maybe they can help. Noted: \"it is on fire\".",
 		expServiceInvoked: true,
+		expNote:           "it is on fire",
+		expDialName:       "prod",
+	}, {
+		msg:               "named query command",
+		text:              "  prod   ?  ",
+		expType:           "ephemeral",
+		expText:           "Your prod dial (id) is set to 10.0.",
+		expServiceInvoked: false,
+		expGetInvoked:     true,
+		expDialName:       "prod",
+	}, {
+		msg:               "named dial without value",
+		text:              "prod high",
+		expType:           "ephemeral",
+		expText:           "Please supply a single number as your WTF level.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "named dial out of bounds",
+		text:              "prod 101",
+		expType:           "ephemeral",
+		expText:           "Value out of bounds. Please supply a number between 0 and 100.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "empty command",
+		text:              "",
+		expType:           "ephemeral",
+		expText:           "Please supply a single number as your WTF level.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "invalid number command",
+		text:              "this isn't a number",
+		expType:           "ephemeral",
+		expText:           "Please supply a single number as your WTF level.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "nan number command",
+		text:              "nan",
+		expType:           "ephemeral",
+		expText:           "Sneaky. Please supply a _number_ as your WTF level.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "positive infinite number command",
+		text:              "+Inf",
+		expType:           "ephemeral",
+		expText:           "Definitely seek out help! Unfortunately, I only go up to 100.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "negative infinite number command",
+		text:              "-Inf",
+		expType:           "ephemeral",
+		expText:           "Definitely seek out help! Unfortunately, I only go up to 100.",
+		expServiceInvoked: false,
+	}, {
+		msg:               "infinite number command",
+		text:              "Inf",
+		expType:           "ephemeral",
+		expText:           "Definitely seek out help! Unfortunately, I only go up to 100.",
+		expServiceInvoked: false,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			// Capture the user name, dial name and note set with the value.
+			var setUserName, setNote, usedDialName string
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					setUserName, setNote, usedDialName = userName, note, dialName
+					if value > 100.0 || value < 0.0 {
+						return nil, false, ooohh.ErrDialValueInvalid
+					}
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, false, nil
+				},
+				GetDialFn: func(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error) {
+					usedDialName = dialName
+					return &ooohh.Dial{
+						ID:        ooohh.DialID("id"),
+						Name:      "dial",
+						Token:     "token",
+						Value:     10.0,
+						UpdatedAt: time.Now(),
+					}, nil
+				},
+			}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
+
+			// Create a new request.
+			formData := url.Values{
+				"command":   {"/wtf"},
+				"user_id":   {"user"},
+				"user_name": {"jane"},
+				"team_id":   {"team"},
+				"text":      {tt.text},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the slack service was/was not invoked as expected.
+			is.Equal(ss.SetDialValueInvoked, tt.expServiceInvoked)
+			if tt.expServiceInvoked {
+				is.Equal(setUserName, "jane") // user name is passed from the form.
+			}
+
+			// Check the GetDial method of the slack service was/was not invoked as expected.
+			is.Equal(ss.GetDialInvoked, tt.expGetInvoked)
+
+			// Check the note was set as expected.
+			is.Equal(setNote, tt.expNote)
+
+			// Check the expected dial was used.
+			is.Equal(usedDialName, tt.expDialName)
+
+			// Check the response body is correct.
+			type body struct {
+				Type string `json:"response_type"`
+				Text string `json:"text"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Type, tt.expType) // type is correct.
+			is.Equal(actualBody.Text, tt.expText) // text is correct.
+		})
+	}
+}
+
+// signSlackRequest signs the request as Slack would, using the given secret and timestamp.
+func signSlackRequest(r *http.Request, body, secret string, ts time.Time) {
+	t := fmt.Sprintf("%d", ts.Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:%s", t, body)
+
+	r.Header.Set("X-Slack-Request-Timestamp", t)
+	r.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestSlackCommandVerification(t *testing.T) {
+
+	now := time.Unix(1600000000, 0)
+
+	for _, tt := range []struct {
+		msg           string
+		tolerance     time.Duration
+		ts            time.Time
+		secret        string
+		unsigned      bool
+		noSecret      bool
+		insecure      bool
+		tamper        bool
+		maxBodySize   int64
+		expStatus     int
+		expSetInvoked bool
+	}{{
+		msg:           "just inside default tolerance",
+		ts:            now.Add(-5*time.Minute + time.Second),
+		secret:        "secret",
+		expStatus:     http.StatusOK,
+		expSetInvoked: true,
 	}, {
-		msg:               "with spaces",
-		text:              "           85       ",
-		expType:           "ephemeral",
-		expText:           "Ooohh, make sure you check in with someone, maybe they can help.",
-		expServiceInvoked: true,
+		msg:           "at default tolerance",
+		ts:            now.Add(-5 * time.Minute),
+		secret:        "secret",
+		expStatus:     http.StatusOK,
+		expSetInvoked: true,
+	}, {
+		msg:           "just outside default tolerance",
+		ts:            now.Add(-5*time.Minute - time.Second),
+		secret:        "secret",
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "just outside default tolerance in the future",
+		ts:            now.Add(5*time.Minute + time.Second),
+		secret:        "secret",
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "just inside configured tolerance",
+		tolerance:     10 * time.Minute,
+		ts:            now.Add(-10*time.Minute + time.Second),
+		secret:        "secret",
+		expStatus:     http.StatusOK,
+		expSetInvoked: true,
+	}, {
+		msg:           "just outside configured tolerance",
+		tolerance:     10 * time.Minute,
+		ts:            now.Add(-10*time.Minute - time.Second),
+		secret:        "secret",
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "invalid signature",
+		ts:            now,
+		secret:        "wrong",
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "unsigned",
+		ts:            now,
+		unsigned:      true,
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "no signing secret configured",
+		ts:            now,
+		unsigned:      true,
+		noSecret:      true,
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "no signing secret configured, with insecure requests allowed",
+		ts:            now,
+		unsigned:      true,
+		noSecret:      true,
+		insecure:      true,
+		expStatus:     http.StatusOK,
+		expSetInvoked: true,
+	}, {
+		msg:           "signing secret configured, with insecure requests allowed",
+		ts:            now,
+		unsigned:      true,
+		insecure:      true,
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "body modified after signing",
+		ts:            now,
+		secret:        "secret",
+		tamper:        true,
+		expStatus:     http.StatusUnauthorized,
+		expSetInvoked: false,
+	}, {
+		msg:           "body too large",
+		ts:            now,
+		secret:        "secret",
+		maxBodySize:   10,
+		expStatus:     http.StatusBadRequest,
+		expSetInvoked: false,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, false, nil
+				},
+			}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API, with a mock clock.
+			opts := []Option{
+				WithNow(func() time.Time { return now }),
+			}
+			if !tt.noSecret {
+				opts = append(opts, WithSlackSigningSecret("secret"))
+			}
+			if tt.insecure {
+				opts = append(opts, WithInsecureSlackRequests())
+			}
+			if tt.tolerance != 0 {
+				opts = append(opts, WithSlackTolerance(tt.tolerance))
+			}
+			if tt.maxBodySize != 0 {
+				opts = append(opts, WithSlackMaxBodySize(tt.maxBodySize))
+			}
+			a := NewAPI(logger, s, ss, ui, opts...)
+
+			// Create a new, signed, request.
+			formData := url.Values{
+				"command": {"/wtf"},
+				"user_id": {"user"},
+				"team_id": {"team"},
+				"text":    {"55"},
+			}
+			body := formData.Encode()
+			signed := body
+			if tt.tamper {
+				// Change the value after the body is signed.
+				formData.Set("text", "100")
+				body = formData.Encode()
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(body))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if !tt.unsigned {
+				signSlackRequest(r, signed, tt.secret, tt.ts)
+			}
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, tt.expStatus)
+
+			// Check whether the slack service was invoked.
+			is.Equal(ss.SetDialValueInvoked, tt.expSetInvoked)
+		})
+	}
+}
+
+func TestSlackCommandCreatedOrUpdated(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg     string
+		created bool
+		expText string
+	}{{
+		msg:     "first set creates dial",
+		created: true,
+		expText: "Welcome to ooohh! Your dial (id) has been created. Ooohh, make sure you take a break!",
+	}, {
+		msg:     "subsequent set updates dial",
+		created: false,
+		expText: "Ooohh, make sure you take a break!",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, tt.created, nil
+				},
+			}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
+
+			// Create a new request.
+			formData := url.Values{
+				"command": {"/wtf"},
+				"user_id": {"user"},
+				"team_id": {"team"},
+				"text":    {"55"},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the response body is correct.
+			type body struct {
+				Type string `json:"response_type"`
+				Text string `json:"text"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Type, "ephemeral") // type is correct.
+			is.Equal(actualBody.Text, tt.expText)  // text is correct.
+		})
+	}
+}
+
+func TestSlackCommandValueMessages(t *testing.T) {
+
+	// Messages with exact matches for 0 and 100.
+	messages := DefaultValueMessages()
+	messages.Zero = "Zero WTFs given 😌"
+	messages.Hundred = "Maximum WTF!"
+
+	for _, tt := range []struct {
+		msg     string
+		text    string
+		expText string
+	}{{
+		msg:     "exactly zero",
+		text:    "0",
+		expText: "Zero WTFs given 😌",
+	}, {
+		msg:     "just above zero",
+		text:    "0.1",
+		expText: "Ooohh, I wish I felt like that.",
+	}, {
+		msg:     "exactly one hundred",
+		text:    "100",
+		expText: "Maximum WTF!",
+	}, {
+		msg:     "just below one hundred",
+		text:    "99.9",
+		expText: "Ooohh, make sure you check in with someone, maybe they can help.",
+	}, {
+		msg:     "middle band",
+		text:    "50.1",
+		expText: "Ooohh, make sure you take a break!",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, false, nil
+				},
+			}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui, WithValueMessages(messages), WithInsecureSlackRequests())
+
+			// Create a new request.
+			formData := url.Values{
+				"command": {"/wtf"},
+				"user_id": {"user"},
+				"team_id": {"team"},
+				"text":    {tt.text},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response body is correct.
+			type body struct {
+				Type string `json:"response_type"`
+				Text string `json:"text"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Text, tt.expText) // text is correct.
+		})
+	}
+}
+
+func TestSlackCommandCustomValueBands(t *testing.T) {
+
+	// Messages with two bands, split at 60.
+	messages := ValueMessages{
+		Bands:   []ValueBand{{60, "Alles klar?"}},
+		Default: "Alles gut.",
+	}
+
+	for _, tt := range []struct {
+		msg     string
+		text    string
+		expText string
+	}{{
+		msg:     "zero",
+		text:    "0",
+		expText: "Alles gut.",
+	}, {
+		msg:     "at the threshold",
+		text:    "60",
+		expText: "Alles gut.",
+	}, {
+		msg:     "above the threshold",
+		text:    "60.1",
+		expText: "Alles klar?",
+	}, {
+		msg:     "one hundred",
+		text:    "100",
+		expText: "Alles klar?",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, false, nil
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui.NewUI(s), WithValueMessages(messages), WithInsecureSlackRequests())
+
+			// Create a new request.
+			formData := url.Values{
+				"command": {"/wtf"},
+				"user_id": {"user"},
+				"team_id": {"team"},
+				"text":    {tt.text},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response body is correct.
+			type body struct {
+				Type string `json:"response_type"`
+				Text string `json:"text"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Text, tt.expText) // text is correct.
+		})
+	}
+}
+
+func TestValueMessagesValidate(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		messages ValueMessages
+		valid    bool
+	}{{
+		msg:      "default",
+		messages: DefaultValueMessages(),
+		valid:    true,
 	}, {
-		msg:               "query command",
-		text:              "?",
-		expType:           "ephemeral",
-		expText:           "Your dial (id) is set to 10.0.",
-		expServiceInvoked: false,
-		expGetInvoked:     true,
+		msg:      "no bands",
+		messages: ValueMessages{Default: "ok"},
+		valid:    true,
 	}, {
-		msg:               "empty command",
-		text:              "",
-		expType:           "ephemeral",
-		expText:           "Please supply a single number as your WTF level.",
-		expServiceInvoked: false,
+		msg:      "bands out of order",
+		messages: ValueMessages{Bands: []ValueBand{{50, "a"}, {75, "b"}}, Default: "ok"},
 	}, {
-		msg:               "invalid number command",
-		text:              "this isn't a number",
-		expType:           "ephemeral",
-		expText:           "Please supply a single number as your WTF level.",
-		expServiceInvoked: false,
+		msg:      "repeated band",
+		messages: ValueMessages{Bands: []ValueBand{{50, "a"}, {50, "b"}}, Default: "ok"},
 	}, {
-		msg:               "nan number command",
-		text:              "nan",
-		expType:           "ephemeral",
-		expText:           "Sneaky. Please supply a _number_ as your WTF level.",
-		expServiceInvoked: false,
+		msg:      "band without message",
+		messages: ValueMessages{Bands: []ValueBand{{50, ""}}, Default: "ok"},
 	}, {
-		msg:               "positive infinite number command",
-		text:              "+Inf",
-		expType:           "ephemeral",
-		expText:           "Definitely seek out help! Unfortunately, I only go up to 100.",
+		msg:      "no default",
+		messages: ValueMessages{Bands: []ValueBand{{50, "a"}}},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			err := tt.messages.Validate()
+			is.Equal(err == nil, tt.valid) // messages are validated.
+		})
+	}
+}
+
+func TestParseValueBands(t *testing.T) {
+
+	is := is.New(t)
+
+	bands, err := ParseValueBands([]string{"75:Check in: now", " 50.5 : Take a break"})
+	is.NoErr(err)                                                               // bands are parsed.
+	is.Equal(bands, []ValueBand{{75, "Check in: now"}, {50.5, "Take a break"}}) // bands are as configured.
+
+	_, err = ParseValueBands([]string{"75"})
+	is.True(err != nil) // band without a message is invalid.
+
+	_, err = ParseValueBands([]string{"high:Check in"})
+	is.True(err != nil) // band with an invalid threshold is invalid.
+
+	_, err = ParseValueBands([]string{"NaN:Check in"})
+	is.True(err != nil) // band with a NaN threshold is invalid.
+}
+
+func TestSlackCommandValueBounds(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg               string
+		text              string
+		expText           string
+		expServiceInvoked bool
+	}{{
+		msg:               "below configured bounds",
+		text:              "0",
+		expText:           "Value out of bounds. Please supply a number between 1 and 10.",
 		expServiceInvoked: false,
 	}, {
-		msg:               "negative infinite number command",
-		text:              "-Inf",
-		expType:           "ephemeral",
-		expText:           "Definitely seek out help! Unfortunately, I only go up to 100.",
+		msg:               "above configured bounds",
+		text:              "10.5",
+		expText:           "Value out of bounds. Please supply a number between 1 and 10.",
 		expServiceInvoked: false,
 	}, {
-		msg:               "infinite number command",
-		text:              "Inf",
-		expType:           "ephemeral",
-		expText:           "Definitely seek out help! Unfortunately, I only go up to 100.",
-		expServiceInvoked: false,
+		msg:               "within configured bounds",
+		text:              "10",
+		expText:           "Ooohh, I wish I felt like that.",
+		expServiceInvoked: true,
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
 			is := is.New(t)
 
+			// Get a logger.
+			logger, logs := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
 			// Create a mock slack service.
 			ss := &mock.SlackService{
-				SetDialValueFn: func(ctx context.Context, teamID, userID, userName string, value float64) error {
-					if value > 100.0 || value < 0.0 {
-						return ooohh.ErrDialValueInvalid
-					}
-					return nil
-				},
-				GetDialFn: func(ctx context.Context, teamID, userID string) (*ooohh.Dial, error) {
-					return &ooohh.Dial{
-						ID:        ooohh.DialID("id"),
-						Name:      "dial",
-						Token:     "token",
-						Value:     10.0,
-						UpdatedAt: time.Now(),
-					}, nil
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, false, nil
 				},
 			}
 
-			// Create UI.
-			ui := ui.NewUI(s)
-
 			// Get an API.
-			a := NewAPI(logger, s, ss, ui)
+			a := NewAPI(logger, s, ss, ui.NewUI(s), WithValueBounds(1, 10), WithInsecureSlackRequests())
 
 			// Create a new request.
 			formData := url.Values{
@@ -1531,25 +6532,23 @@ func TestSlackCommand(t *testing.T) {
 			// Invoke the slack command handler.
 			a.slackCommand().ServeHTTP(rr, r)
 
-			// Check the response status code is correct.
-			is.Equal(rr.Code, http.StatusOK)
-
 			// Check the slack service was/was not invoked as expected.
 			is.Equal(ss.SetDialValueInvoked, tt.expServiceInvoked)
 
-			// Check the GetDial method of the slack service was/was not invoked as expected.
-			is.Equal(ss.GetDialInvoked, tt.expGetInvoked)
+			// Check out of bounds values are logged.
+			if tt.expServiceInvoked {
+				is.Equal(len(logs.FilterMessage("slack value out of bounds").All()), 0) // value isn't logged.
+			} else {
+				is.Equal(len(logs.FilterMessage("slack value out of bounds").All()), 1) // value is logged.
+			}
 
 			// Check the response body is correct.
-			type body struct {
-				Type string `json:"response_type"`
+			var actualBody struct {
 				Text string `json:"text"`
 			}
-			var actualBody body
 			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
 			is.NoErr(err) // actual body is json.
 
-			is.Equal(actualBody.Type, tt.expType) // type is correct.
 			is.Equal(actualBody.Text, tt.expText) // text is correct.
 		})
 	}
@@ -1566,8 +6565,8 @@ func TestSlackCommandServiceError(t *testing.T) {
 
 	// Create a mock slack service.
 	ss := &mock.SlackService{
-		SetDialValueFn: func(ctx context.Context, teamID, userID, userName string, value float64) error {
-			return errors.New("uh-oh")
+		SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+			return nil, false, errors.New("uh-oh")
 		},
 	}
 
@@ -1575,7 +6574,7 @@ func TestSlackCommandServiceError(t *testing.T) {
 	ui := ui.NewUI(s)
 
 	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+	a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
 
 	// Create a new request.
 	formData := url.Values{
@@ -1614,6 +6613,83 @@ func TestSlackCommandServiceError(t *testing.T) {
 	is.Equal(actualBody.Text, "Oops, something didn't quite work out. Please, try again.") // text is correct.
 }
 
+func TestSlackCommandServiceRejectsValue(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{}
+
+	for _, tt := range []struct {
+		msg     string
+		err     error
+		expText string
+	}{{
+		msg:     "value out of bounds",
+		err:     ooohh.ErrDialValueInvalid,
+		expText: "Value out of bounds. Please supply a number between 0 and 100.",
+	}, {
+		msg:     "board frozen",
+		err:     ooohh.ErrBoardFrozen,
+		expText: "Your dial is on a frozen board, so can't be set right now.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			// Create a mock slack service, that rejects the value itself.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return nil, false, tt.err
+				},
+			}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
+
+			// Create a new request, with a value the API considers in bounds.
+			formData := url.Values{
+				"command": {"/wtf"},
+				"user_id": {"user"},
+				"team_id": {"team"},
+				"text":    {"55"},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the slack service was invoked.
+			is.True(ss.SetDialValueInvoked)
+
+			// Check the response body is correct.
+			type body struct {
+				Type string `json:"response_type"`
+				Text string `json:"text"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Type, "ephemeral") // type is correct.
+			is.Equal(actualBody.Text, tt.expText)  // reason is explained, not a generic error.
+		})
+	}
+}
+
 func TestSlackCommandGetDialError(t *testing.T) {
 	is := is.New(t)
 
@@ -1625,7 +6701,7 @@ func TestSlackCommandGetDialError(t *testing.T) {
 
 	// Create a mock slack service.
 	ss := &mock.SlackService{
-		GetDialFn: func(ctx context.Context, teamID, userID string) (*ooohh.Dial, error) {
+		GetDialFn: func(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error) {
 			return nil, errors.New("uh-oh")
 		},
 	}
@@ -1634,7 +6710,7 @@ func TestSlackCommandGetDialError(t *testing.T) {
 	ui := ui.NewUI(s)
 
 	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+	a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
 
 	// Create a new request.
 	formData := url.Values{
@@ -1676,6 +6752,217 @@ func TestSlackCommandGetDialError(t *testing.T) {
 	is.Equal(actualBody.Text, "Oops, something didn't quite work out. Please, try again.") // text is correct.
 }
 
+func TestSlackCommandBoard(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg     string
+		text    string
+		err     error
+		invoked bool
+		resp    string
+	}{{
+		msg:     "added",
+		text:    "board my-board my-token",
+		invoked: true,
+		resp:    "Your dial is on board my-board.",
+	}, {
+		msg:     "extra whitespace",
+		text:    "  board   my-board\tmy-token ",
+		invoked: true,
+		resp:    "Your dial is on board my-board.",
+	}, {
+		msg:  "missing token",
+		text: "board my-board",
+		resp: "Use the following format to add your dial to a board: `/wtf board <board id> <board token>`",
+	}, {
+		msg:  "too many arguments",
+		text: "board my-board my-token extra",
+		resp: "Use the following format to add your dial to a board: `/wtf board <board id> <board token>`",
+	}, {
+		msg:     "unauthorized",
+		text:    "board my-board wrong",
+		err:     ooohh.ErrUnauthorized,
+		invoked: true,
+		resp:    "That board token isn't right. Please check it with whoever created the board, and try again.",
+	}, {
+		msg:     "board not found",
+		text:    "board my-board my-token",
+		err:     ooohh.ErrBoardNotFound,
+		invoked: true,
+		resp:    "Board my-board wasn't found. Please check the board id, and try again.",
+	}, {
+		msg:     "board frozen",
+		text:    "board my-board my-token",
+		err:     ooohh.ErrBoardFrozen,
+		invoked: true,
+		resp:    "That board is frozen, so dials can't be added to it right now.",
+	}, {
+		msg:     "service error",
+		text:    "board my-board my-token",
+		err:     errors.New("uh-oh"),
+		invoked: true,
+		resp:    "Oops, something didn't quite work out. Please, try again.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Arguments the slack service is called with.
+			var teamID, userID, userName, boardID, boardToken string
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				AddToBoardFn: func(ctx context.Context, tID, uID, uName, bID, bToken string) error {
+					teamID, userID, userName, boardID, boardToken = tID, uID, uName, bID, bToken
+					return tt.err
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, ss, ui.NewUI(s), WithInsecureSlackRequests())
+
+			// Create a new request.
+			formData := url.Values{
+				"command":   {"/wtf"},
+				"user_id":   {"user"},
+				"user_name": {"alice"},
+				"team_id":   {"team"},
+				"text":      {tt.text},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, http.StatusOK)           // response is ok.
+			is.Equal(ss.AddToBoardInvoked, tt.invoked) // dial is added to the board when the command is valid.
+			is.True(!ss.SetDialValueInvoked)           // dial value isn't set.
+
+			if tt.invoked {
+				is.Equal(teamID, "team")      // team is passed on.
+				is.Equal(userID, "user")      // user is passed on.
+				is.Equal(userName, "alice")   // user name is passed on.
+				is.Equal(boardID, "my-board") // board id is parsed.
+				is.True(boardToken != "")     // board token is parsed.
+			}
+
+			// Check the response body is correct.
+			type body struct {
+				Type string `json:"response_type"`
+				Text string `json:"text"`
+			}
+			var actualBody body
+			err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+			is.NoErr(err) // actual body is json.
+
+			is.Equal(actualBody.Type, "ephemeral") // type is correct.
+			is.Equal(actualBody.Text, tt.resp)     // text is correct.
+		})
+	}
+}
+
+func TestSlackCommandTeams(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg           string
+		teams         []string
+		team          string
+		expSetInvoked bool
+		expText       string
+	}{{
+		msg:           "all teams allowed by default",
+		teams:         nil,
+		team:          "team",
+		expSetInvoked: true,
+	}, {
+		msg:           "allowed team",
+		teams:         []string{"other", "team"},
+		team:          "team",
+		expSetInvoked: true,
+	}, {
+		msg:           "disallowed team",
+		teams:         []string{"other"},
+		team:          "team",
+		expSetInvoked: false,
+		expText:       "Sorry, this workspace isn't set up to use ooohh.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Create a mock slack service.
+			ss := &mock.SlackService{
+				SetDialValueFn: func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
+					return &ooohh.Dial{ID: ooohh.DialID("id"), Value: value}, false, nil
+				},
+			}
+
+			// Create UI.
+			ui := ui.NewUI(s)
+
+			// Get an API, allowing the given teams.
+			a := NewAPI(logger, s, ss, ui, WithSlackTeams(tt.teams...), WithInsecureSlackRequests())
+
+			// Create a new request.
+			formData := url.Values{
+				"command": {"/wtf"},
+				"user_id": {"user"},
+				"team_id": {tt.team},
+				"text":    {"55"},
+			}
+			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(formData.Encode()))
+			is.NoErr(err)
+
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the slack command handler.
+			a.slackCommand().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check whether the slack service was invoked.
+			is.Equal(ss.SetDialValueInvoked, tt.expSetInvoked)
+
+			if tt.expText != "" {
+				type body struct {
+					Type string `json:"response_type"`
+					Text string `json:"text"`
+				}
+				var actualBody body
+				err = json.Unmarshal(rr.Body.Bytes(), &actualBody)
+				is.NoErr(err) // actual body is json.
+
+				is.Equal(actualBody.Type, "ephemeral") // type is correct.
+				is.Equal(actualBody.Text, tt.expText)  // text is correct.
+			}
+		})
+	}
+}
+
 func TestSlackCommandInvalidCommand(t *testing.T) {
 	is := is.New(t)
 
@@ -1692,7 +6979,7 @@ func TestSlackCommandInvalidCommand(t *testing.T) {
 	ui := ui.NewUI(s)
 
 	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+	a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
 
 	// Create a new request.
 	formData := url.Values{
@@ -1776,7 +7063,7 @@ func TestSlackCommandValidation(t *testing.T) {
 			ui := ui.NewUI(s)
 
 			// Get an API.
-			a := NewAPI(logger, s, ss, ui)
+			a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
 
 			// Create a new request.
 			r, err := http.NewRequest("POST", "/api/slack/command", strings.NewReader(tt.data.Encode()))
@@ -1869,7 +7156,7 @@ func TestSlackCommandQueryWithoutPriorSet(t *testing.T) {
 
 	// Create a mock slack service.
 	ss := &mock.SlackService{
-		GetDialFn: func(ctx context.Context, teamID, userID string) (*ooohh.Dial, error) {
+		GetDialFn: func(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error) {
 			return nil, slack.ErrDialNotFound
 		},
 	}
@@ -1878,7 +7165,7 @@ func TestSlackCommandQueryWithoutPriorSet(t *testing.T) {
 	ui := ui.NewUI(s)
 
 	// Get an API.
-	a := NewAPI(logger, s, ss, ui)
+	a := NewAPI(logger, s, ss, ui, WithInsecureSlackRequests())
 
 	// Create a new request.
 	formData := url.Values{
@@ -1919,3 +7206,600 @@ func TestSlackCommandQueryWithoutPriorSet(t *testing.T) {
 	is.Equal(actualBody.Type, "ephemeral")                                                // type is correct.
 	is.Equal(actualBody.Text, "Use the following format to set a value: `/wtf <number>`") // text is correct.
 }
+
+func TestMethodNotAllowed(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		method   string
+		path     string
+		expAllow string
+	}{{
+		msg:      "put dial",
+		method:   "PUT",
+		path:     "/api/dials/dial-id",
+		expAllow: "DELETE, GET, OPTIONS, PATCH, POST",
+	}, {
+		msg:      "delete health",
+		method:   "DELETE",
+		path:     "/api/health",
+		expAllow: "GET, OPTIONS",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			s := &mock.Service{}
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+			h := MethodNotAllowed(newTestRouter(a))
+
+			r, err := http.NewRequest(tt.method, tt.path, nil)
+			is.NoErr(err) // request is created.
+
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, http.StatusMethodNotAllowed)                             // method is not allowed.
+			is.Equal(rr.Header().Get("Allow"), tt.expAllow)                            // allowed methods are listed.
+			is.Equal(rr.Header().Get("Content-Type"), "application/problem+json")      // problem is returned.
+			is.True(!s.GetDialInvoked && !s.UpdateDialInvoked && !s.DeleteDialInvoked) // no handler is called.
+
+			var p map[string]interface{}
+			is.NoErr(json.NewDecoder(rr.Body).Decode(&p))                // body is a single json object.
+			is.Equal(p["status"], float64(http.StatusMethodNotAllowed))  // problem has the status.
+			is.Equal(p["title"], "Method Not Allowed")                   // problem has the title.
+			is.Equal(p["code"], "method_not_allowed")                    // problem has the code.
+			is.True(strings.Contains(p["detail"].(string), tt.expAllow)) // detail lists the allowed methods.
+		})
+	}
+
+	t.Run("other responses", func(t *testing.T) {
+
+		is := is.New(t)
+
+		// Create logger.
+		logger, _ := newTestLogger(zap.InfoLevel)
+
+		s := &mock.Service{}
+		a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+		h := MethodNotAllowed(newTestRouter(a))
+
+		r, err := http.NewRequest("GET", "/api/health", nil)
+		is.NoErr(err) // request is created.
+
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+
+		is.Equal(rr.Code, http.StatusOK)       // supported methods are served.
+		is.Equal(rr.Header().Get("Allow"), "") // no methods are listed.
+	})
+}
+
+func TestCORS(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "board"}, nil
+		},
+	}
+
+	// Get an API.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s))
+
+	for _, tt := range []struct {
+		msg           string
+		origins       []string
+		method        string
+		origin        string
+		preflight     bool
+		expCode       int
+		expAllowed    string
+		expAllowsCall bool
+	}{{
+		msg:        "allowed origin",
+		origins:    []string{"https://dash.example.com"},
+		method:     "GET",
+		origin:     "https://dash.example.com",
+		expCode:    http.StatusOK,
+		expAllowed: "https://dash.example.com",
+	}, {
+		msg:           "preflight",
+		origins:       []string{"https://other.example.com", "https://dash.example.com"},
+		method:        "OPTIONS",
+		origin:        "https://dash.example.com",
+		preflight:     true,
+		expCode:       http.StatusNoContent,
+		expAllowed:    "https://dash.example.com",
+		expAllowsCall: true,
+	}, {
+		msg:        "any origin",
+		origins:    []string{"*"},
+		method:     "GET",
+		origin:     "https://dash.example.com",
+		expCode:    http.StatusOK,
+		expAllowed: "https://dash.example.com",
+	}, {
+		msg:     "disallowed origin",
+		origins: []string{"https://dash.example.com"},
+		method:  "GET",
+		origin:  "https://evil.example.com",
+		expCode: http.StatusOK,
+	}, {
+		msg:       "disallowed preflight",
+		origins:   []string{"https://dash.example.com"},
+		method:    "OPTIONS",
+		origin:    "https://evil.example.com",
+		preflight: true,
+		expCode:   http.StatusOK,
+	}, {
+		msg:     "not configured",
+		method:  "GET",
+		origin:  "https://dash.example.com",
+		expCode: http.StatusOK,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Route requests as the server would, with the middleware.
+			h := CORS(tt.origins...)(newTestRouter(a))
+
+			r, err := http.NewRequest(tt.method, "/api/boards/1234", nil)
+			is.NoErr(err)
+			r.Header.Set("Origin", tt.origin)
+			if tt.preflight {
+				r.Header.Set("Access-Control-Request-Method", "GET")
+			}
+
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expCode)                                                     // response code is correct.
+			is.Equal(rr.Header().Get("Access-Control-Allow-Origin"), tt.expAllowed)           // origin is only allowed when configured.
+			is.Equal(rr.Header().Get("Access-Control-Allow-Methods") != "", tt.expAllowsCall) // methods are allowed on preflight.
+			is.Equal(rr.Header().Get("Access-Control-Allow-Headers") != "", tt.expAllowsCall) // headers are allowed on preflight.
+		})
+	}
+}
+
+func TestProblemCodes(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		opts      []Option
+		method    string
+		path      string
+		body      string
+		auth      string
+		err       error
+		expStatus int
+		expCode   string
+	}{{
+		msg:       "invalid json",
+		method:    "POST",
+		path:      "/api/dials",
+		body:      `{`,
+		expStatus: http.StatusBadRequest,
+		expCode:   "invalid_json",
+	}, {
+		msg:       "validation error",
+		method:    "POST",
+		path:      "/api/dials",
+		body:      `{"name": "dial"}`,
+		expStatus: http.StatusBadRequest,
+		expCode:   "validation_error",
+	}, {
+		msg:       "dial not found",
+		method:    "GET",
+		path:      "/api/dials/1234",
+		err:       ooohh.ErrDialNotFound,
+		expStatus: http.StatusNotFound,
+		expCode:   "dial_not_found",
+	}, {
+		msg:       "internal error",
+		method:    "GET",
+		path:      "/api/dials/1234",
+		err:       errors.New("uh-oh"),
+		expStatus: http.StatusInternalServerError,
+		expCode:   "internal_error",
+	}, {
+		msg:       "value invalid",
+		method:    "PATCH",
+		path:      "/api/dials/1234",
+		body:      `{"token": "token", "value": 101}`,
+		err:       ooohh.ErrDialValueInvalid,
+		expStatus: http.StatusBadRequest,
+		expCode:   "value_invalid",
+	}, {
+		msg:       "color invalid",
+		method:    "PATCH",
+		path:      "/api/dials/1234",
+		body:      `{"token": "token", "color": "red"}`,
+		err:       ooohh.ErrDialColorInvalid,
+		expStatus: http.StatusBadRequest,
+		expCode:   "color_invalid",
+	}, {
+		msg:       "token invalid",
+		method:    "PATCH",
+		path:      "/api/dials/1234",
+		body:      `{"token": "token", "new_token": ""}`,
+		err:       ooohh.ErrDialTokenInvalid,
+		expStatus: http.StatusBadRequest,
+		expCode:   "token_invalid",
+	}, {
+		msg:       "unauthorized",
+		method:    "PATCH",
+		path:      "/api/dials/1234",
+		body:      `{"token": "wrong", "value": 50}`,
+		err:       ooohh.ErrUnauthorized,
+		expStatus: http.StatusUnauthorized,
+		expCode:   "unauthorized",
+	}, {
+		msg:       "locked out",
+		method:    "PATCH",
+		path:      "/api/dials/1234",
+		body:      `{"token": "wrong", "value": 50}`,
+		err:       ooohh.ErrLockedOut,
+		expStatus: http.StatusTooManyRequests,
+		expCode:   "locked_out",
+	}, {
+		msg:       "board frozen",
+		method:    "PATCH",
+		path:      "/api/dials/1234",
+		body:      `{"token": "token", "value": 50}`,
+		err:       ooohh.ErrBoardFrozen,
+		expStatus: http.StatusConflict,
+		expCode:   "board_frozen",
+	}, {
+		msg:       "board not found",
+		method:    "GET",
+		path:      "/api/boards/1234",
+		err:       ooohh.ErrBoardNotFound,
+		expStatus: http.StatusNotFound,
+		expCode:   "board_not_found",
+	}, {
+		msg:       "board token required",
+		opts:      []Option{WithPrivateBoards()},
+		method:    "GET",
+		path:      "/api/boards/1234",
+		expStatus: http.StatusUnauthorized,
+		expCode:   "token_required",
+	}, {
+		msg:       "board token forbidden",
+		opts:      []Option{WithPrivateBoards()},
+		method:    "GET",
+		path:      "/api/boards/1234",
+		auth:      "Bearer wrong",
+		expStatus: http.StatusForbidden,
+		expCode:   "forbidden",
+	}, {
+		msg:       "admin only",
+		method:    "GET",
+		path:      "/api/dials",
+		expStatus: http.StatusNotFound,
+		expCode:   "not_found",
+	}, {
+		msg: "webhook not found",
+		opts: []Option{WithWebhooks(&mock.WebhookService{DeleteWebhookFn: func(ctx context.Context, board ooohh.BoardID, token, id string) error {
+			return webhook.ErrWebhookNotFound
+		}})},
+		method:    "DELETE",
+		path:      "/api/boards/1234/webhooks/5678",
+		body:      `{"token": "token"}`,
+		expStatus: http.StatusNotFound,
+		expCode:   "webhook_not_found",
+	}, {
+		msg:       "invalid request",
+		method:    "POST",
+		path:      "/api/slack/command",
+		body:      "command=/wtf",
+		expStatus: http.StatusInternalServerError,
+		expCode:   "invalid_request",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create a mock service, that fails with the error.
+			s := &mock.Service{
+				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+					return nil, tt.err
+				},
+				UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+					return nil, tt.err
+				},
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &ooohh.Board{ID: id, Token: "token"}, nil
+				},
+			}
+
+			// Get an API.
+			a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), append(tt.opts, WithInsecureSlackRequests())...)
+
+			// Create a new request.
+			r, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			is.NoErr(err)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+
+			// Route the request as the server would.
+			rr := httptest.NewRecorder()
+			newTestRouter(a).ServeHTTP(rr, r)
+
+			is.Equal(rr.Code, tt.expStatus) // response status is correct.
+
+			var problem map[string]interface{}
+			err = json.Unmarshal(rr.Body.Bytes(), &problem)
+			is.NoErr(err)                         // body is a problem.
+			is.Equal(problem["code"], tt.expCode) // problem code is correct.
+			is.True(problem["title"] != "")       // problem still has a title.
+		})
+	}
+}
+
+func TestStripTrailingSlash(t *testing.T) {
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return &ooohh.Dial{ID: id, Name: "dial", Value: 66.6}, nil
+		},
+		UpdateDialFn: func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+			return &ooohh.Dial{ID: id, Name: "dial", Value: *u.Value}, nil
+		},
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "board"}, nil
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API, served with the middleware.
+	h := StripTrailingSlash(newTestRouter(NewAPI(logger, s, ss, ui)))
+
+	for _, tt := range []struct {
+		msg       string
+		method    string
+		path      string
+		canonical string
+		body      string
+	}{{
+		msg:       "get dial",
+		method:    "GET",
+		path:      "/api/dials/1234/",
+		canonical: "/api/dials/1234",
+	}, {
+		msg:       "set dial",
+		method:    "PATCH",
+		path:      "/api/dials/1234/",
+		canonical: "/api/dials/1234",
+		body:      `{"token": "token", "value": 66.6}`,
+	}, {
+		msg:       "get board",
+		method:    "GET",
+		path:      "/api/boards/1234//",
+		canonical: "/api/boards/1234",
+	}, {
+		msg:       "board page",
+		method:    "GET",
+		path:      "/boards/1234/",
+		canonical: "/boards/1234",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Serve the canonical path.
+			r, err := http.NewRequest(tt.method, tt.canonical, strings.NewReader(tt.body))
+			is.NoErr(err)
+			canonical := httptest.NewRecorder()
+			h.ServeHTTP(canonical, r)
+
+			// Serve the trailing slash path.
+			r, err = http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			is.NoErr(err)
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, r)
+
+			is.Equal(canonical.Code, http.StatusOK)             // canonical path is served.
+			is.Equal(rr.Code, canonical.Code)                   // status code is the same.
+			is.Equal(rr.Body.String(), canonical.Body.String()) // body is the same.
+		})
+	}
+}
+
+func TestStripTrailingSlashRoot(t *testing.T) {
+
+	is := is.New(t)
+
+	var path string
+	h := StripTrailingSlash(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	}))
+
+	r, err := http.NewRequest("GET", "/", nil)
+	is.NoErr(err)
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	is.Equal(path, "/") // root path is left alone.
+}
+
+func TestTimeoutCancelsSlowRequests(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// The error seen by the service.
+	var ctxErr error
+
+	// Create a mock service, with a slow GetDial implemented.
+	s := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			select {
+			case <-ctx.Done():
+				ctxErr = ctx.Err()
+				return nil, ctxErr
+			case <-time.After(5 * time.Second):
+				return &ooohh.Dial{ID: id}, nil
+			}
+		},
+	}
+
+	// Create a mock slack service.
+	ss := &mock.SlackService{}
+
+	// Create UI.
+	ui := ui.NewUI(s)
+
+	// Get an API.
+	a := NewAPI(logger, s, ss, ui)
+
+	// Create a new request, with a short timeout.
+	r, err := newRequest("GET", "/api/dials/:id", nil, httprouter.Params{{Key: "id", Value: "1234"}})
+	is.NoErr(err)
+	r.Header.Set("X-Request-Timeout", "10ms")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get dial handler, with the timeout middleware.
+	start := time.Now()
+	Timeout(10*time.Second)(a.getDial()).ServeHTTP(rr, r)
+
+	is.True(time.Since(start) < time.Second)         // request is cancelled early.
+	is.Equal(ctxErr, context.DeadlineExceeded)       // service call sees the deadline.
+	is.Equal(rr.Code, http.StatusServiceUnavailable) // request times out.
+}
+
+func TestEndpointsTimeOut(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Whether the dial export had a deadline.
+	var exportDeadline bool
+
+	// Create a mock service, that blocks until the request is done.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		ListDialsFn: func(ctx context.Context, fn func(ooohh.Dial) error) error {
+			_, exportDeadline = ctx.Deadline()
+			return nil
+		},
+	}
+
+	// Get an API, with a short request timeout.
+	a := NewAPI(logger, s, &mock.SlackService{}, ui.NewUI(s), WithRequestTimeout(10*time.Millisecond), WithAdminToken("admin"))
+
+	// Route requests as the server would.
+	router := newTestRouter(a)
+
+	// Get a board, which times out.
+	r, err := http.NewRequest("GET", "/api/boards/1234", nil)
+	is.NoErr(err)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, r)
+
+	is.Equal(rr.Code, http.StatusServiceUnavailable) // request times out.
+
+	var problem map[string]interface{}
+	err = json.Unmarshal(rr.Body.Bytes(), &problem)
+	is.NoErr(err)                                    // body is a single problem.
+	is.Equal(problem["detail"], "Request timed out") // problem says the request timed out.
+	is.Equal(problem["code"], "timeout")             // problem has the timeout code.
+
+	// Export the dials, which isn't timed out.
+	r, err = http.NewRequest("GET", "/api/admin/dials", nil)
+	is.NoErr(err)
+	r.Header.Set("Authorization", "Bearer admin")
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, r)
+
+	is.Equal(rr.Code, http.StatusOK) // dials are exported.
+	is.True(!exportDeadline)         // export has no deadline.
+}
+
+func TestTimeoutDeadlines(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		header string
+		exp    time.Duration
+	}{{
+		msg:    "no header",
+		header: "",
+		exp:    time.Minute,
+	}, {
+		msg:    "shorter timeout",
+		header: "2s",
+		exp:    2 * time.Second,
+	}, {
+		msg:    "over cap timeout is clamped",
+		header: "1h",
+		exp:    time.Minute,
+	}, {
+		msg:    "invalid timeout is ignored",
+		header: "soon",
+		exp:    time.Minute,
+	}, {
+		msg:    "negative timeout is ignored",
+		header: "-2s",
+		exp:    time.Minute,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// The deadline seen by the handler.
+			var deadline time.Time
+			var ok bool
+			h := Timeout(time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				deadline, ok = r.Context().Deadline()
+			}))
+
+			r, err := http.NewRequest("GET", "/", nil)
+			is.NoErr(err)
+			r.Header.Set("X-Request-Timeout", tt.header)
+
+			start := time.Now()
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			is.True(ok)                                               // deadline is set.
+			is.True(!deadline.Before(start.Add(tt.exp)))              // deadline is at least the expected timeout.
+			is.True(deadline.Before(start.Add(tt.exp + time.Second))) // deadline is at most the expected timeout.
+		})
+	}
+}
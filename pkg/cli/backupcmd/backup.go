@@ -0,0 +1,56 @@
+package backupcmd
+
+import (
+	"context"
+	"flag"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/service"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+// backup is the part of the service that exports and imports all of its data.
+type backup interface {
+	Export(ctx context.Context, w io.Writer) error
+	Import(ctx context.Context, r io.Reader) error
+}
+
+// Config for the export and import subcommands, including a reference to the
+// root config. They work against a local db, rather than the API, so the db
+// must not be open in a running server.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+
+	dbPath       string
+	bucketPrefix string
+}
+
+// registerFlags registers the flags shared by the export and import subcommands.
+func (c *Config) registerFlags(fs *flag.FlagSet) {
+	c.rootConfig.RegisterFlags(fs)
+	fs.StringVar(&c.dbPath, "db", "/tmp/ooohh.db", "path of the bolt db")
+	fs.StringVar(&c.bucketPrefix, "bucket-prefix", "", "prefix of the db's bucket names, if the server is configured with one")
+}
+
+// withBackup opens the db, and calls fn with a service that keeps its data in
+// it. The db is closed once fn returns.
+func (c *Config) withBackup(fn func(b backup) error) error {
+	db, err := service.OpenDB(c.dbPath, service.DBOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "opening db %s", c.dbPath)
+	}
+	defer db.Close() //nolint:errcheck
+
+	s, err := service.NewService(store.NewBolt(db), zap.NewNop().Sugar(), time.Now, service.WithBucketPrefix(c.bucketPrefix))
+	if err != nil {
+		return errors.Wrap(err, "creating service")
+	}
+
+	return fn(s)
+}
@@ -0,0 +1,91 @@
+package backupcmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/service"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+func TestExportAndImport(t *testing.T) {
+
+	is := is.New(t)
+
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	is.NoErr(err)           // temporary directory is created.
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	from := filepath.Join(dir, "from.db")
+	to := filepath.Join(dir, "to.db")
+
+	// Populate a db, as a server would.
+	var d *ooohh.Dial
+	var b *ooohh.Board
+	func() {
+		db, err := service.OpenDB(from, service.DBOptions{})
+		is.NoErr(err)    // db opens.
+		defer db.Close() //nolint:errcheck
+
+		s, err := service.NewService(store.NewBolt(db), zap.NewNop().Sugar(), time.Now)
+		is.NoErr(err) // service initializes correctly.
+
+		d, err = s.CreateDialWithValue(context.TODO(), "TEST-DIAL", "MYTOKEN", 42)
+		is.NoErr(err) // dial creates correctly.
+		b, err = s.CreateBoard(context.TODO(), "TEST-BOARD", "MYTOKEN")
+		is.NoErr(err) // board creates correctly.
+		is.NoErr(s.SetBoard(context.TODO(), b.ID, "MYTOKEN", []ooohh.DialID{d.ID}))
+	}()
+
+	// Export it.
+	var exported bytes.Buffer
+	export := NewExport(&rootcmd.Config{}, &exported)
+	is.NoErr(export.Parse([]string{"-db", from})) // export command line parses.
+	is.NoErr(export.Run(context.TODO()))          // db exports.
+
+	path := filepath.Join(dir, "export.ndjson")
+	is.NoErr(ioutil.WriteFile(path, exported.Bytes(), 0600)) // export is written.
+
+	// Import it into a new db.
+	var out bytes.Buffer
+	imp := NewImport(&rootcmd.Config{}, &out)
+	is.NoErr(imp.Parse([]string{"-db", to, path}))      // import command line parses.
+	is.NoErr(imp.Run(context.TODO()))                   // export imports.
+	is.True(strings.Contains(out.String(), "Imported")) // import is reported.
+
+	// Check the new db has the dial and board.
+	db, err := service.OpenDB(to, service.DBOptions{})
+	is.NoErr(err)    // imported db opens.
+	defer db.Close() //nolint:errcheck
+
+	s, err := service.NewService(store.NewBolt(db), zap.NewNop().Sugar(), time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	got, err := s.GetBoard(context.TODO(), b.ID)
+	is.NoErr(err)                                                // board is imported.
+	is.Equal(got.Name, "TEST-BOARD")                             // board has its name.
+	is.Equal(len(got.Dials), 1)                                  // board has its dial.
+	is.Equal(got.Dials[0].ID, d.ID)                              // dial is imported.
+	is.Equal(got.Dials[0].Value, 42.0)                           // dial has its value.
+	is.NoErr(s.VerifyDialToken(context.TODO(), d.ID, "MYTOKEN")) // dial has its token.
+}
+
+func TestImportArguments(t *testing.T) {
+
+	is := is.New(t)
+
+	imp := NewImport(&rootcmd.Config{}, &bytes.Buffer{})
+	is.NoErr(imp.Parse([]string{}))         // command line parses.
+	is.True(imp.Run(context.TODO()) != nil) // import requires a file.
+}
@@ -0,0 +1,44 @@
+package backupcmd
+
+import (
+	"context"
+	"flag"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// NewExport creates a new cli.Command for the export subcommand.
+func NewExport(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh export", flag.ContinueOnError)
+	cfg.registerFlags(fs)
+
+	return &cli.Command{
+		Name:       "export",
+		ShortUsage: "ooohh export [-db <path>] > <file>",
+		ShortHelp:  "Back up all dials and boards from a local db.",
+		LongHelp: "Writes all dials, boards and dial history in the db to stdout, as newline-delimited JSON,\n" +
+			"which can be restored with `ooohh import`. The db must not be in use by a running server.",
+		FlagSet: fs,
+		Exec:    cfg.ExecExport,
+	}
+}
+
+// ExecExport function for the export command.
+func (c *Config) ExecExport(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return errors.New("export takes no arguments")
+	}
+
+	return c.withBackup(func(b backup) error {
+		return errors.Wrap(b.Export(ctx, c.out), "exporting")
+	})
+}
@@ -0,0 +1,59 @@
+package backupcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// NewImport creates a new cli.Command for the import subcommand.
+func NewImport(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh import", flag.ContinueOnError)
+	cfg.registerFlags(fs)
+
+	return &cli.Command{
+		Name:       "import",
+		ShortUsage: "ooohh import [-db <path>] <file>",
+		ShortHelp:  "Restore a backup made with export into a local db.",
+		LongHelp: "Restores all dials, boards and dial history from a file written by `ooohh export`.\n" +
+			"The db must be empty, and not in use by a running server. Either all of the file is restored, or none of it is.",
+		FlagSet: fs,
+		Exec:    cfg.ExecImport,
+	}
+}
+
+// ExecImport function for the import command.
+func (c *Config) ExecImport(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("import requires exactly 1 argument")
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return errors.Wrap(err, "opening export")
+	}
+	defer f.Close() //nolint:errcheck
+
+	err = c.withBackup(func(b backup) error {
+		return errors.Wrap(b.Import(ctx, f), "importing")
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Imported %s into %s.\n", args[0], c.dbPath)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package boardcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+)
+
+func (c *Config) addCommand() *cli.Command {
+	fs := flag.NewFlagSet("ooohh board add", flag.ContinueOnError)
+	c.rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "add",
+		ShortUsage: "ooohh board add [<board-id>] <dial-id> <token>",
+		ShortHelp:  "Add a dial to a board, defaulting to the last board created.",
+		FlagSet:    fs,
+		Exec:       c.addExec,
+	}
+}
+
+// addExec is the Exec function of the board add subcommand.
+func (c *Config) addExec(ctx context.Context, args []string) error {
+	if len(args) != 2 && len(args) != 3 {
+		return errors.New("board add requires 2 or 3 arguments")
+	}
+
+	id, err := c.boardID(args, 3)
+	if err != nil {
+		return err
+	}
+
+	dial, token := ooohh.DialID(args[len(args)-2]), args[len(args)-1]
+
+	dials, err := c.rootConfig.Client.GetBoardDialIDs(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "retrieving board %s", id)
+	}
+
+	if err := c.rootConfig.Client.SetBoard(ctx, id, token, append(dials, dial)); err != nil {
+		return errors.Wrapf(err, "adding dial to board %s", id)
+	}
+
+	fmt.Fprintf(c.out, "Added dial %s to board %s.\n", dial, id)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package boardcmd
+
+import (
+	"context"
+	"flag"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// Config for the board subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+
+	interval time.Duration
+}
+
+// New creates a new cli.Command for the board subcommand, and its own subcommands.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh board", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "board",
+		ShortUsage: "ooohh board <subcommand> [flags] [<arg>...]",
+		ShortHelp:  "Manage boards.",
+		FlagSet:    fs,
+		Subcommands: []*cli.Command{
+			cfg.createCommand(),
+			cfg.showCommand(),
+			cfg.addCommand(),
+			cfg.tailCommand(),
+			cfg.urlCommand(),
+		},
+		Exec: cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(context.Context, []string) error {
+	// The board command has no meaning by itself, so if it gets executed,
+	// display the usage text to the user instead.
+	return flag.ErrHelp
+}
+
+// boardID returns the board ID given as an argument, or the last board created
+// if it isn't given.
+func (c *Config) boardID(args []string, n int) (ooohh.BoardID, error) {
+	if len(args) == n {
+		return ooohh.BoardID(args[0]), nil
+	}
+
+	if c.rootConfig.Cache.BoardID == "" {
+		return "", errors.New("no board given, and no board created yet")
+	}
+
+	return c.rootConfig.Cache.BoardID, nil
+}
@@ -0,0 +1,568 @@
+package boardcmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestBoardCreateArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments",
+		args: []string{"create"},
+	}, {
+		msg:  "one argument",
+		args: []string{"create", "name"},
+	}, {
+		msg:  "three arguments",
+		args: []string{"create", "name", "token", "extra"},
+	}, {
+		msg:  "empty dial in list",
+		args: []string{"create", "name", "token", "-dials", "id1,,id2"},
+	}, {
+		msg:  "trailing comma in list",
+		args: []string{"create", "name", "token", "-dials", "id1,"},
+	}, {
+		msg:  "dial containing space",
+		args: []string{"create", "name", "token", "-dials", "id 1,id2"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)            // command errors.
+			is.True(!c.CreateBoardInvoked) // board is not created.
+			is.True(!c.SetBoardInvoked)    // board is not set.
+		})
+	}
+}
+
+func TestBoardCreate(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		args     []string
+		expDials []ooohh.DialID
+	}{{
+		msg:      "without dials",
+		args:     []string{"create", "name", "token"},
+		expDials: nil,
+	}, {
+		msg:      "with dials after arguments",
+		args:     []string{"create", "name", "token", "--dials", "id1,id2,id3"},
+		expDials: []ooohh.DialID{"id1", "id2", "id3"},
+	}, {
+		msg:      "with dials before arguments",
+		args:     []string{"create", "-dials", "id1, id2", "name", "token"},
+		expDials: []ooohh.DialID{"id1", "id2"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the client.
+			var createName, createToken string
+			var setID ooohh.BoardID
+			var setToken string
+			var setDials []ooohh.DialID
+
+			c := &mock.Service{
+				CreateBoardFn: func(ctx context.Context, name, token string) (*ooohh.Board, error) {
+					createName, createToken = name, token
+					return &ooohh.Board{ID: ooohh.BoardID("board-id"), Name: name, Token: token}, nil
+				},
+				SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+					setID, setToken, setDials = id, token, dials
+					return nil
+				},
+			}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			var out bytes.Buffer
+			cmd := New(rootConfig, &out)
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.NoErr(err) // command runs.
+
+			is.Equal(createName, "name")   // board is created with name.
+			is.Equal(createToken, "token") // board is created with token.
+
+			is.Equal(c.SetBoardInvoked, tt.expDials != nil) // board is set only when dials are given.
+			if tt.expDials != nil {
+				is.Equal(setID, ooohh.BoardID("board-id")) // created board is set.
+				is.Equal(setToken, "token")                // board token is used.
+				is.Equal(setDials, tt.expDials)            // dials are set.
+			}
+
+			is.Equal(out.String(), "Created board name (board-id).\nhttp://localhost:8080/boards/board-id\n") // output is correct.
+
+			// Check the board is remembered.
+			cached := rootcmd.Config{CachePath: rootConfig.CachePath}
+			is.NoErr(cached.LoadCache())                              // cache loads.
+			is.Equal(cached.Cache.BoardID, ooohh.BoardID("board-id")) // created board is cached.
+		})
+	}
+}
+
+func TestBoardShowArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments, and no board created",
+		args: []string{"show"},
+	}, {
+		msg:  "two arguments",
+		args: []string{"show", "id", "extra"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)         // command errors.
+			is.True(!c.GetBoardInvoked) // board is not retrieved.
+		})
+	}
+}
+
+func TestBoardShow(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		args   []string
+		cached ooohh.BoardID
+		expID  ooohh.BoardID
+	}{{
+		msg:   "given board",
+		args:  []string{"show", "board-id"},
+		expID: "board-id",
+	}, {
+		msg:    "last board created",
+		args:   []string{"show"},
+		cached: "cached-id",
+		expID:  "cached-id",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the client.
+			var getID ooohh.BoardID
+
+			c := &mock.Service{
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					getID = id
+					return &ooohh.Board{
+						ID:   id,
+						Name: "Team",
+						Dials: []ooohh.Dial{
+							{ID: "dial-1", Name: "alice", Value: 12.34},
+							{ID: "dial-2", Name: "bob", Value: 100},
+						},
+					}, nil
+				},
+			}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+			rootConfig.Cache.BoardID = tt.cached
+
+			var out bytes.Buffer
+			cmd := New(rootConfig, &out)
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.NoErr(err) // command runs.
+
+			is.Equal(getID, tt.expID) // correct board is retrieved.
+			is.Equal(out.String(), "Team ("+string(tt.expID)+")\n\n"+
+				"DIAL   VALUE\n"+
+				"alice  12.3\n"+
+				"bob    100.0\n") // output is correct.
+		})
+	}
+}
+
+func TestBoardAddArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments",
+		args: []string{"add"},
+	}, {
+		msg:  "one argument",
+		args: []string{"add", "dial-id"},
+	}, {
+		msg:  "two arguments, and no board created",
+		args: []string{"add", "dial-id", "token"},
+	}, {
+		msg:  "four arguments",
+		args: []string{"add", "board-id", "dial-id", "token", "extra"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)         // command errors.
+			is.True(!c.SetBoardInvoked) // board is not set.
+		})
+	}
+}
+
+func TestBoardAdd(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		args   []string
+		cached ooohh.BoardID
+		expID  ooohh.BoardID
+	}{{
+		msg:   "given board",
+		args:  []string{"add", "board-id", "dial-3", "token"},
+		expID: "board-id",
+	}, {
+		msg:    "last board created",
+		args:   []string{"add", "dial-3", "token"},
+		cached: "cached-id",
+		expID:  "cached-id",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the client.
+			var setID ooohh.BoardID
+			var setToken string
+			var setDials []ooohh.DialID
+
+			c := &mock.Service{
+				GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+					return []ooohh.DialID{"dial-1", "dial-2"}, nil
+				},
+				SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+					setID, setToken, setDials = id, token, dials
+					return nil
+				},
+			}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+			rootConfig.Cache.BoardID = tt.cached
+
+			var out bytes.Buffer
+			cmd := New(rootConfig, &out)
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.NoErr(err) // command runs.
+
+			is.Equal(setID, tt.expID)                                        // correct board is set.
+			is.Equal(setToken, "token")                                      // board token is used.
+			is.Equal(setDials, []ooohh.DialID{"dial-1", "dial-2", "dial-3"}) // dial is added to the existing dials.
+
+			is.Equal(out.String(), "Added dial dial-3 to board "+string(tt.expID)+".\n") // output is correct.
+		})
+	}
+}
+
+func TestBoardTailArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments, and no board created",
+		args: []string{"tail"},
+	}, {
+		msg:  "two arguments",
+		args: []string{"tail", "id", "extra"},
+	}, {
+		msg:  "zero interval",
+		args: []string{"tail", "-interval", "0s", "id"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)         // command errors.
+			is.True(!c.GetBoardInvoked) // board is not retrieved.
+		})
+	}
+}
+
+func TestBoardTail(t *testing.T) {
+
+	is := is.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The board as it is on each poll, the first being before tailing starts.
+	polls := []ooohh.Board{{
+		ID:   "board-id",
+		Name: "Team",
+		Dials: []ooohh.Dial{
+			{ID: "dial-1", Name: "alice", Value: 10},
+			{ID: "dial-2", Name: "bob", Value: 20},
+		},
+	}, {
+		// Unchanged.
+		ID:   "board-id",
+		Name: "Team",
+		Dials: []ooohh.Dial{
+			{ID: "dial-1", Name: "alice", Value: 10},
+			{ID: "dial-2", Name: "bob", Value: 20},
+		},
+	}, {
+		// Both dials changed.
+		ID:   "board-id",
+		Name: "Team",
+		Dials: []ooohh.Dial{
+			{ID: "dial-1", Name: "alice", Value: 55.5},
+			{ID: "dial-2", Name: "bob", Value: 0},
+		},
+	}, {
+		// A dial added, which isn't a change, and one changed.
+		ID:   "board-id",
+		Name: "Team",
+		Dials: []ooohh.Dial{
+			{ID: "dial-1", Name: "alice", Value: 55.5},
+			{ID: "dial-2", Name: "bob", Value: 100},
+			{ID: "dial-3", Name: "carol", Value: 30},
+		},
+	}}
+
+	// Variables that will be set by the client.
+	var getIDs []ooohh.BoardID
+
+	c := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			getIDs = append(getIDs, id)
+
+			// Stop tailing once every poll has been seen.
+			i := len(getIDs) - 1
+			if i >= len(polls)-1 {
+				cancel()
+				i = len(polls) - 1
+			}
+			b := polls[i]
+			return &b, nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(ctx, []string{"tail", "-interval", "1ms", "board-id"})
+	is.NoErr(err) // command runs until cancelled.
+
+	is.Equal(getIDs[0], ooohh.BoardID("board-id")) // correct board is retrieved.
+	is.Equal(out.String(), "Tailing Team (board-id), press Ctrl-C to stop.\n"+
+		"alice: 10.0 -> 55.5\n"+
+		"bob: 20.0 -> 0.0\n"+
+		"bob: 0.0 -> 100.0\n") // changes are printed as they happen.
+}
+
+func TestBoardURLArguments(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"url", "board-id", "extra"})
+	is.True(err != nil)        // command errors.
+	is.Equal(out.String(), "") // nothing is printed.
+
+	err = New(rootConfig, &out).ParseAndRun(context.TODO(), []string{"url"})
+	is.True(err != nil)        // command errors without a board.
+	is.Equal(out.String(), "") // nothing is printed.
+}
+
+func TestBoardURL(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		base   string
+		cached ooohh.BoardID
+		args   []string
+		expOut string
+		expErr bool
+	}{{
+		msg:    "base without trailing slash",
+		base:   "http://example.com",
+		args:   []string{"url", "board-id"},
+		expOut: "http://example.com/boards/board-id\n",
+	}, {
+		msg:    "base with trailing slash",
+		base:   "http://example.com/",
+		args:   []string{"url", "board-id"},
+		expOut: "http://example.com/boards/board-id\n",
+	}, {
+		msg:    "base path without trailing slash",
+		base:   "http://example.com/ooohh",
+		args:   []string{"url", "board-id"},
+		expOut: "http://example.com/ooohh/boards/board-id\n",
+	}, {
+		msg:    "base path with trailing slash",
+		base:   "http://example.com/ooohh/",
+		args:   []string{"url", "board-id"},
+		expOut: "http://example.com/ooohh/boards/board-id\n",
+	}, {
+		msg:    "cached board",
+		base:   "http://example.com",
+		cached: "cached-id",
+		args:   []string{"url"},
+		expOut: "http://example.com/boards/cached-id\n",
+	}, {
+		msg:    "invalid base",
+		base:   "http://example.com/%zz",
+		args:   []string{"url", "board-id"},
+		expErr: true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			rootConfig, cleanup := newRootConfig(t, &mock.Service{})
+			defer cleanup()
+
+			rootConfig.URL = tt.base
+			rootConfig.Cache.BoardID = tt.cached
+
+			var out bytes.Buffer
+			cmd := New(rootConfig, &out)
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.Equal(err != nil, tt.expErr)   // command errors as expected.
+			is.Equal(out.String(), tt.expOut) // url is printed.
+		})
+	}
+}
+
+func TestBoardURLOpen(t *testing.T) {
+
+	is := is.New(t)
+
+	// Replace the browser opener.
+	var opened []string
+	defer func(f func(string) error) { openURL = f }(openURL)
+	openURL = func(u string) error {
+		opened = append(opened, u)
+		return nil
+	}
+
+	c := &mock.Service{
+		CreateBoardFn: func(ctx context.Context, name, token string) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: ooohh.BoardID("board-id"), Name: name, Token: token}, nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	rootConfig.URL = "http://example.com"
+
+	// Without the flag, the browser isn't opened.
+	err := New(rootConfig, &bytes.Buffer{}).ParseAndRun(context.TODO(), []string{"url", "board-id"})
+	is.NoErr(err)            // command runs.
+	is.Equal(len(opened), 0) // browser isn't opened.
+
+	err = New(rootConfig, &bytes.Buffer{}).ParseAndRun(context.TODO(), []string{"url", "-open", "board-id"})
+	is.NoErr(err)                                                    // command runs.
+	is.Equal(opened, []string{"http://example.com/boards/board-id"}) // board is opened.
+
+	err = New(rootConfig, &bytes.Buffer{}).ParseAndRun(context.TODO(), []string{"create", "name", "token", "-open"})
+	is.NoErr(err)                                             // command runs.
+	is.Equal(len(opened), 2)                                  // created board is opened.
+	is.Equal(opened[1], "http://example.com/boards/board-id") // created board's url is opened.
+
+	// Failing to open the browser is an error.
+	openURL = func(string) error { return errors.New("no browser") }
+	err = New(rootConfig, &bytes.Buffer{}).ParseAndRun(context.TODO(), []string{"url", "-open", "board-id"})
+	is.True(err != nil) // command errors.
+}
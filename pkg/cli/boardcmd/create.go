@@ -0,0 +1,91 @@
+package boardcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+)
+
+// createConfig for the board create subcommand.
+type createConfig struct {
+	*Config
+	dials string
+	open  bool
+}
+
+func (c *Config) createCommand() *cli.Command {
+	cfg := createConfig{Config: c}
+
+	fs := flag.NewFlagSet("ooohh board create", flag.ContinueOnError)
+	c.rootConfig.RegisterFlags(fs)
+	fs.StringVar(&cfg.dials, "dials", "", "comma separated list of dial IDs to add to the board")
+	fs.BoolVar(&cfg.open, "open", false, "open the created board in the default browser")
+
+	return &cli.Command{
+		Name:       "create",
+		ShortUsage: "ooohh board create [-dials <id>,<id>...] [-open] <name> <token>",
+		ShortHelp:  "Create a new board, optionally adding existing dials to it.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *createConfig) Exec(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("board create requires exactly 2 arguments")
+	}
+
+	dials, err := parseDials(c.dials)
+	if err != nil {
+		return err
+	}
+
+	b, err := c.rootConfig.Client.CreateBoard(ctx, args[0], args[1])
+	if err != nil {
+		return errors.Wrap(err, "creating board")
+	}
+
+	if len(dials) > 0 {
+		if err := c.rootConfig.Client.SetBoard(ctx, b.ID, args[1], dials); err != nil {
+			return errors.Wrapf(err, "adding dials to board %s", b.ID)
+		}
+	}
+
+	// Remember the board, so other board commands can default to it.
+	c.rootConfig.Cache.BoardID = b.ID
+	if err := c.rootConfig.SaveCache(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Created board %s (%s).\n", b.Name, b.ID)
+
+	return c.printURL(b.ID, c.open)
+}
+
+// parseDials parses a comma separated list of dial IDs.
+func parseDials(s string) ([]ooohh.DialID, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(s, ",")
+
+	dials := make([]ooohh.DialID, len(parts))
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" || strings.IndexFunc(p, unicode.IsSpace) >= 0 {
+			return nil, errors.Errorf("invalid dials list %q, expected a comma separated list of dial IDs", s)
+		}
+		dials[i] = ooohh.DialID(p)
+	}
+
+	return dials, nil
+}
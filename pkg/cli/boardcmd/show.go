@@ -0,0 +1,53 @@
+package boardcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+)
+
+func (c *Config) showCommand() *cli.Command {
+	fs := flag.NewFlagSet("ooohh board show", flag.ContinueOnError)
+	c.rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "show",
+		ShortUsage: "ooohh board show [<id>]",
+		ShortHelp:  "Show a board's dials, defaulting to the last board created.",
+		FlagSet:    fs,
+		Exec:       c.showExec,
+	}
+}
+
+// showExec is the Exec function of the board show subcommand.
+func (c *Config) showExec(ctx context.Context, args []string) error {
+	if len(args) > 1 {
+		return errors.New("board show takes at most 1 argument")
+	}
+
+	id, err := c.boardID(args, 1)
+	if err != nil {
+		return err
+	}
+
+	b, err := c.rootConfig.Client.GetBoard(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "retrieving board %s", id)
+	}
+
+	fmt.Fprintf(c.out, "%s (%s)\n\n", b.Name, b.ID)
+
+	tw := tabwriter.NewWriter(c.out, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "DIAL\tVALUE")
+	for _, d := range b.Dials {
+		fmt.Fprintf(tw, "%s\t%s\n", d.Name, ooohh.FormatValue(d.Value, ooohh.DefaultPrecision))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,105 @@
+package boardcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+)
+
+// defaultTailInterval is how often a tailed board is checked for changes.
+const defaultTailInterval = 5 * time.Second
+
+func (c *Config) tailCommand() *cli.Command {
+	fs := flag.NewFlagSet("ooohh board tail", flag.ContinueOnError)
+	c.rootConfig.RegisterFlags(fs)
+	fs.DurationVar(&c.interval, "interval", defaultTailInterval, "how often the board is checked for changes")
+
+	return &cli.Command{
+		Name:       "tail",
+		ShortUsage: "ooohh board tail [-interval <duration>] [<id>]",
+		ShortHelp:  "Print changes to a board's dials as they happen, until interrupted.",
+		FlagSet:    fs,
+		Exec:       c.tailExec,
+	}
+}
+
+// tailExec is the Exec function of the board tail subcommand.
+func (c *Config) tailExec(ctx context.Context, args []string) error {
+	if len(args) > 1 {
+		return errors.New("board tail takes at most 1 argument")
+	}
+
+	if c.interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	id, err := c.boardID(args, 1)
+	if err != nil {
+		return err
+	}
+
+	b, err := c.rootConfig.Client.GetBoard(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "retrieving board %s", id)
+	}
+
+	fmt.Fprintf(c.out, "Tailing %s (%s), press Ctrl-C to stop.\n", b.Name, b.ID)
+
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		next, err := c.rootConfig.Client.GetBoard(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrapf(err, "retrieving board %s", id)
+		}
+
+		for _, ch := range diffDials(b.Dials, next.Dials) {
+			fmt.Fprintf(c.out, "%s: %s -> %s\n", ch.dial.Name,
+				ooohh.FormatValue(ch.old, ooohh.DefaultPrecision),
+				ooohh.FormatValue(ch.dial.Value, ooohh.DefaultPrecision),
+			)
+		}
+
+		b = next
+	}
+}
+
+// dialChange is a dial whose value has changed from old.
+type dialChange struct {
+	dial ooohh.Dial
+	old  float64
+}
+
+// diffDials returns the dials in next whose values have changed since prev,
+// in the order of next. Dials that have been added or removed aren't changes.
+func diffDials(prev, next []ooohh.Dial) []dialChange {
+	values := make(map[ooohh.DialID]float64, len(prev))
+	for _, d := range prev {
+		values[d.ID] = d.Value
+	}
+
+	var changes []dialChange
+	for _, d := range next {
+		if old, ok := values[d.ID]; ok && old != d.Value {
+			changes = append(changes, dialChange{d, old})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,107 @@
+package boardcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+)
+
+// urlConfig for the board url subcommand.
+type urlConfig struct {
+	*Config
+	open bool
+}
+
+func (c *Config) urlCommand() *cli.Command {
+	cfg := urlConfig{Config: c}
+
+	fs := flag.NewFlagSet("ooohh board url", flag.ContinueOnError)
+	c.rootConfig.RegisterFlags(fs)
+	fs.BoolVar(&cfg.open, "open", false, "open the board in the default browser")
+
+	return &cli.Command{
+		Name:       "url",
+		ShortUsage: "ooohh board url [-open] [<board-id>]",
+		ShortHelp:  "Print a board's web URL, defaulting to the last board created.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *urlConfig) Exec(ctx context.Context, args []string) error {
+	if len(args) > 1 {
+		return errors.New("board url takes at most 1 argument")
+	}
+
+	id, err := c.boardID(args, 1)
+	if err != nil {
+		return err
+	}
+
+	return c.printURL(id, c.open)
+}
+
+// printURL prints the web URL of the board, opening it in the default browser
+// if asked to.
+func (c *Config) printURL(id ooohh.BoardID, open bool) error {
+	u, err := boardURL(c.rootConfig.URL, id)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, u)
+
+	if open {
+		if err := openURL(u); err != nil {
+			return errors.Wrap(err, "opening browser")
+		}
+	}
+
+	return nil
+}
+
+// boardURL returns the web URL of the board, relative to the base URL. Any path
+// of the base URL is kept, whether or not it ends with a slash.
+func boardURL(base string, id ooohh.BoardID) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing url %q", base)
+	}
+
+	// Relative references replace the last segment of the base path, unless it
+	// ends with a slash.
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+		u.RawPath = ""
+	}
+
+	ref := &url.URL{Path: "boards/" + string(id)}
+
+	return u.ResolveReference(ref).String(), nil
+}
+
+// openURL opens the URL in the default browser. It is a variable so tests can
+// replace it.
+var openURL = func(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+
+	return cmd.Start()
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// Command is a single command of a command line application. Commands can have
+// subcommands, which are selected by name from the first positional argument.
+type Command struct {
+	// Name of the command, used to select it as a subcommand.
+	Name string
+	// ShortUsage is a single line describing how to invoke the command.
+	ShortUsage string
+	// ShortHelp is a single line description of the command, shown in the
+	// parent command's help.
+	ShortHelp string
+	// LongHelp is a longer description of the command, shown in the command's help.
+	LongHelp string
+	// FlagSet holds the flags of the command. If nil, an empty FlagSet is used.
+	FlagSet *flag.FlagSet
+	// Subcommands of this command.
+	Subcommands []*Command
+	// Exec is invoked with the remaining positional arguments when this command
+	// is selected. If nil, the command's help is shown.
+	Exec func(ctx context.Context, args []string) error
+
+	selected *Command
+	args     []string
+}
+
+// Parse parses the given arguments, selecting the command to run. Commands
+// without subcommands accept flags interspersed with their positional arguments.
+func (c *Command) Parse(args []string) error {
+	if c.FlagSet == nil {
+		c.FlagSet = flag.NewFlagSet(c.Name, flag.ContinueOnError)
+	}
+	c.FlagSet.Usage = func() {
+		fmt.Fprintln(c.FlagSet.Output(), c.usage())
+	}
+
+	if err := c.FlagSet.Parse(args); err != nil {
+		return err
+	}
+	args = c.FlagSet.Args()
+
+	if len(args) > 0 {
+		for _, sub := range c.Subcommands {
+			if sub.Name == args[0] {
+				c.selected = sub
+				return sub.Parse(args[1:])
+			}
+		}
+	}
+
+	if len(c.Subcommands) == 0 {
+		// Gather positional arguments, parsing any flags found after them.
+		var positional []string
+		for len(args) > 0 {
+			positional = append(positional, args[0])
+			if err := c.FlagSet.Parse(args[1:]); err != nil {
+				return err
+			}
+			args = c.FlagSet.Args()
+		}
+		args = positional
+	}
+
+	c.selected = c
+	c.args = args
+
+	return nil
+}
+
+// Run executes the command selected by Parse.
+func (c *Command) Run(ctx context.Context) error {
+	if c.selected == nil {
+		return fmt.Errorf("command %q not parsed", c.Name)
+	}
+
+	if c.selected != c {
+		return c.selected.Run(ctx)
+	}
+
+	if c.Exec == nil {
+		c.FlagSet.Usage()
+		return flag.ErrHelp
+	}
+
+	err := c.Exec(ctx, c.args)
+	if err == flag.ErrHelp {
+		c.FlagSet.Usage()
+	}
+
+	return err
+}
+
+// ParseAndRun parses the given arguments, then runs the selected command.
+func (c *Command) ParseAndRun(ctx context.Context, args []string) error {
+	if err := c.Parse(args); err != nil {
+		return err
+	}
+
+	return c.Run(ctx)
+}
+
+// usage returns the help text of the command.
+func (c *Command) usage() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "USAGE\n  %s\n", c.ShortUsage)
+
+	if c.LongHelp != "" {
+		fmt.Fprintf(&b, "\n%s\n", c.LongHelp)
+	}
+
+	if len(c.Subcommands) > 0 {
+		fmt.Fprintf(&b, "\nSUBCOMMANDS\n")
+		tw := tabwriter.NewWriter(&b, 0, 2, 2, ' ', 0)
+		for _, sub := range c.Subcommands {
+			fmt.Fprintf(tw, "  %s\t%s\n", sub.Name, sub.ShortHelp)
+		}
+		tw.Flush() //nolint:errcheck
+	}
+
+	hasFlags := false
+	c.FlagSet.VisitAll(func(*flag.Flag) { hasFlags = true })
+	if hasFlags {
+		fmt.Fprintf(&b, "\nFLAGS\n")
+		tw := tabwriter.NewWriter(&b, 0, 2, 2, ' ', 0)
+		c.FlagSet.VisitAll(func(f *flag.Flag) {
+			def := f.DefValue
+			if def == "" {
+				def = "..."
+			}
+			fmt.Fprintf(tw, "  -%s %s\t%s\n", f.Name, def, f.Usage)
+		})
+		tw.Flush() //nolint:errcheck
+	}
+
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestParseSelectsSubcommand(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set when the subcommand is run.
+	var ran string
+	var ranArgs []string
+
+	exec := func(name string) func(context.Context, []string) error {
+		return func(ctx context.Context, args []string) error {
+			ran = name
+			ranArgs = args
+			return nil
+		}
+	}
+
+	sub := &Command{Name: "sub", Exec: exec("sub")}
+	other := &Command{Name: "other", Exec: exec("other")}
+	root := &Command{Name: "root", Subcommands: []*Command{sub, other}, Exec: exec("root")}
+
+	err := root.ParseAndRun(context.TODO(), []string{"sub", "a", "b"})
+	is.NoErr(err) // command runs.
+
+	is.Equal(ran, "sub")                  // subcommand was run.
+	is.Equal(ranArgs, []string{"a", "b"}) // subcommand was given the remaining args.
+}
+
+func TestParseAllowsInterspersedFlags(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set when the command is run.
+	var ranArgs []string
+
+	fs := flag.NewFlagSet("cmd", flag.ContinueOnError)
+	f := fs.String("flag", "", "a flag")
+
+	cmd := &Command{
+		Name:    "cmd",
+		FlagSet: fs,
+		Exec: func(ctx context.Context, args []string) error {
+			ranArgs = args
+			return nil
+		},
+	}
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"a", "-flag", "value", "b"})
+	is.NoErr(err) // command runs.
+
+	is.Equal(*f, "value")                 // flag after positional argument is parsed.
+	is.Equal(ranArgs, []string{"a", "b"}) // positional arguments are passed through.
+}
+
+func TestRunWithoutExecShowsHelp(t *testing.T) {
+
+	is := is.New(t)
+
+	fs := flag.NewFlagSet("cmd", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+
+	cmd := &Command{Name: "cmd", FlagSet: fs}
+
+	err := cmd.ParseAndRun(context.TODO(), []string{})
+	is.Equal(err, flag.ErrHelp) // help is shown.
+}
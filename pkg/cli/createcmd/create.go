@@ -0,0 +1,61 @@
+package createcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// Config for the create subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+}
+
+// New creates a new cli.Command for the create subcommand.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh create", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "create",
+		ShortUsage: "ooohh create <name> <token>",
+		ShortHelp:  "Create a new dial, and use it from now on.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("create requires exactly 2 arguments")
+	}
+
+	d, err := c.rootConfig.Client.CreateDial(ctx, args[0], args[1])
+	if err != nil {
+		return errors.Wrap(err, "creating dial")
+	}
+
+	// Remember the dial, so it can be used by other commands.
+	c.rootConfig.Cache.DialID = d.ID
+	c.rootConfig.Cache.Token = args[1]
+	if err := c.rootConfig.SaveCache(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Created dial %s (%s).\n", d.Name, d.ID)
+
+	return nil
+}
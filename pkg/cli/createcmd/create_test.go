@@ -0,0 +1,103 @@
+package createcmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestCreateArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments",
+		args: []string{},
+	}, {
+		msg:  "one argument",
+		args: []string{"name"},
+	}, {
+		msg:  "three arguments",
+		args: []string{"name", "token", "extra"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)           // command errors.
+			is.True(!c.CreateDialInvoked) // dial is not created.
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the client.
+	var setName, setToken string
+
+	c := &mock.Service{
+		CreateDialFn: func(ctx context.Context, name, token string) (*ooohh.Dial, error) {
+			setName, setToken = name, token
+			return &ooohh.Dial{ID: ooohh.DialID("dial-id"), Name: name, Token: token}, nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"name", "token"})
+	is.NoErr(err) // command runs.
+
+	is.Equal(setName, "name")   // dial is created with name.
+	is.Equal(setToken, "token") // dial is created with token.
+
+	is.Equal(out.String(), "Created dial name (dial-id).\n") // output is correct.
+
+	// Check the dial is cached.
+	cfg := rootcmd.Config{CachePath: rootConfig.CachePath}
+	is.NoErr(cfg.LoadCache())
+	is.Equal(cfg.Cache, rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}) // dial is cached.
+}
@@ -0,0 +1,99 @@
+package democmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// demoDials are the dials created on the demo board, with a spread of values
+// so that each level of the UI is shown.
+var demoDials = []struct {
+	name  string
+	value float64
+}{
+	{"Ada", 5},
+	{"Grace", 27.5},
+	{"Alan", 48},
+	{"Margaret", 63.2},
+	{"Linus", 81},
+	{"Ken", 97.5},
+}
+
+// Config for the demo subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+
+	confirm bool
+}
+
+// New creates a new cli.Command for the demo subcommand.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh demo", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+	fs.BoolVar(&cfg.confirm, "confirm", false, "confirm that sample data should be created against the API")
+
+	return &cli.Command{
+		Name:       "demo",
+		ShortUsage: "ooohh demo -confirm <token>",
+		ShortHelp:  "Create a board of sample dials.",
+		LongHelp: "Creates a board populated with sample dials, useful for demos and screenshots.\n" +
+			"As this writes to the API, -confirm must be given.",
+		FlagSet: fs,
+		Exec:    cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("demo requires exactly 1 argument")
+	}
+
+	if !c.confirm {
+		return errors.Errorf("demo creates sample data at %s, pass -confirm to continue", c.rootConfig.URL)
+	}
+
+	token := args[0]
+
+	dials := make([]ooohh.DialID, len(demoDials))
+	for i, dd := range demoDials {
+		d, err := c.rootConfig.Client.CreateDial(ctx, dd.name, token)
+		if err != nil {
+			return errors.Wrapf(err, "creating dial %s", dd.name)
+		}
+
+		if err := c.rootConfig.Client.SetDial(ctx, d.ID, token, dd.value); err != nil {
+			return errors.Wrapf(err, "setting dial %s", d.ID)
+		}
+
+		dials[i] = d.ID
+	}
+
+	b, err := c.rootConfig.Client.CreateBoard(ctx, "Demo", token)
+	if err != nil {
+		return errors.Wrap(err, "creating board")
+	}
+
+	if err := c.rootConfig.Client.SetBoard(ctx, b.ID, token, dials); err != nil {
+		return errors.Wrapf(err, "adding dials to board %s", b.ID)
+	}
+
+	fmt.Fprintf(c.out, "Created demo board with %d dials: %s/boards/%s\n", len(dials), c.rootConfig.URL, url.PathEscape(string(b.ID)))
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package democmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		URL:       "http://ooohh.test",
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestDemoArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments",
+		args: []string{"-confirm"},
+	}, {
+		msg:  "two arguments",
+		args: []string{"-confirm", "token", "extra"},
+	}, {
+		msg:  "not confirmed",
+		args: []string{"token"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)            // command errors.
+			is.True(!c.CreateDialInvoked)  // no dials are created.
+			is.True(!c.CreateBoardInvoked) // board is not created.
+		})
+	}
+}
+
+func TestDemo(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the client.
+	values := make(map[ooohh.DialID]float64)
+	var created, boardDials []ooohh.DialID
+
+	c := &mock.Service{
+		CreateDialFn: func(ctx context.Context, name, token string) (*ooohh.Dial, error) {
+			id := ooohh.DialID(name)
+			created = append(created, id)
+			return &ooohh.Dial{ID: id, Name: name}, nil
+		},
+		SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+			values[id] = value
+			return nil
+		},
+		CreateBoardFn: func(ctx context.Context, name, token string) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: "board-id", Name: name}, nil
+		},
+		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+			boardDials = dials
+			return nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"-confirm", "token"})
+	is.NoErr(err) // command runs.
+
+	is.Equal(len(created), len(demoDials)) // expected number of dials are created.
+	is.Equal(boardDials, created)          // all dials are added to the board.
+
+	for _, id := range created {
+		v, ok := values[id]
+		is.True(ok)                 // dial value is set.
+		is.True(v >= 0 && v <= 100) // dial value is in range.
+	}
+
+	is.Equal(out.String(), "Created demo board with 6 dials: http://ooohh.test/boards/board-id\n") // output is correct.
+}
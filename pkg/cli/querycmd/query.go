@@ -0,0 +1,73 @@
+package querycmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// Config for the query subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+
+	// output is the format the dial is shown in, either text or json.
+	output string
+}
+
+// New creates a new cli.Command for the query subcommand.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh ?", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+	fs.StringVar(&cfg.output, "output", "text", "format the dial is shown in, text or json")
+
+	return &cli.Command{
+		Name:       "?",
+		ShortUsage: "ooohh ?",
+		ShortHelp:  "Show the value of the dial being used.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 0 {
+		return errors.New("? takes no arguments")
+	}
+
+	if c.output != "text" && c.output != "json" {
+		return errors.Errorf("unknown output format %q, use text or json", c.output)
+	}
+
+	if c.rootConfig.Cache.DialID == "" {
+		return errors.New("no dial in use, run `ooohh create` or `ooohh set` first")
+	}
+
+	d, err := c.rootConfig.Client.GetDial(ctx, c.rootConfig.Cache.DialID)
+	if err != nil {
+		return errors.Wrap(err, "retrieving dial")
+	}
+
+	// The dial's token isn't marshalled, so is never shown.
+	if c.output == "json" {
+		return errors.Wrap(json.NewEncoder(c.out).Encode(d), "writing dial")
+	}
+
+	fmt.Fprintf(c.out, "Your dial (%s) is set to %s.\n", d.ID, ooohh.FormatValue(d.Value, ooohh.DefaultPrecision))
+
+	return nil
+}
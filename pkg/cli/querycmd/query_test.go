@@ -0,0 +1,158 @@
+package querycmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestQueryArguments(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+	rootConfig.Cache = rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}
+
+	cmd := New(rootConfig, &bytes.Buffer{})
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"extra"})
+	is.True(err != nil)        // command errors.
+	is.True(!c.GetDialInvoked) // dial is not retrieved.
+}
+
+func TestQueryWithoutDial(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	cmd := New(rootConfig, &bytes.Buffer{})
+
+	err := cmd.ParseAndRun(context.TODO(), []string{})
+	is.True(err != nil)        // command errors.
+	is.True(!c.GetDialInvoked) // dial is not retrieved.
+}
+
+func TestQuery(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return &ooohh.Dial{ID: id, Name: "name", Value: 66.6}, nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+	rootConfig.Cache = rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{})
+	is.NoErr(err) // command runs.
+
+	is.Equal(out.String(), "Your dial (dial-id) is set to 66.6.\n") // output is correct.
+}
+
+func TestQueryOutput(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "text",
+		args: []string{"-output", "text"},
+	}, {
+		msg:  "json",
+		args: []string{"-output", "json"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{
+				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+					return &ooohh.Dial{ID: id, Name: "name", Token: "secret", Value: 66.6}, nil
+				},
+			}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+			rootConfig.Cache = rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}
+
+			var out bytes.Buffer
+			cmd := New(rootConfig, &out)
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.NoErr(err) // command runs.
+
+			if tt.msg == "text" {
+				is.Equal(out.String(), "Your dial (dial-id) is set to 66.6.\n") // output is unchanged.
+				return
+			}
+
+			var d map[string]interface{}
+			err = json.Unmarshal(out.Bytes(), &d)
+			is.NoErr(err)                                      // output is json.
+			is.Equal(d["id"], "dial-id")                       // output contains the dial id.
+			is.Equal(d["value"], 66.6)                         // output contains the value.
+			is.True(!strings.Contains(out.String(), "secret")) // output doesn't contain the token.
+		})
+	}
+}
+
+func TestQueryUnknownOutput(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+	rootConfig.Cache = rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}
+
+	cmd := New(rootConfig, &bytes.Buffer{})
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"-output", "yaml"})
+	is.True(err != nil)        // command errors.
+	is.True(!c.GetDialInvoked) // dial is not retrieved.
+}
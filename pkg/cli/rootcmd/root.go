@@ -0,0 +1,111 @@
+package rootcmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+)
+
+// Config for the root command, including flags and types that should be
+// available to each subcommand.
+type Config struct {
+	URL       string
+	CachePath string
+
+	Client ooohh.Service
+	Cache  Cache
+}
+
+// Cache holds the details of the dial being used, and the last board created,
+// persisted between invocations.
+type Cache struct {
+	DialID  ooohh.DialID  `json:"dial_id"`
+	Token   string        `json:"token"`
+	BoardID ooohh.BoardID `json:"board_id,omitempty"`
+}
+
+// New constructs a usable cli.Command and Config for the root command.
+func New() (*cli.Command, *Config) {
+	var cfg Config
+
+	fs := flag.NewFlagSet("ooohh", flag.ContinueOnError)
+	cfg.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "ooohh",
+		ShortUsage: "ooohh [flags] <subcommand> [flags] [<arg>...]",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}, &cfg
+}
+
+// RegisterFlags registers the flag fields into the provided flag.FlagSet. This
+// helper function allows subcommands to register the root flags into their
+// flagsets, creating "global" flags that can be passed after any subcommand at
+// the commandline.
+// Values already set on the config are kept as the flag defaults.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	if c.URL == "" {
+		c.URL = "http://localhost:8080"
+	}
+	if c.CachePath == "" {
+		c.CachePath = defaultCachePath()
+	}
+
+	fs.StringVar(&c.URL, "url", c.URL, "base URL of the ooohh API")
+	fs.StringVar(&c.CachePath, "cache", c.CachePath, "file used to remember the dial being used")
+}
+
+// Exec function for this command.
+func (c *Config) Exec(context.Context, []string) error {
+	// The root command has no meaning, so if it gets executed,
+	// display the usage text to the user instead.
+	return flag.ErrHelp
+}
+
+// LoadCache reads the cache file, if it exists.
+func (c *Config) LoadCache() error {
+	b, err := ioutil.ReadFile(c.CachePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "reading cache file")
+	}
+
+	if err := json.Unmarshal(b, &c.Cache); err != nil {
+		return errors.Wrap(err, "parsing cache file")
+	}
+
+	return nil
+}
+
+// SaveCache writes the cache file.
+func (c *Config) SaveCache() error {
+	b, err := json.Marshal(c.Cache)
+	if err != nil {
+		return errors.Wrap(err, "marshalling cache")
+	}
+
+	if err := ioutil.WriteFile(c.CachePath, b, 0600); err != nil {
+		return errors.Wrap(err, "writing cache file")
+	}
+
+	return nil
+}
+
+func defaultCachePath() string {
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+
+	return filepath.Join(dir, ".ooohh.json")
+}
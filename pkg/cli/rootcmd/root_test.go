@@ -0,0 +1,37 @@
+package rootcmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+func TestCacheRoundTrip(t *testing.T) {
+
+	is := is.New(t)
+
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	is.NoErr(err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	path := filepath.Join(dir, "cache.json")
+
+	// Loading a missing cache file is ok.
+	cfg := Config{CachePath: path}
+	is.NoErr(cfg.LoadCache())    // missing cache loads.
+	is.Equal(cfg.Cache, Cache{}) // cache is empty.
+
+	// Save the cache.
+	cfg.Cache = Cache{DialID: ooohh.DialID("dial-id"), Token: "token", BoardID: ooohh.BoardID("board-id")}
+	is.NoErr(cfg.SaveCache()) // cache is saved.
+
+	// Load it again.
+	cfg2 := Config{CachePath: path}
+	is.NoErr(cfg2.LoadCache())      // cache loads.
+	is.Equal(cfg2.Cache, cfg.Cache) // cache is the same.
+}
@@ -0,0 +1,63 @@
+package setcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// Config for the set subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+}
+
+// New creates a new cli.Command for the set subcommand.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh set", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "set",
+		ShortUsage: "ooohh set <dial-id> <token>",
+		ShortHelp:  "Use an existing dial from now on.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errors.New("set requires exactly 2 arguments")
+	}
+
+	// Check the dial exists before using it.
+	d, err := c.rootConfig.Client.GetDial(ctx, ooohh.DialID(args[0]))
+	if err != nil {
+		return errors.Wrap(err, "retrieving dial")
+	}
+
+	// Remember the dial, so it can be used by other commands.
+	c.rootConfig.Cache.DialID = d.ID
+	c.rootConfig.Cache.Token = args[1]
+	if err := c.rootConfig.SaveCache(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Using dial %s (%s).\n", d.Name, d.ID)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package setcmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestSetArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments",
+		args: []string{},
+	}, {
+		msg:  "one argument",
+		args: []string{"dial-id"},
+	}, {
+		msg:  "three arguments",
+		args: []string{"dial-id", "token", "extra"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)        // command errors.
+			is.True(!c.GetDialInvoked) // dial is not retrieved.
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return &ooohh.Dial{ID: id, Name: "name"}, nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"dial-id", "token"})
+	is.NoErr(err) // command runs.
+
+	is.Equal(out.String(), "Using dial name (dial-id).\n") // output is correct.
+
+	// Check the dial is cached.
+	cfg := rootcmd.Config{CachePath: rootConfig.CachePath}
+	is.NoErr(cfg.LoadCache())
+	is.Equal(cfg.Cache, rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}) // dial is cached.
+}
+
+func TestSetUnknownDial(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return nil, errors.New("Not Found")
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	cmd := New(rootConfig, &bytes.Buffer{})
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"dial-id", "token"})
+	is.True(err != nil) // command errors.
+
+	// Check the dial isn't cached.
+	_, err = os.Stat(rootConfig.CachePath)
+	is.True(os.IsNotExist(err)) // cache is not written.
+}
@@ -0,0 +1,65 @@
+package verifycmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// Config for the verify subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+}
+
+// New creates a new cli.Command for the verify subcommand.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh verify", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "verify",
+		ShortUsage: "ooohh verify [<dial-id> <token>]",
+		ShortHelp:  "Check a token is correct for a dial, the one being used by default.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	var id ooohh.DialID
+	var token string
+
+	switch len(args) {
+	case 0:
+		if c.rootConfig.Cache.DialID == "" {
+			return errors.New("no dial in use, run `ooohh create` or `ooohh set` first")
+		}
+		id, token = c.rootConfig.Cache.DialID, c.rootConfig.Cache.Token
+	case 2:
+		id, token = ooohh.DialID(args[0]), args[1]
+	default:
+		return errors.New("verify requires either 0 or 2 arguments")
+	}
+
+	if err := c.rootConfig.Client.VerifyDialToken(ctx, id, token); err != nil {
+		return errors.Wrap(err, "verifying token")
+	}
+
+	fmt.Fprintf(c.out, "Token is correct for dial %s.\n", id)
+
+	return nil
+}
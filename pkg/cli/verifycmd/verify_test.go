@@ -0,0 +1,143 @@
+package verifycmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestVerifyArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments, and no dial in use",
+		args: []string{},
+	}, {
+		msg:  "one argument",
+		args: []string{"dial-id"},
+	}, {
+		msg:  "three arguments",
+		args: []string{"dial-id", "token", "extra"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)                // command errors.
+			is.True(!c.VerifyDialTokenInvoked) // token is not verified.
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		args     []string
+		expID    ooohh.DialID
+		expToken string
+	}{{
+		msg:      "given dial",
+		args:     []string{"dial-id", "token"},
+		expID:    "dial-id",
+		expToken: "token",
+	}, {
+		msg:      "dial in use",
+		args:     []string{},
+		expID:    "cached-id",
+		expToken: "cached-token",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the client.
+			var verifyID ooohh.DialID
+			var verifyToken string
+
+			c := &mock.Service{
+				VerifyDialTokenFn: func(ctx context.Context, id ooohh.DialID, token string) error {
+					verifyID, verifyToken = id, token
+					return nil
+				},
+			}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+			rootConfig.Cache = rootcmd.Cache{DialID: "cached-id", Token: "cached-token"}
+
+			var out bytes.Buffer
+			cmd := New(rootConfig, &out)
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.NoErr(err) // command runs.
+
+			is.Equal(verifyID, tt.expID)       // correct dial is verified.
+			is.Equal(verifyToken, tt.expToken) // correct token is verified.
+
+			is.Equal(out.String(), "Token is correct for dial "+string(tt.expID)+".\n") // output is correct.
+		})
+	}
+}
+
+func TestVerifyIncorrectToken(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{
+		VerifyDialTokenFn: func(ctx context.Context, id ooohh.DialID, token string) error {
+			return errors.New("Unauthorized")
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"dial-id", "wrong"})
+	is.True(err != nil)        // command errors.
+	is.Equal(out.String(), "") // nothing is output.
+}
@@ -0,0 +1,65 @@
+package wtfcmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+)
+
+// Config for the wtf subcommand, including a reference to the root config.
+type Config struct {
+	rootConfig *rootcmd.Config
+	out        io.Writer
+}
+
+// New creates a new cli.Command for the wtf subcommand.
+func New(rootConfig *rootcmd.Config, out io.Writer) *cli.Command {
+	cfg := Config{
+		rootConfig: rootConfig,
+		out:        out,
+	}
+
+	fs := flag.NewFlagSet("ooohh wtf", flag.ContinueOnError)
+	rootConfig.RegisterFlags(fs)
+
+	return &cli.Command{
+		Name:       "wtf",
+		ShortUsage: "ooohh wtf <value>",
+		ShortHelp:  "Set the value of the dial being used.",
+		FlagSet:    fs,
+		Exec:       cfg.Exec,
+	}
+}
+
+// Exec function for this command.
+func (c *Config) Exec(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("wtf requires exactly 1 argument")
+	}
+
+	value, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return errors.New("value must be a number")
+	}
+
+	if c.rootConfig.Cache.DialID == "" {
+		return errors.New("no dial in use, run `ooohh create` or `ooohh set` first")
+	}
+
+	err = c.rootConfig.Client.SetDial(ctx, c.rootConfig.Cache.DialID, c.rootConfig.Cache.Token, value)
+	if err != nil {
+		return errors.Wrap(err, "setting dial")
+	}
+
+	fmt.Fprintf(c.out, "Your dial (%s) is now set to %s.\n", c.rootConfig.Cache.DialID, ooohh.FormatValue(value, ooohh.DefaultPrecision))
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package wtfcmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/cli/rootcmd"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+// newRootConfig returns a root config using the given client, and a cache file
+// within a new temporary directory. It returns a function that should be called
+// to cleanup the cache.
+func newRootConfig(t *testing.T, c ooohh.Service) (*rootcmd.Config, func()) {
+	dir, err := ioutil.TempDir("", "ooohh-cli-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		os.RemoveAll(dir) //nolint:errcheck
+	}
+
+	return &rootcmd.Config{
+		CachePath: filepath.Join(dir, "cache.json"),
+		Client:    c,
+	}, cleanup
+}
+
+func TestWtfArguments(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg  string
+		args []string
+	}{{
+		msg:  "no arguments",
+		args: []string{},
+	}, {
+		msg:  "two arguments",
+		args: []string{"10", "20"},
+	}, {
+		msg:  "not a number",
+		args: []string{"lots"},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			c := &mock.Service{}
+
+			rootConfig, cleanup := newRootConfig(t, c)
+			defer cleanup()
+			rootConfig.Cache = rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}
+
+			cmd := New(rootConfig, &bytes.Buffer{})
+
+			err := cmd.ParseAndRun(context.TODO(), tt.args)
+			is.True(err != nil)        // command errors.
+			is.True(!c.SetDialInvoked) // dial is not set.
+		})
+	}
+}
+
+func TestWtfWithoutDial(t *testing.T) {
+
+	is := is.New(t)
+
+	c := &mock.Service{}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+
+	cmd := New(rootConfig, &bytes.Buffer{})
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"10"})
+	is.True(err != nil)        // command errors.
+	is.True(!c.SetDialInvoked) // dial is not set.
+}
+
+func TestWtf(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the client.
+	var setID ooohh.DialID
+	var setToken string
+	var setValue float64
+
+	c := &mock.Service{
+		SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+			setID, setToken, setValue = id, token, value
+			return nil
+		},
+	}
+
+	rootConfig, cleanup := newRootConfig(t, c)
+	defer cleanup()
+	rootConfig.Cache = rootcmd.Cache{DialID: ooohh.DialID("dial-id"), Token: "token"}
+
+	var out bytes.Buffer
+	cmd := New(rootConfig, &out)
+
+	err := cmd.ParseAndRun(context.TODO(), []string{"66.6"})
+	is.NoErr(err) // command runs.
+
+	is.Equal(setID, ooohh.DialID("dial-id")) // cached dial is set.
+	is.Equal(setToken, "token")              // cached token is used.
+	is.Equal(setValue, 66.6)                 // value is set.
+
+	is.Equal(out.String(), "Your dial (dial-id) is now set to 66.6.\n") // output is correct.
+}
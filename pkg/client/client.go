@@ -0,0 +1,955 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+const (
+	// defaultUserAgent is the user agent sent when none is configured.
+	defaultUserAgent = "ooohh cli"
+	// defaultTimeout is the timeout of requests when none is configured.
+	defaultTimeout = 10 * time.Second
+)
+
+type client struct {
+	base       string
+	adminToken string
+	userAgent  string
+	timeout    time.Duration
+	c          *http.Client
+
+	// attempts is the most times a request is sent, and retryDelay the delay
+	// before it is first retried.
+	attempts   int
+	retryDelay time.Duration
+}
+
+// Option configures the client.
+type Option func(*client)
+
+// WithAdminToken sets the token used for admin only requests, such as listing dials.
+func WithAdminToken(token string) Option {
+	return func(c *client) {
+		c.adminToken = token
+	}
+}
+
+// WithUserAgent sets the user agent sent with requests.
+func WithUserAgent(ua string) Option {
+	return func(c *client) {
+		c.userAgent = ua
+	}
+}
+
+// WithHTTPClient sets the http client requests are sent with, e.g. to trace or
+// retry requests. The given client isn't changed by other options.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *client) {
+		c.c = hc
+	}
+}
+
+// WithTimeout sets the timeout of requests, including reading the response.
+// Requests time out after 10 seconds by default, or after the timeout of the
+// client given with WithHTTPClient.
+func WithTimeout(d time.Duration) Option {
+	return func(c *client) {
+		c.timeout = d
+	}
+}
+
+// WithRetry retries requests that fail with a network error, or a 502, 503 or
+// 504 response, until they have been sent maxAttempts times. The delay before
+// each retry doubles from baseDelay, with jitter. Only requests that can be
+// repeated without changing their outcome are retried, that is GET, PUT and
+// PATCH requests, other than dial adjustments, so dials and boards are never
+// created, or adjusted, twice. Requests aren't retried by default.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *client) {
+		c.attempts = maxAttempts
+		c.retryDelay = baseDelay
+	}
+}
+
+// UserAgent returns the user agent of the given version of the CLI.
+func UserAgent(version string) string {
+	return "ooohh-cli/" + version
+}
+
+// NewClient returns an ooohh.Service that talks to the ooohh API found at the
+// given base URL.
+func NewClient(base string, opts ...Option) *client {
+	c := &client{
+		base:      strings.TrimRight(base, "/"),
+		userAgent: defaultUserAgent,
+		attempts:  1,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.c == nil {
+		c.c = &http.Client{Timeout: defaultTimeout}
+	}
+
+	// Copy the client to set its timeout, so a given client isn't changed.
+	if c.timeout > 0 {
+		hc := *c.c
+		hc.Timeout = c.timeout
+		c.c = &hc
+	}
+
+	return c
+}
+
+// Close releases any idle connections held by the client. The client can
+// still be used afterwards, and Close can be called more than once.
+func (c *client) Close() error {
+	c.c.CloseIdleConnections()
+	return nil
+}
+
+var (
+	// ErrNotFound is matched by problems where something wasn't found.
+	ErrNotFound = errors.New("not found")
+	// ErrValidation is matched by problems with the values of a request.
+	ErrValidation = errors.New("validation error")
+)
+
+// problemErrors are the errors matched by problems with each code. Problems
+// map to the ooohh errors that the API responded to, where there is one.
+var problemErrors = map[string][]error{
+	"invalid_json":      {ErrValidation},
+	"validation_error":  {ErrValidation},
+	"value_invalid":     {ErrValidation, ooohh.ErrDialValueInvalid},
+	"color_invalid":     {ErrValidation, ooohh.ErrDialColorInvalid},
+	"token_invalid":     {ErrValidation, ooohh.ErrDialTokenInvalid},
+	"token_required":    {ooohh.ErrUnauthorized},
+	"unauthorized":      {ooohh.ErrUnauthorized},
+	"forbidden":         {ooohh.ErrUnauthorized},
+	"locked_out":        {ooohh.ErrLockedOut},
+	"board_frozen":      {ooohh.ErrBoardFrozen},
+	"not_found":         {ErrNotFound},
+	"dial_not_found":    {ErrNotFound, ooohh.ErrDialNotFound},
+	"board_not_found":   {ErrNotFound, ooohh.ErrBoardNotFound},
+	"webhook_not_found": {ErrNotFound},
+}
+
+// Problem is an error response returned by the API. Problems can be matched
+// by their code with errors.Is, e.g. errors.Is(err, ErrNotFound).
+type Problem struct {
+	Status int    `json:"status"`
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// Error implements error.
+func (p *Problem) Error() string {
+	return p.Title
+}
+
+// Is reports whether the problem's code matches the target error.
+func (p *Problem) Is(target error) bool {
+	for _, err := range problemErrors[p.Code] {
+		if err == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateDial will create the dial with the given name,
+// and associate it to the specified token.
+func (c *client) CreateDial(ctx context.Context, name, token string) (*ooohh.Dial, error) {
+	type request struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+	}
+
+	return c.createDial(ctx, request{name, token})
+}
+
+// CreateDialWithValue is like CreateDial, but the dial is created with the
+// given value rather than zero, which is recorded in its history.
+func (c *client) CreateDialWithValue(ctx context.Context, name, token string, value float64) (*ooohh.Dial, error) {
+	type request struct {
+		Name  string  `json:"name"`
+		Token string  `json:"token"`
+		Value float64 `json:"value"`
+	}
+
+	return c.createDial(ctx, request{name, token, value})
+}
+
+// CreateDialFrom is like CreateDial, but the dial is also created with the
+// given value, color and group, all at once, or not at all.
+func (c *client) CreateDialFrom(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+	type request struct {
+		Name  string   `json:"name"`
+		Token string   `json:"token"`
+		Value *float64 `json:"value,omitempty"`
+		Color string   `json:"color,omitempty"`
+		Group string   `json:"group,omitempty"`
+	}
+
+	return c.createDial(ctx, request{nd.Name, nd.Token, nd.Value, nd.Color, nd.Group})
+}
+
+// createDial creates a dial from the request body.
+func (c *client) createDial(ctx context.Context, body interface{}) (*ooohh.Dial, error) {
+	var resp struct {
+		ooohh.Dial
+		Token string `json:"token"`
+	}
+	err := c.do(ctx, "POST", "/api/dials", body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a generated token is returned.
+	d := resp.Dial
+	if resp.Token != "" {
+		d.Token = resp.Token
+	}
+
+	return &d, nil
+}
+
+// GetDial retrieves a dial by ID. Anyone can retrieve any dial with its ID.
+func (c *client) GetDial(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+	var d ooohh.Dial
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), nil, &d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// EnsureDial retrieves the dial mapped to the given external ID, creating it
+// with the given name and token if there isn't one. Whether the dial was
+// created is also returned. The token must match that of an existing dial.
+func (c *client) EnsureDial(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+	type request struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+	}
+
+	var d ooohh.Dial
+	status, err := c.doStatus(ctx, "PUT", fmt.Sprintf("/api/dials/by-external/%s", url.PathEscape(externalID)), request{name, token}, &d)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &d, status == http.StatusCreated, nil
+}
+
+// GetDials retrieves many dials by ID. Dials that are not found are omitted.
+func (c *client) GetDials(ctx context.Context, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+	type request struct {
+		IDs []ooohh.DialID `json:"ids"`
+	}
+
+	var dials map[ooohh.DialID]ooohh.Dial
+	err := c.do(ctx, "POST", "/api/dials/batch-get", request{ids}, &dials)
+	if err != nil {
+		return nil, err
+	}
+
+	return dials, nil
+}
+
+// ListDials calls fn with each dial, in ID order. Iteration stops at the
+// first error returned by fn, which is then returned. An admin token is required.
+func (c *client) ListDials(ctx context.Context, fn func(ooohh.Dial) error) error {
+	// Exports can be large, so don't time them out.
+	hc := *c.c
+	hc.Timeout = 0
+
+	resp, err := c.send(&hc, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", c.base+"/api/admin/dials", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Authorization", "Bearer "+c.adminToken)
+
+		return req, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	// Decode each dial as it arrives, rather than the whole array at once.
+	dec := json.NewDecoder(resp.Body)
+	if _, err := dec.Token(); err != nil {
+		return errors.Wrap(err, "reading response")
+	}
+	for dec.More() {
+		var d ooohh.Dial
+		if err := dec.Decode(&d); err != nil {
+			return errors.Wrap(err, "reading response")
+		}
+		if err := fn(d); err != nil {
+			return err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return errors.Wrap(err, "reading response")
+	}
+
+	return nil
+}
+
+// PageDials retrieves up to limit dials, in ID order, starting after the
+// given ID. The first page is retrieved with an empty ID. An admin token is required.
+func (c *client) PageDials(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
+	q := url.Values{}
+	q.Set("after", string(after))
+	q.Set("limit", strconv.Itoa(limit))
+
+	resp, err := c.send(c.c, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", c.base+"/api/admin/dials?"+q.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Authorization", "Bearer "+c.adminToken)
+
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var p ooohh.DialPage
+	if err := json.NewDecoder(resp.Body).Decode(&p.Dials); err != nil {
+		return nil, errors.Wrap(err, "reading response")
+	}
+
+	if p.Total, err = strconv.Atoi(resp.Header.Get("X-Total-Count")); err != nil {
+		return nil, errors.Wrap(err, "reading total count")
+	}
+
+	if next := nextLink(resp.Header.Get("Link")); next != "" {
+		u, err := url.Parse(next)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading next link")
+		}
+		p.Next = ooohh.DialID(u.Query().Get("after"))
+	}
+
+	return &p, nil
+}
+
+// SetDial updates the dial value. It can be updated by anyone who knows
+// the original token it was created with.
+func (c *client) SetDial(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+	type request struct {
+		Token string  `json:"token"`
+		Value float64 `json:"value"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, value}, nil)
+}
+
+// SetDialWithNote is like SetDial, but also records the note against the
+// value in the dial's history.
+func (c *client) SetDialWithNote(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+	type request struct {
+		Token string  `json:"token"`
+		Value float64 `json:"value"`
+		Note  string  `json:"note,omitempty"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, value, note}, nil)
+}
+
+// AdjustDial adds the delta to the dial value, clamping the result to the
+// value bounds. Adjustments aren't retried, as the server may have applied the
+// delta before the request failed.
+func (c *client) AdjustDial(ctx context.Context, id ooohh.DialID, token string, delta float64) error {
+	type request struct {
+		Token string  `json:"token"`
+		Delta float64 `json:"delta"`
+	}
+
+	return c.do(withoutRetry(ctx), "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, delta}, nil)
+}
+
+// GetDialHistory retrieves the values the dial has been set to since the
+// given time, oldest first. The zero time retrieves the whole history.
+func (c *client) GetDialHistory(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+	path := fmt.Sprintf("/api/dials/%s/history", url.PathEscape(string(id)))
+	if !since.IsZero() {
+		path += "?since=" + url.QueryEscape(since.UTC().Format(time.RFC3339Nano))
+	}
+
+	var readings []ooohh.DialReading
+	err := c.do(ctx, "GET", path, nil, &readings)
+	if err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
+
+// SetDialColor updates the dial color. It can be updated by anyone who knows
+// the original token it was created with.
+func (c *client) SetDialColor(ctx context.Context, id ooohh.DialID, token, color string) error {
+	type request struct {
+		Token string `json:"token"`
+		Color string `json:"color"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, color}, nil)
+}
+
+// SetDialGroup updates the group the dial is displayed in on boards. It can
+// be updated by anyone who knows the original token it was created with.
+func (c *client) SetDialGroup(ctx context.Context, id ooohh.DialID, token, group string) error {
+	type request struct {
+		Token string `json:"token"`
+		Group string `json:"group"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, group}, nil)
+}
+
+// RenameDial updates the dial name. It can be renamed by anyone who knows
+// the original token it was created with.
+func (c *client) RenameDial(ctx context.Context, id ooohh.DialID, token, name string) error {
+	type request struct {
+		Token string `json:"token"`
+		Name  string `json:"name"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, name}, nil)
+}
+
+// SetDialToken replaces the token the dial is updated with. It can be
+// replaced by anyone who knows the dial's current token.
+func (c *client) SetDialToken(ctx context.Context, id ooohh.DialID, token, newToken string) error {
+	type request struct {
+		Token    string `json:"token"`
+		NewToken string `json:"new_token"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, newToken}, nil)
+}
+
+// UpdateDial applies the update to the dial, all at once, so either all of
+// its changes are made, or none are. Updates with a delta aren't retried, as
+// the server may have applied them before the request failed.
+func (c *client) UpdateDial(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+	type request struct {
+		Token    string   `json:"token"`
+		Name     *string  `json:"name,omitempty"`
+		Value    *float64 `json:"value,omitempty"`
+		Delta    *float64 `json:"delta,omitempty"`
+		Color    *string  `json:"color,omitempty"`
+		Group    *string  `json:"group,omitempty"`
+		Note     string   `json:"note,omitempty"`
+		NewToken *string  `json:"new_token,omitempty"`
+	}
+
+	if u.Delta != nil {
+		ctx = withoutRetry(ctx)
+	}
+
+	var d ooohh.Dial
+	err := c.do(ctx, "PATCH", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token, u.Name, u.Value, u.Delta, u.Color, u.Group, u.Note, u.Token}, &d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// DeleteDial removes the dial, and its history. It can be deleted by anyone
+// who knows the original token it was created with. Boards the dial is on
+// keep its ID, but skip it as they do any other missing dial.
+func (c *client) DeleteDial(ctx context.Context, id ooohh.DialID, token string) error {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return c.do(ctx, "DELETE", fmt.Sprintf("/api/dials/%s", url.PathEscape(string(id))), request{token}, nil)
+}
+
+// VerifyDialToken checks the token is the one the dial was created with,
+// without changing the dial. Bad tokens count towards the dial's lockout.
+func (c *client) VerifyDialToken(ctx context.Context, id ooohh.DialID, token string) error {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return c.do(ctx, "POST", fmt.Sprintf("/api/dials/%s/verify-token", url.PathEscape(string(id))), request{token}, nil)
+}
+
+// CreateBoard will create a board with the given name,
+// and associate it to the specified token.
+func (c *client) CreateBoard(ctx context.Context, name, token string) (*ooohh.Board, error) {
+	return c.CreateBoardWithDescription(ctx, name, token, "")
+}
+
+// CreateBoardWithDescription is like CreateBoard, but the board is created
+// with the given description, together, or not at all.
+func (c *client) CreateBoardWithDescription(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+	type request struct {
+		Name        string `json:"name"`
+		Token       string `json:"token"`
+		Description string `json:"description,omitempty"`
+	}
+
+	var resp struct {
+		ooohh.Board
+		Token string `json:"token"`
+	}
+	err := c.do(ctx, "POST", "/api/boards", request{name, token, description}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a generated token is returned.
+	b := resp.Board
+	if resp.Token != "" {
+		b.Token = resp.Token
+	}
+
+	return &b, nil
+}
+
+// CreateBoardWithDials will create a board with the given name, and a dial
+// with each of the given names on it, all associated to the specified token.
+// Either the board and all of its dials are created, or none are.
+func (c *client) CreateBoardWithDials(ctx context.Context, name, token string, dials []string) (*ooohh.Board, error) {
+	type request struct {
+		Name  string   `json:"name"`
+		Token string   `json:"token"`
+		Dials []string `json:"dials"`
+	}
+
+	var resp struct {
+		ooohh.Board
+		Token string `json:"token"`
+	}
+	err := c.do(ctx, "POST", "/api/boards/with-dials", request{name, token, dials}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a generated token is returned.
+	b := resp.Board
+	if resp.Token != "" {
+		b.Token = resp.Token
+	}
+
+	return &b, nil
+}
+
+// CloneBoard creates a board with the given name and token, with the same
+// dials as the board with the given ID or code. The dials are shared with the
+// board, not copied, so stay live on both.
+func (c *client) CloneBoard(ctx context.Context, id ooohh.BoardID, name, token string) (*ooohh.Board, error) {
+	type request struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+	}
+
+	var resp struct {
+		ooohh.Board
+		Token string `json:"token"`
+	}
+	err := c.do(ctx, "POST", fmt.Sprintf("/api/boards/%s/clone", url.PathEscape(string(id))), request{name, token}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a generated token is returned.
+	b := resp.Board
+	if resp.Token != "" {
+		b.Token = resp.Token
+	}
+
+	return &b, nil
+}
+
+// GetBoard retrieves a board by ID or code. Anyone can retrieve any board with its ID.
+func (c *client) GetBoard(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+	var b ooohh.Board
+	err := c.do(ctx, "GET", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), nil, &b)
+	if err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// GetBoardDialIDs retrieves the IDs of the dials on a board. The API only
+// exposes populated boards, so dials that no longer exist are not included.
+func (c *client) GetBoardDialIDs(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+	b, err := c.GetBoard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]ooohh.DialID, len(b.Dials))
+	for i := range b.Dials {
+		ids[i] = b.Dials[i].ID
+	}
+
+	return ids, nil
+}
+
+// ListBoardsByToken retrieves the boards created with the given token.
+// Only the IDs of the boards' dials are retrieved, not their values.
+func (c *client) ListBoardsByToken(ctx context.Context, token string) ([]ooohh.Board, error) {
+	var resp []struct {
+		ooohh.Board
+		Dials []ooohh.DialID `json:"dials"`
+	}
+	err := c.do(ctx, "GET", "/api/boards?token="+url.QueryEscape(token), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]ooohh.Board, len(resp))
+	for i := range resp {
+		boards[i] = resp[i].Board
+		boards[i].Dials = make([]ooohh.Dial, len(resp[i].Dials))
+		for j, id := range resp[i].Dials {
+			boards[i].Dials[j] = ooohh.Dial{ID: id}
+		}
+	}
+
+	return boards, nil
+}
+
+// SetBoard updates the dials associated with the board. It can be updated
+// by anyone who knows the original token it was created with.
+func (c *client) SetBoard(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+
+	// The API only clears boards through a dedicated endpoint.
+	if len(dials) == 0 {
+		type request struct {
+			Token string `json:"token"`
+		}
+
+		return c.do(ctx, "DELETE", fmt.Sprintf("/api/boards/%s/dials", url.PathEscape(string(id))), request{token}, nil)
+	}
+
+	type request struct {
+		Token string   `json:"token"`
+		Dials []string `json:"dials"`
+	}
+
+	ids := make([]string, len(dials))
+	for i := range dials {
+		ids[i] = string(dials[i])
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token, ids}, nil)
+}
+
+// AddBoardDial adds the dial to the end of the board's dials, unless it is
+// already on the board. It can be added by anyone who knows the board's
+// original token.
+func (c *client) AddBoardDial(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error {
+	type request struct {
+		Token  string       `json:"token"`
+		DialID ooohh.DialID `json:"dial_id"`
+	}
+
+	return c.do(ctx, "POST", fmt.Sprintf("/api/boards/%s/dials", url.PathEscape(string(id))), request{token, dialID}, nil)
+}
+
+// SetBoardName renames the board. It can be renamed by anyone who knows
+// the original token it was created with.
+func (c *client) SetBoardName(ctx context.Context, id ooohh.BoardID, token, name string) error {
+	type request struct {
+		Token string `json:"token"`
+		Name  string `json:"name"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token, name}, nil)
+}
+
+// SetBoardOwnsDials sets whether the board owns its dials. The values of the
+// dials on a board that owns them can also be set with the board's token. It
+// can be set by anyone who knows the original token the board was created with.
+func (c *client) SetBoardOwnsDials(ctx context.Context, id ooohh.BoardID, token string, owns bool) error {
+	type request struct {
+		Token     string `json:"token"`
+		OwnsDials bool   `json:"owns_dials"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token, owns}, nil)
+}
+
+// FreezeBoard snapshots the values of the board's dials, which the board is
+// then retrieved with, and stops the dials' values being set, until it is
+// unfrozen. It can be frozen by anyone who knows the original token the board
+// was created with.
+func (c *client) FreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	return c.setBoardFrozen(ctx, id, token, true)
+}
+
+// UnfreezeBoard undoes FreezeBoard, so the board is retrieved with the live
+// values of its dials again. It can be unfrozen by anyone who knows the
+// original token the board was created with.
+func (c *client) UnfreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	return c.setBoardFrozen(ctx, id, token, false)
+}
+
+// setBoardFrozen freezes or unfreezes the board.
+func (c *client) setBoardFrozen(ctx context.Context, id ooohh.BoardID, token string, frozen bool) error {
+	type request struct {
+		Token  string `json:"token"`
+		Frozen bool   `json:"frozen"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token, frozen}, nil)
+}
+
+// SetBoardDescription updates the board description. It can be updated by
+// anyone who knows the original token it was created with.
+func (c *client) SetBoardDescription(ctx context.Context, id ooohh.BoardID, token, description string) error {
+	type request struct {
+		Token       string `json:"token"`
+		Description string `json:"description"`
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token, description}, nil)
+}
+
+// UpdateBoard applies the update to the board, all at once, so either all of
+// its changes are made, or none are. The API only clears boards through a
+// dedicated endpoint, so the update's dials, if set, must not be empty.
+func (c *client) UpdateBoard(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+	type request struct {
+		Token       string    `json:"token"`
+		Name        *string   `json:"name,omitempty"`
+		Description *string   `json:"description,omitempty"`
+		Dials       *[]string `json:"dials,omitempty"`
+		OwnsDials   *bool     `json:"owns_dials,omitempty"`
+		Frozen      *bool     `json:"frozen,omitempty"`
+	}
+
+	var ids *[]string
+	if u.Dials != nil {
+		dials := make([]string, len(*u.Dials))
+		for i := range dials {
+			dials[i] = string((*u.Dials)[i])
+		}
+		ids = &dials
+	}
+
+	return c.do(ctx, "PATCH", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token, u.Name, u.Description, ids, u.OwnsDials, u.Frozen}, nil)
+}
+
+// DeleteBoard removes the board. It can be deleted by anyone who knows the
+// original token it was created with. The board's dials are not deleted, as
+// they may be on other boards.
+func (c *client) DeleteBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	type request struct {
+		Token string `json:"token"`
+	}
+
+	return c.do(ctx, "DELETE", fmt.Sprintf("/api/boards/%s", url.PathEscape(string(id))), request{token}, nil)
+}
+
+// do sends a request to the API, encoding body as the JSON request body if given,
+// and decoding the JSON response body into v if given. Non 2XX responses are
+// returned as errors.
+func (c *client) do(ctx context.Context, method, path string, body, v interface{}) error {
+	_, err := c.doStatus(ctx, method, path, body, v)
+	return err
+}
+
+// doStatus is like do, but also returns the status code of successful responses.
+func (c *client) doStatus(ctx context.Context, method, path string, body, v interface{}) (int, error) {
+
+	var b []byte
+	if body != nil {
+		var err error
+		if b, err = json.Marshal(body); err != nil {
+			return 0, errors.Wrap(err, "marshalling request")
+		}
+	}
+
+	resp, err := c.send(c.c, func() (*http.Request, error) {
+		var r io.Reader
+		if body != nil {
+			r = bytes.NewReader(b)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.base+path, r)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", c.userAgent)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		return req, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		return 0, err
+	}
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return 0, errors.Wrap(err, "reading response")
+		}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// send sends the request made by newReq with the given http client, retrying
+// it as configured by WithRetry. A new request is made for each attempt, so
+// that its body is read from the start.
+func (c *client) send(hc *http.Client, newReq func() (*http.Request, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		req, err := newReq()
+		if err != nil {
+			return nil, errors.Wrap(err, "creating request")
+		}
+
+		resp, err := hc.Do(req)
+
+		// Return the outcome if the request can't be retried, or needn't be.
+		if attempt >= c.attempts || !retryable(req) || req.Context().Err() != nil {
+			if err != nil {
+				return nil, errors.Wrap(err, "sending request")
+			}
+			return resp, nil
+		}
+		if err == nil {
+			if !retryableStatus(resp.StatusCode) {
+				return resp, nil
+			}
+
+			// Release the connection before retrying.
+			io.Copy(ioutil.Discard, resp.Body) //nolint:errcheck
+			resp.Body.Close()
+		}
+
+		t := time.NewTimer(c.backoff(attempt))
+		select {
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, errors.Wrap(req.Context().Err(), "sending request")
+		case <-t.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before retrying a request that has been
+// sent the given number of times. The delay doubles with each attempt, and is
+// jittered so that many clients don't retry at once.
+func (c *client) backoff(attempt int) time.Duration {
+	d := c.retryDelay << (attempt - 1)
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// noRetryKey is the context key requests that mustn't be retried are marked
+// with.
+type noRetryKey struct{}
+
+// withoutRetry returns a copy of the context that requests are sent with once,
+// whatever their method.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// retryable reports whether the request can be repeated without changing its
+// outcome.
+func retryable(req *http.Request) bool {
+	if noRetry, _ := req.Context().Value(noRetryKey{}).(bool); noRetry {
+		return false
+	}
+
+	return req.Method == "GET" || req.Method == "PUT" || req.Method == "PATCH"
+}
+
+// retryableStatus reports whether responses with the given status code are
+// likely to succeed if the request is retried.
+func retryableStatus(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
+
+// checkResponse returns the problem of non 2XX responses as an error.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+
+	problem := Problem{Status: resp.StatusCode}
+	if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+		return errors.Wrapf(err, "reading error response with status %d", resp.StatusCode)
+	}
+
+	return &problem
+}
+
+// nextLink returns the URL of the rel="next" link in the given Link header,
+// or the empty string if there isn't one.
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,1361 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+func TestClientIsOoohhService(t *testing.T) {
+
+	is := is.New(t)
+
+	var i interface{} = &client{}
+	_, ok := i.(ooohh.Service)
+	is.True(ok) // client is ooohh service.
+}
+
+func TestCreateDial(t *testing.T) {
+
+	is := is.New(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	// Variables that will be set by the server.
+	var method, path, ua string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, ua = r.Method, r.URL.Path, r.UserAgent()
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Name: "dial", UpdatedAt: now}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	d, err := c.CreateDial(context.TODO(), "dial", "token")
+	is.NoErr(err) // dial is created.
+
+	is.Equal(method, "POST")                                                 // correct method is used.
+	is.Equal(path, "/api/dials")                                             // correct path is used.
+	is.Equal(ua, "ooohh cli")                                                // user agent is set.
+	is.Equal(body, map[string]interface{}{"name": "dial", "token": "token"}) // correct body is sent.
+
+	is.Equal(d.ID, ooohh.DialID("dial-id")) // dial id is correct.
+	is.Equal(d.Name, "dial")                // dial name is correct.
+	is.Equal(d.UpdatedAt, now)              // dial updated at is correct.
+}
+
+func TestCreateDialWithValue(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Name: "dial", Value: 42}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	d, err := c.CreateDialWithValue(context.TODO(), "dial", "token", 42)
+	is.NoErr(err) // dial is created.
+
+	is.Equal(method, "POST")                                                                // correct method is used.
+	is.Equal(path, "/api/dials")                                                            // correct path is used.
+	is.Equal(body, map[string]interface{}{"name": "dial", "token": "token", "value": 42.0}) // correct body is sent.
+
+	is.Equal(d.ID, ooohh.DialID("dial-id")) // dial id is correct.
+	is.Equal(d.Value, 42.0)                 // dial value is correct.
+}
+
+func TestCreateDialWithGeneratedToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a test server that mimics the API, generating a token.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "dial-id", "name": "dial", "token": "generated"}`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	d, err := c.CreateDial(context.TODO(), "dial", "")
+	is.NoErr(err) // dial is created.
+
+	is.Equal(d.ID, ooohh.DialID("dial-id")) // dial id is correct.
+	is.Equal(d.Token, "generated")          // generated token is returned.
+}
+
+func TestEnsureDial(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg        string
+		status     int
+		expCreated bool
+	}{{
+		msg:        "created",
+		status:     http.StatusCreated,
+		expCreated: true,
+	}, {
+		msg:        "existing",
+		status:     http.StatusOK,
+		expCreated: false,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the server.
+			var method, path string
+			var body map[string]interface{}
+
+			// Create a test server that mimics the API.
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.EscapedPath()
+				json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Name: "dial"}) //nolint:errcheck
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL)
+
+			d, created, err := c.EnsureDial(context.TODO(), "ext/1", "dial", "token")
+			is.NoErr(err) // dial is ensured.
+
+			is.Equal(method, "PUT")                                                  // correct method is used.
+			is.Equal(path, "/api/dials/by-external/ext%2F1")                         // correct path is used, with the external id escaped.
+			is.Equal(body, map[string]interface{}{"name": "dial", "token": "token"}) // correct body is sent.
+
+			is.Equal(d.ID, ooohh.DialID("dial-id")) // dial id is correct.
+			is.Equal(created, tt.expCreated)        // created is taken from the status code.
+		})
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var uas []string
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uas = append(uas, r.UserAgent())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "0")
+		if r.URL.Path == "/api/admin/dials" {
+			w.Write([]byte(`[]`)) //nolint:errcheck
+			return
+		}
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithUserAgent(UserAgent("1.2.3")))
+
+	_, err := c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.NoErr(err) // dial is retrieved.
+	err = c.ListDials(context.TODO(), func(ooohh.Dial) error { return nil })
+	is.NoErr(err) // dials are listed.
+	_, err = c.PageDials(context.TODO(), "", 10)
+	is.NoErr(err) // dials are paged.
+
+	is.Equal(uas, []string{"ooohh-cli/1.2.3", "ooohh-cli/1.2.3", "ooohh-cli/1.2.3"}) // versioned user agent is sent on all requests.
+}
+
+// roundTripperFunc is a http.RoundTripper implemented by a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHTTPClient(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	// Create a http client that counts the requests sent with it.
+	var sent int
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			sent++
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	c := NewClient(srv.URL, WithHTTPClient(hc), WithUserAgent("monitor/1.0"))
+
+	_, err := c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.NoErr(err)     // dial is retrieved.
+	is.Equal(sent, 1) // request is sent with the given client.
+}
+
+func TestTimeout(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a test server that is slow to respond.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithTimeout(20*time.Millisecond))
+
+	_, err := c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.True(err != nil) // request times out.
+
+	// A given client times out too, without being changed.
+	hc := &http.Client{}
+	c = NewClient(srv.URL, WithTimeout(20*time.Millisecond), WithHTTPClient(hc))
+
+	_, err = c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.True(err != nil)                    // request times out.
+	is.Equal(hc.Timeout, time.Duration(0)) // given client is unchanged.
+
+	// By default, requests time out after 10 seconds.
+	is.Equal(NewClient(srv.URL).c.Timeout, 10*time.Second) // default timeout is kept.
+}
+
+func TestRetry(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg         string
+		opts        []Option
+		call        func(c *client) error
+		status      int
+		expErr      bool
+		expAttempts int
+	}{{
+		msg:         "get succeeds after failures",
+		opts:        []Option{WithRetry(3, time.Millisecond)},
+		call:        func(c *client) error { _, err := c.GetDial(context.TODO(), "dial-id"); return err },
+		status:      http.StatusServiceUnavailable,
+		expAttempts: 3,
+	}, {
+		msg:         "set succeeds after failures",
+		opts:        []Option{WithRetry(5, time.Millisecond)},
+		call:        func(c *client) error { return c.SetDial(context.TODO(), "dial-id", "token", 50) },
+		status:      http.StatusBadGateway,
+		expAttempts: 3,
+	}, {
+		msg:         "too few attempts",
+		opts:        []Option{WithRetry(2, time.Millisecond)},
+		call:        func(c *client) error { _, err := c.GetDial(context.TODO(), "dial-id"); return err },
+		status:      http.StatusGatewayTimeout,
+		expErr:      true,
+		expAttempts: 2,
+	}, {
+		msg:         "client error",
+		opts:        []Option{WithRetry(3, time.Millisecond)},
+		call:        func(c *client) error { _, err := c.GetDial(context.TODO(), "dial-id"); return err },
+		status:      http.StatusNotFound,
+		expErr:      true,
+		expAttempts: 1,
+	}, {
+		msg:         "create isn't repeated",
+		opts:        []Option{WithRetry(3, time.Millisecond)},
+		call:        func(c *client) error { _, err := c.CreateDial(context.TODO(), "dial", "token"); return err },
+		status:      http.StatusServiceUnavailable,
+		expErr:      true,
+		expAttempts: 1,
+	}, {
+		msg:         "no retries by default",
+		call:        func(c *client) error { _, err := c.GetDial(context.TODO(), "dial-id"); return err },
+		status:      http.StatusServiceUnavailable,
+		expErr:      true,
+		expAttempts: 1,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a test server that fails twice, then succeeds.
+			var attempts int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+
+				w.Header().Set("Content-Type", "application/json")
+				if attempts <= 2 {
+					w.WriteHeader(tt.status)
+					w.Write([]byte(`{"title": "Failed"}`)) //nolint:errcheck
+					return
+				}
+				json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+			}))
+			defer srv.Close()
+
+			err := tt.call(NewClient(srv.URL, tt.opts...))
+			is.Equal(err != nil, tt.expErr)    // request fails or succeeds as expected.
+			is.Equal(attempts, tt.expAttempts) // request is sent the expected number of times.
+		})
+	}
+}
+
+func TestAdjustDialIsNotRetried(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a test server that applies each delta, then fails.
+	var attempts int
+	var value float64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		var body struct {
+			Delta float64 `json:"delta"`
+		}
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+		value += body.Delta
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"title": "Failed"}`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithRetry(3, time.Millisecond))
+
+	err := c.AdjustDial(context.TODO(), "dial-id", "token", 5)
+	is.True(err != nil)   // adjustment fails.
+	is.Equal(attempts, 1) // adjustment isn't retried.
+	is.Equal(value, 5.0)  // delta is applied once.
+
+	// Setting the dial is still retried.
+	attempts = 0
+	err = c.SetDial(context.TODO(), "dial-id", "token", 50)
+	is.True(err != nil)   // set fails.
+	is.Equal(attempts, 3) // set is retried.
+}
+
+func TestRetryIsCancelled(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a test server that always fails.
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"title": "Failed"}`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithRetry(10, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetDial(ctx, "dial-id")
+	is.True(errors.Is(err, context.DeadlineExceeded)) // request is cancelled.
+	is.True(time.Since(start) < time.Second)          // retries aren't waited for.
+	is.Equal(attempts, 1)                             // request isn't retried.
+}
+
+func TestGetDial(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Name: "dial", Value: 66.6}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	d, err := c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.NoErr(err) // dial is retrieved.
+
+	is.Equal(method, "GET")                 // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")    // correct path is used.
+	is.Equal(d.ID, ooohh.DialID("dial-id")) // dial id is correct.
+	is.Equal(d.Value, 66.6)                 // dial value is correct.
+}
+
+func TestGetDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[ooohh.DialID]ooohh.Dial{"dial-1": {ID: "dial-1", Value: 66.6}}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	dials, err := c.GetDials(context.TODO(), []ooohh.DialID{"dial-1", "dial-2"})
+	is.NoErr(err) // dials are retrieved.
+
+	is.Equal(method, "POST")                                                         // correct method is used.
+	is.Equal(path, "/api/dials/batch-get")                                           // correct path is used.
+	is.Equal(body, map[string]interface{}{"ids": []interface{}{"dial-1", "dial-2"}}) // correct body is sent.
+	is.Equal(len(dials), 1)                                                          // only returned dials are present.
+	is.Equal(dials["dial-1"].Value, 66.6)                                            // dial value is correct.
+}
+
+func TestListDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var path, auth string
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path, r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"dial-1","value":10},{"id":"dial-2","value":20}]`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, WithAdminToken("admin"))
+
+	var dials []ooohh.Dial
+	err := c.ListDials(context.TODO(), func(d ooohh.Dial) error {
+		dials = append(dials, d)
+		return nil
+	})
+	is.NoErr(err) // dials are listed.
+
+	is.Equal(path, "/api/admin/dials")            // correct path is used.
+	is.Equal(auth, "Bearer admin")                // admin token is used.
+	is.Equal(len(dials), 2)                       // all dials are listed.
+	is.Equal(dials[1].ID, ooohh.DialID("dial-2")) // dials are in order.
+	is.Equal(dials[1].Value, 20.0)                // dial value is correct.
+}
+
+func TestPageDials(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg     string
+		link    string
+		expNext ooohh.DialID
+	}{{
+		msg:     "next page",
+		link:    `</api/admin/dials?after=dial-1&limit=2>; rel="next"`,
+		expNext: "dial-1",
+	}, {
+		msg:     "last page",
+		link:    "",
+		expNext: "",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the server.
+			var path, after, limit, auth string
+
+			// Create a test server that mimics the API.
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path, auth = r.URL.Path, r.Header.Get("Authorization")
+				after, limit = r.URL.Query().Get("after"), r.URL.Query().Get("limit")
+
+				w.Header().Set("Content-Type", "application/json")
+				if tt.link != "" {
+					w.Header().Set("Link", tt.link)
+				}
+				w.Header().Set("X-Total-Count", "5")
+				json.NewEncoder(w).Encode([]ooohh.Dial{{ID: "dial-0"}, {ID: "dial-1"}}) //nolint:errcheck
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL, WithAdminToken("admin"))
+
+			p, err := c.PageDials(context.TODO(), ooohh.DialID("dial-start"), 2)
+			is.NoErr(err) // dials are paged.
+
+			is.Equal(path, "/api/admin/dials") // correct path is used.
+			is.Equal(auth, "Bearer admin")     // admin token is sent.
+			is.Equal(after, "dial-start")      // page starts after the given dial.
+			is.Equal(limit, "2")               // limit is sent.
+
+			is.Equal(len(p.Dials), 2)    // page dials are returned.
+			is.Equal(p.Total, 5)         // total is read from the header.
+			is.Equal(p.Next, tt.expNext) // next is read from the link header.
+		})
+	}
+}
+
+func TestSetDial(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Value: 66.6}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL + "/")
+
+	err := c.SetDial(context.TODO(), ooohh.DialID("dial-id"), "token", 66.6)
+	is.NoErr(err) // dial is set.
+
+	is.Equal(method, "PATCH")                                               // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                    // correct path is used, with trailing slash of base removed.
+	is.Equal(body, map[string]interface{}{"token": "token", "value": 66.6}) // correct body is sent.
+}
+
+func TestSetDialWithNote(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Value: 66.6}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetDialWithNote(context.TODO(), ooohh.DialID("dial-id"), "token", 66.6, "note")
+	is.NoErr(err) // dial is set.
+
+	is.Equal(method, "PATCH")                                                               // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                                    // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token", "value": 66.6, "note": "note"}) // correct body is sent.
+}
+
+func TestAdjustDial(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Value: 45}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.AdjustDial(context.TODO(), ooohh.DialID("dial-id"), "token", -5)
+	is.NoErr(err) // dial is adjusted.
+
+	is.Equal(method, "PATCH")                                               // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                    // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token", "delta": -5.0}) // correct body is sent.
+}
+
+func TestGetDialHistory(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path, query string
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, query = r.Method, r.URL.Path, r.URL.RawQuery
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]ooohh.DialReading{{Value: 10.0}, {Value: 80.0, Note: "note"}}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	readings, err := c.GetDialHistory(context.TODO(), ooohh.DialID("dial-id"), time.Time{})
+	is.NoErr(err) // history is retrieved.
+
+	is.Equal(method, "GET")                      // correct method is used.
+	is.Equal(path, "/api/dials/dial-id/history") // correct path is used.
+	is.Equal(query, "")                          // no since time is sent.
+	is.Equal(len(readings), 2)                   // all readings are returned.
+	is.Equal(readings[1].Value, 80.0)            // reading value is correct.
+	is.Equal(readings[1].Note, "note")           // reading note is correct.
+
+	since := time.Date(2020, 5, 17, 10, 30, 15, 0, time.UTC)
+	_, err = c.GetDialHistory(context.TODO(), ooohh.DialID("dial-id"), since)
+	is.NoErr(err)                                     // history is retrieved.
+	is.Equal(query, "since=2020-05-17T10%3A30%3A15Z") // since time is sent.
+}
+
+func TestSetDialColor(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Color: "#00ff00"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetDialColor(context.TODO(), ooohh.DialID("dial-id"), "token", "#00ff00")
+	is.NoErr(err) // dial color is set.
+
+	is.Equal(method, "PATCH")                                                    // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                         // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token", "color": "#00ff00"}) // correct body is sent.
+}
+
+func TestSetDialGroup(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id", Group: "backend"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetDialGroup(context.TODO(), ooohh.DialID("dial-id"), "token", "backend")
+	is.NoErr(err) // dial group is set.
+
+	is.Equal(method, "PATCH")                                                    // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                         // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token", "group": "backend"}) // correct body is sent.
+}
+
+func TestRenameDial(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.RenameDial(context.TODO(), ooohh.DialID("dial-id"), "token", "renamed")
+	is.NoErr(err) // dial is renamed.
+
+	is.Equal(method, "PATCH")                                                   // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                        // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token", "name": "renamed"}) // correct body is sent.
+}
+
+func TestSetDialToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetDialToken(context.TODO(), ooohh.DialID("dial-id"), "token", "new-token")
+	is.NoErr(err) // dial token is set.
+
+	is.Equal(method, "PATCH")                                                          // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                                               // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token", "new_token": "new-token"}) // correct body is sent.
+}
+
+func TestDeleteDial(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.DeleteDial(context.TODO(), ooohh.DialID("dial-id"), "token")
+	is.NoErr(err) // dial is deleted.
+
+	is.Equal(method, "DELETE")                               // correct method is used.
+	is.Equal(path, "/api/dials/dial-id")                     // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token"}) // correct body is sent.
+}
+
+func TestVerifyDialToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.VerifyDialToken(context.TODO(), ooohh.DialID("dial-id"), "token")
+	is.NoErr(err) // token is verified.
+
+	is.Equal(method, "POST")                                 // correct method is used.
+	is.Equal(path, "/api/dials/dial-id/verify-token")        // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token"}) // correct body is sent.
+}
+
+func TestCreateBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ooohh.Board{ID: "board-id", Name: "board", Dials: []ooohh.Dial{}}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	b, err := c.CreateBoard(context.TODO(), "board", "token")
+	is.NoErr(err) // board is created.
+
+	is.Equal(method, "POST")                                                  // correct method is used.
+	is.Equal(path, "/api/boards")                                             // correct path is used.
+	is.Equal(body, map[string]interface{}{"name": "board", "token": "token"}) // correct body is sent.
+
+	is.Equal(b.ID, ooohh.BoardID("board-id")) // board id is correct.
+	is.Equal(b.Name, "board")                 // board name is correct.
+	is.Equal(len(b.Dials), 0)                 // board has no dials.
+}
+
+func TestListBoardsByToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path, token string
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, token = r.Method, r.URL.Path, r.URL.Query().Get("token")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "board-id", "name": "board", "dials": ["dial-1", "dial-2"]}]`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	boards, err := c.ListBoardsByToken(context.TODO(), "my token")
+	is.NoErr(err) // boards are listed.
+
+	is.Equal(method, "GET")       // correct method is used.
+	is.Equal(path, "/api/boards") // correct path is used.
+	is.Equal(token, "my token")   // correct token is sent.
+
+	is.Equal(len(boards), 1)                                                // boards are returned.
+	is.Equal(boards[0].ID, ooohh.BoardID("board-id"))                       // board id is correct.
+	is.Equal(boards[0].Name, "board")                                       // board name is correct.
+	is.Equal(boards[0].Dials, []ooohh.Dial{{ID: "dial-1"}, {ID: "dial-2"}}) // board dial ids are correct.
+}
+
+func TestCreateBoardWithDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ooohh.Board{ //nolint:errcheck
+			ID:    "board-id",
+			Name:  "board",
+			Dials: []ooohh.Dial{{ID: "dial-1", Name: "one"}},
+		})
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	b, err := c.CreateBoardWithDials(context.TODO(), "board", "token", []string{"one"})
+	is.NoErr(err) // board is created.
+
+	is.Equal(method, "POST")                 // correct method is used.
+	is.Equal(path, "/api/boards/with-dials") // correct path is used.
+	is.Equal(body, map[string]interface{}{   // correct body is sent.
+		"name":  "board",
+		"token": "token",
+		"dials": []interface{}{"one"},
+	})
+
+	is.Equal(b.ID, ooohh.BoardID("board-id"))       // board id is correct.
+	is.Equal(b.Dials[0].ID, ooohh.DialID("dial-1")) // board dials are correct.
+}
+
+func TestCloneBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ooohh.Board{ //nolint:errcheck
+			ID:    "clone-id",
+			Name:  "clone",
+			Dials: []ooohh.Dial{{ID: "dial-1", Name: "one"}},
+		})
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	b, err := c.CloneBoard(context.TODO(), "board-id", "clone", "token")
+	is.NoErr(err) // board is cloned.
+
+	is.Equal(method, "POST")                     // correct method is used.
+	is.Equal(path, "/api/boards/board-id/clone") // correct path is used.
+	is.Equal(body, map[string]interface{}{       // correct body is sent.
+		"name":  "clone",
+		"token": "token",
+	})
+
+	is.Equal(b.ID, ooohh.BoardID("clone-id"))       // clone id is correct.
+	is.Equal(b.Dials[0].ID, ooohh.DialID("dial-1")) // clone dials are correct.
+}
+
+func TestGetBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Board{ //nolint:errcheck
+			ID:   "board-id",
+			Name: "board",
+			Dials: []ooohh.Dial{
+				{ID: "dial-1", Name: "one", Value: 10},
+				{ID: "dial-2", Name: "two", Value: 20},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	b, err := c.GetBoard(context.TODO(), ooohh.BoardID("board-id"))
+	is.NoErr(err) // board is retrieved.
+
+	is.Equal(method, "GET")                         // correct method is used.
+	is.Equal(path, "/api/boards/board-id")          // correct path is used.
+	is.Equal(b.ID, ooohh.BoardID("board-id"))       // board id is correct.
+	is.Equal(b.Name, "board")                       // board name is correct.
+	is.Equal(len(b.Dials), 2)                       // board dials are retrieved.
+	is.Equal(b.Dials[0].ID, ooohh.DialID("dial-1")) // first dial is correct.
+	is.Equal(b.Dials[1].Value, 20.0)                // second dial value is correct.
+}
+
+func TestSetBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Board{ID: "board-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetBoard(context.TODO(), ooohh.BoardID("board-id"), "token", []ooohh.DialID{"dial-1", "dial-2"})
+	is.NoErr(err) // board is set.
+
+	is.Equal(method, "PATCH")              // correct method is used.
+	is.Equal(path, "/api/boards/board-id") // correct path is used.
+	is.Equal(body, map[string]interface{}{ // correct body is sent.
+		"token": "token",
+		"dials": []interface{}{"dial-1", "dial-2"},
+	})
+}
+
+func TestSetBoardWithoutDialsClearsBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Board{ID: "board-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetBoard(context.TODO(), ooohh.BoardID("board-id"), "token", nil)
+	is.NoErr(err) // board is cleared.
+
+	is.Equal(method, "DELETE")                               // clear endpoint is used.
+	is.Equal(path, "/api/boards/board-id/dials")             // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token"}) // correct body is sent.
+}
+
+func TestSetBoardOwnsDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Board{ID: "board-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.SetBoardOwnsDials(context.TODO(), ooohh.BoardID("board-id"), "token", true)
+	is.NoErr(err) // board ownership is set.
+
+	is.Equal(method, "PATCH")              // correct method is used.
+	is.Equal(path, "/api/boards/board-id") // correct path is used.
+	is.Equal(body, map[string]interface{}{ // correct body is sent.
+		"token":      "token",
+		"owns_dials": true,
+	})
+}
+
+func TestFreezeBoard(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg       string
+		freeze    bool
+		expFrozen bool
+	}{{
+		msg:       "freeze",
+		freeze:    true,
+		expFrozen: true,
+	}, {
+		msg:       "unfreeze",
+		freeze:    false,
+		expFrozen: false,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Variables that will be set by the server.
+			var method, path string
+			var body map[string]interface{}
+
+			// Create a test server that mimics the API.
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+				json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(ooohh.Board{ID: "board-id"}) //nolint:errcheck
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL)
+
+			var err error
+			if tt.freeze {
+				err = c.FreezeBoard(context.TODO(), ooohh.BoardID("board-id"), "token")
+			} else {
+				err = c.UnfreezeBoard(context.TODO(), ooohh.BoardID("board-id"), "token")
+			}
+			is.NoErr(err) // board is frozen or unfrozen.
+
+			is.Equal(method, "PATCH")              // correct method is used.
+			is.Equal(path, "/api/boards/board-id") // correct path is used.
+			is.Equal(body, map[string]interface{}{ // correct body is sent.
+				"token":  "token",
+				"frozen": tt.expFrozen,
+			})
+		})
+	}
+}
+
+func TestDeleteBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Variables that will be set by the server.
+	var method, path string
+	var body map[string]interface{}
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	err := c.DeleteBoard(context.TODO(), ooohh.BoardID("board-id"), "token")
+	is.NoErr(err) // board is deleted.
+
+	is.Equal(method, "DELETE")                               // correct method is used.
+	is.Equal(path, "/api/boards/board-id")                   // correct path is used.
+	is.Equal(body, map[string]interface{}{"token": "token"}) // correct body is sent.
+}
+
+func TestClose(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a test server that mimics the API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ooohh.Dial{ID: "dial-id"}) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	// Make a request, so there is a connection to release.
+	_, err := c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.NoErr(err) // dial is retrieved.
+
+	is.NoErr(c.Close()) // close doesn't error.
+	is.NoErr(c.Close()) // close is safe to call twice.
+
+	// Check the client can still be used.
+	_, err = c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+	is.NoErr(err) // dial is retrieved after close.
+}
+
+func TestDialErrors(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		status int
+		title  string
+		call   func(c *client) error
+	}{{
+		msg:    "create dial error",
+		status: http.StatusInternalServerError,
+		title:  "Internal Server Error",
+		call: func(c *client) error {
+			_, err := c.CreateDial(context.TODO(), "dial", "token")
+			return err
+		},
+	}, {
+		msg:    "get dial not found",
+		status: http.StatusNotFound,
+		title:  "Not Found",
+		call: func(c *client) error {
+			_, err := c.GetDial(context.TODO(), ooohh.DialID("dial-id"))
+			return err
+		},
+	}, {
+		msg:    "set dial unauthorized",
+		status: http.StatusUnauthorized,
+		title:  "Unauthorized",
+		call: func(c *client) error {
+			return c.SetDial(context.TODO(), ooohh.DialID("dial-id"), "token", 10)
+		},
+	}, {
+		msg:    "verify dial token invalid",
+		status: http.StatusUnauthorized,
+		title:  "Unauthorized",
+		call: func(c *client) error {
+			return c.VerifyDialToken(context.TODO(), ooohh.DialID("dial-id"), "token")
+		},
+	}, {
+		msg:    "get board not found",
+		status: http.StatusNotFound,
+		title:  "Board not found",
+		call: func(c *client) error {
+			_, err := c.GetBoard(context.TODO(), ooohh.BoardID("board-id"))
+			return err
+		},
+	}, {
+		msg:    "set board unauthorized",
+		status: http.StatusUnauthorized,
+		title:  "Unauthorized",
+		call: func(c *client) error {
+			return c.SetBoard(context.TODO(), ooohh.BoardID("board-id"), "token", []ooohh.DialID{"dial-id"})
+		},
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a test server that returns a problem response.
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(map[string]interface{}{"title": tt.title, "detail": "detail"}) //nolint:errcheck
+			}))
+			defer srv.Close()
+
+			err := tt.call(NewClient(srv.URL))
+			is.True(err != nil)             // call errors.
+			is.Equal(err.Error(), tt.title) // error is the problem title.
+		})
+	}
+}
+
+func TestProblemCodes(t *testing.T) {
+
+	for _, tt := range []struct {
+		code     string
+		status   int
+		matches  []error
+		excludes []error
+	}{{
+		code:     "dial_not_found",
+		status:   http.StatusNotFound,
+		matches:  []error{ErrNotFound, ooohh.ErrDialNotFound},
+		excludes: []error{ooohh.ErrBoardNotFound, ErrValidation},
+	}, {
+		code:     "board_not_found",
+		status:   http.StatusNotFound,
+		matches:  []error{ErrNotFound, ooohh.ErrBoardNotFound},
+		excludes: []error{ooohh.ErrDialNotFound},
+	}, {
+		code:     "not_found",
+		status:   http.StatusNotFound,
+		matches:  []error{ErrNotFound},
+		excludes: []error{ooohh.ErrDialNotFound, ooohh.ErrBoardNotFound},
+	}, {
+		code:     "unauthorized",
+		status:   http.StatusUnauthorized,
+		matches:  []error{ooohh.ErrUnauthorized},
+		excludes: []error{ErrNotFound},
+	}, {
+		code:    "locked_out",
+		status:  http.StatusTooManyRequests,
+		matches: []error{ooohh.ErrLockedOut},
+	}, {
+		code:     "value_invalid",
+		status:   http.StatusBadRequest,
+		matches:  []error{ErrValidation, ooohh.ErrDialValueInvalid},
+		excludes: []error{ooohh.ErrDialColorInvalid},
+	}, {
+		code:    "validation_error",
+		status:  http.StatusBadRequest,
+		matches: []error{ErrValidation},
+	}, {
+		code:    "board_frozen",
+		status:  http.StatusConflict,
+		matches: []error{ooohh.ErrBoardFrozen},
+	}, {
+		code:     "internal_error",
+		status:   http.StatusInternalServerError,
+		excludes: []error{ErrNotFound, ErrValidation, ooohh.ErrUnauthorized},
+	}, {
+		code:     "",
+		status:   http.StatusNotFound,
+		excludes: []error{ErrNotFound},
+	}} {
+
+		t.Run(tt.code, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a test server that returns a problem response with the code.
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(map[string]interface{}{"title": "Title", "detail": "detail", "status": tt.status, "code": tt.code}) //nolint:errcheck
+			}))
+			defer srv.Close()
+
+			_, err := NewClient(srv.URL).GetDial(context.TODO(), ooohh.DialID("dial-id"))
+			is.True(err != nil) // call errors.
+
+			var problem *Problem
+			is.True(errors.As(err, &problem))   // error is a problem.
+			is.Equal(problem.Code, tt.code)     // problem code is surfaced.
+			is.Equal(problem.Status, tt.status) // problem status is surfaced.
+			is.Equal(problem.Detail, "detail")  // problem detail is surfaced.
+
+			for _, target := range tt.matches {
+				is.True(errors.Is(err, target)) // error matches the code's errors.
+			}
+			for _, target := range tt.excludes {
+				is.True(!errors.Is(err, target)) // error doesn't match other errors.
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/webhook"
 )
 
 // Service provides a mock ooohh.Service.
@@ -11,20 +13,107 @@ type Service struct {
 	CreateDialFn      func(ctx context.Context, name string, token string) (*ooohh.Dial, error)
 	CreateDialInvoked bool
 
+	CreateDialWithValueFn      func(ctx context.Context, name, token string, value float64) (*ooohh.Dial, error)
+	CreateDialWithValueInvoked bool
+
+	CreateDialFromFn      func(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error)
+	CreateDialFromInvoked bool
+
 	GetDialFn      func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error)
 	GetDialInvoked bool
 
+	EnsureDialFn      func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error)
+	EnsureDialInvoked bool
+
+	GetDialsFn      func(ctx context.Context, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error)
+	GetDialsInvoked bool
+
+	ListDialsFn      func(ctx context.Context, fn func(ooohh.Dial) error) error
+	ListDialsInvoked bool
+
+	PageDialsFn      func(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error)
+	PageDialsInvoked bool
+
 	SetDialFn      func(ctx context.Context, id ooohh.DialID, token string, value float64) error
 	SetDialInvoked bool
 
+	SetDialWithNoteFn      func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error
+	SetDialWithNoteInvoked bool
+
+	AdjustDialFn      func(ctx context.Context, id ooohh.DialID, token string, delta float64) error
+	AdjustDialInvoked bool
+
+	GetDialHistoryFn      func(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error)
+	GetDialHistoryInvoked bool
+
+	SetDialColorFn      func(ctx context.Context, id ooohh.DialID, token, color string) error
+	SetDialColorInvoked bool
+
+	SetDialGroupFn      func(ctx context.Context, id ooohh.DialID, token, group string) error
+	SetDialGroupInvoked bool
+
+	RenameDialFn      func(ctx context.Context, id ooohh.DialID, token, name string) error
+	RenameDialInvoked bool
+
+	SetDialTokenFn      func(ctx context.Context, id ooohh.DialID, token, newToken string) error
+	SetDialTokenInvoked bool
+
+	UpdateDialFn      func(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error)
+	UpdateDialInvoked bool
+
+	DeleteDialFn      func(ctx context.Context, id ooohh.DialID, token string) error
+	DeleteDialInvoked bool
+
+	VerifyDialTokenFn      func(ctx context.Context, id ooohh.DialID, token string) error
+	VerifyDialTokenInvoked bool
+
 	CreateBoardFn      func(ctx context.Context, name string, token string) (*ooohh.Board, error)
 	CreateBoardInvoked bool
 
+	CreateBoardWithDescriptionFn      func(ctx context.Context, name, token, description string) (*ooohh.Board, error)
+	CreateBoardWithDescriptionInvoked bool
+
+	CreateBoardWithDialsFn      func(ctx context.Context, name, token string, dials []string) (*ooohh.Board, error)
+	CreateBoardWithDialsInvoked bool
+
+	CloneBoardFn      func(ctx context.Context, id ooohh.BoardID, name, token string) (*ooohh.Board, error)
+	CloneBoardInvoked bool
+
 	GetBoardFn      func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error)
 	GetBoardInvoked bool
 
+	GetBoardDialIDsFn      func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error)
+	GetBoardDialIDsInvoked bool
+
+	ListBoardsByTokenFn      func(ctx context.Context, token string) ([]ooohh.Board, error)
+	ListBoardsByTokenInvoked bool
+
 	SetBoardFn      func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error
 	SetBoardInvoked bool
+
+	AddBoardDialFn      func(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error
+	AddBoardDialInvoked bool
+
+	SetBoardNameFn      func(ctx context.Context, id ooohh.BoardID, token, name string) error
+	SetBoardNameInvoked bool
+
+	SetBoardOwnsDialsFn      func(ctx context.Context, id ooohh.BoardID, token string, owns bool) error
+	SetBoardOwnsDialsInvoked bool
+
+	FreezeBoardFn      func(ctx context.Context, id ooohh.BoardID, token string) error
+	FreezeBoardInvoked bool
+
+	UnfreezeBoardFn      func(ctx context.Context, id ooohh.BoardID, token string) error
+	UnfreezeBoardInvoked bool
+
+	SetBoardDescriptionFn      func(ctx context.Context, id ooohh.BoardID, token, description string) error
+	SetBoardDescriptionInvoked bool
+
+	UpdateBoardFn      func(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error
+	UpdateBoardInvoked bool
+
+	DeleteBoardFn      func(ctx context.Context, id ooohh.BoardID, token string) error
+	DeleteBoardInvoked bool
 }
 
 // CreateDial will create the dial with the given name,
@@ -34,12 +123,54 @@ func (s *Service) CreateDial(ctx context.Context, name string, token string) (*o
 	return s.CreateDialFn(ctx, name, token)
 }
 
+// CreateDialWithValue is like CreateDial, but the dial is created with the
+// given value rather than zero, which is recorded in its history.
+func (s *Service) CreateDialWithValue(ctx context.Context, name, token string, value float64) (*ooohh.Dial, error) {
+	s.CreateDialWithValueInvoked = true
+	return s.CreateDialWithValueFn(ctx, name, token, value)
+}
+
+// CreateDialFrom is like CreateDial, but the dial is also created with the
+// given value, color and group, all at once, or not at all.
+func (s *Service) CreateDialFrom(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+	s.CreateDialFromInvoked = true
+	return s.CreateDialFromFn(ctx, nd)
+}
+
 // GetDial retrieves a dial by ID. Anyone can retrieve any dial with its ID.
 func (s *Service) GetDial(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
 	s.GetDialInvoked = true
 	return s.GetDialFn(ctx, id)
 }
 
+// EnsureDial retrieves the dial mapped to the given external ID, creating it
+// with the given name and token if there isn't one. Whether the dial was
+// created is also returned. The token must match that of an existing dial.
+func (s *Service) EnsureDial(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+	s.EnsureDialInvoked = true
+	return s.EnsureDialFn(ctx, externalID, name, token)
+}
+
+// GetDials retrieves many dials by ID. Dials that are not found are omitted.
+func (s *Service) GetDials(ctx context.Context, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+	s.GetDialsInvoked = true
+	return s.GetDialsFn(ctx, ids)
+}
+
+// ListDials calls fn with each dial, in ID order. Iteration stops at the
+// first error returned by fn, which is then returned.
+func (s *Service) ListDials(ctx context.Context, fn func(ooohh.Dial) error) error {
+	s.ListDialsInvoked = true
+	return s.ListDialsFn(ctx, fn)
+}
+
+// PageDials retrieves up to limit dials, in ID order, starting after the
+// given ID. The first page is retrieved with an empty ID.
+func (s *Service) PageDials(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
+	s.PageDialsInvoked = true
+	return s.PageDialsFn(ctx, after, limit)
+}
+
 // SetDial updates the dial value. It can be updated by anyone who knows
 // the original token it was created with.
 func (s *Service) SetDial(ctx context.Context, id ooohh.DialID, token string, value float64) error {
@@ -47,6 +178,79 @@ func (s *Service) SetDial(ctx context.Context, id ooohh.DialID, token string, va
 	return s.SetDialFn(ctx, id, token, value)
 }
 
+// SetDialWithNote is like SetDial, but also records the note against the
+// value in the dial's history.
+func (s *Service) SetDialWithNote(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+	s.SetDialWithNoteInvoked = true
+	return s.SetDialWithNoteFn(ctx, id, token, value, note)
+}
+
+// AdjustDial adds the delta to the dial value, clamping the result to the
+// value bounds.
+func (s *Service) AdjustDial(ctx context.Context, id ooohh.DialID, token string, delta float64) error {
+	s.AdjustDialInvoked = true
+	return s.AdjustDialFn(ctx, id, token, delta)
+}
+
+// GetDialHistory retrieves the values the dial has been set to since the
+// given time, oldest first. The zero time retrieves the whole history.
+func (s *Service) GetDialHistory(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+	s.GetDialHistoryInvoked = true
+	return s.GetDialHistoryFn(ctx, id, since)
+}
+
+// SetDialColor updates the dial color. It can be updated by anyone who knows
+// the original token it was created with.
+func (s *Service) SetDialColor(ctx context.Context, id ooohh.DialID, token, color string) error {
+	s.SetDialColorInvoked = true
+	return s.SetDialColorFn(ctx, id, token, color)
+}
+
+// SetDialGroup updates the group the dial is displayed in on boards. It can
+// be updated by anyone who knows the original token it was created with.
+func (s *Service) SetDialGroup(ctx context.Context, id ooohh.DialID, token, group string) error {
+	s.SetDialGroupInvoked = true
+	return s.SetDialGroupFn(ctx, id, token, group)
+}
+
+// RenameDial updates the dial name. It can be renamed by anyone who knows
+// the original token it was created with.
+func (s *Service) RenameDial(ctx context.Context, id ooohh.DialID, token, name string) error {
+	s.RenameDialInvoked = true
+	return s.RenameDialFn(ctx, id, token, name)
+}
+
+// SetDialToken replaces the token the dial is updated with. It can be
+// replaced by anyone who knows the dial's current token.
+func (s *Service) SetDialToken(ctx context.Context, id ooohh.DialID, token, newToken string) error {
+	s.SetDialTokenInvoked = true
+	return s.SetDialTokenFn(ctx, id, token, newToken)
+}
+
+// UpdateDial applies the update to the dial, all at once, so either all of
+// its changes are made, or none are. It can be updated by anyone who knows
+// the original token it was created with, or, if only its value is updated,
+// by whoever can set it. The updated dial is returned.
+func (s *Service) UpdateDial(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+	s.UpdateDialInvoked = true
+	return s.UpdateDialFn(ctx, id, token, u)
+}
+
+// DeleteDial removes the dial, and its history. It can be deleted by anyone
+// who knows the original token it was created with. Boards the dial is on
+// keep its ID, but skip it as they do any other missing dial.
+func (s *Service) DeleteDial(ctx context.Context, id ooohh.DialID, token string) error {
+	s.DeleteDialInvoked = true
+	return s.DeleteDialFn(ctx, id, token)
+}
+
+// VerifyDialToken checks the token is the one the dial was created with,
+// without changing the dial. Bad tokens count towards the dial's lockout.
+func (s *Service) VerifyDialToken(ctx context.Context, id ooohh.DialID, token string) error {
+	s.VerifyDialTokenInvoked = true
+	return s.VerifyDialTokenFn(ctx, id, token)
+}
+
 // CreateBoard will create a board with the given name,
 // and associate it to the specified token.
 func (s *Service) CreateBoard(ctx context.Context, name string, token string) (*ooohh.Board, error) {
@@ -54,12 +258,49 @@ func (s *Service) CreateBoard(ctx context.Context, name string, token string) (*
 	return s.CreateBoardFn(ctx, name, token)
 }
 
-// GetBoard retrieves a board by ID. Anyone can retrieve any board with its ID.
+// CreateBoardWithDescription is like CreateBoard, but the board is created
+// with the given description, together, or not at all.
+func (s *Service) CreateBoardWithDescription(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+	s.CreateBoardWithDescriptionInvoked = true
+	return s.CreateBoardWithDescriptionFn(ctx, name, token, description)
+}
+
+// CreateBoardWithDials will create a board with the given name, and a dial
+// with each of the given names on it, all associated to the specified token.
+// Either the board and all of its dials are created, or none are.
+func (s *Service) CreateBoardWithDials(ctx context.Context, name, token string, dials []string) (*ooohh.Board, error) {
+	s.CreateBoardWithDialsInvoked = true
+	return s.CreateBoardWithDialsFn(ctx, name, token, dials)
+}
+
+// CloneBoard creates a board with the given name and token, with the same
+// dials as the board with the given ID or code. The dials are shared with the
+// board, not copied, so stay live on both.
+func (s *Service) CloneBoard(ctx context.Context, id ooohh.BoardID, name, token string) (*ooohh.Board, error) {
+	s.CloneBoardInvoked = true
+	return s.CloneBoardFn(ctx, id, name, token)
+}
+
+// GetBoard retrieves a board by ID or code. Anyone can retrieve any board with its ID.
 func (s *Service) GetBoard(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
 	s.GetBoardInvoked = true
 	return s.GetBoardFn(ctx, id)
 }
 
+// GetBoardDialIDs retrieves the IDs of the dials stored against a board,
+// including any that no longer exist and so are skipped by GetBoard.
+func (s *Service) GetBoardDialIDs(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+	s.GetBoardDialIDsInvoked = true
+	return s.GetBoardDialIDsFn(ctx, id)
+}
+
+// ListBoardsByToken retrieves the boards created with the given token.
+// Only the IDs of the boards' dials are retrieved, not their values.
+func (s *Service) ListBoardsByToken(ctx context.Context, token string) ([]ooohh.Board, error) {
+	s.ListBoardsByTokenInvoked = true
+	return s.ListBoardsByTokenFn(ctx, token)
+}
+
 // SetBoard updates the dials associated with the board. It can be updated
 // by anyone who knows the original token it was created with.
 func (s *Service) SetBoard(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
@@ -67,33 +308,163 @@ func (s *Service) SetBoard(ctx context.Context, id ooohh.BoardID, token string,
 	return s.SetBoardFn(ctx, id, token, dials)
 }
 
+// AddBoardDial adds the dial to the end of the board's dials, unless it is
+// already on the board.
+func (s *Service) AddBoardDial(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error {
+	s.AddBoardDialInvoked = true
+	return s.AddBoardDialFn(ctx, id, token, dialID)
+}
+
+// SetBoardName renames the board. It can be renamed by anyone who knows
+// the original token it was created with.
+func (s *Service) SetBoardName(ctx context.Context, id ooohh.BoardID, token, name string) error {
+	s.SetBoardNameInvoked = true
+	return s.SetBoardNameFn(ctx, id, token, name)
+}
+
+// SetBoardOwnsDials sets whether the board owns its dials. The values of the
+// dials on a board that owns them can also be set with the board's token. It
+// can be set by anyone who knows the original token the board was created with.
+func (s *Service) SetBoardOwnsDials(ctx context.Context, id ooohh.BoardID, token string, owns bool) error {
+	s.SetBoardOwnsDialsInvoked = true
+	return s.SetBoardOwnsDialsFn(ctx, id, token, owns)
+}
+
+// FreezeBoard snapshots the values of the board's dials, and stops them being
+// set, until it is unfrozen. It can be frozen by anyone who knows the original
+// token the board was created with.
+func (s *Service) FreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	s.FreezeBoardInvoked = true
+	return s.FreezeBoardFn(ctx, id, token)
+}
+
+// UnfreezeBoard undoes FreezeBoard. It can be unfrozen by anyone who knows the
+// original token the board was created with.
+func (s *Service) UnfreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	s.UnfreezeBoardInvoked = true
+	return s.UnfreezeBoardFn(ctx, id, token)
+}
+
+// SetBoardDescription updates the board description. It can be updated by
+// anyone who knows the original token it was created with.
+func (s *Service) SetBoardDescription(ctx context.Context, id ooohh.BoardID, token, description string) error {
+	s.SetBoardDescriptionInvoked = true
+	return s.SetBoardDescriptionFn(ctx, id, token, description)
+}
+
+// UpdateBoard applies the update to the board, all at once, so either all of
+// its changes are made, or none are. It can be updated by anyone who knows
+// the original token it was created with.
+func (s *Service) UpdateBoard(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+	s.UpdateBoardInvoked = true
+	return s.UpdateBoardFn(ctx, id, token, u)
+}
+
+// DeleteBoard removes the board. It can be deleted by anyone who knows the
+// original token it was created with. The board's dials are not deleted, as
+// they may be on other boards.
+func (s *Service) DeleteBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	s.DeleteBoardInvoked = true
+	return s.DeleteBoardFn(ctx, id, token)
+}
+
 // Reset undoes the tracking of function invocations.
 func (s *Service) Reset() {
 	s.CreateDialInvoked = false
+	s.CreateDialWithValueInvoked = false
+	s.CreateDialFromInvoked = false
 	s.GetDialInvoked = false
+	s.EnsureDialInvoked = false
+	s.GetDialsInvoked = false
+	s.ListDialsInvoked = false
+	s.PageDialsInvoked = false
 	s.SetDialInvoked = false
+	s.SetDialWithNoteInvoked = false
+	s.AdjustDialInvoked = false
+	s.GetDialHistoryInvoked = false
+	s.SetDialColorInvoked = false
+	s.SetDialGroupInvoked = false
+	s.RenameDialInvoked = false
+	s.SetDialTokenInvoked = false
+	s.UpdateDialInvoked = false
+	s.DeleteDialInvoked = false
+	s.VerifyDialTokenInvoked = false
 	s.CreateBoardInvoked = false
+	s.CreateBoardWithDescriptionInvoked = false
+	s.CreateBoardWithDialsInvoked = false
+	s.CloneBoardInvoked = false
 	s.GetBoardInvoked = false
+	s.GetBoardDialIDsInvoked = false
+	s.ListBoardsByTokenInvoked = false
 	s.SetBoardInvoked = false
+	s.AddBoardDialInvoked = false
+	s.SetBoardNameInvoked = false
+	s.SetBoardOwnsDialsInvoked = false
+	s.FreezeBoardInvoked = false
+	s.UnfreezeBoardInvoked = false
+	s.SetBoardDescriptionInvoked = false
+	s.UpdateBoardInvoked = false
+	s.DeleteBoardInvoked = false
 }
 
 // SlackService provides a mock slack.Service.
 type SlackService struct {
-	SetDialValueFn      func(ctx context.Context, teamID, userID, userName string, value float64) error
+	SetDialValueFn      func(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error)
 	SetDialValueInvoked bool
 
-	GetDialFn      func(ctx context.Context, teamID, userID string) (*ooohh.Dial, error)
+	GetDialFn      func(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error)
 	GetDialInvoked bool
+
+	AddToBoardFn      func(ctx context.Context, teamID, userID, userName, boardID, boardToken string) error
+	AddToBoardInvoked bool
 }
 
-// SetDialValue updates the given user's dial value.
-func (s *SlackService) SetDialValue(ctx context.Context, teamID, userID, userName string, value float64) error {
+// SetDialValue updates the value of the given user's dial with the given
+// name, creating the dial if the user doesn't have one yet. The note is
+// optional.
+func (s *SlackService) SetDialValue(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
 	s.SetDialValueInvoked = true
-	return s.SetDialValueFn(ctx, teamID, userID, userName, value)
+	return s.SetDialValueFn(ctx, teamID, userID, userName, dialName, value, note)
 }
 
-// GetDial returns the dial for the given user.
-func (s *SlackService) GetDial(ctx context.Context, teamID, userID string) (*ooohh.Dial, error) {
+// GetDial returns the given user's dial with the given name.
+func (s *SlackService) GetDial(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error) {
 	s.GetDialInvoked = true
-	return s.GetDialFn(ctx, teamID, userID)
+	return s.GetDialFn(ctx, teamID, userID, dialName)
+}
+
+// AddToBoard adds the given user's dial to the board.
+func (s *SlackService) AddToBoard(ctx context.Context, teamID, userID, userName, boardID, boardToken string) error {
+	s.AddToBoardInvoked = true
+	return s.AddToBoardFn(ctx, teamID, userID, userName, boardID, boardToken)
+}
+
+// WebhookService provides a mock webhook.Service.
+type WebhookService struct {
+	AddWebhookFn      func(ctx context.Context, board ooohh.BoardID, token, url string) (*webhook.Webhook, error)
+	AddWebhookInvoked bool
+
+	ListWebhooksFn      func(ctx context.Context, board ooohh.BoardID, token string) ([]webhook.Webhook, error)
+	ListWebhooksInvoked bool
+
+	DeleteWebhookFn      func(ctx context.Context, board ooohh.BoardID, token, id string) error
+	DeleteWebhookInvoked bool
+}
+
+// AddWebhook registers the URL to receive the board whenever it changes.
+func (s *WebhookService) AddWebhook(ctx context.Context, board ooohh.BoardID, token, url string) (*webhook.Webhook, error) {
+	s.AddWebhookInvoked = true
+	return s.AddWebhookFn(ctx, board, token, url)
+}
+
+// ListWebhooks returns the board's webhooks.
+func (s *WebhookService) ListWebhooks(ctx context.Context, board ooohh.BoardID, token string) ([]webhook.Webhook, error) {
+	s.ListWebhooksInvoked = true
+	return s.ListWebhooksFn(ctx, board, token)
+}
+
+// DeleteWebhook removes the webhook from the board.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, board ooohh.BoardID, token, id string) error {
+	s.DeleteWebhookInvoked = true
+	return s.DeleteWebhookFn(ctx, board, token, id)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/dlmiddlecote/ooohh"
 	"github.com/dlmiddlecote/ooohh/pkg/slack"
+	"github.com/dlmiddlecote/ooohh/pkg/webhook"
 )
 
 func TestMockServiceIsOoohhService(t *testing.T) {
@@ -26,3 +27,12 @@ func TestMockSlackServiceIsSlackService(t *testing.T) {
 	_, ok := i.(slack.Service)
 	is.True(ok) // mock slack service is a slack service.
 }
+
+func TestMockWebhookServiceIsWebhookService(t *testing.T) {
+
+	is := is.New(t)
+
+	var i interface{} = &WebhookService{}
+	_, ok := i.(webhook.Service)
+	is.True(ok) // mock webhook service is a webhook service.
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+// Codec encodes the dials, boards and readings the service stores.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	// MsgpackCodec stores entities as msgpack. It is compact, and the default.
+	MsgpackCodec Codec = msgpackCodec{}
+	// JSONCodec stores entities as JSON, which is larger, but human-readable
+	// when inspecting the raw db.
+	JSONCodec Codec = jsonCodec{}
+)
+
+// CodecByName returns the codec with the given name, either "msgpack" or "json".
+func CodecByName(name string) (Codec, bool) {
+	switch name {
+	case "msgpack":
+		return MsgpackCodec, true
+	case "json":
+		return JSONCodec, true
+	default:
+		return nil, false
+	}
+}
+
+// decode unmarshals stored data into v, detecting which codec it was stored
+// with, so a db can contain a mix of formats.
+func decode(data []byte, v interface{}) error {
+	// msgpack never starts an encoded map or struct with '{', which is a
+	// positive fixint in msgpack.
+	if len(data) > 0 && data[0] == '{' {
+		return JSONCodec.Unmarshal(data, v)
+	}
+
+	return MsgpackCodec.Unmarshal(data, v)
+}
+
+type msgpackCodec struct{}
+
+// Marshal implements Codec.
+func (msgpackCodec) Marshal(v interface{}) ([]byte, error) {
+	return msgpack.Marshal(v)
+}
+
+// Unmarshal implements Codec.
+func (msgpackCodec) Unmarshal(data []byte, v interface{}) error {
+	return msgpack.Unmarshal(data, v)
+}
+
+type jsonCodec struct{}
+
+// jsonDial and jsonBoard store the tokens that are left out of the JSON
+// encodings of dials and boards.
+type jsonDial struct {
+	ooohh.Dial
+	Token string `json:"token"`
+}
+
+type jsonBoard struct {
+	ooohh.Board
+	Token string `json:"token"`
+}
+
+// Marshal implements Codec.
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case ooohh.Dial:
+		return json.Marshal(jsonDial{v, v.Token})
+	case ooohh.Board:
+		return json.Marshal(jsonBoard{v, v.Token})
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// Unmarshal implements Codec.
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	switch v := v.(type) {
+	case *ooohh.Dial:
+		var d jsonDial
+		if err := json.Unmarshal(data, &d); err != nil {
+			return err
+		}
+		*v = d.Dial
+		v.Token = d.Token
+		return nil
+	case *ooohh.Board:
+		var b jsonBoard
+		if err := json.Unmarshal(data, &b); err != nil {
+			return err
+		}
+		*v = b.Board
+		v.Token = b.Token
+		return nil
+	default:
+		return json.Unmarshal(data, v)
+	}
+}
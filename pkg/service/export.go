@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+// exportRecord is a line of an export. Its type says which of the other
+// fields are set.
+type exportRecord struct {
+	Type       string             `json:"type"`
+	Dial       *jsonDial          `json:"dial,omitempty"`
+	Board      *jsonBoard         `json:"board,omitempty"`
+	DialID     ooohh.DialID       `json:"dial_id,omitempty"`
+	Reading    *ooohh.DialReading `json:"reading,omitempty"`
+	ExternalID string             `json:"external_id,omitempty"`
+}
+
+// Export writes all of the service's dials, boards, dial history and external
+// IDs to w as newline-delimited JSON, from a consistent view of the store.
+// Tokens are exported as they are stored, so are hashed.
+func (s *service) Export(ctx context.Context, w io.Writer) error {
+
+	// start a read-only transaction
+	txn, err := s.store.Begin(false)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	enc := json.NewEncoder(w)
+
+	err = txn.ForEach("dials", func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var d ooohh.Dial
+		if err := decode(v, &d); err != nil {
+			return errors.Wrapf(err, "reading dial %s", k)
+		}
+
+		return enc.Encode(exportRecord{Type: "dial", Dial: &jsonDial{d, d.Token}})
+	})
+	if err != nil {
+		return errors.Wrap(err, "exporting dials")
+	}
+
+	err = txn.ForEach("boards", func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var b ooohh.Board
+		if err := decode(v, &b); err != nil {
+			return errors.Wrapf(err, "reading board %s", k)
+		}
+
+		return enc.Encode(exportRecord{Type: "board", Board: &jsonBoard{b, b.Token}})
+	})
+	if err != nil {
+		return errors.Wrap(err, "exporting boards")
+	}
+
+	err = txn.ForEach("dial_history", func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var r ooohh.DialReading
+		if err := decode(v, &r); err != nil {
+			return errors.Wrapf(err, "reading history %s", k)
+		}
+
+		// History keys are the dial ID, a separator, then the reading's time.
+		id := ooohh.DialID(k[:len(k)-9])
+
+		return enc.Encode(exportRecord{Type: "reading", DialID: id, Reading: &r})
+	})
+	if err != nil {
+		return errors.Wrap(err, "exporting history")
+	}
+
+	err = txn.ForEach("external_ids", func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return enc.Encode(exportRecord{Type: "external_id", ExternalID: string(k), DialID: ooohh.DialID(v)})
+	})
+	if err != nil {
+		return errors.Wrap(err, "exporting external ids")
+	}
+
+	return nil
+}
+
+// Import restores an export written by Export, in a single transaction, so
+// either all of it is restored or none of it is. Only empty stores can be
+// imported into, so existing data is never overwritten or mixed with an
+// export.
+func (s *service) Import(ctx context.Context, r io.Reader) error {
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	if txn.Count("dials") > 0 || txn.Count("boards") > 0 {
+		return errors.New("store is not empty")
+	}
+
+	dec := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var rec exportRecord
+		if err := dec.Decode(&rec); err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrapf(err, "reading record %d", line)
+		}
+
+		if err := s.importRecord(txn, rec); err != nil {
+			return errors.Wrapf(err, "importing record %d", line)
+		}
+	}
+
+	return txn.Commit()
+}
+
+// importRecord stores the exported record within the given transaction.
+func (s *service) importRecord(txn store.Tx, rec exportRecord) error {
+	switch {
+	case rec.Type == "dial" && rec.Dial != nil:
+		d := rec.Dial.Dial
+		d.Token = rec.Dial.Token
+
+		if v, err := s.codec.Marshal(d); err != nil {
+			return errors.Wrap(err, "marshalling dial")
+		} else if err := txn.Put("dials", []byte(d.ID), v); err != nil {
+			return errors.Wrap(err, "storing dial")
+		}
+
+		return nil
+
+	case rec.Type == "board" && rec.Board != nil:
+		b := rec.Board.Board
+		b.Token = rec.Board.Token
+
+		if err := indexBoardDials(txn, b.ID, nil, b.Dials); err != nil {
+			return err
+		}
+
+		if v, err := s.codec.Marshal(b); err != nil {
+			return errors.Wrap(err, "marshalling board")
+		} else if err := txn.Put("boards", []byte(b.ID), v); err != nil {
+			return errors.Wrap(err, "storing board")
+		}
+
+		// Boards stored before board codes were added don't have one.
+		if b.Code != "" {
+			if err := txn.Put("board_codes", []byte(b.Code), []byte(b.ID)); err != nil {
+				return errors.Wrap(err, "storing board code")
+			}
+		}
+
+		return nil
+
+	case rec.Type == "reading" && rec.Reading != nil && rec.DialID != "":
+		return addReading(txn, s.codec, rec.DialID, *rec.Reading)
+
+	case rec.Type == "external_id" && rec.ExternalID != "" && rec.DialID != "":
+		return errors.Wrap(txn.Put("external_ids", []byte(rec.ExternalID), []byte(rec.DialID)), "storing external id")
+
+	default:
+		return errors.Errorf("invalid %q record", rec.Type)
+	}
+}
@@ -0,0 +1,81 @@
+// Package lockout tracks failed token attempts, so that keys which have had
+// too many can be locked out for a while.
+package lockout
+
+import (
+	"sync"
+	"time"
+)
+
+// Lockout tracks failed token attempts per key, locking a key out once it
+// has had too many failures within the window.
+type Lockout struct {
+	attempts int
+	window   time.Duration
+
+	mu       sync.Mutex
+	failures map[string][]time.Time
+}
+
+// New returns a Lockout that locks keys out once they have had the given
+// number of failures within the window. Keys are never locked out if attempts
+// is less than 1.
+func New(attempts int, window time.Duration) *Lockout {
+	return &Lockout{
+		attempts: attempts,
+		window:   window,
+		failures: make(map[string][]time.Time),
+	}
+}
+
+// Locked reports whether the key is locked out at the given time.
+func (l *Lockout) Locked(key string, now time.Time) bool {
+	if l.attempts < 1 {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.prune(key, now)) >= l.attempts
+}
+
+// Fail records a failed attempt for the key at the given time.
+func (l *Lockout) Fail(key string, now time.Time) {
+	if l.attempts < 1 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.failures[key] = append(l.prune(key, now), now)
+}
+
+// Reset forgets the failed attempts for the key.
+func (l *Lockout) Reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.failures, key)
+}
+
+// prune drops the failures of the key that are outside the window, returning
+// those that remain. The lock must be held.
+func (l *Lockout) prune(key string, now time.Time) []time.Time {
+	failures := l.failures[key]
+
+	i := 0
+	for i < len(failures) && !failures[i].After(now.Add(-l.window)) {
+		i++
+	}
+	failures = failures[i:]
+
+	if len(failures) == 0 {
+		delete(l.failures, key)
+		return nil
+	}
+
+	l.failures[key] = failures
+	return failures
+}
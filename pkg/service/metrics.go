@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+// metricsService is an ooohh.Service that records the calls made to another
+// ooohh.Service.
+type metricsService struct {
+	next ooohh.Service
+
+	calls    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewMetricsService returns an ooohh.Service that calls the given service,
+// recording the number of calls to each method, by outcome, and how long they
+// took in the given registry.
+func NewMetricsService(next ooohh.Service, reg prometheus.Registerer) (*metricsService, error) {
+	m := &metricsService{
+		next: next,
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ooohh_service_calls_total",
+			Help: "Number of calls to the ooohh service, by method and outcome.",
+		}, []string{"method", "outcome"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "ooohh_service_call_duration_seconds",
+			Help:    "Time taken by calls to the ooohh service, by method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+
+	if err := reg.Register(m.calls); err != nil {
+		return nil, errors.Wrap(err, "registering calls metric")
+	}
+
+	if err := reg.Register(m.duration); err != nil {
+		return nil, errors.Wrap(err, "registering duration metric")
+	}
+
+	return m, nil
+}
+
+// track starts timing a call to the method. The returned function records the
+// call, and should be deferred with a pointer to the call's error.
+func (m *metricsService) track(method string) func(err *error) {
+	start := time.Now()
+	return func(err *error) {
+		outcome := "ok"
+		if *err != nil {
+			outcome = "error"
+		}
+
+		m.calls.WithLabelValues(method, outcome).Inc()
+		m.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	}
+}
+
+// CreateDial will create the dial with the given name,
+// and associate it to the specified token.
+func (m *metricsService) CreateDial(ctx context.Context, name, token string) (d *ooohh.Dial, err error) {
+	defer m.track("CreateDial")(&err)
+	return m.next.CreateDial(ctx, name, token)
+}
+
+// CreateDialWithValue will create the dial with the given name and value,
+// and associate it to the specified token.
+func (m *metricsService) CreateDialWithValue(ctx context.Context, name, token string, value float64) (d *ooohh.Dial, err error) {
+	defer m.track("CreateDialWithValue")(&err)
+	return m.next.CreateDialWithValue(ctx, name, token, value)
+}
+
+// CreateDialFrom will create the dial with the given name, value, color and
+// group, and associate it to the specified token.
+func (m *metricsService) CreateDialFrom(ctx context.Context, nd ooohh.NewDial) (d *ooohh.Dial, err error) {
+	defer m.track("CreateDialFrom")(&err)
+	return m.next.CreateDialFrom(ctx, nd)
+}
+
+// GetDial retrieves a dial by ID.
+func (m *metricsService) GetDial(ctx context.Context, id ooohh.DialID) (d *ooohh.Dial, err error) {
+	defer m.track("GetDial")(&err)
+	return m.next.GetDial(ctx, id)
+}
+
+// EnsureDial retrieves the dial mapped to the given external ID, creating it
+// if there isn't one.
+func (m *metricsService) EnsureDial(ctx context.Context, externalID, name, token string) (d *ooohh.Dial, created bool, err error) {
+	defer m.track("EnsureDial")(&err)
+	return m.next.EnsureDial(ctx, externalID, name, token)
+}
+
+// GetDials retrieves many dials by ID.
+func (m *metricsService) GetDials(ctx context.Context, ids []ooohh.DialID) (dials map[ooohh.DialID]ooohh.Dial, err error) {
+	defer m.track("GetDials")(&err)
+	return m.next.GetDials(ctx, ids)
+}
+
+// ListDials calls fn with each dial, in ID order.
+func (m *metricsService) ListDials(ctx context.Context, fn func(ooohh.Dial) error) (err error) {
+	defer m.track("ListDials")(&err)
+	return m.next.ListDials(ctx, fn)
+}
+
+// PageDials retrieves up to limit dials, in ID order, starting after the given ID.
+func (m *metricsService) PageDials(ctx context.Context, after ooohh.DialID, limit int) (p *ooohh.DialPage, err error) {
+	defer m.track("PageDials")(&err)
+	return m.next.PageDials(ctx, after, limit)
+}
+
+// SetDial updates the dial value.
+func (m *metricsService) SetDial(ctx context.Context, id ooohh.DialID, token string, value float64) (err error) {
+	defer m.track("SetDial")(&err)
+	return m.next.SetDial(ctx, id, token, value)
+}
+
+// SetDialWithNote updates the dial value, recording the note against it.
+func (m *metricsService) SetDialWithNote(ctx context.Context, id ooohh.DialID, token string, value float64, note string) (err error) {
+	defer m.track("SetDialWithNote")(&err)
+	return m.next.SetDialWithNote(ctx, id, token, value, note)
+}
+
+// AdjustDial adds the delta to the dial value.
+func (m *metricsService) AdjustDial(ctx context.Context, id ooohh.DialID, token string, delta float64) (err error) {
+	defer m.track("AdjustDial")(&err)
+	return m.next.AdjustDial(ctx, id, token, delta)
+}
+
+// GetDialHistory retrieves the values the dial has been set to since the given time.
+func (m *metricsService) GetDialHistory(ctx context.Context, id ooohh.DialID, since time.Time) (h []ooohh.DialReading, err error) {
+	defer m.track("GetDialHistory")(&err)
+	return m.next.GetDialHistory(ctx, id, since)
+}
+
+// SetDialColor updates the dial color.
+func (m *metricsService) SetDialColor(ctx context.Context, id ooohh.DialID, token, color string) (err error) {
+	defer m.track("SetDialColor")(&err)
+	return m.next.SetDialColor(ctx, id, token, color)
+}
+
+// SetDialGroup updates the group the dial is displayed in on boards.
+func (m *metricsService) SetDialGroup(ctx context.Context, id ooohh.DialID, token, group string) (err error) {
+	defer m.track("SetDialGroup")(&err)
+	return m.next.SetDialGroup(ctx, id, token, group)
+}
+
+// RenameDial updates the dial name.
+func (m *metricsService) RenameDial(ctx context.Context, id ooohh.DialID, token, name string) (err error) {
+	defer m.track("RenameDial")(&err)
+	return m.next.RenameDial(ctx, id, token, name)
+}
+
+// SetDialToken replaces the token the dial is updated with.
+func (m *metricsService) SetDialToken(ctx context.Context, id ooohh.DialID, token, newToken string) (err error) {
+	defer m.track("SetDialToken")(&err)
+	return m.next.SetDialToken(ctx, id, token, newToken)
+}
+
+// UpdateDial applies the update to the dial, all at once.
+func (m *metricsService) UpdateDial(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (d *ooohh.Dial, err error) {
+	defer m.track("UpdateDial")(&err)
+	return m.next.UpdateDial(ctx, id, token, u)
+}
+
+// DeleteDial removes the dial, and its history.
+func (m *metricsService) DeleteDial(ctx context.Context, id ooohh.DialID, token string) (err error) {
+	defer m.track("DeleteDial")(&err)
+	return m.next.DeleteDial(ctx, id, token)
+}
+
+// VerifyDialToken checks the token is the one the dial was created with.
+func (m *metricsService) VerifyDialToken(ctx context.Context, id ooohh.DialID, token string) (err error) {
+	defer m.track("VerifyDialToken")(&err)
+	return m.next.VerifyDialToken(ctx, id, token)
+}
+
+// CreateBoard will create a board with the given name,
+// and associate it to the specified token.
+func (m *metricsService) CreateBoard(ctx context.Context, name, token string) (b *ooohh.Board, err error) {
+	defer m.track("CreateBoard")(&err)
+	return m.next.CreateBoard(ctx, name, token)
+}
+
+// CreateBoardWithDescription will create a board with the given name and
+// description, and associate it to the specified token.
+func (m *metricsService) CreateBoardWithDescription(ctx context.Context, name, token, description string) (b *ooohh.Board, err error) {
+	defer m.track("CreateBoardWithDescription")(&err)
+	return m.next.CreateBoardWithDescription(ctx, name, token, description)
+}
+
+// CreateBoardWithDials will create a board with the given name, and a dial
+// with each of the given names on it.
+func (m *metricsService) CreateBoardWithDials(ctx context.Context, name, token string, dials []string) (b *ooohh.Board, err error) {
+	defer m.track("CreateBoardWithDials")(&err)
+	return m.next.CreateBoardWithDials(ctx, name, token, dials)
+}
+
+// CloneBoard creates a board with the given name and token, with the same
+// dials as the board with the given ID or code.
+func (m *metricsService) CloneBoard(ctx context.Context, id ooohh.BoardID, name, token string) (b *ooohh.Board, err error) {
+	defer m.track("CloneBoard")(&err)
+	return m.next.CloneBoard(ctx, id, name, token)
+}
+
+// GetBoard retrieves a board by ID or code.
+func (m *metricsService) GetBoard(ctx context.Context, id ooohh.BoardID) (b *ooohh.Board, err error) {
+	defer m.track("GetBoard")(&err)
+	return m.next.GetBoard(ctx, id)
+}
+
+// GetBoardDialIDs retrieves the IDs of the dials stored against a board.
+func (m *metricsService) GetBoardDialIDs(ctx context.Context, id ooohh.BoardID) (ids []ooohh.DialID, err error) {
+	defer m.track("GetBoardDialIDs")(&err)
+	return m.next.GetBoardDialIDs(ctx, id)
+}
+
+// ListBoardsByToken retrieves the boards created with the given token.
+func (m *metricsService) ListBoardsByToken(ctx context.Context, token string) (boards []ooohh.Board, err error) {
+	defer m.track("ListBoardsByToken")(&err)
+	return m.next.ListBoardsByToken(ctx, token)
+}
+
+// SetBoard updates the dials associated with the board.
+func (m *metricsService) SetBoard(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) (err error) {
+	defer m.track("SetBoard")(&err)
+	return m.next.SetBoard(ctx, id, token, dials)
+}
+
+// AddBoardDial adds the dial to the end of the board's dials.
+func (m *metricsService) AddBoardDial(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) (err error) {
+	defer m.track("AddBoardDial")(&err)
+	return m.next.AddBoardDial(ctx, id, token, dialID)
+}
+
+// SetBoardName renames the board.
+func (m *metricsService) SetBoardName(ctx context.Context, id ooohh.BoardID, token, name string) (err error) {
+	defer m.track("SetBoardName")(&err)
+	return m.next.SetBoardName(ctx, id, token, name)
+}
+
+// SetBoardOwnsDials sets whether the board owns its dials.
+func (m *metricsService) SetBoardOwnsDials(ctx context.Context, id ooohh.BoardID, token string, owns bool) (err error) {
+	defer m.track("SetBoardOwnsDials")(&err)
+	return m.next.SetBoardOwnsDials(ctx, id, token, owns)
+}
+
+// FreezeBoard snapshots the values of the board's dials, and stops them being set.
+func (m *metricsService) FreezeBoard(ctx context.Context, id ooohh.BoardID, token string) (err error) {
+	defer m.track("FreezeBoard")(&err)
+	return m.next.FreezeBoard(ctx, id, token)
+}
+
+// UnfreezeBoard undoes FreezeBoard.
+func (m *metricsService) UnfreezeBoard(ctx context.Context, id ooohh.BoardID, token string) (err error) {
+	defer m.track("UnfreezeBoard")(&err)
+	return m.next.UnfreezeBoard(ctx, id, token)
+}
+
+// SetBoardDescription updates the board description.
+func (m *metricsService) SetBoardDescription(ctx context.Context, id ooohh.BoardID, token, description string) (err error) {
+	defer m.track("SetBoardDescription")(&err)
+	return m.next.SetBoardDescription(ctx, id, token, description)
+}
+
+// UpdateBoard applies the update to the board, all at once.
+func (m *metricsService) UpdateBoard(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) (err error) {
+	defer m.track("UpdateBoard")(&err)
+	return m.next.UpdateBoard(ctx, id, token, u)
+}
+
+// DeleteBoard removes the board.
+func (m *metricsService) DeleteBoard(ctx context.Context, id ooohh.BoardID, token string) (err error) {
+	defer m.track("DeleteBoard")(&err)
+	return m.next.DeleteBoard(ctx, id, token)
+}
+
+// CountMetrics reports the number of dials and boards in a store.
+type CountMetrics struct {
+	logger *zap.SugaredLogger
+	st     store.Store
+
+	dials  prometheus.Gauge
+	boards prometheus.Gauge
+}
+
+// NewCountMetrics returns CountMetrics of the dials and boards in the given
+// store, registered in the given registry.
+func NewCountMetrics(logger *zap.SugaredLogger, st store.Store, reg prometheus.Registerer) (*CountMetrics, error) {
+	c := &CountMetrics{
+		logger: logger,
+		st:     st,
+		dials: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "ooohh_dials_total",
+			Help: "Number of dials.",
+		}),
+		boards: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "ooohh_boards_total",
+			Help: "Number of boards.",
+		}),
+	}
+
+	if err := reg.Register(c.dials); err != nil {
+		return nil, errors.Wrap(err, "registering dials metric")
+	}
+
+	if err := reg.Register(c.boards); err != nil {
+		return nil, errors.Wrap(err, "registering boards metric")
+	}
+
+	return c, nil
+}
+
+// Run updates the counts every interval until the context is cancelled.
+func (c *CountMetrics) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		if err := c.Update(); err != nil {
+			c.logger.Errorw("could not update count metrics", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Update counts the dials and boards in the store.
+func (c *CountMetrics) Update() error {
+
+	// start a read-only transaction
+	txn, err := c.st.Begin(false)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	c.dials.Set(float64(txn.Count("dials")))
+	c.boards.Set(float64(txn.Count("boards")))
+
+	return nil
+}
@@ -0,0 +1,1457 @@
+// Package postgres provides an ooohh.Service that keeps its data in Postgres,
+// so that many instances of ooohh can share it.
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/markbates/pkger"
+	"github.com/pkg/errors"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/service/lockout"
+)
+
+// maxBoardCodeAttempts is the number of times a board code is generated before giving up.
+const maxBoardCodeAttempts = 10
+
+// readOnly are the options of transactions that only read, which see a
+// consistent snapshot of the database.
+var readOnly = &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+
+type service struct {
+	db      *sql.DB
+	logger  *zap.SugaredLogger
+	now     func() time.Time
+	lockout *lockout.Lockout
+
+	// decay is how much dial values fall by per hour since they were last
+	// updated. Zero disables decay.
+	decay float64
+
+	// minValue and maxValue bound the values dials can be set to, inclusively.
+	minValue, maxValue float64
+}
+
+// Option configures the service.
+type Option func(*service)
+
+// WithLockout locks a dial out of updates, even with the correct token, once
+// the given number of bad token attempts have been made within the window.
+// Attempts are counted per instance of the service, rather than in the
+// database. Lockouts are disabled by default.
+func WithLockout(attempts int, window time.Duration) Option {
+	return func(s *service) {
+		s.lockout = lockout.New(attempts, window)
+	}
+}
+
+// WithDecay makes retrieved dial values fall towards zero by the
+// given amount per hour since they were last updated, without going below zero.
+// The stored value is only brought down to the decayed value when the dial is
+// next updated, which restarts the decay.
+// Decay is disabled by default.
+func WithDecay(perHour float64) Option {
+	return func(s *service) {
+		s.decay = perHour
+	}
+}
+
+// WithValueBounds sets the range, inclusive of both ends, that dial values must
+// be within. The range is 0 to 100 by default.
+func WithValueBounds(min, max float64) Option {
+	return func(s *service) {
+		s.minValue = min
+		s.maxValue = max
+	}
+}
+
+// NewService returns an ooohh.Service that keeps its data in the given
+// Postgres database, which must be opened with a Postgres driver. The tables
+// the service needs are created, if they don't already exist.
+func NewService(db *sql.DB, logger *zap.SugaredLogger, now func() time.Time, opts ...Option) (*service, error) {
+
+	s := &service{
+		db:       db,
+		logger:   logger,
+		now:      now,
+		lockout:  lockout.New(0, 0),
+		minValue: 0,
+		maxValue: 100,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	f, err := pkger.Open("/pkg/service/postgres/schema.sql")
+	if err != nil {
+		return nil, errors.Wrap(err, "opening schema")
+	}
+	defer f.Close() //nolint:errcheck
+
+	schema, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading schema")
+	}
+
+	if _, err := db.Exec(string(schema)); err != nil {
+		return nil, errors.Wrap(err, "applying schema")
+	}
+
+	return s, nil
+}
+
+// Ping checks that the database can be reached.
+func (s *service) Ping(ctx context.Context) error {
+	return errors.Wrap(s.db.PingContext(ctx), "pinging db")
+}
+
+// CreateDial will create the dial with the given name, and associate it to the specified token.
+func (s *service) CreateDial(ctx context.Context, name, token string) (*ooohh.Dial, error) {
+	return s.CreateDialFrom(ctx, ooohh.NewDial{Name: name, Token: token})
+}
+
+// CreateDialWithValue is like CreateDial, but the dial is created with the
+// given value rather than zero, which is recorded in its history. The dial is
+// created and its value set together, or not at all.
+func (s *service) CreateDialWithValue(ctx context.Context, name, token string, value float64) (*ooohh.Dial, error) {
+	return s.CreateDialFrom(ctx, ooohh.NewDial{Name: name, Token: token, Value: &value})
+}
+
+// CreateDialFrom is like CreateDial, but the dial is also created with the
+// given value, color and group, all at once, or not at all.
+func (s *service) CreateDialFrom(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+
+	// check value and color validity.
+	if nd.Value != nil && !s.validValue(*nd.Value) {
+		return nil, ooohh.ErrDialValueInvalid
+	}
+	if !ooohh.ValidColor(nd.Color) {
+		return nil, ooohh.ErrDialColorInvalid
+	}
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	d := ooohh.Dial{Name: nd.Name, Token: nd.Token, Color: nd.Color, Group: strings.TrimSpace(nd.Group)}
+	if nd.Value != nil {
+		d.Value = *nd.Value
+	}
+
+	created, err := s.createDial(ctx, tx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if nd.Value != nil {
+		err = addReading(ctx, tx, created.ID, ooohh.DialReading{
+			Value: created.Value,
+			At:    created.UpdatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return created, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// EnsureDial retrieves the dial mapped to the given external ID, creating it
+// with the given name and token if there isn't one. Whether the dial was
+// created is also returned. The token must match that of an existing dial,
+// and bad tokens count towards its lockout.
+func (s *service) EnsureDial(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// Lock the external ID until the transaction ends, so concurrent calls
+	// don't each create a dial for it.
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, externalID); err != nil {
+		return nil, false, errors.Wrap(err, "locking external id")
+	}
+
+	// Return the mapped dial, if it still exists.
+	row := tx.QueryRowContext(ctx, `SELECT `+dialColumns+` FROM dials WHERE id = (SELECT dial_id FROM external_ids WHERE external_id = $1)`, externalID)
+	if d, err := scanDial(row); err == nil {
+
+		// check for too many bad token attempts.
+		if s.lockout.Locked(string(d.ID), s.now()) {
+			return nil, false, ooohh.ErrLockedOut
+		}
+
+		// check token matches
+		if !ooohh.TokenMatches(d.Token, token) {
+			s.lockout.Fail(string(d.ID), s.now())
+			return nil, false, ooohh.ErrUnauthorized
+		}
+		s.lockout.Reset(string(d.ID))
+
+		d.Value = s.decayed(*d)
+
+		return d, false, nil
+	} else if err != sql.ErrNoRows {
+		return nil, false, errors.Wrap(err, "reading dial")
+	}
+
+	d, err := s.createDial(ctx, tx, ooohh.Dial{Name: name, Token: token})
+	if err != nil {
+		return nil, false, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO external_ids (external_id, dial_id) VALUES ($1, $2)
+		ON CONFLICT (external_id) DO UPDATE SET dial_id = EXCLUDED.dial_id`, externalID, d.ID)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "storing external id")
+	}
+
+	return d, true, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// GetDial retrieves a dial by ID. Anyone can retrieve any dial with its ID.
+func (s *service) GetDial(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+	d, err := getDial(ctx, s.db, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Value = s.decayed(*d)
+
+	return d, nil
+}
+
+// GetDials retrieves many dials by ID. Dials that are not found are omitted.
+func (s *service) GetDials(ctx context.Context, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+	return s.getDials(ctx, s.db, ids)
+}
+
+// ListDials calls fn with each dial, in ID order. Iteration stops at the
+// first error returned by fn, which is then returned.
+func (s *service) ListDials(ctx context.Context, fn func(ooohh.Dial) error) error {
+
+	rows, err := s.db.QueryContext(ctx, `SELECT `+dialColumns+` FROM dials ORDER BY id`)
+	if err != nil {
+		return errors.Wrap(err, "querying dials")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	// Read each dial as it is iterated, so they aren't all held in memory.
+	for rows.Next() {
+		d, err := scanDial(rows)
+		if err != nil {
+			return errors.Wrap(err, "reading dial")
+		}
+
+		d.Value = s.decayed(*d)
+
+		if err := fn(*d); err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrap(rows.Err(), "reading dials")
+}
+
+// PageDials retrieves up to limit dials, in ID order, starting after the
+// given ID. The first page is retrieved with an empty ID.
+func (s *service) PageDials(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
+
+	if limit < 1 {
+		return nil, errors.New("limit must be positive")
+	}
+
+	// start a read-only transaction, so the total is of the same dials the
+	// page is of.
+	tx, err := s.db.BeginTx(ctx, readOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	p := ooohh.DialPage{
+		Dials: make([]ooohh.Dial, 0, limit),
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT count(*) FROM dials`).Scan(&p.Total); err != nil {
+		return nil, errors.Wrap(err, "counting dials")
+	}
+
+	// Read one more dial than needed, to know whether there is a next page.
+	rows, err := tx.QueryContext(ctx, `SELECT `+dialColumns+` FROM dials WHERE id > $1 ORDER BY id LIMIT $2`, after, limit+1)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying dials")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		if len(p.Dials) == limit {
+			p.Next = p.Dials[limit-1].ID
+			break
+		}
+
+		d, err := scanDial(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading dial")
+		}
+
+		d.Value = s.decayed(*d)
+
+		p.Dials = append(p.Dials, *d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading dials")
+	}
+
+	return &p, nil
+}
+
+// SetDial updates the dial value. It can be updated by anyone who knows
+// the original token it was created with, or the token of a board it is
+// on that owns its dials, unless a board it is on is frozen.
+func (s *service) SetDial(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+	return s.SetDialWithNote(ctx, id, token, value, "")
+}
+
+// SetDialWithNote is like SetDial, but also records the note against the
+// value in the dial's history.
+func (s *service) SetDialWithNote(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Value: &value, Note: note})
+	return err
+}
+
+// AdjustDial adds the delta to the dial value, clamping the result to the
+// value bounds. The dial's row is locked while it is updated, so concurrent
+// adjustments aren't lost. It can be adjusted by whoever can set it.
+func (s *service) AdjustDial(ctx context.Context, id ooohh.DialID, token string, delta float64) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Delta: &delta})
+	return err
+}
+
+// GetDialHistory retrieves the values the dial has been set to since the
+// given time, oldest first. The zero time retrieves the whole history.
+func (s *service) GetDialHistory(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+
+	// start a read-only transaction
+	tx, err := s.db.BeginTx(ctx, readOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM dials WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return nil, errors.Wrap(err, "reading dial")
+	} else if !exists {
+		return nil, ooohh.ErrDialNotFound
+	}
+
+	// Readings at the same time are kept in the order they're added.
+	query := `SELECT value, note, at FROM dial_history WHERE dial_id = $1 ORDER BY at, seq`
+	args := []interface{}{id}
+	if !since.IsZero() {
+		query = `SELECT value, note, at FROM dial_history WHERE dial_id = $1 AND at >= $2 ORDER BY at, seq`
+		args = append(args, since.UTC())
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying dial history")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	readings := make([]ooohh.DialReading, 0)
+	for rows.Next() {
+		var r ooohh.DialReading
+		if err := rows.Scan(&r.Value, &r.Note, &r.At); err != nil {
+			return nil, errors.Wrap(err, "reading dial history")
+		}
+
+		// Update timezone.
+		r.At = r.At.UTC()
+
+		readings = append(readings, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading dial history")
+	}
+
+	return readings, nil
+}
+
+// SetDialColor updates the dial color. It can be updated by anyone who knows
+// the original token it was created with.
+func (s *service) SetDialColor(ctx context.Context, id ooohh.DialID, token, color string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Color: &color})
+	return err
+}
+
+// SetDialGroup updates the group the dial is displayed in on boards. It can
+// be updated by anyone who knows the original token it was created with.
+func (s *service) SetDialGroup(ctx context.Context, id ooohh.DialID, token, group string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Group: &group})
+	return err
+}
+
+// RenameDial updates the dial name. It can be renamed by anyone who knows
+// the original token it was created with.
+func (s *service) RenameDial(ctx context.Context, id ooohh.DialID, token, name string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Name: &name})
+	return err
+}
+
+// SetDialToken replaces the token the dial is updated with. It can be
+// replaced by anyone who knows the dial's current token.
+func (s *service) SetDialToken(ctx context.Context, id ooohh.DialID, token, newToken string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Token: &newToken})
+	return err
+}
+
+// UpdateDial applies the update to the dial, all at once, so either all of
+// its changes are made, or none are. It can be updated by anyone who knows
+// the original token it was created with, or, if only its value is updated,
+// by whoever can set it. The updated dial is returned.
+func (s *service) UpdateDial(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+
+	// check the update is valid, before anything is changed.
+	switch {
+	case u.Value != nil && u.Delta != nil:
+		return nil, ooohh.ErrDialValueInvalid
+	case u.Value != nil && !s.validValue(*u.Value):
+		return nil, ooohh.ErrDialValueInvalid
+	case u.Delta != nil && (math.IsNaN(*u.Delta) || math.IsInf(*u.Delta, 0)):
+		return nil, ooohh.ErrDialValueInvalid
+	case u.Color != nil && !ooohh.ValidColor(*u.Color):
+		return nil, ooohh.ErrDialColorInvalid
+	case u.Token != nil && *u.Token == "":
+		return nil, ooohh.ErrDialTokenInvalid
+	}
+
+	var updated ooohh.Dial
+	err := s.updateDial(ctx, id, token, u.Valued(), func(tx *sql.Tx, d *ooohh.Dial) error {
+
+		// Only the dial's value can be updated with a board's token.
+		if !u.ValueOnly() && !ooohh.TokenMatches(d.Token, token) {
+			return ooohh.ErrUnauthorized
+		}
+
+		if u.Name != nil {
+			d.Name = *u.Name
+		}
+		if u.Color != nil {
+			d.Color = *u.Color
+		}
+		if u.Group != nil {
+			d.Group = strings.TrimSpace(*u.Group)
+		}
+		if u.Value != nil {
+			d.Value = *u.Value
+		}
+		if u.Delta != nil {
+			d.Value = math.Max(s.minValue, math.Min(s.maxValue, d.Value+*u.Delta))
+		}
+		if u.Token != nil {
+			hashed, err := ooohh.HashToken(*u.Token)
+			if err != nil {
+				return errors.Wrap(err, "hashing token")
+			}
+			d.Token = hashed
+		}
+
+		if u.Valued() {
+			err := addReading(ctx, tx, id, ooohh.DialReading{
+				Value: d.Value,
+				Note:  strings.TrimSpace(u.Note),
+				At:    d.UpdatedAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		updated = *d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteDial removes the dial, and its history. It can be deleted by anyone
+// who knows the original token it was created with. Boards the dial is on
+// keep its ID, but skip it as they do any other missing dial.
+func (s *service) DeleteDial(ctx context.Context, id ooohh.DialID, token string) error {
+
+	// check for too many bad token attempts.
+	if s.lockout.Locked(string(id), s.now()) {
+		return ooohh.ErrLockedOut
+	}
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	d, err := getDial(ctx, tx, id, true)
+	if err != nil {
+		return err
+	}
+
+	// check token matches
+	if !ooohh.TokenMatches(d.Token, token) {
+		s.lockout.Fail(string(id), s.now())
+		return ooohh.ErrUnauthorized
+	}
+	s.lockout.Reset(string(id))
+
+	// The dial's history is deleted with it.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM dials WHERE id = $1`, id); err != nil {
+		return errors.Wrap(err, "deleting dial")
+	}
+
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// VerifyDialToken checks the token is the one the dial was created with,
+// without changing the dial. Bad tokens count towards the dial's lockout.
+func (s *service) VerifyDialToken(ctx context.Context, id ooohh.DialID, token string) error {
+
+	// check for too many bad token attempts.
+	if s.lockout.Locked(string(id), s.now()) {
+		return ooohh.ErrLockedOut
+	}
+
+	d, err := getDial(ctx, s.db, id, false)
+	if err != nil {
+		return err
+	}
+
+	// check token matches
+	if !ooohh.TokenMatches(d.Token, token) {
+		s.lockout.Fail(string(id), s.now())
+		return ooohh.ErrUnauthorized
+	}
+	s.lockout.Reset(string(id))
+
+	return nil
+}
+
+// updateDial applies the update to the dial, if the token matches the one the
+// dial was created with, and the dial isn't locked out. If value is set, the update is to the dial's value,
+// so the token of a board the dial is on, that owns its dials, also matches,
+// and it isn't applied while any board the dial is on is frozen. The dial's
+// row is locked, and the update is made within the transaction, after the
+// dial's update time is set.
+func (s *service) updateDial(ctx context.Context, id ooohh.DialID, token string, value bool, update func(tx *sql.Tx, d *ooohh.Dial) error) error {
+
+	// check for too many bad token attempts.
+	if s.lockout.Locked(string(id), s.now()) {
+		return ooohh.ErrLockedOut
+	}
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	d, err := getDial(ctx, tx, id, true)
+	if err != nil {
+		return err
+	}
+
+	// check token matches
+	owner := ooohh.TokenMatches(d.Token, token)
+	authorized := owner
+	if !authorized && value {
+		if authorized, err = ownsDial(ctx, tx, id, token); err != nil {
+			return err
+		}
+	}
+	if !authorized {
+		s.lockout.Fail(string(id), s.now())
+		return ooohh.ErrUnauthorized
+	}
+	s.lockout.Reset(string(id))
+
+	// check the value isn't frozen
+	if value {
+		if frozen, err := onFrozenBoard(ctx, tx, id); err != nil {
+			return err
+		} else if frozen {
+			return ooohh.ErrBoardFrozen
+		}
+	}
+
+	// Hash tokens stored before tokens were hashed, now the token is known.
+	if owner && !ooohh.TokenHashed(d.Token) {
+		if d.Token, err = ooohh.HashToken(token); err != nil {
+			return errors.Wrap(err, "hashing token")
+		}
+	}
+
+	// Updates start from the decayed value, as the decay restarts once the
+	// dial is updated, even if its value isn't.
+	d.Value = s.decayed(*d)
+
+	// Update dial
+	d.UpdatedAt = s.now().UTC()
+	if err := update(tx, d); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE dials SET token = $2, name = $3, value = $4, color = $5, "group" = $6, updated_at = $7
+		WHERE id = $1`, d.ID, d.Token, d.Name, d.Value, d.Color, d.Group, d.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, "storing dial")
+	}
+
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// CreateBoard will create a board with the given name, and associate it to the specified token.
+func (s *service) CreateBoard(ctx context.Context, name, token string) (*ooohh.Board, error) {
+	return s.CreateBoardWithDescription(ctx, name, token, "")
+}
+
+// CreateBoardWithDescription is like CreateBoard, but the board is created
+// with the given description, together, or not at all.
+func (s *service) CreateBoardWithDescription(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+
+	// check description validity.
+	if !ooohh.ValidDescription(description) {
+		return nil, ooohh.ErrBoardDescriptionInvalid
+	}
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	b, err := s.createBoard(ctx, tx, name, token, strings.TrimSpace(description), []ooohh.Dial{})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// CreateBoardWithDials will create a board with the given name, and a dial
+// with each of the given names on it, all associated to the specified token.
+// Either the board and all of its dials are created, or none are.
+func (s *service) CreateBoardWithDials(ctx context.Context, name, token string, dials []string) (*ooohh.Board, error) {
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	created := make([]ooohh.Dial, len(dials))
+	for i := range dials {
+		d, err := s.createDial(ctx, tx, ooohh.Dial{Name: dials[i], Token: token})
+		if err != nil {
+			return nil, err
+		}
+		created[i] = *d
+	}
+
+	b, err := s.createBoard(ctx, tx, name, token, "", created)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// CloneBoard creates a board with the given name and token, with the same
+// dials as the board with the given ID or code. The dials are shared with the
+// board, not copied, so stay live on both. Dials that no longer exist are kept
+// on the clone, as they are on the board.
+func (s *service) CloneBoard(ctx context.Context, id ooohh.BoardID, name, token string) (*ooohh.Board, error) {
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// Lock the board, so its dials don't change while they're cloned.
+	src, err := getBoard(ctx, tx, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := boardDials(ctx, tx, src.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the IDs of the dials are cloned, even from frozen boards.
+	ids := make([]ooohh.DialID, len(stored))
+	dials := make([]ooohh.Dial, len(stored))
+	for i := range stored {
+		ids[i] = stored[i].ID
+		dials[i] = ooohh.Dial{ID: stored[i].ID}
+	}
+
+	b, err := s.createBoard(ctx, tx, name, token, "", dials)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the clone with its dials populated, skipping missing dials, as
+	// GetBoard does.
+	found, err := s.getDials(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Dials = make([]ooohh.Dial, 0, len(found))
+	for _, id := range ids {
+		if d, ok := found[id]; ok {
+			b.Dials = append(b.Dials, d)
+		}
+	}
+
+	return b, errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// GetBoard retrieves a board by ID or code. Anyone can retrieve any board with its ID.
+func (s *service) GetBoard(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+
+	// start a read-only transaction, so the board is a consistent snapshot.
+	tx, err := s.db.BeginTx(ctx, readOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	b, err := getBoard(ctx, tx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := boardDials(ctx, tx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Frozen boards keep their own snapshot of their dials. Dials that no
+	// longer existed when the board was frozen weren't snapshotted.
+	dials := make([]ooohh.Dial, 0)
+	if b.Frozen {
+		for _, d := range stored {
+			if !d.UpdatedAt.IsZero() {
+				d.UpdatedAt = d.UpdatedAt.UTC()
+				dials = append(dials, d)
+			}
+		}
+
+		b.Dials = dials
+		return b, nil
+	}
+
+	ids := make([]ooohh.DialID, len(stored))
+	for i := range stored {
+		ids[i] = stored[i].ID
+	}
+
+	found, err := s.getDials(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		d, ok := found[id]
+		if !ok {
+			s.logger.Errorw("GetDial error", "id", id, "board", b.ID, "err", ooohh.ErrDialNotFound)
+			continue
+		}
+		dials = append(dials, d)
+	}
+
+	// Associate populated dials to board.
+	b.Dials = dials
+
+	return b, nil
+}
+
+// GetBoardDialIDs retrieves the IDs of the dials stored against a board,
+// including any that no longer exist and so are skipped by GetBoard.
+func (s *service) GetBoardDialIDs(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+
+	// start a read-only transaction
+	tx, err := s.db.BeginTx(ctx, readOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	b, err := getBoard(ctx, tx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dials, err := boardDials(ctx, tx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]ooohh.DialID, len(dials))
+	for i := range dials {
+		ids[i] = dials[i].ID
+	}
+
+	return ids, nil
+}
+
+// ListBoardsByToken retrieves the boards created with the given token.
+// Only the IDs of the boards' dials are retrieved, not their values.
+func (s *service) ListBoardsByToken(ctx context.Context, token string) ([]ooohh.Board, error) {
+
+	// start a read-only transaction
+	tx, err := s.db.BeginTx(ctx, readOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// Tokens are hashed with their own salt, so can only be matched by
+	// checking each board's.
+	rows, err := tx.QueryContext(ctx, `SELECT `+boardColumns+` FROM boards ORDER BY id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying boards")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	boards := make([]ooohh.Board, 0)
+	for rows.Next() {
+		b, err := scanBoard(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading board")
+		}
+
+		if ooohh.TokenMatches(b.Token, token) {
+			boards = append(boards, *b)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading boards")
+	}
+
+	// The boards' dials are read once the boards have been, as a transaction
+	// can only run one query at a time.
+	for i := range boards {
+		dials, err := boardDials(ctx, tx, boards[i].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		boards[i].Dials = make([]ooohh.Dial, len(dials))
+		for j := range dials {
+			boards[i].Dials[j] = ooohh.Dial{ID: dials[j].ID}
+		}
+	}
+
+	return boards, nil
+}
+
+// SetBoard updates the dials associated with the board. It can be updated
+// by anyone who knows the original token it was created with, unless the
+// board is frozen.
+func (s *service) SetBoard(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Dials: &dials})
+}
+
+// AddBoardDial adds the dial to the end of the board's dials, unless it is
+// already on the board. The dial is added within the board's update, so
+// concurrent additions to the board aren't lost. It can be added by anyone
+// who knows the original token the board was created with, unless the board
+// is frozen.
+func (s *service) AddBoardDial(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error {
+	return s.updateBoard(ctx, id, token, func(tx *sql.Tx, b *ooohh.Board) error {
+		if b.Frozen {
+			return ooohh.ErrBoardFrozen
+		}
+
+		for _, d := range b.Dials {
+			if d.ID == dialID {
+				return nil
+			}
+		}
+
+		b.Dials = append(b.Dials, ooohh.Dial{ID: dialID})
+		return nil
+	})
+}
+
+// SetBoardName renames the board. It can be renamed by anyone who knows
+// the original token it was created with.
+func (s *service) SetBoardName(ctx context.Context, id ooohh.BoardID, token, name string) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Name: &name})
+}
+
+// SetBoardOwnsDials sets whether the board owns its dials. The values of the
+// dials on a board that owns them can also be set with the board's token. It
+// can be set by anyone who knows the original token the board was created with.
+func (s *service) SetBoardOwnsDials(ctx context.Context, id ooohh.BoardID, token string, owns bool) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{OwnsDials: &owns})
+}
+
+// FreezeBoard snapshots the values of the board's dials, which the board is
+// then retrieved with, and stops the dials' values being set, until it is
+// unfrozen. Freezing a frozen board keeps its original snapshot. It can be
+// frozen by anyone who knows the original token the board was created with.
+func (s *service) FreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	frozen := true
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Frozen: &frozen})
+}
+
+// UnfreezeBoard undoes FreezeBoard, so the board is retrieved with the live
+// values of its dials again. It can be unfrozen by anyone who knows the
+// original token the board was created with.
+func (s *service) UnfreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	frozen := false
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Frozen: &frozen})
+}
+
+// SetBoardDescription updates the board description. It can be updated by
+// anyone who knows the original token it was created with.
+func (s *service) SetBoardDescription(ctx context.Context, id ooohh.BoardID, token, description string) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Description: &description})
+}
+
+// UpdateBoard applies the update to the board, all at once, so either all of
+// its changes are made, or none are. It can be updated by anyone who knows
+// the original token it was created with.
+func (s *service) UpdateBoard(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+
+	// check description validity.
+	if u.Description != nil && !ooohh.ValidDescription(*u.Description) {
+		return ooohh.ErrBoardDescriptionInvalid
+	}
+
+	return s.updateBoard(ctx, id, token, func(tx *sql.Tx, b *ooohh.Board) error {
+
+		// Unfreeze first, so the dials can be changed along with it.
+		// Only the IDs of the dials are stored against unfrozen boards.
+		if u.Frozen != nil && !*u.Frozen {
+			for i := range b.Dials {
+				b.Dials[i] = ooohh.Dial{ID: b.Dials[i].ID}
+			}
+			b.Frozen = false
+		}
+
+		if u.Name != nil {
+			b.Name = *u.Name
+		}
+		if u.Description != nil {
+			b.Description = strings.TrimSpace(*u.Description)
+		}
+		if u.OwnsDials != nil {
+			b.OwnsDials = *u.OwnsDials
+		}
+
+		if u.Dials != nil {
+			if b.Frozen {
+				return ooohh.ErrBoardFrozen
+			}
+
+			// Populate minimal dial.
+			// Value not stored on set.
+			b.Dials = make([]ooohh.Dial, len(*u.Dials))
+			for i, id := range *u.Dials {
+				b.Dials[i] = ooohh.Dial{ID: id}
+			}
+		}
+
+		// Freeze last, so the snapshot has the changed dials. Freezing a
+		// frozen board keeps its original snapshot.
+		if u.Frozen != nil && *u.Frozen && !b.Frozen {
+			ids := make([]ooohh.DialID, len(b.Dials))
+			for i := range b.Dials {
+				ids[i] = b.Dials[i].ID
+			}
+
+			dials, err := s.getDials(ctx, tx, ids)
+			if err != nil {
+				return err
+			}
+
+			// Snapshot the dials, without their tokens. Dials that aren't found
+			// keep only their ID.
+			for i := range b.Dials {
+				if d, ok := dials[b.Dials[i].ID]; ok {
+					d.Token = ""
+					b.Dials[i] = d
+				}
+			}
+
+			b.Frozen = true
+		}
+
+		return nil
+	})
+}
+
+// DeleteBoard removes the board. It can be deleted by anyone who knows the
+// original token it was created with. The board's dials are not deleted, as
+// they may be on other boards.
+func (s *service) DeleteBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	b, err := getBoard(ctx, tx, id, true)
+	if err != nil {
+		return err
+	}
+
+	// Check token matches
+	if !ooohh.TokenMatches(b.Token, token) {
+		return ooohh.ErrUnauthorized
+	}
+
+	// Only the board's rows in board_dials are deleted with it, not its dials.
+	if _, err := tx.ExecContext(ctx, `DELETE FROM boards WHERE id = $1`, b.ID); err != nil {
+		return errors.Wrap(err, "deleting board")
+	}
+
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// updateBoard applies the update to the board, if the token matches the one
+// the board was created with. The board's row is locked, and the update is
+// made within the transaction.
+func (s *service) updateBoard(ctx context.Context, id ooohh.BoardID, token string, update func(tx *sql.Tx, b *ooohh.Board) error) error {
+
+	// start read/write transaction
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	b, err := getBoard(ctx, tx, id, true)
+	if err != nil {
+		return err
+	}
+
+	// Check token matches
+	if !ooohh.TokenMatches(b.Token, token) {
+		return ooohh.ErrUnauthorized
+	}
+
+	// Hash tokens stored before tokens were hashed, now the token is known.
+	if !ooohh.TokenHashed(b.Token) {
+		if b.Token, err = ooohh.HashToken(token); err != nil {
+			return errors.Wrap(err, "hashing token")
+		}
+	}
+
+	if b.Dials, err = boardDials(ctx, tx, b.ID); err != nil {
+		return err
+	}
+
+	// Update board
+	if err := update(tx, b); err != nil {
+		return err
+	}
+	b.UpdatedAt = s.now().UTC()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE boards SET token = $2, name = $3, description = $4, owns_dials = $5, frozen = $6, updated_at = $7
+		WHERE id = $1`, b.ID, b.Token, b.Name, b.Description, b.OwnsDials, b.Frozen, b.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, "storing board")
+	}
+
+	if err := storeBoardDials(ctx, tx, b.ID, b.Dials); err != nil {
+		return err
+	}
+
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// createBoard stores a new board with the given name, token and dials within
+// the given transaction. Only the IDs of the dials are stored against the board.
+func (s *service) createBoard(ctx context.Context, tx *sql.Tx, name, token, description string, dials []ooohh.Dial) (*ooohh.Board, error) {
+
+	// generate new id
+	id := ooohh.BoardID(ksuid.New().String())
+
+	// generate a short code, regenerating on collision.
+	var code string
+	for i := 0; ; i++ {
+		if i == maxBoardCodeAttempts {
+			return nil, errors.New("could not generate unique board code")
+		}
+
+		var err error
+		if code, err = generateBoardCode(); err != nil {
+			return nil, errors.Wrap(err, "generating board code")
+		}
+
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM boards WHERE code = $1)`, code).Scan(&exists); err != nil {
+			return nil, errors.Wrap(err, "reading board code")
+		} else if !exists {
+			break
+		}
+	}
+
+	// Populate minimal dials to store.
+	ids := make([]ooohh.Dial, len(dials))
+	for i := range dials {
+		ids[i] = ooohh.Dial{ID: dials[i].ID}
+	}
+
+	b := ooohh.Board{
+		ID:          id,
+		Code:        code,
+		Token:       token,
+		Name:        name,
+		Description: description,
+		Dials:       ids,
+		UpdatedAt:   s.now().UTC(),
+	}
+
+	// Store the token hashed, but return the board with the token itself.
+	hashed, err := ooohh.HashToken(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "hashing token")
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO boards (`+boardColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		b.ID, b.Code, hashed, b.Name, b.Description, b.OwnsDials, b.Frozen, b.UpdatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "storing board")
+	}
+
+	if err := storeBoardDials(ctx, tx, id, ids); err != nil {
+		return nil, err
+	}
+
+	// Return the board with its full dials.
+	b.Dials = dials
+
+	return &b, nil
+}
+
+// createDial stores the new dial within the given transaction, with a new ID
+// and update time.
+func (s *service) createDial(ctx context.Context, tx *sql.Tx, d ooohh.Dial) (*ooohh.Dial, error) {
+
+	// generate new id
+	id := ooohh.DialID(ksuid.New().String())
+
+	d.ID = id
+	d.UpdatedAt = s.now().UTC()
+
+	// Store the token hashed, but return the dial with the token itself.
+	hashed, err := ooohh.HashToken(d.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "hashing token")
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO dials (`+dialColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		d.ID, hashed, d.Name, d.Value, d.Color, d.Group, d.UpdatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "storing dial")
+	}
+
+	return &d, nil
+}
+
+// querier is a *sql.DB or a *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// scanner is a *sql.Row or a *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// dialColumns are the columns of the dials table, in the order scanDial reads them.
+const dialColumns = `id, token, name, value, color, "group", updated_at`
+
+// scanDial reads a dial from the row, which has the dialColumns.
+func scanDial(row scanner) (*ooohh.Dial, error) {
+	var d ooohh.Dial
+	if err := row.Scan(&d.ID, &d.Token, &d.Name, &d.Value, &d.Color, &d.Group, &d.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	// Update timezone.
+	d.UpdatedAt = d.UpdatedAt.UTC()
+
+	return &d, nil
+}
+
+// getDial reads the dial, locking its row until the end of the transaction if
+// lock is set.
+func getDial(ctx context.Context, q querier, id ooohh.DialID, lock bool) (*ooohh.Dial, error) {
+	query := `SELECT ` + dialColumns + ` FROM dials WHERE id = $1`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	d, err := scanDial(q.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ooohh.ErrDialNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "reading dial")
+	}
+
+	return d, nil
+}
+
+// getDials reads the dials with the given IDs, with their values decayed.
+// Dials that are not found are omitted.
+func (s *service) getDials(ctx context.Context, q querier, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+	dials := make(map[ooohh.DialID]ooohh.Dial, len(ids))
+	if len(ids) == 0 {
+		return dials, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i := range ids {
+		args[i] = ids[i]
+	}
+
+	rows, err := q.QueryContext(ctx, `SELECT `+dialColumns+` FROM dials WHERE id IN (`+placeholders(len(ids))+`)`, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying dials")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		d, err := scanDial(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading dial")
+		}
+
+		d.Value = s.decayed(*d)
+
+		dials[d.ID] = *d
+	}
+
+	return dials, errors.Wrap(rows.Err(), "reading dials")
+}
+
+// addReading stores the reading in the dial's history.
+func addReading(ctx context.Context, q querier, id ooohh.DialID, r ooohh.DialReading) error {
+	_, err := q.ExecContext(ctx, `INSERT INTO dial_history (dial_id, value, note, at) VALUES ($1, $2, $3, $4)`,
+		id, r.Value, r.Note, r.At.UTC())
+
+	return errors.Wrap(err, "storing reading")
+}
+
+// ownsDial reports whether the token is that of a board the dial is on, that
+// owns its dials.
+func ownsDial(ctx context.Context, q querier, id ooohh.DialID, token string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT DISTINCT b.token FROM boards b JOIN board_dials bd ON bd.board_id = b.id
+		WHERE bd.dial_id = $1 AND b.owns_dials`, id)
+	if err != nil {
+		return false, errors.Wrap(err, "querying dial boards")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		var stored string
+		if err := rows.Scan(&stored); err != nil {
+			return false, errors.Wrap(err, "reading board")
+		}
+
+		if ooohh.TokenMatches(stored, token) {
+			return true, nil
+		}
+	}
+
+	return false, errors.Wrap(rows.Err(), "reading dial boards")
+}
+
+// onFrozenBoard reports whether the dial is on a frozen board.
+func onFrozenBoard(ctx context.Context, q querier, id ooohh.DialID) (bool, error) {
+	var frozen bool
+	err := q.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM boards b JOIN board_dials bd ON bd.board_id = b.id
+			WHERE bd.dial_id = $1 AND b.frozen
+		)`, id).Scan(&frozen)
+
+	return frozen, errors.Wrap(err, "reading dial boards")
+}
+
+// boardColumns are the columns of the boards table, in the order scanBoard reads them.
+const boardColumns = `id, code, token, name, description, owns_dials, frozen, updated_at`
+
+// scanBoard reads a board, without its dials, from the row, which has the boardColumns.
+func scanBoard(row scanner) (*ooohh.Board, error) {
+	var b ooohh.Board
+	if err := row.Scan(&b.ID, &b.Code, &b.Token, &b.Name, &b.Description, &b.OwnsDials, &b.Frozen, &b.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	// Update timezone.
+	b.UpdatedAt = b.UpdatedAt.UTC()
+
+	return &b, nil
+}
+
+// getBoard reads the board with the given ID or code, without its dials,
+// locking its row until the end of the transaction if lock is set.
+func getBoard(ctx context.Context, q querier, id ooohh.BoardID, lock bool) (*ooohh.Board, error) {
+	query := `SELECT ` + boardColumns + ` FROM boards WHERE id = $1 OR code = $2`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	b, err := scanBoard(q.QueryRowContext(ctx, query, id, strings.ToUpper(string(id))))
+	if err == sql.ErrNoRows {
+		return nil, ooohh.ErrBoardNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "reading board")
+	}
+
+	return b, nil
+}
+
+// boardDials reads the dials stored against the board, in board order. Only
+// their IDs are stored, unless the board is frozen, when the dials that were
+// snapshotted are stored whole.
+func boardDials(ctx context.Context, q querier, id ooohh.BoardID) ([]ooohh.Dial, error) {
+	rows, err := q.QueryContext(ctx, `SELECT dial_id, snapshot FROM board_dials WHERE board_id = $1 ORDER BY position`, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying board dials")
+	}
+	defer rows.Close() //nolint:errcheck
+
+	dials := make([]ooohh.Dial, 0)
+	for rows.Next() {
+		var d ooohh.Dial
+		var snapshot []byte
+		if err := rows.Scan(&d.ID, &snapshot); err != nil {
+			return nil, errors.Wrap(err, "reading board dial")
+		}
+
+		if snapshot != nil {
+			if err := json.Unmarshal(snapshot, &d); err != nil {
+				return nil, errors.Wrapf(err, "reading board dial %s snapshot", d.ID)
+			}
+		}
+
+		dials = append(dials, d)
+	}
+
+	return dials, errors.Wrap(rows.Err(), "reading board dials")
+}
+
+// storeBoardDials replaces the dials stored against the board. Dials with an
+// update time are snapshots, and are stored whole.
+func storeBoardDials(ctx context.Context, tx *sql.Tx, id ooohh.BoardID, dials []ooohh.Dial) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM board_dials WHERE board_id = $1`, id); err != nil {
+		return errors.Wrap(err, "deleting board dials")
+	}
+
+	for i, d := range dials {
+		var snapshot interface{}
+		if !d.UpdatedAt.IsZero() {
+			v, err := json.Marshal(d)
+			if err != nil {
+				return errors.Wrapf(err, "marshalling board dial %s snapshot", d.ID)
+			}
+			snapshot = string(v)
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO board_dials (board_id, position, dial_id, snapshot) VALUES ($1, $2, $3, $4)`,
+			id, i, d.ID, snapshot)
+		if err != nil {
+			return errors.Wrap(err, "storing board dial")
+		}
+	}
+
+	return nil
+}
+
+// placeholders returns n comma separated query placeholders, numbered from 1.
+func placeholders(n int) string {
+	p := make([]string, n)
+	for i := range p {
+		p[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	return strings.Join(p, ", ")
+}
+
+// validValue reports whether the value is a number within the value bounds.
+func (s *service) validValue(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0) && value >= s.minValue && value <= s.maxValue
+}
+
+// decayed returns the dial's value after it has decayed since it was last updated.
+func (s *service) decayed(d ooohh.Dial) float64 {
+	if s.decay <= 0 || d.Value <= 0 {
+		return d.Value
+	}
+
+	elapsed := s.now().Sub(d.UpdatedAt).Hours()
+	if elapsed <= 0 {
+		return d.Value
+	}
+
+	return math.Max(d.Value-s.decay*elapsed, 0)
+}
+
+// generateBoardCode returns a random, 6 character base32 code.
+func generateBoardCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.EncodeToString(b)[:6], nil
+}
@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/matryer/is"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/service/servicetest"
+)
+
+// newTestLogger returns a logger usable in tests, and also a struct that captures log lines
+// logged via the returned logger. It is possible to change the returned loggers level with the
+// available level argument.
+func newTestLogger(level zapcore.LevelEnabler) (*zap.SugaredLogger, *observer.ObservedLogs) {
+	core, recorded := observer.New(level)
+	return zap.New(core).Sugar(), recorded
+}
+
+// newTestDB returns the Postgres database named by the POSTGRES_DSN
+// environment variable, emptied of any data. The test is skipped if there
+// isn't one, or if the driver, named by POSTGRES_DRIVER and "postgres" by
+// default, isn't linked into the test binary. It returns a function that
+// should be called to close the db.
+func newTestDB(t *testing.T) (*sql.DB, func()) {
+	dsn := os.Getenv("POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_DSN is not set")
+	}
+
+	driver := os.Getenv("POSTGRES_DRIVER")
+	if driver == "" {
+		driver = "postgres"
+	}
+
+	registered := false
+	for _, d := range sql.Drivers() {
+		registered = registered || d == driver
+	}
+	if !registered {
+		t.Skipf("%s driver is not linked in", driver)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db, func() {
+		db.Close() //nolint:errcheck
+	}
+}
+
+func TestPostgresServiceIsOoohhService(t *testing.T) {
+
+	is := is.New(t)
+
+	var i interface{} = &service{}
+	_, ok := i.(ooohh.Service)
+	is.True(ok) // postgres service is ooohh service.
+}
+
+func TestPlaceholders(t *testing.T) {
+
+	is := is.New(t)
+
+	is.Equal(placeholders(1), "$1")         // single placeholder.
+	is.Equal(placeholders(3), "$1, $2, $3") // placeholders are numbered.
+}
+
+func TestServiceBehavesLikeBoltService(t *testing.T) {
+
+	servicetest.Run(t, func(t *testing.T) (ooohh.Service, func()) {
+
+		// Each behavior starts from an empty db.
+		return newTestService(t, func() time.Time { return servicetest.Now })
+	})
+}
+
+func TestSchemaCanBeAppliedAgain(t *testing.T) {
+
+	is := is.New(t)
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	_, err := NewService(db, logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	_, err = NewService(db, logger, time.Now)
+	is.NoErr(err) // service initializes again over the existing schema.
+}
+
+// newTestService returns a service over an emptied test database, with the
+// given clock and options. It returns a function that should be called to
+// close the db.
+func newTestService(t *testing.T, now func() time.Time, opts ...Option) (*service, func()) {
+
+	db, cleanup := newTestDB(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	s, err := NewService(db, logger, now, opts...)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`TRUNCATE dials, dial_history, external_ids, boards, board_dials`); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	return s, cleanup
+}
+
+func TestDialValueDecays(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create service, with a clock that can be moved forward.
+	clock := servicetest.Now
+	s, cleanup := newTestService(t, func() time.Time { return clock }, WithDecay(1.0))
+	defer cleanup()
+
+	ctx := context.TODO()
+
+	// Create dial, and set its value.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
+
+	// After 2.5 hours, the value has decayed by 2.5.
+	clock = servicetest.Now.Add(150 * time.Minute)
+	dp, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)                           // dial is retrieved correctly.
+	is.Equal(dp.Value, 7.5)                 // dial value has decayed.
+	is.Equal(dp.UpdatedAt, servicetest.Now) // dial updated at is unchanged.
+
+	// Dials are decayed however they are retrieved.
+	dials, err := s.GetDials(ctx, []ooohh.DialID{d.ID})
+	is.NoErr(err)                    // dials are retrieved correctly.
+	is.Equal(dials[d.ID].Value, 7.5) // dial value has decayed.
+
+	page, err := s.PageDials(ctx, "", 10)
+	is.NoErr(err)                      // dials are paged correctly.
+	is.Equal(page.Dials[0].Value, 7.5) // paged dial value has decayed.
+
+	is.NoErr(s.ListDials(ctx, func(ld ooohh.Dial) error {
+		is.Equal(ld.Value, 7.5) // listed dial value has decayed.
+		return nil
+	}))
+
+	// Adjusting the dial adds to its decayed value, and restarts the decay.
+	is.NoErr(s.AdjustDial(ctx, d.ID, "MYTOKEN", 1.0))
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(dp.Value, 8.5) // delta is added to the decayed value.
+}
+
+func TestDialLockout(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create service, with a clock that can be moved.
+	current := servicetest.Now
+	s, cleanup := newTestService(t, func() time.Time { return current }, WithLockout(3, time.Minute))
+	defer cleanup()
+
+	ctx := context.TODO()
+
+	// Create dial.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Make bad attempts, up to the limit.
+	for i := 0; i < 3; i++ {
+		err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50.0)
+		is.Equal(err, ooohh.ErrUnauthorized) // bad attempts are unauthorized.
+		current = current.Add(time.Second)
+	}
+
+	// Check the dial is locked out, even with the correct token.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 50.0), ooohh.ErrLockedOut)   // dial is locked out.
+	is.Equal(s.VerifyDialToken(ctx, d.ID, "MYTOKEN"), ooohh.ErrLockedOut) // tokens can't be verified.
+	is.Equal(s.DeleteDial(ctx, d.ID, "MYTOKEN"), ooohh.ErrLockedOut)      // dial can't be deleted.
+
+	// Check the lockout clears after the window.
+	current = current.Add(time.Minute)
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)) // dial can be updated after the window.
+
+	// Check bad tokens for dials ensured by external ID also count.
+	e, _, err := s.EnsureDial(ctx, "ext-1", "EXTERNAL-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial is ensured correctly.
+	for i := 0; i < 3; i++ {
+		_, _, err = s.EnsureDial(ctx, "ext-1", "EXTERNAL-DIAL", "NOTMYTOKEN")
+		is.Equal(err, ooohh.ErrUnauthorized) // bad attempts are unauthorized.
+	}
+	_, _, err = s.EnsureDial(ctx, "ext-1", "EXTERNAL-DIAL", "MYTOKEN")
+	is.Equal(err, ooohh.ErrLockedOut)                                   // ensured dial is locked out.
+	is.Equal(s.SetDial(ctx, e.ID, "MYTOKEN", 50.0), ooohh.ErrLockedOut) // ensured dial's updates are locked out.
+}
+
+func TestDialValueConfiguredBounds(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create service, with narrower bounds.
+	s, cleanup := newTestService(t, func() time.Time { return servicetest.Now }, WithValueBounds(10, 20))
+	defer cleanup()
+
+	ctx := context.TODO()
+
+	// Create dial.
+	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10))                              // value on lower bound is valid.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 20))                              // value on upper bound is valid.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 9.99), ooohh.ErrDialValueInvalid) // value below lower bound is invalid.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 50), ooohh.ErrDialValueInvalid)   // value above upper bound is invalid.
+
+	is.NoErr(s.AdjustDial(ctx, d.ID, "MYTOKEN", -50)) // dial value adjusts without error.
+	dp, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)            // dial is retrieved correctly.
+	is.Equal(dp.Value, 10.0) // value is clamped at the lower bound.
+}
@@ -0,0 +1,57 @@
+-- The schema of the Postgres service. Each statement can be run again, so the
+-- schema is applied whenever the service starts.
+
+-- IDs are collated bytewise, so dials and boards are listed in the same order
+-- as the bolt service lists them in.
+CREATE TABLE IF NOT EXISTS dials (
+    id         TEXT COLLATE "C" PRIMARY KEY,
+    token      TEXT NOT NULL,
+    name       TEXT NOT NULL,
+    value      DOUBLE PRECISION NOT NULL,
+    color      TEXT NOT NULL DEFAULT '',
+    "group"    TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Readings at the same time are kept in the order they're added by seq.
+CREATE TABLE IF NOT EXISTS dial_history (
+    dial_id TEXT COLLATE "C" NOT NULL REFERENCES dials (id) ON DELETE CASCADE,
+    seq     BIGSERIAL,
+    value   DOUBLE PRECISION NOT NULL,
+    note    TEXT NOT NULL DEFAULT '',
+    at      TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (dial_id, seq)
+);
+
+CREATE INDEX IF NOT EXISTS dial_history_at ON dial_history (dial_id, at, seq);
+
+-- External IDs outlive their dials, as EnsureDial creates a new dial for an
+-- external ID whose dial has been deleted.
+CREATE TABLE IF NOT EXISTS external_ids (
+    external_id TEXT PRIMARY KEY,
+    dial_id     TEXT COLLATE "C" NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS boards (
+    id          TEXT COLLATE "C" PRIMARY KEY,
+    code        TEXT NOT NULL UNIQUE,
+    token       TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    owns_dials  BOOLEAN NOT NULL DEFAULT FALSE,
+    frozen      BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at  TIMESTAMPTZ NOT NULL
+);
+
+-- board_dials joins boards to their dials, in board order. Dials aren't
+-- referenced, as boards keep the IDs of deleted dials. Frozen boards keep a
+-- snapshot of each of their dials that existed when they were frozen.
+CREATE TABLE IF NOT EXISTS board_dials (
+    board_id TEXT COLLATE "C" NOT NULL REFERENCES boards (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    dial_id  TEXT COLLATE "C" NOT NULL,
+    snapshot JSONB,
+    PRIMARY KEY (board_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS board_dials_dial_id ON board_dials (dial_id);
@@ -1,250 +1,1705 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/pkg/errors"
 	"github.com/segmentio/ksuid"
-	"github.com/vmihailenco/msgpack/v5"
 	"go.uber.org/zap"
 
 	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/service/lockout"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
 )
 
+// DBOptions configures how the database is opened.
+type DBOptions struct {
+	// Unsafe skips syncing writes to disk. This is much faster, but data can be
+	// lost or corrupted if the process or machine crashes, so should only be
+	// used where durability doesn't matter, e.g. for demos.
+	Unsafe bool
+}
+
+// OpenDB opens the bolt database at the given path, creating it if needed.
+func OpenDB(path string, opts DBOptions) (*bolt.DB, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{
+		Timeout:    5 * time.Second,
+		NoGrowSync: opts.Unsafe,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "opening db")
+	}
+
+	// NoSync can only be set once the db is opened.
+	db.NoSync = opts.Unsafe
+
+	return db, nil
+}
+
 type service struct {
-	db     *bolt.DB
-	logger *zap.SugaredLogger
-	now    func() time.Time
+	store   store.Store
+	reads   store.Store
+	logger  *zap.SugaredLogger
+	now     func() time.Time
+	lockout *lockout.Lockout
+
+	// decay is how much dial values fall by per hour since they were last
+	// updated. Zero disables decay.
+	decay float64
+
+	// generateTokens generates a token for dials and boards created without one.
+	generateTokens bool
+
+	// minValue and maxValue bound the values dials can be set to, inclusively.
+	minValue, maxValue float64
+
+	// codec encodes the entities that are stored. Stored entities are read
+	// back with whichever codec they were stored with.
+	codec Codec
+
+	// bucketPrefix, if set, prefixes the names of the buckets in the stores.
+	bucketPrefix string
+}
+
+// Option configures the service.
+type Option func(*service)
+
+// WithLockout locks a dial out of updates, even with the correct token, once
+// the given number of bad token attempts have been made within the window.
+// Lockouts are disabled by default.
+func WithLockout(attempts int, window time.Duration) Option {
+	return func(s *service) {
+		s.lockout = lockout.New(attempts, window)
+	}
+}
+
+// WithReadStore serves GetDial and GetBoard from the given store, e.g. a
+// store.Replica, instead of the store that is written to. Reads will only see
+// writes once they have made it to the read store, unless their context is
+// from ooohh.WithPrimaryReads.
+func WithReadStore(st store.Store) Option {
+	return func(s *service) {
+		s.reads = st
+	}
+}
+
+// WithDecay makes retrieved dial values fall towards zero by the
+// given amount per hour since they were last updated, without going below zero.
+// The stored value is only brought down to the decayed value when the dial is
+// next updated, which restarts the decay.
+// Decay is disabled by default.
+func WithDecay(perHour float64) Option {
+	return func(s *service) {
+		s.decay = perHour
+	}
+}
+
+// WithValueBounds sets the range, inclusive of both ends, that dial values must
+// be within. The range is 0 to 100 by default.
+func WithValueBounds(min, max float64) Option {
+	return func(s *service) {
+		s.minValue = min
+		s.maxValue = max
+	}
+}
+
+// WithCodec stores entities with the given codec. Entities already stored with
+// another codec can still be read, so the codec can be changed on an existing
+// db. Entities are stored with MsgpackCodec by default.
+func WithCodec(c Codec) Option {
+	return func(s *service) {
+		s.codec = c
+	}
+}
+
+// WithBucketPrefix names the service's buckets "<prefix>_<bucket>", e.g.
+// "<prefix>_dials", in both the store and any read store, so services with
+// different prefixes can share a db without seeing each other's data. The
+// buckets are named without a prefix by default.
+func WithBucketPrefix(prefix string) Option {
+	return func(s *service) {
+		s.bucketPrefix = prefix
+	}
 }
 
-func NewService(db *bolt.DB, logger *zap.SugaredLogger, now func() time.Time) (*service, error) {
+// WithGeneratedTokens generates a random token for dials and boards that are
+// created without one. The generated token is returned on the created dial or
+// board. By default, the token is used as given, even if it is empty.
+func WithGeneratedTokens() Option {
+	return func(s *service) {
+		s.generateTokens = true
+	}
+}
+
+// NewService returns an ooohh.Service that keeps its data in the given store.
+func NewService(st store.Store, logger *zap.SugaredLogger, now func() time.Time, opts ...Option) (*service, error) {
+
+	s := &service{
+		store:    st,
+		reads:    st,
+		logger:   logger,
+		now:      now,
+		lockout:  lockout.New(0, 0),
+		minValue: 0,
+		maxValue: 100,
+		codec:    MsgpackCodec,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.bucketPrefix != "" {
+		s.store = store.NewPrefixed(s.store, s.bucketPrefix)
+		s.reads = store.NewPrefixed(s.reads, s.bucketPrefix)
+	}
 
 	// Initialize top-level buckets.
-	txn, err := db.Begin(true)
+	txn, err := s.store.Begin(true)
 	if err != nil {
 		return nil, errors.Wrap(err, "beginning transaction")
 	}
 	defer txn.Rollback() //nolint:errcheck
 
-	if _, err := txn.CreateBucketIfNotExists([]byte("dials")); err != nil {
+	if err := txn.CreateBucketIfNotExists("dials"); err != nil {
 		return nil, errors.Wrap(err, "creating dials bucket")
 	}
 
-	if _, err := txn.CreateBucketIfNotExists([]byte("boards")); err != nil {
+	if err := txn.CreateBucketIfNotExists("boards"); err != nil {
 		return nil, errors.Wrap(err, "creating boards bucket")
 	}
 
-	return &service{db, logger, now}, txn.Commit()
+	if err := txn.CreateBucketIfNotExists("board_codes"); err != nil {
+		return nil, errors.Wrap(err, "creating board_codes bucket")
+	}
+
+	if err := txn.CreateBucketIfNotExists("external_ids"); err != nil {
+		return nil, errors.Wrap(err, "creating external_ids bucket")
+	}
+
+	if err := txn.CreateBucketIfNotExists("dial_history"); err != nil {
+		return nil, errors.Wrap(err, "creating dial_history bucket")
+	}
+
+	if err := txn.CreateBucketIfNotExists("dial_boards"); err != nil {
+		return nil, errors.Wrap(err, "creating dial_boards bucket")
+	}
+
+	// Index the boards stored before the dial_boards index was.
+	if txn.Count("dial_boards") == 0 {
+		err := txn.ForEach("boards", func(k, v []byte) error {
+			var b ooohh.Board
+			if err := decode(v, &b); err != nil {
+				return errors.Wrap(err, "reading board")
+			}
+
+			return indexBoardDials(txn, b.ID, nil, b.Dials)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "indexing board dials")
+		}
+	}
+
+	return s, txn.Commit()
+}
+
+// Ping checks that the store can be read from.
+func (s *service) Ping(ctx context.Context) error {
+	txn, err := s.store.Begin(false)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+
+	return txn.Rollback()
 }
 
 // CreateDial will create the dial with the given name, and associate it to the specified token.
 func (s *service) CreateDial(ctx context.Context, name, token string) (*ooohh.Dial, error) {
+	return s.CreateDialFrom(ctx, ooohh.NewDial{Name: name, Token: token})
+}
 
-	// generate new id
-	id := ooohh.DialID(ksuid.New().String())
+// CreateDialWithValue is like CreateDial, but the dial is created with the
+// given value rather than zero, which is recorded in its history. The dial is
+// created and its value set together, or not at all.
+func (s *service) CreateDialWithValue(ctx context.Context, name, token string, value float64) (*ooohh.Dial, error) {
+	return s.CreateDialFrom(ctx, ooohh.NewDial{Name: name, Token: token, Value: &value})
+}
+
+// CreateDialFrom is like CreateDial, but the dial is also created with the
+// given value, color and group, all at once, or not at all.
+func (s *service) CreateDialFrom(ctx context.Context, nd ooohh.NewDial) (*ooohh.Dial, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// check value and color validity.
+	if nd.Value != nil && !s.validValue(*nd.Value) {
+		return nil, ooohh.ErrDialValueInvalid
+	}
+	if !ooohh.ValidColor(nd.Color) {
+		return nil, ooohh.ErrDialColorInvalid
+	}
 
 	// start read/write transaction
-	txn, err := s.db.Begin(true)
+	txn, err := s.store.Begin(true)
 	if err != nil {
 		return nil, errors.Wrap(err, "beginning transaction")
 	}
 	defer txn.Rollback() //nolint:errcheck
 
-	d := ooohh.Dial{
-		ID:        id,
-		Token:     token,
-		Name:      name,
-		Value:     0.0,
-		UpdatedAt: s.now().UTC(),
+	token, err := s.token(nd.Token)
+	if err != nil {
+		return nil, err
 	}
 
-	if v, err := msgpack.Marshal(d); err != nil {
-		return nil, errors.Wrap(err, "marshalling dial")
-	} else if err := txn.Bucket([]byte("dials")).Put([]byte(id), v); err != nil {
-		return nil, errors.Wrap(err, "storing dial")
+	d := ooohh.Dial{Name: nd.Name, Token: token, Color: nd.Color, Group: strings.TrimSpace(nd.Group)}
+	if nd.Value != nil {
+		d.Value = *nd.Value
+	}
+
+	created, err := s.createDial(txn, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if nd.Value != nil {
+		err = addReading(txn, s.codec, created.ID, ooohh.DialReading{
+			Value: created.Value,
+			At:    created.UpdatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return created, txn.Commit()
+}
+
+// EnsureDial retrieves the dial mapped to the given external ID, creating it
+// with the given name and token if there isn't one. Whether the dial was
+// created is also returned. The token must match that of an existing dial,
+// and bad tokens count towards its lockout.
+func (s *service) EnsureDial(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	// Return the mapped dial, if it still exists.
+	if id := txn.Get("external_ids", []byte(externalID)); id != nil {
+		if v := txn.Get("dials", id); v != nil {
+			var d ooohh.Dial
+			if err := decode(v, &d); err != nil {
+				return nil, false, errors.Wrap(err, "reading dial")
+			}
+
+			// check for too many bad token attempts.
+			if s.lockout.Locked(string(d.ID), s.now()) {
+				return nil, false, ooohh.ErrLockedOut
+			}
+
+			// check token matches
+			if !ooohh.TokenMatches(d.Token, token) {
+				s.lockout.Fail(string(d.ID), s.now())
+				return nil, false, ooohh.ErrUnauthorized
+			}
+			s.lockout.Reset(string(d.ID))
+
+			// Update timezone.
+			d.UpdatedAt = d.UpdatedAt.UTC()
+
+			d.Value = s.decayed(d)
+
+			return &d, false, nil
+		}
+	}
+
+	d, err := s.createDial(txn, ooohh.Dial{Name: name, Token: token})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := txn.Put("external_ids", []byte(externalID), []byte(d.ID)); err != nil {
+		return nil, false, errors.Wrap(err, "storing external id")
 	}
 
-	return &d, txn.Commit()
+	return d, true, txn.Commit()
 }
 
 // GetDial retrieves a dial by ID. Anyone can retrieve any dial with its ID.
 func (s *service) GetDial(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
 
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// start a read-only transaction
-	txn, err := s.db.Begin(false)
+	txn, err := s.readStore(ctx).Begin(false)
 	if err != nil {
 		return nil, errors.Wrap(err, "beginning transaction")
 	}
 	defer txn.Rollback() //nolint:errcheck
 
+	return s.getDial(txn, id)
+}
+
+// readStore returns the store to read from with the context.
+func (s *service) readStore(ctx context.Context) store.Store {
+	if ooohh.PrimaryReads(ctx) {
+		return s.store
+	}
+
+	return s.reads
+}
+
+// getDial reads the dial within the given transaction, with its value decayed.
+func (s *service) getDial(txn store.Tx, id ooohh.DialID) (*ooohh.Dial, error) {
 	var d ooohh.Dial
-	if v := txn.Bucket([]byte("dials")).Get([]byte(id)); v == nil {
+	if v := txn.Get("dials", []byte(id)); v == nil {
 		return nil, ooohh.ErrDialNotFound
-	} else if err := msgpack.Unmarshal(v, &d); err != nil {
+	} else if err := decode(v, &d); err != nil {
 		return nil, errors.Wrap(err, "reading dial")
 	}
 
 	// Update timezone.
 	d.UpdatedAt = d.UpdatedAt.UTC()
 
+	d.Value = s.decayed(d)
+
 	return &d, nil
 }
 
-// SetDial updates the dial value. It can be updated by anyone who knows
-// the original token it was created with.
-func (s *service) SetDial(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+// decayed returns the dial's value after it has decayed since it was last updated.
+func (s *service) decayed(d ooohh.Dial) float64 {
+	if s.decay <= 0 || d.Value <= 0 {
+		return d.Value
+	}
 
-	// check value validity.
-	if value > 100.0 || value < 0.0 {
-		return ooohh.ErrDialValueInvalid
+	elapsed := s.now().Sub(d.UpdatedAt).Hours()
+	if elapsed <= 0 {
+		return d.Value
 	}
 
-	// start read/write transaction
-	txn, err := s.db.Begin(true)
+	return math.Max(d.Value-s.decay*elapsed, 0)
+}
+
+// GetDials retrieves many dials by ID. Dials that are not found are omitted.
+func (s *service) GetDials(ctx context.Context, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// start a read-only transaction
+	txn, err := s.store.Begin(false)
 	if err != nil {
-		return errors.Wrap(err, "beginning transaction")
+		return nil, errors.Wrap(err, "beginning transaction")
 	}
 	defer txn.Rollback() //nolint:errcheck
 
-	bkt := txn.Bucket([]byte("dials"))
+	return s.getDials(txn, ids)
+}
 
-	// Find and unmarshal dial
-	var d ooohh.Dial
-	if v := bkt.Get([]byte(id)); v == nil {
-		return ooohh.ErrDialNotFound
-	} else if err := msgpack.Unmarshal(v, &d); err != nil {
-		return errors.Wrap(err, "reading dial")
+// ListDials calls fn with each dial, in ID order. Iteration stops at the
+// first error returned by fn, which is then returned.
+func (s *service) ListDials(ctx context.Context, fn func(ooohh.Dial) error) error {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	// check token matches
-	if token != d.Token {
-		return ooohh.ErrUnauthorized
+	// start a read-only transaction
+	txn, err := s.store.Begin(false)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
 	}
+	defer txn.Rollback() //nolint:errcheck
 
-	// Update value
-	d.Value = value
-	d.UpdatedAt = s.now().UTC()
+	// Read each dial as it is iterated, so they aren't all held in memory.
+	return txn.ForEach("dials", func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 
-	if v, err := msgpack.Marshal(d); err != nil {
-		return errors.Wrap(err, "marshalling dial")
-	} else if err := bkt.Put([]byte(id), v); err != nil {
-		return errors.Wrap(err, "storing dial")
-	}
+		var d ooohh.Dial
+		if err := decode(v, &d); err != nil {
+			return errors.Wrapf(err, "reading dial %s", k)
+		}
 
-	return txn.Commit()
+		// Update timezone.
+		d.UpdatedAt = d.UpdatedAt.UTC()
+
+		d.Value = s.decayed(d)
+
+		return fn(d)
+	})
 }
 
-// CreateBoard will create a board with the given name, and associate it to the specified token.
-func (s *service) CreateBoard(ctx context.Context, name, token string) (*ooohh.Board, error) {
+// PageDials retrieves up to limit dials, in ID order, starting after the
+// given ID. The first page is retrieved with an empty ID.
+func (s *service) PageDials(ctx context.Context, after ooohh.DialID, limit int) (*ooohh.DialPage, error) {
 
-	// generate new id
-	id := ooohh.BoardID(ksuid.New().String())
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	// start read/write transaction
-	txn, err := s.db.Begin(true)
+	if limit < 1 {
+		return nil, errors.New("limit must be positive")
+	}
+
+	// start a read-only transaction
+	txn, err := s.store.Begin(false)
 	if err != nil {
 		return nil, errors.Wrap(err, "beginning transaction")
 	}
 	defer txn.Rollback() //nolint:errcheck
 
-	b := ooohh.Board{
-		ID:        id,
-		Token:     token,
-		Name:      name,
-		Dials:     []ooohh.Dial{},
-		UpdatedAt: s.now().UTC(),
+	p := ooohh.DialPage{
+		Dials: make([]ooohh.Dial, 0, limit),
+		Total: txn.Count("dials"),
 	}
 
-	if v, err := msgpack.Marshal(b); err != nil {
-		return nil, errors.Wrap(err, "marshalling board")
-	} else if err := txn.Bucket([]byte("boards")).Put([]byte(id), v); err != nil {
-		return nil, errors.Wrap(err, "storing board")
+	// Read one more dial than needed, to know whether there is a next page.
+	err = txn.ForEachAfter("dials", []byte(after), func(k, v []byte) error {
+		if len(p.Dials) == limit {
+			p.Next = p.Dials[limit-1].ID
+			return errStopIteration
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var d ooohh.Dial
+		if err := decode(v, &d); err != nil {
+			return errors.Wrapf(err, "reading dial %s", k)
+		}
+
+		// Update timezone.
+		d.UpdatedAt = d.UpdatedAt.UTC()
+
+		d.Value = s.decayed(d)
+
+		p.Dials = append(p.Dials, d)
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return nil, err
 	}
 
-	return &b, txn.Commit()
+	return &p, nil
 }
 
-// GetBoard retrieves a board by ID. Anyone can retrieve any board with its ID.
-func (s *service) GetBoard(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+// SetDial updates the dial value. It can be updated by anyone who knows
+// the original token it was created with, or the token of a board it is
+// on that owns its dials, unless a board it is on is frozen.
+func (s *service) SetDial(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+	return s.SetDialWithNote(ctx, id, token, value, "")
+}
+
+// SetDialWithNote is like SetDial, but also records the note against the
+// value in the dial's history.
+func (s *service) SetDialWithNote(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Value: &value, Note: note})
+	return err
+}
+
+// AdjustDial adds the delta to the dial value, clamping the result to the
+// value bounds. The value is read and updated at once, so concurrent
+// adjustments aren't lost. It can be adjusted by whoever can set it.
+func (s *service) AdjustDial(ctx context.Context, id ooohh.DialID, token string, delta float64) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Delta: &delta})
+	return err
+}
+
+// validValue reports whether the value is a number within the value bounds.
+func (s *service) validValue(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0) && value >= s.minValue && value <= s.maxValue
+}
+
+// GetDialHistory retrieves the values the dial has been set to since the
+// given time, oldest first. The zero time retrieves the whole history.
+func (s *service) GetDialHistory(ctx context.Context, id ooohh.DialID, since time.Time) ([]ooohh.DialReading, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	// start a read-only transaction
-	txn, err := s.db.Begin(false)
+	txn, err := s.store.Begin(false)
 	if err != nil {
 		return nil, errors.Wrap(err, "beginning transaction")
 	}
 	defer txn.Rollback() //nolint:errcheck
 
-	var b ooohh.Board
-	if v := txn.Bucket([]byte("boards")).Get([]byte(id)); v == nil {
-		return nil, ooohh.ErrBoardNotFound
-	} else if err := msgpack.Unmarshal(v, &b); err != nil {
-		return nil, errors.Wrap(err, "reading board")
+	if txn.Get("dials", []byte(id)) == nil {
+		return nil, ooohh.ErrDialNotFound
 	}
 
-	// Get dial values.
-	dials := make([]ooohh.Dial, 0)
-	for _, d := range b.Dials {
-		dial, err := s.GetDial(ctx, d.ID)
-		if err != nil {
-			s.logger.Errorw("GetDial error", "id", d.ID, "board", id, "err", err)
-			continue
+	readings := make([]ooohh.DialReading, 0)
+
+	// Readings are keyed by time, so start from just before the first one
+	// that could be since the given time.
+	prefix := historyPrefix(id)
+	after := prefix
+	if n := since.UnixNano(); !since.IsZero() && n > 0 {
+		after = historyKey(id, uint64(n)-1)
+	}
+
+	err = txn.ForEachAfter("dial_history", after, func(k, v []byte) error {
+		if !bytes.HasPrefix(k, prefix) {
+			return errStopIteration
 		}
-		dials = append(dials, *dial)
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var r ooohh.DialReading
+		if err := decode(v, &r); err != nil {
+			return errors.Wrap(err, "reading dial history")
+		}
+
+		// Update timezone.
+		r.At = r.At.UTC()
+
+		readings = append(readings, r)
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return nil, err
 	}
 
-	// Associate populated dials to board.
-	b.Dials = dials
+	return readings, nil
+}
 
-	// Update timezone.
-	b.UpdatedAt = b.UpdatedAt.UTC()
+// SetDialColor updates the dial color. It can be updated by anyone who knows
+// the original token it was created with.
+func (s *service) SetDialColor(ctx context.Context, id ooohh.DialID, token, color string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Color: &color})
+	return err
+}
 
-	return &b, nil
+// SetDialGroup updates the group the dial is displayed in on boards. It can
+// be updated by anyone who knows the original token it was created with.
+func (s *service) SetDialGroup(ctx context.Context, id ooohh.DialID, token, group string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Group: &group})
+	return err
 }
 
-// SetBoard updates the dials associated with the board. It can be updated
-// by anyone who knows the original token it was created with.
-func (s *service) SetBoard(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+// RenameDial updates the dial name. It can be renamed by anyone who knows
+// the original token it was created with.
+func (s *service) RenameDial(ctx context.Context, id ooohh.DialID, token, name string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Name: &name})
+	return err
+}
 
-	// start read/write transaction
-	txn, err := s.db.Begin(true)
-	if err != nil {
-		return errors.Wrap(err, "beginning transaction")
+// SetDialToken replaces the token the dial is updated with. It can be
+// replaced by anyone who knows the dial's current token.
+func (s *service) SetDialToken(ctx context.Context, id ooohh.DialID, token, newToken string) error {
+	_, err := s.UpdateDial(ctx, id, token, ooohh.DialUpdate{Token: &newToken})
+	return err
+}
+
+// UpdateDial applies the update to the dial, all at once, so either all of
+// its changes are made, or none are. It can be updated by anyone who knows
+// the original token it was created with, or, if only its value is updated,
+// by whoever can set it. The updated dial is returned.
+func (s *service) UpdateDial(ctx context.Context, id ooohh.DialID, token string, u ooohh.DialUpdate) (*ooohh.Dial, error) {
+
+	// check the update is valid, before anything is changed.
+	if err := validateDialUpdate(u, s.validValue); err != nil {
+		return nil, err
 	}
-	defer txn.Rollback() //nolint:errcheck
 
-	bkt := txn.Bucket([]byte("boards"))
+	var updated ooohh.Dial
+	err := s.updateDial(ctx, id, token, u.Valued(), func(txn store.Tx, d *ooohh.Dial) error {
 
-	// Find and unmarshal dial
-	var b ooohh.Board
-	if v := bkt.Get([]byte(id)); v == nil {
-		return ooohh.ErrBoardNotFound
-	} else if err := msgpack.Unmarshal(v, &b); err != nil {
-		return errors.Wrap(err, "reading board")
+		// Only the dial's value can be updated with a board's token.
+		if !u.ValueOnly() && !ooohh.TokenMatches(d.Token, token) {
+			return ooohh.ErrUnauthorized
+		}
+
+		if err := applyDialUpdate(d, u, s.minValue, s.maxValue); err != nil {
+			return err
+		}
+
+		if u.Valued() {
+			err := addReading(txn, s.codec, id, ooohh.DialReading{
+				Value: d.Value,
+				Note:  strings.TrimSpace(u.Note),
+				At:    d.UpdatedAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		updated = *d
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Check token matches
-	if token != b.Token {
-		return ooohh.ErrUnauthorized
+	return &updated, nil
+}
+
+// validateDialUpdate checks the fields of the update are valid, with the
+// given check of values.
+func validateDialUpdate(u ooohh.DialUpdate, validValue func(float64) bool) error {
+	if u.Value != nil && u.Delta != nil {
+		return ooohh.ErrDialValueInvalid
+	}
+	if u.Value != nil && !validValue(*u.Value) {
+		return ooohh.ErrDialValueInvalid
+	}
+	if u.Delta != nil && (math.IsNaN(*u.Delta) || math.IsInf(*u.Delta, 0)) {
+		return ooohh.ErrDialValueInvalid
+	}
+	if u.Color != nil && !ooohh.ValidColor(*u.Color) {
+		return ooohh.ErrDialColorInvalid
+	}
+	if u.Token != nil && *u.Token == "" {
+		return ooohh.ErrDialTokenInvalid
 	}
 
-	// Populate minimal dial.
-	// Value not stored on set.
-	allDials := make([]ooohh.Dial, len(dials))
-	for i := range dials {
-		allDials[i] = ooohh.Dial{ID: dials[i]}
+	return nil
+}
+
+// applyDialUpdate makes the changes of the validated update to the dial.
+// Deltas are clamped to the given value bounds.
+func applyDialUpdate(d *ooohh.Dial, u ooohh.DialUpdate, min, max float64) error {
+	if u.Name != nil {
+		d.Name = *u.Name
+	}
+	if u.Color != nil {
+		d.Color = *u.Color
+	}
+	if u.Group != nil {
+		d.Group = strings.TrimSpace(*u.Group)
+	}
+	if u.Value != nil {
+		d.Value = *u.Value
+	}
+	if u.Delta != nil {
+		d.Value = math.Max(min, math.Min(max, d.Value+*u.Delta))
+	}
+	if u.Token != nil {
+		hashed, err := ooohh.HashToken(*u.Token)
+		if err != nil {
+			return errors.Wrap(err, "hashing token")
+		}
+		d.Token = hashed
 	}
 
-	// Update value
-	b.Dials = allDials
-	b.UpdatedAt = s.now().UTC()
+	return nil
+}
 
-	if v, err := msgpack.Marshal(b); err != nil {
-		return errors.Wrap(err, "marshalling board")
-	} else if err := bkt.Put([]byte(id), v); err != nil {
-		return errors.Wrap(err, "storing board")
+// DeleteDial removes the dial, and its history. It can be deleted by anyone
+// who knows the original token it was created with. Boards the dial is on
+// keep its ID, but skip it as they do any other missing dial.
+func (s *service) DeleteDial(ctx context.Context, id ooohh.DialID, token string) error {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	return txn.Commit()
+	// check for too many bad token attempts.
+	if s.lockout.Locked(string(id), s.now()) {
+		return ooohh.ErrLockedOut
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	// Find and unmarshal dial
+	var d ooohh.Dial
+	if v := txn.Get("dials", []byte(id)); v == nil {
+		return ooohh.ErrDialNotFound
+	} else if err := decode(v, &d); err != nil {
+		return errors.Wrap(err, "reading dial")
+	}
+
+	// check token matches
+	if !ooohh.TokenMatches(d.Token, token) {
+		s.lockout.Fail(string(id), s.now())
+		return ooohh.ErrUnauthorized
+	}
+	s.lockout.Reset(string(id))
+
+	if err := txn.Delete("dials", []byte(id)); err != nil {
+		return errors.Wrap(err, "deleting dial")
+	}
+
+	// Collect the dial's history before deleting it, as keys can't be deleted
+	// while iterating over them.
+	var keys [][]byte
+	prefix := historyPrefix(id)
+	err = txn.ForEachAfter("dial_history", prefix, func(k, v []byte) error {
+		if !bytes.HasPrefix(k, prefix) {
+			return errStopIteration
+		}
+
+		keys = append(keys, append([]byte(nil), k...))
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return err
+	}
+
+	for _, k := range keys {
+		if err := txn.Delete("dial_history", k); err != nil {
+			return errors.Wrap(err, "deleting dial history")
+		}
+	}
+
+	return txn.Commit()
+}
+
+// VerifyDialToken checks the token is the one the dial was created with,
+// without changing the dial. Bad tokens count towards the dial's lockout.
+func (s *service) VerifyDialToken(ctx context.Context, id ooohh.DialID, token string) error {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// check for too many bad token attempts.
+	if s.lockout.Locked(string(id), s.now()) {
+		return ooohh.ErrLockedOut
+	}
+
+	// start a read-only transaction
+	txn, err := s.store.Begin(false)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	// Find and unmarshal dial
+	var d ooohh.Dial
+	if v := txn.Get("dials", []byte(id)); v == nil {
+		return ooohh.ErrDialNotFound
+	} else if err := decode(v, &d); err != nil {
+		return errors.Wrap(err, "reading dial")
+	}
+
+	// check token matches
+	if !ooohh.TokenMatches(d.Token, token) {
+		s.lockout.Fail(string(id), s.now())
+		return ooohh.ErrUnauthorized
+	}
+	s.lockout.Reset(string(id))
+
+	return nil
+}
+
+// updateDial applies the update to the dial, if the token matches the one the
+// dial was created with, and the dial isn't locked out. If value is set, the
+// update is to the dial's value, so the token of a board the dial is on, that
+// owns its dials, also matches, and it isn't applied while any board the dial
+// is on is frozen. The update is made within the transaction, after the dial's
+// update time is set.
+func (s *service) updateDial(ctx context.Context, id ooohh.DialID, token string, value bool, update func(txn store.Tx, d *ooohh.Dial) error) error {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// check for too many bad token attempts.
+	if s.lockout.Locked(string(id), s.now()) {
+		return ooohh.ErrLockedOut
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	// Find and unmarshal dial
+	var d ooohh.Dial
+	if v := txn.Get("dials", []byte(id)); v == nil {
+		return ooohh.ErrDialNotFound
+	} else if err := decode(v, &d); err != nil {
+		return errors.Wrap(err, "reading dial")
+	}
+
+	// check token matches
+	owner := ooohh.TokenMatches(d.Token, token)
+	authorized := owner
+	if !authorized && value {
+		if authorized, err = ownsDial(txn, id, token); err != nil {
+			return err
+		}
+	}
+	if !authorized {
+		s.lockout.Fail(string(id), s.now())
+		return ooohh.ErrUnauthorized
+	}
+	s.lockout.Reset(string(id))
+
+	// check the value isn't frozen
+	if value {
+		if frozen, err := onFrozenBoard(txn, id); err != nil {
+			return err
+		} else if frozen {
+			return ooohh.ErrBoardFrozen
+		}
+	}
+
+	// Hash tokens stored before tokens were hashed, now the token is known.
+	if owner && !ooohh.TokenHashed(d.Token) {
+		if d.Token, err = ooohh.HashToken(token); err != nil {
+			return errors.Wrap(err, "hashing token")
+		}
+	}
+
+	// Updates start from the decayed value, as the decay restarts once the
+	// dial is updated, even if its value isn't.
+	d.Value = s.decayed(d)
+
+	// Update dial
+	d.UpdatedAt = s.now().UTC()
+	if err := update(txn, &d); err != nil {
+		return err
+	}
+
+	if v, err := s.codec.Marshal(d); err != nil {
+		return errors.Wrap(err, "marshalling dial")
+	} else if err := txn.Put("dials", []byte(id), v); err != nil {
+		return errors.Wrap(err, "storing dial")
+	}
+
+	return txn.Commit()
+}
+
+// CreateBoard will create a board with the given name, and associate it to the specified token.
+func (s *service) CreateBoard(ctx context.Context, name, token string) (*ooohh.Board, error) {
+	return s.CreateBoardWithDescription(ctx, name, token, "")
+}
+
+// CreateBoardWithDescription is like CreateBoard, but the board is created
+// with the given description, together, or not at all.
+func (s *service) CreateBoardWithDescription(ctx context.Context, name, token, description string) (*ooohh.Board, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// check description validity.
+	if !ooohh.ValidDescription(description) {
+		return nil, ooohh.ErrBoardDescriptionInvalid
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	token, err = s.token(token)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.createBoard(txn, name, token, strings.TrimSpace(description), []ooohh.Dial{})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, txn.Commit()
+}
+
+// CreateBoardWithDials will create a board with the given name, and a dial
+// with each of the given names on it, all associated to the specified token.
+// Either the board and all of its dials are created, or none are.
+func (s *service) CreateBoardWithDials(ctx context.Context, name, token string, dials []string) (*ooohh.Board, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	token, err = s.token(token)
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]ooohh.Dial, len(dials))
+	for i := range dials {
+		d, err := s.createDial(txn, ooohh.Dial{Name: dials[i], Token: token})
+		if err != nil {
+			return nil, err
+		}
+		created[i] = *d
+	}
+
+	b, err := s.createBoard(txn, name, token, "", created)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, txn.Commit()
+}
+
+// CloneBoard creates a board with the given name and token, with the same
+// dials as the board with the given ID or code. The dials are shared with the
+// board, not copied, so stay live on both. Dials that no longer exist are kept
+// on the clone, as they are on the board.
+func (s *service) CloneBoard(ctx context.Context, id ooohh.BoardID, name, token string) (*ooohh.Board, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	var src ooohh.Board
+	if v := txn.Get("boards", []byte(resolveBoardID(txn, id))); v == nil {
+		return nil, ooohh.ErrBoardNotFound
+	} else if err := decode(v, &src); err != nil {
+		return nil, errors.Wrap(err, "reading board")
+	}
+
+	token, err = s.token(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the IDs of the dials are cloned, even from frozen boards.
+	ids := make([]ooohh.DialID, len(src.Dials))
+	dials := make([]ooohh.Dial, len(src.Dials))
+	for i := range src.Dials {
+		ids[i] = src.Dials[i].ID
+		dials[i] = ooohh.Dial{ID: src.Dials[i].ID}
+	}
+
+	b, err := s.createBoard(txn, name, token, "", dials)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the clone with its dials populated, skipping missing dials, as
+	// GetBoard does.
+	found, err := s.getDials(txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Dials = make([]ooohh.Dial, 0, len(found))
+	for _, id := range ids {
+		if d, ok := found[id]; ok {
+			b.Dials = append(b.Dials, d)
+		}
+	}
+
+	return b, txn.Commit()
+}
+
+// GetBoard retrieves a board by ID or code. Anyone can retrieve any board with its ID.
+func (s *service) GetBoard(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// start a read-only transaction
+	txn, err := s.readStore(ctx).Begin(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	var b ooohh.Board
+	if v := txn.Get("boards", []byte(resolveBoardID(txn, id))); v == nil {
+		return nil, ooohh.ErrBoardNotFound
+	} else if err := decode(v, &b); err != nil {
+		return nil, errors.Wrap(err, "reading board")
+	}
+
+	// Get dial values within the same transaction, so the board is a
+	// consistent snapshot. Frozen boards keep their own snapshot of them.
+	// Dials that no longer existed when the board was frozen weren't
+	// snapshotted.
+	dials := make([]ooohh.Dial, 0)
+	for _, d := range b.Dials {
+
+		// Stop populating dials once the request is cancelled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if b.Frozen {
+			if !d.UpdatedAt.IsZero() {
+				d.UpdatedAt = d.UpdatedAt.UTC()
+				dials = append(dials, d)
+			}
+			continue
+		}
+
+		dial, err := s.getDial(txn, d.ID)
+		if err != nil {
+			s.logger.Errorw("GetDial error", "id", d.ID, "board", id, "err", err)
+			continue
+		}
+		dials = append(dials, *dial)
+	}
+
+	// Associate populated dials to board.
+	b.Dials = dials
+
+	// Update timezone.
+	b.UpdatedAt = b.UpdatedAt.UTC()
+
+	return &b, nil
+}
+
+// GetBoardDialIDs retrieves the IDs of the dials stored against a board,
+// including any that no longer exist and so are skipped by GetBoard.
+func (s *service) GetBoardDialIDs(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// start a read-only transaction
+	txn, err := s.store.Begin(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	var b ooohh.Board
+	if v := txn.Get("boards", []byte(resolveBoardID(txn, id))); v == nil {
+		return nil, ooohh.ErrBoardNotFound
+	} else if err := decode(v, &b); err != nil {
+		return nil, errors.Wrap(err, "reading board")
+	}
+
+	ids := make([]ooohh.DialID, len(b.Dials))
+	for i := range b.Dials {
+		ids[i] = b.Dials[i].ID
+	}
+
+	return ids, nil
+}
+
+// ListBoardsByToken retrieves the boards created with the given token.
+// Only the IDs of the boards' dials are retrieved, not their values.
+func (s *service) ListBoardsByToken(ctx context.Context, token string) ([]ooohh.Board, error) {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// start a read-only transaction
+	txn, err := s.readStore(ctx).Begin(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	boards := make([]ooohh.Board, 0)
+	err = txn.ForEach("boards", func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var b ooohh.Board
+		if err := decode(v, &b); err != nil {
+			return errors.Wrapf(err, "reading board %s", k)
+		}
+
+		if !ooohh.TokenMatches(b.Token, token) {
+			return nil
+		}
+
+		// Update timezone.
+		b.UpdatedAt = b.UpdatedAt.UTC()
+
+		boards = append(boards, b)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+// SetBoard updates the dials associated with the board. It can be updated
+// by anyone who knows the original token it was created with, unless the
+// board is frozen.
+func (s *service) SetBoard(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Dials: &dials})
+}
+
+// AddBoardDial adds the dial to the end of the board's dials, unless it is
+// already on the board. The dial is added within the board's update, so
+// concurrent additions to the board aren't lost. It can be added by anyone
+// who knows the original token the board was created with, unless the board
+// is frozen.
+func (s *service) AddBoardDial(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error {
+	return s.updateBoard(ctx, id, token, func(txn store.Tx, b *ooohh.Board) error {
+		if b.Frozen {
+			return ooohh.ErrBoardFrozen
+		}
+
+		for _, d := range b.Dials {
+			if d.ID == dialID {
+				return nil
+			}
+		}
+
+		b.Dials = append(b.Dials, ooohh.Dial{ID: dialID})
+		return nil
+	})
+}
+
+// SetBoardName renames the board. It can be renamed by anyone who knows
+// the original token it was created with.
+func (s *service) SetBoardName(ctx context.Context, id ooohh.BoardID, token, name string) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Name: &name})
+}
+
+// SetBoardOwnsDials sets whether the board owns its dials. The values of the
+// dials on a board that owns them can also be set with the board's token. It
+// can be set by anyone who knows the original token the board was created with.
+func (s *service) SetBoardOwnsDials(ctx context.Context, id ooohh.BoardID, token string, owns bool) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{OwnsDials: &owns})
+}
+
+// FreezeBoard snapshots the values of the board's dials, which the board is
+// then retrieved with, and stops the dials' values being set, until it is
+// unfrozen. Freezing a frozen board keeps its original snapshot. It can be
+// frozen by anyone who knows the original token the board was created with.
+func (s *service) FreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	frozen := true
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Frozen: &frozen})
+}
+
+// UnfreezeBoard undoes FreezeBoard, so the board is retrieved with the live
+// values of its dials again. It can be unfrozen by anyone who knows the
+// original token the board was created with.
+func (s *service) UnfreezeBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+	frozen := false
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Frozen: &frozen})
+}
+
+// SetBoardDescription updates the board description. It can be updated by
+// anyone who knows the original token it was created with.
+func (s *service) SetBoardDescription(ctx context.Context, id ooohh.BoardID, token, description string) error {
+	return s.UpdateBoard(ctx, id, token, ooohh.BoardUpdate{Description: &description})
+}
+
+// UpdateBoard applies the update to the board, all at once, so either all of
+// its changes are made, or none are. It can be updated by anyone who knows
+// the original token it was created with.
+func (s *service) UpdateBoard(ctx context.Context, id ooohh.BoardID, token string, u ooohh.BoardUpdate) error {
+
+	// check description validity.
+	if u.Description != nil && !ooohh.ValidDescription(*u.Description) {
+		return ooohh.ErrBoardDescriptionInvalid
+	}
+
+	return s.updateBoard(ctx, id, token, func(txn store.Tx, b *ooohh.Board) error {
+
+		// Unfreeze first, so the dials can be changed along with it.
+		// Only the IDs of the dials are stored against unfrozen boards.
+		if u.Frozen != nil && !*u.Frozen {
+			for i := range b.Dials {
+				b.Dials[i] = ooohh.Dial{ID: b.Dials[i].ID}
+			}
+			b.Frozen = false
+		}
+
+		if u.Name != nil {
+			b.Name = *u.Name
+		}
+		if u.Description != nil {
+			b.Description = strings.TrimSpace(*u.Description)
+		}
+		if u.OwnsDials != nil {
+			b.OwnsDials = *u.OwnsDials
+		}
+
+		if u.Dials != nil {
+			if b.Frozen {
+				return ooohh.ErrBoardFrozen
+			}
+
+			// Populate minimal dial.
+			// Value not stored on set.
+			b.Dials = make([]ooohh.Dial, len(*u.Dials))
+			for i, id := range *u.Dials {
+				b.Dials[i] = ooohh.Dial{ID: id}
+			}
+		}
+
+		// Freeze last, so the snapshot has the changed dials. Freezing a
+		// frozen board keeps its original snapshot.
+		if u.Frozen != nil && *u.Frozen && !b.Frozen {
+			ids := make([]ooohh.DialID, len(b.Dials))
+			for i := range b.Dials {
+				ids[i] = b.Dials[i].ID
+			}
+
+			dials, err := s.getDials(txn, ids)
+			if err != nil {
+				return err
+			}
+
+			// Snapshot the dials, without their tokens. Dials that aren't found
+			// keep only their ID, as before.
+			for i := range b.Dials {
+				if d, ok := dials[b.Dials[i].ID]; ok {
+					d.Token = ""
+					b.Dials[i] = d
+				}
+			}
+
+			b.Frozen = true
+		}
+
+		return nil
+	})
+}
+
+// DeleteBoard removes the board. It can be deleted by anyone who knows the
+// original token it was created with. The board's dials are not deleted, as
+// they may be on other boards.
+func (s *service) DeleteBoard(ctx context.Context, id ooohh.BoardID, token string) error {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	id = resolveBoardID(txn, id)
+
+	// Find and unmarshal board
+	var b ooohh.Board
+	if v := txn.Get("boards", []byte(id)); v == nil {
+		return ooohh.ErrBoardNotFound
+	} else if err := decode(v, &b); err != nil {
+		return errors.Wrap(err, "reading board")
+	}
+
+	// Check token matches
+	if !ooohh.TokenMatches(b.Token, token) {
+		return ooohh.ErrUnauthorized
+	}
+
+	// Only the board's entries in the dial_boards index are removed, not its dials.
+	if err := indexBoardDials(txn, id, b.Dials, nil); err != nil {
+		return err
+	}
+
+	if err := txn.Delete("boards", []byte(id)); err != nil {
+		return errors.Wrap(err, "deleting board")
+	} else if err := txn.Delete("board_codes", []byte(b.Code)); err != nil {
+		return errors.Wrap(err, "deleting board code")
+	}
+
+	return txn.Commit()
+}
+
+// updateBoard applies the update to the board, if the token matches the one
+// the board was created with. The update is made within the transaction.
+func (s *service) updateBoard(ctx context.Context, id ooohh.BoardID, token string, update func(txn store.Tx, b *ooohh.Board) error) error {
+
+	// check the request hasn't been cancelled.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// start read/write transaction
+	txn, err := s.store.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	id = resolveBoardID(txn, id)
+
+	// Find and unmarshal board
+	var b ooohh.Board
+	if v := txn.Get("boards", []byte(id)); v == nil {
+		return ooohh.ErrBoardNotFound
+	} else if err := decode(v, &b); err != nil {
+		return errors.Wrap(err, "reading board")
+	}
+
+	// Check token matches
+	if !ooohh.TokenMatches(b.Token, token) {
+		return ooohh.ErrUnauthorized
+	}
+
+	// Hash tokens stored before tokens were hashed, now the token is known.
+	if !ooohh.TokenHashed(b.Token) {
+		if b.Token, err = ooohh.HashToken(token); err != nil {
+			return errors.Wrap(err, "hashing token")
+		}
+	}
+
+	// Update board
+	dials := b.Dials
+	if err := update(txn, &b); err != nil {
+		return err
+	}
+	b.UpdatedAt = s.now().UTC()
+
+	if err := indexBoardDials(txn, id, dials, b.Dials); err != nil {
+		return err
+	}
+
+	if v, err := s.codec.Marshal(b); err != nil {
+		return errors.Wrap(err, "marshalling board")
+	} else if err := txn.Put("boards", []byte(id), v); err != nil {
+		return errors.Wrap(err, "storing board")
+	}
+
+	return txn.Commit()
+}
+
+// createBoard stores a new board with the given name, token and dials within
+// the given transaction. Only the IDs of the dials are stored against the board.
+func (s *service) createBoard(txn store.Tx, name, token, description string, dials []ooohh.Dial) (*ooohh.Board, error) {
+
+	// generate new id
+	id := ooohh.BoardID(ksuid.New().String())
+
+	// generate a short code, regenerating on collision.
+	var code string
+	for i := 0; ; i++ {
+		if i == maxBoardCodeAttempts {
+			return nil, errors.New("could not generate unique board code")
+		}
+
+		var err error
+		if code, err = generateBoardCode(); err != nil {
+			return nil, errors.Wrap(err, "generating board code")
+		}
+
+		if txn.Get("board_codes", []byte(code)) == nil {
+			break
+		}
+	}
+
+	// Populate minimal dials to store.
+	ids := make([]ooohh.Dial, len(dials))
+	for i := range dials {
+		ids[i] = ooohh.Dial{ID: dials[i].ID}
+	}
+
+	b := ooohh.Board{
+		ID:          id,
+		Code:        code,
+		Token:       token,
+		Name:        name,
+		Description: description,
+		Dials:       ids,
+		UpdatedAt:   s.now().UTC(),
+	}
+
+	if err := indexBoardDials(txn, id, nil, ids); err != nil {
+		return nil, err
+	}
+
+	// Store the token hashed, but return the board with the token itself.
+	hashed, err := ooohh.HashToken(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "hashing token")
+	}
+	stored := b
+	stored.Token = hashed
+
+	if v, err := s.codec.Marshal(stored); err != nil {
+		return nil, errors.Wrap(err, "marshalling board")
+	} else if err := txn.Put("boards", []byte(id), v); err != nil {
+		return nil, errors.Wrap(err, "storing board")
+	} else if err := txn.Put("board_codes", []byte(code), []byte(id)); err != nil {
+		return nil, errors.Wrap(err, "storing board code")
+	}
+
+	// Return the board with its full dials.
+	b.Dials = dials
+
+	return &b, nil
+}
+
+// createDial stores the new dial within the given transaction, with a new ID
+// and update time.
+func (s *service) createDial(txn store.Tx, d ooohh.Dial) (*ooohh.Dial, error) {
+
+	// generate new id
+	id := ooohh.DialID(ksuid.New().String())
+
+	d.ID = id
+	d.UpdatedAt = s.now().UTC()
+
+	// Store the token hashed, but return the dial with the token itself.
+	hashed, err := ooohh.HashToken(d.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "hashing token")
+	}
+	stored := d
+	stored.Token = hashed
+
+	if v, err := s.codec.Marshal(stored); err != nil {
+		return nil, errors.Wrap(err, "marshalling dial")
+	} else if err := txn.Put("dials", []byte(id), v); err != nil {
+		return nil, errors.Wrap(err, "storing dial")
+	}
+
+	return &d, nil
+}
+
+// getDials reads the dials with the given IDs within the given transaction,
+// with their values decayed. Dials that are not found are omitted.
+func (s *service) getDials(txn store.Tx, ids []ooohh.DialID) (map[ooohh.DialID]ooohh.Dial, error) {
+	dials := make(map[ooohh.DialID]ooohh.Dial, len(ids))
+	for _, id := range ids {
+		v := txn.Get("dials", []byte(id))
+		if v == nil {
+			continue
+		}
+
+		var d ooohh.Dial
+		if err := decode(v, &d); err != nil {
+			return nil, errors.Wrapf(err, "reading dial %s", id)
+		}
+
+		// Update timezone.
+		d.UpdatedAt = d.UpdatedAt.UTC()
+
+		d.Value = s.decayed(d)
+
+		dials[id] = d
+	}
+
+	return dials, nil
+}
+
+// dialBoardsPrefix returns the prefix of the dial_boards keys of the dial. The
+// dial_boards bucket indexes the boards each dial is on, keyed by the dial ID
+// then the board ID, so a dial's boards are kept together.
+func dialBoardsPrefix(id ooohh.DialID) []byte {
+	return []byte(string(id) + "/")
+}
+
+// indexBoardDials updates the dial_boards index of the board, from the dials
+// it had to the dials it has.
+func indexBoardDials(txn store.Tx, id ooohh.BoardID, from, to []ooohh.Dial) error {
+	keep := make(map[ooohh.DialID]bool, len(to))
+	for _, d := range to {
+		keep[d.ID] = true
+	}
+
+	for _, d := range from {
+		if keep[d.ID] {
+			continue
+		}
+		if err := txn.Delete("dial_boards", append(dialBoardsPrefix(d.ID), id...)); err != nil {
+			return errors.Wrap(err, "unindexing board dial")
+		}
+	}
+
+	for _, d := range to {
+		if err := txn.Put("dial_boards", append(dialBoardsPrefix(d.ID), id...), []byte{}); err != nil {
+			return errors.Wrap(err, "indexing board dial")
+		}
+	}
+
+	return nil
+}
+
+// ownsDial reports whether the token is that of a board the dial is on, that
+// owns its dials.
+func ownsDial(txn store.Tx, id ooohh.DialID, token string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	return anyDialBoard(txn, id, func(b ooohh.Board) bool {
+		return b.OwnsDials && ooohh.TokenMatches(b.Token, token)
+	})
+}
+
+// onFrozenBoard reports whether the dial is on a frozen board.
+func onFrozenBoard(txn store.Tx, id ooohh.DialID) (bool, error) {
+	return anyDialBoard(txn, id, func(b ooohh.Board) bool {
+		return b.Frozen
+	})
+}
+
+// anyDialBoard reports whether fn is true for any of the boards the dial is on.
+func anyDialBoard(txn store.Tx, id ooohh.DialID, fn func(b ooohh.Board) bool) (bool, error) {
+	found := false
+	prefix := dialBoardsPrefix(id)
+	err := txn.ForEachAfter("dial_boards", prefix, func(k, v []byte) error {
+		if !bytes.HasPrefix(k, prefix) {
+			return errStopIteration
+		}
+
+		var b ooohh.Board
+		if v := txn.Get("boards", k[len(prefix):]); v == nil {
+			return nil
+		} else if err := decode(v, &b); err != nil {
+			return errors.Wrap(err, "reading board")
+		}
+
+		if fn(b) {
+			found = true
+			return errStopIteration
+		}
+
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// errStopIteration stops iterating over a bucket early, without it being an error.
+var errStopIteration = errors.New("stop iteration")
+
+// maxBoardCodeAttempts is the number of times a board code is generated before giving up.
+const maxBoardCodeAttempts = 10
+
+// historyPrefix returns the prefix of the keys of the dial's history readings.
+func historyPrefix(id ooohh.DialID) []byte {
+	return []byte(string(id) + "/")
+}
+
+// addReading stores the reading, encoded with the codec, in the dial's history.
+// Readings are keyed by time, so are kept in order, and readings at the same time are kept in the
+// order they're added.
+func addReading(txn store.Tx, codec Codec, id ooohh.DialID, r ooohh.DialReading) error {
+	v, err := codec.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "marshalling reading")
+	}
+
+	var key []byte
+	for n := uint64(r.At.UnixNano()); ; n++ {
+		key = historyKey(id, n)
+		if txn.Get("dial_history", key) == nil {
+			break
+		}
+	}
+
+	return errors.Wrap(txn.Put("dial_history", key, v), "storing reading")
+}
+
+// historyKey returns the key of the dial's reading at the given unix nano time.
+func historyKey(id ooohh.DialID, n uint64) []byte {
+	prefix := historyPrefix(id)
+
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], n)
+
+	return key
+}
+
+// generateBoardCode returns a random, 6 character base32 code.
+func generateBoardCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.EncodeToString(b)[:6], nil
+}
+
+// token returns the token to create a dial or board with. If generated tokens
+// are enabled and no token is given, a random one is generated.
+func (s *service) token(token string) (string, error) {
+	if token != "" || !s.generateTokens {
+		return token, nil
+	}
+
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generating token")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// resolveBoardID returns the board ID that the given ID refers to.
+// If the ID is a board code, the ID of the board with that code is returned,
+// otherwise the ID is returned unchanged.
+func resolveBoardID(txn store.Tx, id ooohh.BoardID) ooohh.BoardID {
+	if txn.Get("boards", []byte(id)) != nil {
+		return id
+	}
+
+	if v := txn.Get("board_codes", []byte(strings.ToUpper(string(id)))); v != nil {
+		return ooohh.BoardID(v)
+	}
+
+	return id
 }
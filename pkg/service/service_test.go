@@ -1,19 +1,30 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/matryer/is"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/service/servicetest"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
 )
 
 // now is the mocked time for tests
@@ -50,6 +61,38 @@ func newTestLogger(level zapcore.LevelEnabler) (*zap.SugaredLogger, *observer.Ob
 	return zap.New(core).Sugar(), recorded
 }
 
+func TestOpenDB(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		unsafe bool
+	}{{
+		msg:    "safe",
+		unsafe: false,
+	}, {
+		msg:    "unsafe",
+		unsafe: true,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a temporary directory for the db.
+			dir, err := ioutil.TempDir("", "ooohh-bolt-")
+			is.NoErr(err)
+			defer os.RemoveAll(dir) //nolint:errcheck
+
+			db, err := OpenDB(filepath.Join(dir, "ooohh.db"), DBOptions{Unsafe: tt.unsafe})
+			is.NoErr(err) // db opens correctly.
+			defer db.Close()
+
+			is.Equal(db.NoSync, tt.unsafe)     // no sync flag is applied.
+			is.Equal(db.NoGrowSync, tt.unsafe) // no grow sync flag is applied.
+		})
+	}
+}
+
 func TestBoltServiceIsOoohhService(t *testing.T) {
 
 	is := is.New(t)
@@ -59,6 +102,107 @@ func TestBoltServiceIsOoohhService(t *testing.T) {
 	is.True(ok) // bolt service is ooohh service.
 }
 
+func TestServiceWithMemoryStore(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewMemory(), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial and board.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 42.0)
+	is.NoErr(err) // dial value sets without error.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	err = s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID})
+	is.NoErr(err) // dial added to board without error.
+
+	// Get board, by code.
+	b, err = s.GetBoard(ctx, ooohh.BoardID(b.Code))
+	is.NoErr(err)                    // board is retrieved correctly.
+	is.Equal(len(b.Dials), 1)        // board has 1 dial.
+	is.Equal(b.Dials[0].Value, 42.0) // board dial has correct value.
+}
+
+func TestServiceBehavesTheSameWithEachStore(t *testing.T) {
+
+	// Stores the service is checked with, each returning a cleanup function.
+	stores := []struct {
+		name string
+		new  func(t *testing.T) (store.Store, func())
+	}{{
+		name: "bolt",
+		new: func(t *testing.T) (store.Store, func()) {
+			db, cleanup := newTmpBoltDB(t)
+			return store.NewBolt(db), cleanup
+		},
+	}, {
+		name: "memory",
+		new: func(t *testing.T) (store.Store, func()) {
+			return store.NewMemory(), func() {}
+		},
+	}}
+
+	for _, st := range stores {
+		st := st
+
+		t.Run(st.name, func(t *testing.T) {
+			servicetest.Run(t, func(t *testing.T) (ooohh.Service, func()) {
+
+				// Get a store.
+				backend, cleanup := st.new(t)
+
+				// Create logger.
+				logger, _ := newTestLogger(zap.InfoLevel)
+
+				// Create service.
+				n := func() time.Time {
+					return servicetest.Now
+				}
+				s, err := NewService(backend, logger, n)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return s, cleanup
+			})
+		})
+	}
+}
+
+func TestPing(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	s, err := NewService(store.NewBolt(db), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	is.NoErr(s.Ping(context.TODO())) // service can read from its store.
+
+	// Close the db, so it can't be read from.
+	is.NoErr(db.Close())
+	is.True(s.Ping(context.TODO()) != nil) // ping fails when the store can't be read.
+}
+
 func TestDialCanBeCreatedAndGot(t *testing.T) {
 
 	is := is.New(t)
@@ -74,7 +218,7 @@ func TestDialCanBeCreatedAndGot(t *testing.T) {
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
@@ -97,21 +241,17 @@ func TestDialCanBeCreatedAndGot(t *testing.T) {
 
 	d2 := *dp
 
-	is.Equal(d2.Name, "TEST-DIAL-1") // dial name is correct.
-	is.Equal(d2.Token, "MYTOKEN")    // dial token is correct.
-	is.Equal(d2.Value, float64(0))   // dial value is correct.
-	is.Equal(d2.UpdatedAt, now)      // dial updated at is correct.
-	is.Equal(d2.ID, d.ID)            // dial id is correct.
+	is.Equal(d2.Name, "TEST-DIAL-1")                 // dial name is correct.
+	is.True(ooohh.TokenMatches(d2.Token, "MYTOKEN")) // dial token is correct.
+	is.Equal(d2.Value, float64(0))                   // dial value is correct.
+	is.Equal(d2.UpdatedAt, now)                      // dial updated at is correct.
+	is.Equal(d2.ID, d.ID)                            // dial id is correct.
 }
 
-func TestDialValueUpdates(t *testing.T) {
+func TestDialCanBeCreatedWithValue(t *testing.T) {
 
 	is := is.New(t)
 
-	// Get a Bolt DB.
-	db, cleanup := newTmpBoltDB(t)
-	defer cleanup()
-
 	// Create logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
@@ -119,28 +259,50 @@ func TestDialValueUpdates(t *testing.T) {
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewMemory(), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create dial.
-	dp, err := s.CreateDial(ctx, "TEST-DIAL-2", "MYTOKEN")
-	is.NoErr(err) // dial creates correctly.
-
-	d := *dp
-
-	// Update Dial Value.
-	err = s.SetDial(ctx, d.ID, "MYTOKEN", 64.0)
-	is.NoErr(err) // dial value sets without error.
-
-	// Check Dial Value.
-	dp, err = s.GetDial(ctx, d.ID)
-	is.NoErr(err)                     // dial is retrieved correctly.
-	is.Equal(dp.Value, float64(64.0)) // dial has correct value.
+	// Create dial, with a value.
+	d, err := s.CreateDialWithValue(ctx, "TEST-DIAL", "MYTOKEN", 42)
+	is.NoErr(err)           // dial creates correctly.
+	is.Equal(d.Value, 42.0) // created dial has the value.
+
+	got, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)                                     // dial is retrieved correctly.
+	is.Equal(got.Value, 42.0)                         // stored dial has the value.
+	is.True(ooohh.TokenMatches(got.Token, "MYTOKEN")) // stored dial has the token.
+
+	h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)                                          // history is retrieved correctly.
+	is.Equal(h, []ooohh.DialReading{{Value: 42, At: now}}) // value is recorded in history.
+
+	// Create dial, without a value.
+	d, err = s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err)          // dial creates correctly.
+	is.Equal(d.Value, 0.0) // dial defaults to zero.
+
+	h, err = s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)       // history is retrieved correctly.
+	is.Equal(len(h), 0) // nothing is recorded in history.
+
+	// Dials aren't created with invalid values.
+	_, err = s.CreateDialWithValue(ctx, "TEST-DIAL", "MYTOKEN", 150)
+	is.Equal(err, ooohh.ErrDialValueInvalid) // too high value is invalid.
+	_, err = s.CreateDialWithValue(ctx, "TEST-DIAL", "MYTOKEN", math.NaN())
+	is.Equal(err, ooohh.ErrDialValueInvalid) // NaN is invalid.
+
+	var dials int
+	err = s.ListDials(ctx, func(ooohh.Dial) error {
+		dials++
+		return nil
+	})
+	is.NoErr(err)      // dials are listed.
+	is.Equal(dials, 2) // only valid dials are created.
 }
 
-func TestDialValueSetUnauthorized(t *testing.T) {
+func TestTokensCanBeGenerated(t *testing.T) {
 
 	is := is.New(t)
 
@@ -151,28 +313,45 @@ func TestDialValueSetUnauthorized(t *testing.T) {
 	// Create logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create service.
+	// Create service, generating tokens.
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n, WithGeneratedTokens())
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create dial.
-	dp, err := s.CreateDial(ctx, "TEST-DIAL-3", "MYTOKEN")
+	// Create dials without a token.
+	d1, err := s.CreateDial(ctx, "TEST-DIAL-1", "")
+	is.NoErr(err) // dial creates correctly.
+	d2, err := s.CreateDial(ctx, "TEST-DIAL-2", "")
 	is.NoErr(err) // dial creates correctly.
 
-	d := *dp
+	is.True(len(d1.Token) >= 32)  // token is generated.
+	is.True(d1.Token != d2.Token) // tokens are random.
 
-	// Try to update Dial Value.
-	err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 64.0)
-	is.Equal(err, ooohh.ErrUnauthorized) // dial value setting errors as unauthorized.
+	// The generated token is stored.
+	is.NoErr(s.SetDial(ctx, d1.ID, d1.Token, 50.0)) // dial can be set with the generated token.
 
+	// Given tokens are used as is.
+	d3, err := s.CreateDial(ctx, "TEST-DIAL-3", "MYTOKEN")
+	is.NoErr(err)                 // dial creates correctly.
+	is.Equal(d3.Token, "MYTOKEN") // given token is used.
+
+	// Boards, with and without dials, also have tokens generated.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD-1", "")
+	is.NoErr(err)               // board creates correctly.
+	is.True(len(b.Token) >= 32) // token is generated.
+
+	b, err = s.CreateBoardWithDials(ctx, "TEST-BOARD-2", "", []string{"TEST-DIAL-4"})
+	is.NoErr(err)                                 // board creates correctly.
+	is.True(len(b.Token) >= 32)                   // token is generated.
+	is.Equal(b.Dials[0].Token, b.Token)           // dials share the board's token.
+	is.NoErr(s.SetBoard(ctx, b.ID, b.Token, nil)) // board can be set with the generated token.
 }
 
-func TestDialNotFound(t *testing.T) {
+func TestTokensAreNotGeneratedByDefault(t *testing.T) {
 
 	is := is.New(t)
 
@@ -187,21 +366,15 @@ func TestDialNotFound(t *testing.T) {
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
-	ctx := context.TODO()
-
-	// Getting non-existant dial errors.
-	_, err = s.GetDial(ctx, ooohh.DialID("NOT-A-DIAL"))
-	is.Equal(err, ooohh.ErrDialNotFound) // Dial not found when getting.
-
-	// Updating a non-existant dial errors.
-	serr := s.SetDial(ctx, ooohh.DialID("NOT-A-DIAL-EITHER"), "MYTOKEN", 44.0)
-	is.Equal(serr, ooohh.ErrDialNotFound) // Dial not found when setting.
+	d, err := s.CreateDial(context.TODO(), "TEST-DIAL", "")
+	is.NoErr(err)         // dial creates correctly.
+	is.Equal(d.Token, "") // token is not generated.
 }
 
-func TestDialSetValueBounds(t *testing.T) {
+func TestDialCanBeEnsured(t *testing.T) {
 
 	is := is.New(t)
 
@@ -216,53 +389,44 @@ func TestDialSetValueBounds(t *testing.T) {
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create dial.
-	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
-	is.NoErr(err) // dial creates correctly.
-
-	for _, tt := range []struct {
-		msg   string
-		value float64
-		err   error
-	}{{
-		msg:   "valid value",
-		value: 66.6,
-		err:   nil,
-	}, {
-		msg:   "value too low",
-		value: -1.0,
-		err:   ooohh.ErrDialValueInvalid,
-	}, {
-		msg:   "value too high",
-		value: 101.0,
-		err:   ooohh.ErrDialValueInvalid,
-	}, {
-		msg:   "value on upper bound",
-		value: 100.0,
-		err:   nil,
-	}, {
-		msg:   "value on lower bound",
-		value: 0.0,
-		err:   nil,
-	}} {
-
-		t.Run(tt.msg, func(t *testing.T) {
-			is := is.New(t)
+	// Ensure dial, for the first time.
+	d, created, err := s.EnsureDial(ctx, "EXT-1", "TEST-DIAL-1", "MYTOKEN")
+	is.NoErr(err)                   // dial is ensured correctly.
+	is.True(created)                // dial is created on first sight.
+	is.Equal(d.Name, "TEST-DIAL-1") // dial name is correct.
+	is.Equal(d.Token, "MYTOKEN")    // dial token is correct.
+	is.True(string(d.ID) != "")     // dial id is not empty.
 
-			// Check service handles bound correctly.
-			err := s.SetDial(ctx, d.ID, "MYTOKEN", tt.value)
-			is.Equal(err, tt.err)
-		})
-	}
+	// Ensure dial again.
+	d2, created, err := s.EnsureDial(ctx, "EXT-1", "OTHER-NAME", "MYTOKEN")
+	is.NoErr(err)                    // dial is ensured correctly.
+	is.True(!created)                // dial is not created again.
+	is.Equal(d2.ID, d.ID)            // existing dial is returned.
+	is.Equal(d2.Name, "TEST-DIAL-1") // existing dial is unchanged.
+
+	// Ensure a different external id.
+	d3, created, err := s.EnsureDial(ctx, "EXT-2", "TEST-DIAL-2", "MYTOKEN")
+	is.NoErr(err)          // dial is ensured correctly.
+	is.True(created)       // new external id creates a dial.
+	is.True(d3.ID != d.ID) // a different dial is created.
+
+	// Ensure with the wrong token.
+	_, _, err = s.EnsureDial(ctx, "EXT-1", "TEST-DIAL-1", "NOTMYTOKEN")
+	is.Equal(err, ooohh.ErrUnauthorized) // existing dial requires the matching token.
+
+	// Check the ensured dial can be got.
+	d4, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)                    // dial is retrieved correctly.
+	is.Equal(d4.Name, "TEST-DIAL-1") // dial name is correct.
 }
 
-// Timezone stuff.
-func TestStoringTimezones(t *testing.T) {
+func TestDialsCanBeGotInBulk(t *testing.T) {
+
 	is := is.New(t)
 
 	// Get a Bolt DB.
@@ -274,27 +438,32 @@ func TestStoringTimezones(t *testing.T) {
 
 	// Create service.
 	n := func() time.Time {
-		// return time in new timezone
-		return now.In(time.FixedZone("My/Zone", 60*60))
+		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create dial.
-	dp, err := s.CreateDial(ctx, "TEST-DIAL-4", "MYTOKEN")
+	// Create dials.
+	d1, err := s.CreateDial(ctx, "TEST-DIAL-1", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	d2, err := s.CreateDial(ctx, "TEST-DIAL-2", "MYTOKEN")
 	is.NoErr(err) // dial creates correctly.
 
-	// Get dial.
-	dp, err = s.GetDial(ctx, dp.ID)
-	is.NoErr(err) // dial is retrieved correctly.
+	// Get dials, including a missing one.
+	dials, err := s.GetDials(ctx, []ooohh.DialID{d1.ID, ooohh.DialID("NON-EXISTANT"), d2.ID})
+	is.NoErr(err) // dials are retrieved correctly.
 
-	// Check time is returned in utc.
-	is.Equal(dp.UpdatedAt, now) // time location is UTC.
+	is.Equal(len(dials), 2)                    // only found dials are returned.
+	is.Equal(dials[d1.ID].Name, "TEST-DIAL-1") // first dial is correct.
+	is.Equal(dials[d2.ID].Name, "TEST-DIAL-2") // second dial is correct.
+	is.Equal(dials[d2.ID].UpdatedAt, now)      // dial updated at is correct.
+	_, ok := dials[ooohh.DialID("NON-EXISTANT")]
+	is.True(!ok) // missing dial is omitted.
 }
 
-func TestBoardCanBeCreatedAndGot(t *testing.T) {
+func TestDialsCanBeListed(t *testing.T) {
 
 	is := is.New(t)
 
@@ -309,33 +478,40 @@ func TestBoardCanBeCreatedAndGot(t *testing.T) {
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create board.
-	bp, err := s.CreateBoard(ctx, "TEST-BOARD-1", "MYTOKEN")
-	is.NoErr(err) // board creates correctly.
-
-	is.Equal(bp.Name, "TEST-BOARD-1")  // board name is correct.
-	is.Equal(bp.Token, "MYTOKEN")      // board token is correct.
-	is.Equal(bp.Dials, []ooohh.Dial{}) // board dials are empty.
-	is.Equal(bp.UpdatedAt, now)        // board updated at is set.
-	is.True(string(bp.ID) != "")       // board id is not empty.
-
-	// Get board.
-	b2, err := s.GetBoard(ctx, bp.ID)
-	is.NoErr(err) // board is retrieved correctly.
+	// Create dials.
+	created := make(map[ooohh.DialID]bool)
+	for i := 0; i < 10; i++ {
+		d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+		created[d.ID] = true
+	}
 
-	is.Equal(b2.Name, "TEST-BOARD-1")  // board name is correct.
-	is.Equal(b2.Token, "MYTOKEN")      // board token is correct.
-	is.Equal(b2.Dials, []ooohh.Dial{}) // board dials are empty.
-	is.Equal(b2.UpdatedAt, now)        // board updated at is correct.
-	is.Equal(b2.ID, bp.ID)             // board id is correct.
+	// List dials.
+	listed := make(map[ooohh.DialID]bool)
+	err = s.ListDials(ctx, func(d ooohh.Dial) error {
+		listed[d.ID] = true
+		return nil
+	})
+	is.NoErr(err)             // dials are listed correctly.
+	is.Equal(listed, created) // all dials are listed.
+
+	// Stop listing early.
+	count := 0
+	errStop := errors.New("stop")
+	err = s.ListDials(ctx, func(d ooohh.Dial) error {
+		count++
+		return errStop
+	})
+	is.Equal(err, errStop) // error from fn is returned.
+	is.Equal(count, 1)     // listing stops at the first error.
 }
 
-func TestBoardDialUpdates(t *testing.T) {
+func TestDialsCanBePaged(t *testing.T) {
 
 	is := is.New(t)
 
@@ -344,60 +520,110 @@ func TestBoardDialUpdates(t *testing.T) {
 	defer cleanup()
 
 	// Create logger.
-	logger, logs := newTestLogger(zap.InfoLevel)
+	logger, _ := newTestLogger(zap.InfoLevel)
 
 	// Create service.
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create dial.
-	dp, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
-	is.NoErr(err) // dial creates correctly.
+	// Create dials.
+	var ids []ooohh.DialID
+	for i := 0; i < 5; i++ {
+		d, err := s.CreateDial(ctx, fmt.Sprintf("TEST-DIAL-%d", i), "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+		ids = append(ids, d.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// Page through all dials.
+	var paged []ooohh.DialID
+	var pages int
+	var after ooohh.DialID
+	for {
+		p, err := s.PageDials(ctx, after, 2)
+		is.NoErr(err)              // page is retrieved correctly.
+		is.Equal(p.Total, 5)       // total is the number of all dials.
+		is.True(len(p.Dials) <= 2) // page is within the limit.
+		pages++
+
+		for _, d := range p.Dials {
+			paged = append(paged, d.ID)
+		}
+
+		if p.Next == "" {
+			break
+		}
+		is.Equal(p.Next, p.Dials[len(p.Dials)-1].ID) // next page starts after this page.
+		after = p.Next
+	}
 
-	// Create board.
-	bp, err := s.CreateBoard(ctx, "TEST-BOARD-2", "MYTOKEN")
-	is.NoErr(err) // board creates correctly.
+	is.Equal(pages, 3)   // dials are split into pages.
+	is.Equal(paged, ids) // all dials are paged, in order.
 
-	// Add dial to board.
-	err = s.SetBoard(ctx, bp.ID, "MYTOKEN", []ooohh.DialID{dp.ID})
-	is.NoErr(err) // dial added to board without error.
+	// Exact page sizes don't have an empty trailing page.
+	p, err := s.PageDials(ctx, ids[2], 2)
+	is.NoErr(err)
+	is.Equal(len(p.Dials), 2)          // last page is full.
+	is.Equal(p.Next, ooohh.DialID("")) // there is no next page.
 
-	// Get board.
-	bp, err = s.GetBoard(ctx, bp.ID)
-	is.NoErr(err)                           // board is retrieved correctly.
-	is.Equal(len(bp.Dials), 1)              // board has 1 dial.
-	is.Equal(bp.Dials[0].Value, float64(0)) // board dial has 0 value.
+	// Limit must be positive.
+	_, err = s.PageDials(ctx, "", 0)
+	is.True(err != nil) // zero limit errors.
+}
 
-	// Update Dial Value.
-	err = s.SetDial(ctx, dp.ID, "MYTOKEN", 64.0)
-	is.NoErr(err) // dial value sets without error.
+func TestDialsCanBeWalkedWithCursor(t *testing.T) {
 
-	// Get board.
-	bp, err = s.GetBoard(ctx, bp.ID)
-	is.NoErr(err)                              // board is retrieved correctly.
-	is.Equal(len(bp.Dials), 1)                 // board has 1 dial.
-	is.Equal(bp.Dials[0].Value, float64(64.0)) // board dial has correct value.
+	is := is.New(t)
 
-	// Add non-existant dial to board.
-	err = s.SetBoard(ctx, bp.ID, "MYTOKEN", []ooohh.DialID{dp.ID, ooohh.DialID("NON-EXISTANT")})
-	is.NoErr(err) // dial added to board without error.
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Get board.
-	bp, err = s.GetBoard(ctx, bp.ID)
-	is.NoErr(err)                              // board is retrieved correctly.
-	is.Equal(len(bp.Dials), 1)                 // board only has 1 dial.
-	is.Equal(bp.Dials[0].Value, float64(64.0)) // board dial has correct value.
+	// Create service.
+	s, err := NewService(store.NewMemory(), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
 
-	// Check non-existant board logs.
-	is.Equal(len(logs.FilterMessage("GetDial error").All()), 1) // error is logged.
+	ctx := context.TODO()
+
+	// Seed more dials than fit in a page.
+	seeded := make(map[ooohh.DialID]bool)
+	for i := 0; i < 250; i++ {
+		d, err := s.CreateDial(ctx, fmt.Sprintf("TEST-DIAL-%d", i), "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+		seeded[d.ID] = true
+	}
+
+	// Walk every dial, using each page's cursor to get the next.
+	walked := make(map[ooohh.DialID]bool)
+	var pages int
+	var cursor ooohh.DialID
+	for {
+		p, err := s.PageDials(ctx, cursor, 100)
+		is.NoErr(err) // page is retrieved correctly.
+		pages++
+
+		for _, d := range p.Dials {
+			is.True(!walked[d.ID]) // dials are walked once.
+			walked[d.ID] = true
+		}
+
+		if p.Next == "" {
+			is.Equal(len(p.Dials), 50) // last page holds the remainder.
+			break
+		}
+		is.Equal(len(p.Dials), 100) // pages before the last are full.
+		cursor = p.Next
+	}
+
+	is.Equal(pages, 3)       // dials are walked in pages.
+	is.Equal(walked, seeded) // every dial is walked.
 }
 
-func TestBoardDialSetUnauthorized(t *testing.T) {
+func TestDialValueUpdates(t *testing.T) {
 
 	is := is.New(t)
 
@@ -412,22 +638,28 @@ func TestBoardDialSetUnauthorized(t *testing.T) {
 	n := func() time.Time {
 		return now
 	}
-	s, err := NewService(db, logger, n)
+	s, err := NewService(store.NewBolt(db), logger, n)
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Create board.
-	bp, err := s.CreateBoard(ctx, "TEST-BOARD-3", "MYTOKEN")
-	is.NoErr(err) // board creates correctly.
+	// Create dial.
+	dp, err := s.CreateDial(ctx, "TEST-DIAL-2", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
 
-	// Try to update Board Value.
-	err = s.SetBoard(ctx, bp.ID, "NOTMYTOKEN", []ooohh.DialID{ooohh.DialID("DIAL")})
-	is.Equal(err, ooohh.ErrUnauthorized) // board dials setting errors as unauthorized.
+	d := *dp
+
+	// Update Dial Value.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 64.0)
+	is.NoErr(err) // dial value sets without error.
 
+	// Check Dial Value.
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)                     // dial is retrieved correctly.
+	is.Equal(dp.Value, float64(64.0)) // dial has correct value.
 }
 
-func TestBoardNotFound(t *testing.T) {
+func TestDialValueDecays(t *testing.T) {
 
 	is := is.New(t)
 
@@ -438,20 +670,2625 @@ func TestBoardNotFound(t *testing.T) {
 	// Create logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
-	// Create service.
+	// Create service, with a clock that can be moved forward.
+	clock := now
 	n := func() time.Time {
-		return now
+		return clock
 	}
-	s, err := NewService(db, logger, n)
+	st := store.NewBolt(db)
+	s, err := NewService(st, logger, n, WithDecay(1.0))
 	is.NoErr(err) // service initializes correctly.
 
 	ctx := context.TODO()
 
-	// Getting non-existant board errors.
-	_, err = s.GetBoard(ctx, ooohh.BoardID("NOT-A-BOARD"))
-	is.Equal(err, ooohh.ErrBoardNotFound) // Board not found when getting.
+	// Create dial, and set its value.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
 
-	// Updating a non-existant board errors.
-	serr := s.SetBoard(ctx, ooohh.BoardID("NOT-A-DIAL-EITHER"), "MYTOKEN", []ooohh.DialID{})
-	is.Equal(serr, ooohh.ErrBoardNotFound) // Board not found when setting.
+	// No time has passed, so no decay.
+	dp, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)               // dial is retrieved correctly.
+	is.Equal(dp.Value, 10.0)    // dial value hasn't decayed.
+	is.Equal(dp.UpdatedAt, now) // dial updated at is unchanged.
+
+	// After 2.5 hours, the value has decayed by 2.5.
+	clock = now.Add(150 * time.Minute)
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)               // dial is retrieved correctly.
+	is.Equal(dp.Value, 7.5)     // dial value has decayed.
+	is.Equal(dp.UpdatedAt, now) // dial updated at is unchanged.
+
+	// Dials are decayed however they are retrieved.
+	dials, err := s.GetDials(ctx, []ooohh.DialID{d.ID})
+	is.NoErr(err)                    // dials are retrieved correctly.
+	is.Equal(dials[d.ID].Value, 7.5) // dial value has decayed.
+
+	page, err := s.PageDials(ctx, "", 10)
+	is.NoErr(err)                      // dials are paged correctly.
+	is.Equal(page.Dials[0].Value, 7.5) // paged dial value has decayed.
+
+	is.NoErr(s.ListDials(ctx, func(ld ooohh.Dial) error {
+		is.Equal(ld.Value, 7.5) // listed dial value has decayed.
+		return nil
+	}))
+
+	// The value never decays below zero.
+	clock = now.Add(24 * time.Hour)
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(dp.Value, 0.0) // dial value is floored at zero.
+
+	// Updating the dial restarts the decay.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 5.0))
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(dp.Value, 5.0) // dial value hasn't decayed.
+
+	// Adjusting the dial adds to its decayed value.
+	clock = clock.Add(2 * time.Hour)
+	is.NoErr(s.AdjustDial(ctx, d.ID, "MYTOKEN", 1.0))
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(dp.Value, 4.0) // delta is added to the decayed value.
+
+	// Updating anything else keeps the decayed value, and restarts the decay
+	// from it.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
+	clock = clock.Add(8 * time.Hour)
+	is.NoErr(s.SetDialColor(ctx, d.ID, "MYTOKEN", "#ff0000"))
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(dp.Value, 2.0) // decayed value is kept after setting the color.
+
+	clock = clock.Add(1 * time.Hour)
+	is.NoErr(s.RenameDial(ctx, d.ID, "MYTOKEN", "RENAMED"))
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(dp.Value, 1.0) // decay restarts from the decayed value after renaming.
+}
+
+func TestDialValueDoesNotDecayByDefault(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that can be moved forward.
+	clock := now
+	n := func() time.Time {
+		return clock
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
+
+	clock = now.Add(24 * time.Hour)
+	dp, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)            // dial is retrieved correctly.
+	is.Equal(dp.Value, 10.0) // dial value hasn't decayed.
+}
+
+func TestDialHistory(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that moves forward on every call.
+	var ticks int
+	n := func() time.Time {
+		ticks++
+		return now.Add(time.Duration(ticks) * time.Minute)
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dials.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	other, err := s.CreateDial(ctx, "OTHER-DIAL", "MYTOKEN")
+	is.NoErr(err) // other dial creates correctly.
+
+	// A new dial has no history.
+	h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)       // history is retrieved.
+	is.Equal(len(h), 0) // history is empty.
+
+	// Set values, with and without notes.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
+	is.NoErr(s.SetDialWithNote(ctx, d.ID, "MYTOKEN", 80.0, "  prod is on fire "))
+	is.NoErr(s.SetDial(ctx, other.ID, "MYTOKEN", 50.0))
+
+	// Failed updates aren't recorded.
+	is.Equal(s.SetDialWithNote(ctx, d.ID, "WRONG", 90.0, "nope"), ooohh.ErrUnauthorized)
+	is.Equal(s.SetDialWithNote(ctx, d.ID, "MYTOKEN", 101.0, "nope"), ooohh.ErrDialValueInvalid)
+
+	h, err = s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)                          // history is retrieved.
+	is.Equal(len(h), 2)                    // only the dial's own readings are returned.
+	is.Equal(h[0].Value, 10.0)             // oldest reading is first.
+	is.Equal(h[0].Note, "")                // reading without a note has none.
+	is.Equal(h[1].Value, 80.0)             // newest reading is last.
+	is.Equal(h[1].Note, "prod is on fire") // note is kept, trimmed.
+	is.True(h[0].At.Before(h[1].At))       // readings are timestamped.
+	is.Equal(h[1].At.Location(), time.UTC) // reading time is in UTC.
+
+	// Getting the history of a missing dial errors.
+	_, err = s.GetDialHistory(ctx, ooohh.DialID("missing"), time.Time{})
+	is.Equal(err, ooohh.ErrDialNotFound) // dial is not found.
+}
+
+func TestDialHistoryAtTheSameTime(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a fixed clock.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	for _, v := range []float64{30.0, 20.0, 10.0} {
+		is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", v))
+	}
+
+	h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)              // history is retrieved.
+	is.Equal(len(h), 3)        // no readings are overwritten.
+	is.Equal(h[0].Value, 30.0) // readings are in the order they were set.
+	is.Equal(h[2].Value, 10.0)
+}
+
+func TestDialHistorySince(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that can be moved forward.
+	clock := now
+	n := func() time.Time {
+		return clock
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Set a value every hour.
+	for i, v := range []float64{10.0, 20.0, 30.0, 40.0} {
+		clock = now.Add(time.Duration(i) * time.Hour)
+		is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", v))
+	}
+
+	h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)       // history is retrieved.
+	is.Equal(len(h), 4) // the whole history is retrieved without a since time.
+
+	h, err = s.GetDialHistory(ctx, d.ID, now.Add(2*time.Hour))
+	is.NoErr(err)                           // history is retrieved.
+	is.Equal(len(h), 2)                     // only readings since the time are retrieved.
+	is.Equal(h[0].Value, 30.0)              // a reading at the since time is included.
+	is.Equal(h[0].At, now.Add(2*time.Hour)) // readings are in order.
+	is.Equal(h[1].Value, 40.0)              // readings are in order.
+
+	h, err = s.GetDialHistory(ctx, d.ID, now.Add(90*time.Minute))
+	is.NoErr(err)              // history is retrieved.
+	is.Equal(len(h), 2)        // only readings since the time are retrieved.
+	is.Equal(h[0].Value, 30.0) // readings before the time are excluded.
+
+	h, err = s.GetDialHistory(ctx, d.ID, now.Add(24*time.Hour))
+	is.NoErr(err)       // history is retrieved.
+	is.Equal(len(h), 0) // there are no readings since the time.
+}
+
+func TestDialColorUpdates(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		token    string
+		color    string
+		expErr   error
+		expColor string
+	}{{
+		msg:      "long hex color",
+		token:    "MYTOKEN",
+		color:    "#00ff00",
+		expColor: "#00ff00",
+	}, {
+		msg:      "short hex color",
+		token:    "MYTOKEN",
+		color:    "#ABC",
+		expColor: "#ABC",
+	}, {
+		msg:      "cleared color",
+		token:    "MYTOKEN",
+		color:    "",
+		expColor: "",
+	}, {
+		msg:      "missing hash",
+		token:    "MYTOKEN",
+		color:    "00ff00",
+		expErr:   ooohh.ErrDialColorInvalid,
+		expColor: "#123456",
+	}, {
+		msg:      "not hex",
+		token:    "MYTOKEN",
+		color:    "#gggggg",
+		expErr:   ooohh.ErrDialColorInvalid,
+		expColor: "#123456",
+	}, {
+		msg:      "named color",
+		token:    "MYTOKEN",
+		color:    "red",
+		expErr:   ooohh.ErrDialColorInvalid,
+		expColor: "#123456",
+	}, {
+		msg:      "wrong token",
+		token:    "NOTMYTOKEN",
+		color:    "#00ff00",
+		expErr:   ooohh.ErrUnauthorized,
+		expColor: "#123456",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a Bolt DB.
+			db, cleanup := newTmpBoltDB(t)
+			defer cleanup()
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create service.
+			n := func() time.Time {
+				return now
+			}
+			s, err := NewService(store.NewBolt(db), logger, n)
+			is.NoErr(err) // service initializes correctly.
+
+			ctx := context.TODO()
+
+			// Create dial, with an initial color.
+			d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+			is.NoErr(err) // dial creates correctly.
+			err = s.SetDialColor(ctx, d.ID, "MYTOKEN", "#123456")
+			is.NoErr(err) // initial dial color sets without error.
+
+			// Update Dial Color.
+			err = s.SetDialColor(ctx, d.ID, tt.token, tt.color)
+			is.Equal(err, tt.expErr) // dial color sets with expected error.
+
+			// Check Dial Color.
+			d, err = s.GetDial(ctx, d.ID)
+			is.NoErr(err)                  // dial is retrieved correctly.
+			is.Equal(d.Color, tt.expColor) // dial has correct color.
+		})
+	}
+}
+
+func TestDialColorNotFound(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	err = s.SetDialColor(context.TODO(), ooohh.DialID("NON-EXISTANT"), "MYTOKEN", "#00ff00")
+	is.Equal(err, ooohh.ErrDialNotFound) // dial is not found.
+}
+
+func TestDialGroupUpdates(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		token    string
+		group    string
+		expErr   error
+		expGroup string
+	}{{
+		msg:      "new group",
+		token:    "MYTOKEN",
+		group:    "backend",
+		expGroup: "backend",
+	}, {
+		msg:      "trimmed group",
+		token:    "MYTOKEN",
+		group:    "  backend ",
+		expGroup: "backend",
+	}, {
+		msg:      "cleared group",
+		token:    "MYTOKEN",
+		group:    "",
+		expGroup: "",
+	}, {
+		msg:      "wrong token",
+		token:    "NOTMYTOKEN",
+		group:    "backend",
+		expErr:   ooohh.ErrUnauthorized,
+		expGroup: "frontend",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a Bolt DB.
+			db, cleanup := newTmpBoltDB(t)
+			defer cleanup()
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create service.
+			n := func() time.Time {
+				return now
+			}
+			s, err := NewService(store.NewBolt(db), logger, n)
+			is.NoErr(err) // service initializes correctly.
+
+			ctx := context.TODO()
+
+			// Create dial, with an initial group.
+			d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+			is.NoErr(err) // dial creates correctly.
+			err = s.SetDialGroup(ctx, d.ID, "MYTOKEN", "frontend")
+			is.NoErr(err) // initial dial group sets without error.
+
+			// Update Dial Group.
+			err = s.SetDialGroup(ctx, d.ID, tt.token, tt.group)
+			is.Equal(err, tt.expErr) // dial group sets with expected error.
+
+			// Check Dial Group.
+			d, err = s.GetDial(ctx, d.ID)
+			is.NoErr(err)                  // dial is retrieved correctly.
+			is.Equal(d.Group, tt.expGroup) // dial has correct group.
+		})
+	}
+}
+
+func TestDialCanBeRenamed(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg     string
+		token   string
+		name    string
+		expErr  error
+		expName string
+	}{{
+		msg:     "new name",
+		token:   "MYTOKEN",
+		name:    "RENAMED-DIAL",
+		expName: "RENAMED-DIAL",
+	}, {
+		msg:     "wrong token",
+		token:   "NOTMYTOKEN",
+		name:    "RENAMED-DIAL",
+		expErr:  ooohh.ErrUnauthorized,
+		expName: "TEST-DIAL",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create service, with a time that moves on.
+			current := now
+			n := func() time.Time {
+				return current
+			}
+			s, err := NewService(store.NewMemory(), logger, n)
+			is.NoErr(err) // service initializes correctly.
+
+			ctx := context.TODO()
+
+			// Create dial.
+			d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+			is.NoErr(err) // dial creates correctly.
+			created := d.UpdatedAt
+
+			// Rename Dial.
+			current = now.Add(time.Minute)
+			err = s.RenameDial(ctx, d.ID, tt.token, tt.name)
+			is.Equal(err, tt.expErr) // dial renames with expected error.
+
+			// Check Dial Name.
+			d, err = s.GetDial(ctx, d.ID)
+			is.NoErr(err)                // dial is retrieved correctly.
+			is.Equal(d.Name, tt.expName) // dial has correct name.
+			if tt.expErr == nil {
+				is.Equal(d.UpdatedAt, current) // dial update time is updated.
+			} else {
+				is.Equal(d.UpdatedAt, created) // dial update time is unchanged.
+			}
+		})
+	}
+
+	t.Run("missing dial", func(t *testing.T) {
+
+		is := is.New(t)
+
+		// Create logger.
+		logger, _ := newTestLogger(zap.InfoLevel)
+
+		s, err := NewService(store.NewMemory(), logger, time.Now)
+		is.NoErr(err) // service initializes correctly.
+
+		err = s.RenameDial(context.TODO(), ooohh.DialID("NON-EXISTANT"), "MYTOKEN", "RENAMED-DIAL")
+		is.Equal(err, ooohh.ErrDialNotFound) // dial is not found.
+	})
+}
+
+func TestDialTokenCanBeReplaced(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		token    string
+		newToken string
+		expErr   error
+		expToken string
+	}{{
+		msg:      "new token",
+		token:    "MYTOKEN",
+		newToken: "NEWTOKEN",
+		expToken: "NEWTOKEN",
+	}, {
+		msg:      "empty token",
+		token:    "MYTOKEN",
+		newToken: "",
+		expErr:   ooohh.ErrDialTokenInvalid,
+		expToken: "MYTOKEN",
+	}, {
+		msg:      "wrong token",
+		token:    "NOTMYTOKEN",
+		newToken: "NEWTOKEN",
+		expErr:   ooohh.ErrUnauthorized,
+		expToken: "MYTOKEN",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create service.
+			n := func() time.Time {
+				return now
+			}
+			s, err := NewService(store.NewMemory(), logger, n)
+			is.NoErr(err) // service initializes correctly.
+
+			ctx := context.TODO()
+
+			// Create dial.
+			d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+			is.NoErr(err) // dial creates correctly.
+
+			// Replace Dial Token.
+			err = s.SetDialToken(ctx, d.ID, tt.token, tt.newToken)
+			is.Equal(err, tt.expErr) // dial token sets with expected error.
+
+			// Check Dial Token.
+			d, err = s.GetDial(ctx, d.ID)
+			is.NoErr(err)                                     // dial is retrieved correctly.
+			is.True(ooohh.TokenMatches(d.Token, tt.expToken)) // dial has correct token.
+
+			// Only the current token can update the dial.
+			err = s.SetDial(ctx, d.ID, tt.expToken, 50)
+			is.NoErr(err) // dial sets with the current token.
+			if tt.expToken != "MYTOKEN" {
+				err = s.SetDial(ctx, d.ID, "MYTOKEN", 50)
+				is.Equal(err, ooohh.ErrUnauthorized) // dial doesn't set with the old token.
+			}
+		})
+	}
+}
+
+func TestDialCanBeDeleted(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dials, with history, on a board.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	other, err := s.CreateDial(ctx, "OTHER-DIAL", "MYTOKEN")
+	is.NoErr(err) // other dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
+	is.NoErr(s.SetDial(ctx, other.ID, "MYTOKEN", 20.0))
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID, other.ID}))
+
+	// Delete with the wrong token.
+	err = s.DeleteDial(ctx, d.ID, "NOTMYTOKEN")
+	is.Equal(err, ooohh.ErrUnauthorized) // dial can't be deleted without its token.
+
+	// Delete the dial.
+	err = s.DeleteDial(ctx, d.ID, "MYTOKEN")
+	is.NoErr(err) // dial is deleted.
+
+	_, err = s.GetDial(ctx, d.ID)
+	is.Equal(err, ooohh.ErrDialNotFound) // dial is gone.
+
+	_, err = s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.Equal(err, ooohh.ErrDialNotFound) // dial history is gone.
+
+	h, err := s.GetDialHistory(ctx, other.ID, time.Time{})
+	is.NoErr(err)       // other dial history is retrieved.
+	is.Equal(len(h), 1) // other dial history is untouched.
+
+	// The board keeps the deleted dial's ID, but skips it, as with any missing dial.
+	b, err = s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                     // board is retrieved correctly.
+	is.Equal(len(b.Dials), 1)         // deleted dial is skipped.
+	is.Equal(b.Dials[0].ID, other.ID) // remaining dial is kept.
+
+	ids, err := s.GetBoardDialIDs(ctx, b.ID)
+	is.NoErr(err)                                 // board dial ids are retrieved.
+	is.Equal(ids, []ooohh.DialID{d.ID, other.ID}) // deleted dial id is still stored.
+
+	// Delete the dial again.
+	err = s.DeleteDial(ctx, d.ID, "MYTOKEN")
+	is.Equal(err, ooohh.ErrDialNotFound) // dial is not found.
+}
+
+func TestBoardCanBeDeleted(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create a dial, on two boards.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10.0))
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID}))
+	other, err := s.CreateBoard(ctx, "OTHER-BOARD", "MYTOKEN")
+	is.NoErr(err) // other board creates correctly.
+	is.NoErr(s.SetBoard(ctx, other.ID, "MYTOKEN", []ooohh.DialID{d.ID}))
+
+	// Delete with the wrong token.
+	err = s.DeleteBoard(ctx, b.ID, "NOTMYTOKEN")
+	is.Equal(err, ooohh.ErrUnauthorized) // board can't be deleted without its token.
+
+	// Delete the board, by its code.
+	err = s.DeleteBoard(ctx, ooohh.BoardID(b.Code), "MYTOKEN")
+	is.NoErr(err) // board is deleted.
+
+	_, err = s.GetBoard(ctx, b.ID)
+	is.Equal(err, ooohh.ErrBoardNotFound) // board is gone.
+
+	_, err = s.GetBoard(ctx, ooohh.BoardID(b.Code))
+	is.Equal(err, ooohh.ErrBoardNotFound) // board code is gone.
+
+	// The dial is kept, on the other board.
+	got, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)             // dial is retrieved correctly.
+	is.Equal(got.Value, 10.0) // dial is untouched.
+
+	other, err = s.GetBoard(ctx, other.ID)
+	is.NoErr(err)                 // other board is retrieved correctly.
+	is.Equal(len(other.Dials), 1) // other board keeps the dial.
+
+	// Delete the board again.
+	err = s.DeleteBoard(ctx, b.ID, "MYTOKEN")
+	is.Equal(err, ooohh.ErrBoardNotFound) // board is not found.
+}
+
+func TestDialTokenCanBeVerified(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that can be moved.
+	current := now
+	n := func() time.Time {
+		return current
+	}
+	s, err := NewService(store.NewBolt(db), logger, n, WithLockout(2, time.Minute))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Verify tokens, later on so any change to the dial would be seen.
+	current = current.Add(time.Hour)
+	is.NoErr(s.VerifyDialToken(ctx, d.ID, "MYTOKEN"))                              // correct token is verified.
+	is.Equal(s.VerifyDialToken(ctx, d.ID, "NOTMYTOKEN"), ooohh.ErrUnauthorized)    // wrong token is unauthorized.
+	is.Equal(s.VerifyDialToken(ctx, "NOTADIAL", "MYTOKEN"), ooohh.ErrDialNotFound) // missing dial is not found.
+
+	// Check the dial is unchanged.
+	dp, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)                  // dial is retrieved correctly.
+	is.Equal(dp.UpdatedAt, now)    // dial updated at is unchanged.
+	is.Equal(dp.Value, float64(0)) // dial value is unchanged.
+
+	// Check wrong tokens count towards the lockout.
+	is.Equal(s.VerifyDialToken(ctx, d.ID, "NOTMYTOKEN"), ooohh.ErrUnauthorized) // wrong token is unauthorized.
+	is.Equal(s.VerifyDialToken(ctx, d.ID, "MYTOKEN"), ooohh.ErrLockedOut)       // dial is locked out.
+}
+
+func TestDialValueSetUnauthorized(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	dp, err := s.CreateDial(ctx, "TEST-DIAL-3", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	d := *dp
+
+	// Try to update Dial Value.
+	err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 64.0)
+	is.Equal(err, ooohh.ErrUnauthorized) // dial value setting errors as unauthorized.
+
+}
+
+func TestDialLockout(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that can be moved.
+	current := now
+	n := func() time.Time {
+		return current
+	}
+	s, err := NewService(store.NewBolt(db), logger, n, WithLockout(3, time.Minute))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dials.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	other, err := s.CreateDial(ctx, "OTHER-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Make bad attempts, up to the limit.
+	for i := 0; i < 3; i++ {
+		err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50.0)
+		is.Equal(err, ooohh.ErrUnauthorized) // bad attempts are unauthorized.
+		current = current.Add(time.Second)
+	}
+
+	// Check the dial is locked out, even with the correct token.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.Equal(err, ooohh.ErrLockedOut) // dial is locked out.
+	err = s.SetDialColor(ctx, d.ID, "MYTOKEN", "#00ff00")
+	is.Equal(err, ooohh.ErrLockedOut) // all updates are locked out.
+
+	// Check other dials aren't locked out.
+	err = s.SetDial(ctx, other.ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // other dials can be updated.
+
+	// Check the lockout clears after the window.
+	current = current.Add(time.Minute)
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // dial can be updated after the window.
+
+	d, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(d.Value, 50.0) // dial value is updated.
+}
+
+func TestEnsureDialLockout(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that can be moved.
+	current := now
+	n := func() time.Time {
+		return current
+	}
+	s, err := NewService(store.NewBolt(db), logger, n, WithLockout(3, time.Minute))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create the dial for the external ID.
+	d, created, err := s.EnsureDial(ctx, "ext-1", "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err)    // dial is ensured correctly.
+	is.True(created) // dial is created.
+
+	// Make bad attempts, up to the limit.
+	for i := 0; i < 3; i++ {
+		_, _, err = s.EnsureDial(ctx, "ext-1", "TEST-DIAL", "NOTMYTOKEN")
+		is.Equal(err, ooohh.ErrUnauthorized) // bad attempts are unauthorized.
+		current = current.Add(time.Second)
+	}
+
+	// Check the dial is locked out, even with the correct token.
+	_, _, err = s.EnsureDial(ctx, "ext-1", "TEST-DIAL", "MYTOKEN")
+	is.Equal(err, ooohh.ErrLockedOut) // dial is locked out.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.Equal(err, ooohh.ErrLockedOut) // the dial's updates are locked out too.
+
+	// Check the lockout clears after the window.
+	current = current.Add(time.Minute)
+	_, created, err = s.EnsureDial(ctx, "ext-1", "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err)     // dial is ensured after the window.
+	is.True(!created) // existing dial is returned.
+}
+
+func TestDialLockoutWindow(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with a clock that can be moved.
+	current := now
+	n := func() time.Time {
+		return current
+	}
+	s, err := NewService(store.NewBolt(db), logger, n, WithLockout(2, time.Minute))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Make bad attempts, spread further apart than the window.
+	err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50.0)
+	is.Equal(err, ooohh.ErrUnauthorized) // bad attempt is unauthorized.
+	current = current.Add(2 * time.Minute)
+	err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50.0)
+	is.Equal(err, ooohh.ErrUnauthorized) // bad attempt is unauthorized.
+
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // failures outside the window don't lock the dial out.
+
+	// Check a successful attempt resets the failures.
+	err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50.0)
+	is.Equal(err, ooohh.ErrUnauthorized) // bad attempt is unauthorized.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // dial is not locked out.
+	err = s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50.0)
+	is.Equal(err, ooohh.ErrUnauthorized) // bad attempt is unauthorized.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // failures before a successful attempt aren't counted.
+}
+
+func TestDialNotFound(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Getting non-existant dial errors.
+	_, err = s.GetDial(ctx, ooohh.DialID("NOT-A-DIAL"))
+	is.Equal(err, ooohh.ErrDialNotFound) // Dial not found when getting.
+
+	// Updating a non-existant dial errors.
+	serr := s.SetDial(ctx, ooohh.DialID("NOT-A-DIAL-EITHER"), "MYTOKEN", 44.0)
+	is.Equal(serr, ooohh.ErrDialNotFound) // Dial not found when setting.
+}
+
+func TestDialSetValueBounds(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	for _, tt := range []struct {
+		msg   string
+		value float64
+		err   error
+	}{{
+		msg:   "valid value",
+		value: 66.6,
+		err:   nil,
+	}, {
+		msg:   "value too low",
+		value: -1.0,
+		err:   ooohh.ErrDialValueInvalid,
+	}, {
+		msg:   "value too high",
+		value: 101.0,
+		err:   ooohh.ErrDialValueInvalid,
+	}, {
+		msg:   "value on upper bound",
+		value: 100.0,
+		err:   nil,
+	}, {
+		msg:   "value on lower bound",
+		value: 0.0,
+		err:   nil,
+	}, {
+		msg:   "value just over upper bound",
+		value: 100.0001,
+		err:   ooohh.ErrDialValueInvalid,
+	}, {
+		msg:   "negative zero",
+		value: math.Copysign(0, -1),
+		err:   nil,
+	}, {
+		msg:   "not a number",
+		value: math.NaN(),
+		err:   ooohh.ErrDialValueInvalid,
+	}, {
+		msg:   "positive infinity",
+		value: math.Inf(1),
+		err:   ooohh.ErrDialValueInvalid,
+	}, {
+		msg:   "negative infinity",
+		value: math.Inf(-1),
+		err:   ooohh.ErrDialValueInvalid,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+			is := is.New(t)
+
+			// Check service handles bound correctly.
+			err := s.SetDial(ctx, d.ID, "MYTOKEN", tt.value)
+			is.Equal(err, tt.err)
+		})
+	}
+}
+
+func TestInvalidDialValuesAreNotStored(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewMemory(), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial, with a valid value.
+	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
+	is.NoErr(err)                                   // dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 66.6)) // valid value sets.
+
+	// Set invalid values.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 150), ooohh.ErrDialValueInvalid)                         // too high value is invalid.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", math.NaN()), ooohh.ErrDialValueInvalid)                  // NaN is invalid.
+	is.Equal(s.SetDialWithNote(ctx, d.ID, "MYTOKEN", 150, "note"), ooohh.ErrDialValueInvalid)         // too high value with note is invalid.
+	is.Equal(s.SetDialWithNote(ctx, d.ID, "MYTOKEN", math.Inf(1), "note"), ooohh.ErrDialValueInvalid) // infinity with note is invalid.
+
+	// Check the dial still has the valid value.
+	d, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(d.Value, 66.6) // dial keeps its valid value.
+
+	h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)              // history is retrieved correctly.
+	is.Equal(len(h), 1)        // invalid values aren't recorded.
+	is.Equal(h[0].Value, 66.6) // only the valid value is recorded.
+}
+
+func TestDialSetValueConfiguredBounds(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with narrower bounds.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n, WithValueBounds(10, 20))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 10))                                    // value on lower bound is valid.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 20))                                    // value on upper bound is valid.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 9.99), ooohh.ErrDialValueInvalid)       // value below lower bound is invalid.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 50), ooohh.ErrDialValueInvalid)         // value above upper bound is invalid.
+	is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", math.NaN()), ooohh.ErrDialValueInvalid) // NaN is invalid.
+}
+
+func TestDialValueAdjusts(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 50)) // dial value sets without error.
+
+	for _, tt := range []struct {
+		msg      string
+		delta    float64
+		expValue float64
+	}{{
+		msg:      "increase",
+		delta:    5,
+		expValue: 55,
+	}, {
+		msg:      "decrease",
+		delta:    -10,
+		expValue: 45,
+	}, {
+		msg:      "clamped at upper bound",
+		delta:    100,
+		expValue: 100,
+	}, {
+		msg:      "increase at upper bound",
+		delta:    1,
+		expValue: 100,
+	}, {
+		msg:      "clamped at lower bound",
+		delta:    -150,
+		expValue: 0,
+	}} {
+		err = s.AdjustDial(ctx, d.ID, "MYTOKEN", tt.delta)
+		is.NoErr(err) // dial value adjusts without error.
+
+		dp, err := s.GetDial(ctx, d.ID)
+		is.NoErr(err)                   // dial is retrieved correctly.
+		is.Equal(dp.Value, tt.expValue) // dial has correct value.
+	}
+
+	// Check the adjusted values are in the dial's history.
+	h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+	is.NoErr(err)                    // history is retrieved.
+	is.Equal(h[len(h)-1].Value, 0.0) // last adjusted value is in the history.
+
+	is.Equal(s.AdjustDial(ctx, d.ID, "MYTOKEN", math.NaN()), ooohh.ErrDialValueInvalid)  // NaN is invalid.
+	is.Equal(s.AdjustDial(ctx, d.ID, "MYTOKEN", math.Inf(1)), ooohh.ErrDialValueInvalid) // infinity is invalid.
+	is.Equal(s.AdjustDial(ctx, d.ID, "WRONG", 5), ooohh.ErrUnauthorized)                 // wrong token is unauthorized.
+	is.Equal(s.AdjustDial(ctx, "missing", "MYTOKEN", 5), ooohh.ErrDialNotFound)          // missing dial is not found.
+}
+
+func TestDialValueAdjustsWithinConfiguredBounds(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service, with narrower bounds.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n, WithValueBounds(10, 20))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	d, err := s.CreateDial(ctx, "DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	is.NoErr(s.AdjustDial(ctx, d.ID, "MYTOKEN", 50)) // dial value adjusts without error.
+	dp, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)            // dial is retrieved correctly.
+	is.Equal(dp.Value, 20.0) // value is clamped at the upper bound.
+
+	is.NoErr(s.AdjustDial(ctx, d.ID, "MYTOKEN", -50)) // dial value adjusts without error.
+	dp, err = s.GetDial(ctx, d.ID)
+	is.NoErr(err)            // dial is retrieved correctly.
+	is.Equal(dp.Value, 10.0) // value is clamped at the lower bound.
+}
+
+// Timezone stuff.
+func TestStoringTimezones(t *testing.T) {
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		// return time in new timezone
+		return now.In(time.FixedZone("My/Zone", 60*60))
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	dp, err := s.CreateDial(ctx, "TEST-DIAL-4", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Get dial.
+	dp, err = s.GetDial(ctx, dp.ID)
+	is.NoErr(err) // dial is retrieved correctly.
+
+	// Check time is returned in utc.
+	is.Equal(dp.UpdatedAt, now) // time location is UTC.
+}
+
+func TestBoardCanBeCreatedAndGot(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create board.
+	bp, err := s.CreateBoard(ctx, "TEST-BOARD-1", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	is.Equal(bp.Name, "TEST-BOARD-1")  // board name is correct.
+	is.Equal(bp.Token, "MYTOKEN")      // board token is correct.
+	is.Equal(bp.Dials, []ooohh.Dial{}) // board dials are empty.
+	is.Equal(bp.UpdatedAt, now)        // board updated at is set.
+	is.True(string(bp.ID) != "")       // board id is not empty.
+
+	// Get board.
+	b2, err := s.GetBoard(ctx, bp.ID)
+	is.NoErr(err) // board is retrieved correctly.
+
+	is.Equal(b2.Name, "TEST-BOARD-1")                // board name is correct.
+	is.True(ooohh.TokenMatches(b2.Token, "MYTOKEN")) // board token is correct.
+	is.Equal(b2.Dials, []ooohh.Dial{})               // board dials are empty.
+	is.Equal(b2.UpdatedAt, now)                      // board updated at is correct.
+	is.Equal(b2.ID, bp.ID)                           // board id is correct.
+}
+
+func TestBoardCanBeCreatedWithDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create board with dials in one call.
+	b, err := s.CreateBoardWithDials(ctx, "TEST-BOARD", "MYTOKEN", []string{"ONE", "TWO"})
+	is.NoErr(err)                    // board creates correctly.
+	is.Equal(b.Name, "TEST-BOARD")   // board name is correct.
+	is.Equal(len(b.Dials), 2)        // board has the created dials.
+	is.Equal(b.Dials[0].Name, "ONE") // dials are in order.
+	is.Equal(b.Dials[1].Name, "TWO") // dials are in order.
+
+	// Retrieve the board.
+	got, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                            // board is retrieved correctly.
+	is.Equal(len(got.Dials), 2)              // board dials are stored.
+	is.Equal(got.Dials[0].ID, b.Dials[0].ID) // board dials are stored.
+	is.Equal(got.Dials[1].ID, b.Dials[1].ID) // board dials are stored.
+	is.Equal(got.Dials[0].Value, 0.0)        // dial has zero value.
+	is.Equal(got.Dials[1].Value, 0.0)        // dial has zero value.
+
+	// The dials can be updated with the same token.
+	err = s.SetDial(ctx, b.Dials[0].ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // dial value is set with the board token.
+
+	// The board can be updated with the same token.
+	err = s.SetBoardName(ctx, b.ID, "MYTOKEN", "RENAMED")
+	is.NoErr(err) // board is renamed with its token.
+}
+
+func TestBoardsCanBeListedByToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create boards under two tokens.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	err = s.SetDial(ctx, d.ID, "MYTOKEN", 50.0)
+	is.NoErr(err) // dial value sets without error.
+
+	mine := make(map[ooohh.BoardID]string)
+	for _, name := range []string{"MINE-1", "MINE-2", "MINE-3"} {
+		b, err := s.CreateBoard(ctx, name, "MYTOKEN")
+		is.NoErr(err) // board creates correctly.
+		err = s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID})
+		is.NoErr(err) // board dials set without error.
+		mine[b.ID] = name
+	}
+	for _, name := range []string{"THEIRS-1", "THEIRS-2"} {
+		_, err := s.CreateBoard(ctx, name, "THEIRTOKEN")
+		is.NoErr(err) // board creates correctly.
+	}
+
+	// List boards by token.
+	boards, err := s.ListBoardsByToken(ctx, "MYTOKEN")
+	is.NoErr(err)            // boards are listed correctly.
+	is.Equal(len(boards), 3) // only matching boards are listed.
+	for _, b := range boards {
+		is.Equal(b.Name, mine[b.ID])                    // board is one of mine.
+		is.True(ooohh.TokenMatches(b.Token, "MYTOKEN")) // board token is correct.
+		is.Equal(b.UpdatedAt, now)                      // board updated at is correct.
+		is.Equal(b.Dials, []ooohh.Dial{{ID: d.ID}})     // only dial ids are retrieved.
+	}
+
+	boards, err = s.ListBoardsByToken(ctx, "THEIRTOKEN")
+	is.NoErr(err)            // boards are listed correctly.
+	is.Equal(len(boards), 2) // only matching boards are listed.
+
+	// List boards by an unknown token.
+	boards, err = s.ListBoardsByToken(ctx, "NOTATOKEN")
+	is.NoErr(err)                     // boards are listed correctly.
+	is.Equal(boards, []ooohh.Board{}) // no boards are listed.
+}
+
+func TestBoardCanBeGotByCode(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create boards.
+	bp, err := s.CreateBoard(ctx, "TEST-BOARD-1", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	other, err := s.CreateBoard(ctx, "TEST-BOARD-2", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	is.Equal(len(bp.Code), 6)         // board code is short.
+	is.True(bp.Code != other.Code)    // board codes are unique.
+	is.True(string(bp.ID) != bp.Code) // board code is not the id.
+
+	for _, id := range []ooohh.BoardID{bp.ID, ooohh.BoardID(bp.Code), ooohh.BoardID(strings.ToLower(bp.Code))} {
+		// Get board.
+		b2, err := s.GetBoard(ctx, id)
+		is.NoErr(err)                     // board is retrieved correctly.
+		is.Equal(b2.ID, bp.ID)            // board id is correct.
+		is.Equal(b2.Code, bp.Code)        // board code is correct.
+		is.Equal(b2.Name, "TEST-BOARD-1") // board name is correct.
+	}
+
+	// Set board dials via its code.
+	err = s.SetBoard(ctx, ooohh.BoardID(bp.Code), "MYTOKEN", []ooohh.DialID{ooohh.DialID("DIAL")})
+	is.NoErr(err) // board is set via code.
+
+	ids, err := s.GetBoardDialIDs(ctx, bp.ID)
+	is.NoErr(err)                                       // board dial ids are retrieved.
+	is.Equal(ids, []ooohh.DialID{ooohh.DialID("DIAL")}) // dials were set on the board.
+}
+
+func TestBoardDialUpdates(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, logs := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	dp, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Create board.
+	bp, err := s.CreateBoard(ctx, "TEST-BOARD-2", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	// Add dial to board.
+	err = s.SetBoard(ctx, bp.ID, "MYTOKEN", []ooohh.DialID{dp.ID})
+	is.NoErr(err) // dial added to board without error.
+
+	// Get board.
+	bp, err = s.GetBoard(ctx, bp.ID)
+	is.NoErr(err)                           // board is retrieved correctly.
+	is.Equal(len(bp.Dials), 1)              // board has 1 dial.
+	is.Equal(bp.Dials[0].Value, float64(0)) // board dial has 0 value.
+
+	// Update Dial Value.
+	err = s.SetDial(ctx, dp.ID, "MYTOKEN", 64.0)
+	is.NoErr(err) // dial value sets without error.
+
+	// Get board.
+	bp, err = s.GetBoard(ctx, bp.ID)
+	is.NoErr(err)                              // board is retrieved correctly.
+	is.Equal(len(bp.Dials), 1)                 // board has 1 dial.
+	is.Equal(bp.Dials[0].Value, float64(64.0)) // board dial has correct value.
+
+	// Add non-existant dial to board.
+	err = s.SetBoard(ctx, bp.ID, "MYTOKEN", []ooohh.DialID{dp.ID, ooohh.DialID("NON-EXISTANT")})
+	is.NoErr(err) // dial added to board without error.
+
+	// Get board.
+	bp, err = s.GetBoard(ctx, bp.ID)
+	is.NoErr(err)                              // board is retrieved correctly.
+	is.Equal(len(bp.Dials), 1)                 // board only has 1 dial.
+	is.Equal(bp.Dials[0].Value, float64(64.0)) // board dial has correct value.
+
+	// Check non-existant board logs.
+	is.Equal(len(logs.FilterMessage("GetDial error").All()), 1) // error is logged.
+}
+
+func TestBoardCanBeRenamed(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create board, with a dial.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	err = s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID})
+	is.NoErr(err) // board dials set correctly.
+
+	// Rename with the wrong token.
+	err = s.SetBoardName(ctx, b.ID, "NOTMYTOKEN", "RENAMED")
+	is.Equal(err, ooohh.ErrUnauthorized) // board can't be renamed without its token.
+
+	// Rename by code.
+	err = s.SetBoardName(ctx, ooohh.BoardID(b.Code), "MYTOKEN", "RENAMED")
+	is.NoErr(err) // board is renamed.
+
+	b, err = s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                 // board is retrieved correctly.
+	is.Equal(b.Name, "RENAMED")   // board name is updated.
+	is.Equal(len(b.Dials), 1)     // board dials are untouched.
+	is.Equal(b.Dials[0].ID, d.ID) // board dials are untouched.
+
+	// Rename a missing board.
+	err = s.SetBoardName(ctx, ooohh.BoardID("NON-EXISTANT"), "MYTOKEN", "RENAMED")
+	is.Equal(err, ooohh.ErrBoardNotFound) // board is not found.
+}
+
+func TestBoardDescriptionUpdates(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg            string
+		token          string
+		description    string
+		expErr         error
+		expDescription string
+	}{{
+		msg:            "new description",
+		token:          "MYTOKEN",
+		description:    "Q3 stress tracking for Platform team",
+		expDescription: "Q3 stress tracking for Platform team",
+	}, {
+		msg:            "trimmed description",
+		token:          "MYTOKEN",
+		description:    "  Q3 stress tracking ",
+		expDescription: "Q3 stress tracking",
+	}, {
+		msg:            "cleared description",
+		token:          "MYTOKEN",
+		description:    "",
+		expDescription: "",
+	}, {
+		msg:            "longest description",
+		token:          "MYTOKEN",
+		description:    strings.Repeat("ü", ooohh.MaxDescriptionLength),
+		expDescription: strings.Repeat("ü", ooohh.MaxDescriptionLength),
+	}, {
+		msg:            "too long description",
+		token:          "MYTOKEN",
+		description:    strings.Repeat("a", ooohh.MaxDescriptionLength+1),
+		expErr:         ooohh.ErrBoardDescriptionInvalid,
+		expDescription: "Initial",
+	}, {
+		msg:            "wrong token",
+		token:          "NOTMYTOKEN",
+		description:    "Q3 stress tracking",
+		expErr:         ooohh.ErrUnauthorized,
+		expDescription: "Initial",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a Bolt DB.
+			db, cleanup := newTmpBoltDB(t)
+			defer cleanup()
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create service.
+			n := func() time.Time {
+				return now
+			}
+			s, err := NewService(store.NewBolt(db), logger, n)
+			is.NoErr(err) // service initializes correctly.
+
+			ctx := context.TODO()
+
+			// Create board, with an initial description.
+			b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+			is.NoErr(err)               // board creates correctly.
+			is.Equal(b.Description, "") // board has no description.
+			err = s.SetBoardDescription(ctx, b.ID, "MYTOKEN", "Initial")
+			is.NoErr(err) // initial board description sets without error.
+
+			// Update board description.
+			err = s.SetBoardDescription(ctx, b.ID, tt.token, tt.description)
+			is.Equal(err, tt.expErr) // board description sets with expected error.
+
+			// Check board description.
+			b, err = s.GetBoard(ctx, b.ID)
+			is.NoErr(err)                              // board is retrieved correctly.
+			is.Equal(b.Description, tt.expDescription) // board description is correct.
+			is.Equal(b.Name, "TEST-BOARD")             // board name is unchanged.
+		})
+	}
+}
+
+func TestDialsCanBeSetWithOwningBoardToken(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	s, err := NewService(store.NewMemory(), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create a dial on a board, and a dial on no board, each with their own token.
+	onBoard, err := s.CreateDial(ctx, "ON-BOARD", "DIALTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	offBoard, err := s.CreateDial(ctx, "OFF-BOARD", "OTHERTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+	is.NoErr(err) // board creates correctly.
+	err = s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{onBoard.ID})
+	is.NoErr(err) // dial added to board without error.
+
+	// Boards don't own their dials by default.
+	err = s.SetDial(ctx, onBoard.ID, "BOARDTOKEN", 10)
+	is.Equal(err, ooohh.ErrUnauthorized) // board token can't set dial.
+
+	// Only the board token can make the board own its dials.
+	err = s.SetBoardOwnsDials(ctx, b.ID, "DIALTOKEN", true)
+	is.Equal(err, ooohh.ErrUnauthorized) // board ownership isn't set with another token.
+	err = s.SetBoardOwnsDials(ctx, b.ID, "BOARDTOKEN", true)
+	is.NoErr(err) // board ownership sets.
+
+	got, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err)          // board is retrieved correctly.
+	is.True(got.OwnsDials) // board owns its dials.
+
+	// The board token sets the values of the dials on the board.
+	err = s.SetDial(ctx, onBoard.ID, "BOARDTOKEN", 20)
+	is.NoErr(err) // board token sets dial.
+	err = s.SetDial(ctx, onBoard.ID, "DIALTOKEN", 30)
+	is.NoErr(err) // dial token still sets dial.
+	err = s.SetDialColor(ctx, onBoard.ID, "BOARDTOKEN", "#fff")
+	is.Equal(err, ooohh.ErrUnauthorized) // board token only sets values.
+
+	// Dials on no board still need their own token.
+	err = s.SetDial(ctx, offBoard.ID, "BOARDTOKEN", 40)
+	is.Equal(err, ooohh.ErrUnauthorized) // board token can't set dial on no board.
+	err = s.SetDial(ctx, offBoard.ID, "OTHERTOKEN", 40)
+	is.NoErr(err) // dial token sets dial on no board.
+
+	// Moving dials on and off the board moves its ownership of them.
+	err = s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{offBoard.ID})
+	is.NoErr(err) // board dials are replaced without error.
+	err = s.SetDial(ctx, onBoard.ID, "BOARDTOKEN", 50)
+	is.Equal(err, ooohh.ErrUnauthorized) // board token can't set dial removed from the board.
+	err = s.SetDial(ctx, offBoard.ID, "BOARDTOKEN", 50)
+	is.NoErr(err) // board token sets dial added to the board.
+
+	// Boards can stop owning their dials.
+	err = s.SetBoardOwnsDials(ctx, b.ID, "BOARDTOKEN", false)
+	is.NoErr(err) // board ownership sets.
+	err = s.SetDial(ctx, offBoard.ID, "BOARDTOKEN", 60)
+	is.Equal(err, ooohh.ErrUnauthorized) // board token can't set dial.
+
+	d, err := s.GetDial(ctx, offBoard.ID)
+	is.NoErr(err)           // dial is retrieved correctly.
+	is.Equal(d.Value, 50.0) // dial has last authorized value.
+}
+
+func TestBoardDialsAreIndexedOnStartup(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	st := store.NewMemory()
+	s, err := NewService(st, logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create a board that owns a dial.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "DIALTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+	is.NoErr(err)                                                       // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d.ID})) // dial added to board without error.
+	is.NoErr(s.SetBoardOwnsDials(ctx, b.ID, "BOARDTOKEN", true))        // board ownership sets.
+
+	// Remove the index, as if the board was stored before there was one.
+	txn, err := st.Begin(true)
+	is.NoErr(err) // transaction begins.
+	var keys [][]byte
+	err = txn.ForEach("dial_boards", func(k, v []byte) error {
+		keys = append(keys, append([]byte(nil), k...))
+		return nil
+	})
+	is.NoErr(err)          // index is read.
+	is.Equal(len(keys), 1) // board dial was indexed.
+	for _, k := range keys {
+		is.NoErr(txn.Delete("dial_boards", k)) // index entry is removed.
+	}
+	is.NoErr(txn.Commit()) // transaction commits.
+
+	err = s.SetDial(ctx, d.ID, "BOARDTOKEN", 10)
+	is.Equal(err, ooohh.ErrUnauthorized) // board token can't set dial without the index.
+
+	// The index is rebuilt when the service starts.
+	s, err = NewService(st, logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	err = s.SetDial(ctx, d.ID, "BOARDTOKEN", 10)
+	is.NoErr(err) // board token sets dial once indexed.
+}
+
+func TestBoardCanBeFrozen(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	s, err := NewService(store.NewMemory(), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create a board of two dials, and a dial on no board.
+	d1, err := s.CreateDialWithValue(ctx, "DIAL-1", "TOKEN-1", 10)
+	is.NoErr(err) // dial creates correctly.
+	d2, err := s.CreateDialWithValue(ctx, "DIAL-2", "TOKEN-2", 20)
+	is.NoErr(err) // dial creates correctly.
+	other, err := s.CreateDialWithValue(ctx, "OTHER", "TOKEN-3", 30)
+	is.NoErr(err) // dial creates correctly.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+	is.NoErr(err)                                                               // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d1.ID, d2.ID})) // dials added to board without error.
+
+	// Only the board token freezes the board.
+	is.Equal(s.FreezeBoard(ctx, b.ID, "TOKEN-1"), ooohh.ErrUnauthorized) // board isn't frozen with another token.
+	is.NoErr(s.FreezeBoard(ctx, b.ID, "BOARDTOKEN"))                     // board freezes.
+
+	// Member dials can't be set while the board is frozen.
+	is.Equal(s.SetDial(ctx, d1.ID, "TOKEN-1", 50), ooohh.ErrBoardFrozen) // member dial isn't set.
+	is.Equal(s.SetDial(ctx, d1.ID, "WRONG", 50), ooohh.ErrUnauthorized)  // wrong token is still unauthorized.
+	is.NoErr(s.SetDialColor(ctx, d1.ID, "TOKEN-1", "#fff"))              // member dial color still sets.
+	is.NoErr(s.SetDial(ctx, other.ID, "TOKEN-3", 60))                    // other dials still set.
+
+	// The board's dials can't be changed while it is frozen.
+	err = s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{other.ID})
+	is.Equal(err, ooohh.ErrBoardFrozen)                          // board dials aren't changed.
+	is.NoErr(s.SetBoardName(ctx, b.ID, "BOARDTOKEN", "RENAMED")) // board still renames.
+
+	// Freezing again keeps the original snapshot.
+	is.NoErr(s.FreezeBoard(ctx, b.ID, "BOARDTOKEN")) // board freezes again.
+
+	got, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                                  // board is retrieved correctly.
+	is.True(got.Frozen)                            // board is frozen.
+	is.Equal(got.Name, "RENAMED")                  // board has correct name.
+	is.Equal(len(got.Dials), 2)                    // board has its dials.
+	is.Equal(got.Dials[0].Name, "DIAL-1")          // first dial is snapshotted.
+	is.Equal(got.Dials[0].Value, 10.0)             // first dial has its value at freeze time.
+	is.Equal(got.Dials[0].Color, "")               // first dial has its color at freeze time.
+	is.Equal(got.Dials[0].Token, "")               // first dial token isn't snapshotted.
+	is.Equal(got.Dials[1].Value, 20.0)             // second dial has its value at freeze time.
+	is.Equal(got.Dials[1].UpdatedAt, d2.UpdatedAt) // second dial has its update time at freeze time.
+
+	ids, err := s.GetBoardDialIDs(ctx, b.ID)
+	is.NoErr(err)                               // board dial ids are retrieved correctly.
+	is.Equal(ids, []ooohh.DialID{d1.ID, d2.ID}) // board dial ids are kept.
+
+	// Only the board token unfreezes the board.
+	is.Equal(s.UnfreezeBoard(ctx, b.ID, "TOKEN-1"), ooohh.ErrUnauthorized) // board isn't unfrozen with another token.
+	is.NoErr(s.UnfreezeBoard(ctx, b.ID, "BOARDTOKEN"))                     // board unfreezes.
+
+	// Unfrozen boards are live again.
+	is.NoErr(s.SetDial(ctx, d1.ID, "TOKEN-1", 50)) // member dial sets.
+
+	got, err = s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                        // board is retrieved correctly.
+	is.True(!got.Frozen)                 // board isn't frozen.
+	is.Equal(got.Dials[0].Value, 50.0)   // first dial has its live value.
+	is.Equal(got.Dials[0].Color, "#fff") // first dial has its live color.
+	is.Equal(got.Dials[1].Value, 20.0)   // second dial has its live value.
+
+	err = s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{other.ID})
+	is.NoErr(err) // board dials change.
+}
+
+func TestBoardDialIDsIncludeMissingDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial.
+	dp, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	// Create board.
+	bp, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	// Add an existing and a non-existant dial to the board.
+	err = s.SetBoard(ctx, bp.ID, "MYTOKEN", []ooohh.DialID{ooohh.DialID("NON-EXISTANT"), dp.ID})
+	is.NoErr(err) // dials added to board without error.
+
+	// Get board dial ids.
+	ids, err := s.GetBoardDialIDs(ctx, bp.ID)
+	is.NoErr(err)                                                      // board dial ids are retrieved correctly.
+	is.Equal(ids, []ooohh.DialID{ooohh.DialID("NON-EXISTANT"), dp.ID}) // all stored dial ids are returned.
+
+	// Getting dial ids of a non-existant board errors.
+	_, err = s.GetBoardDialIDs(ctx, ooohh.BoardID("NOT-A-BOARD"))
+	is.Equal(err, ooohh.ErrBoardNotFound) // Board not found when getting dial ids.
+}
+
+func TestBoardDialSetUnauthorized(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create board.
+	bp, err := s.CreateBoard(ctx, "TEST-BOARD-3", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	// Try to update Board Value.
+	err = s.SetBoard(ctx, bp.ID, "NOTMYTOKEN", []ooohh.DialID{ooohh.DialID("DIAL")})
+	is.Equal(err, ooohh.ErrUnauthorized) // board dials setting errors as unauthorized.
+
+}
+
+func TestBoardCanBeReadWhileDialsAreSet(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	s, err := NewService(store.NewBolt(db), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create a board of dials.
+	var ids []ooohh.DialID
+	for i := 0; i < 5; i++ {
+		d, err := s.CreateDial(ctx, fmt.Sprintf("DIAL-%d", i), "TOKEN")
+		is.NoErr(err) // dial creates correctly.
+		ids = append(ids, d.ID)
+	}
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "TOKEN")
+	is.NoErr(err)                                 // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "TOKEN", ids)) // dials added to board without error.
+
+	// Set each dial to increasing values, while the board is read.
+	const sets = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, len(ids))
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id ooohh.DialID) {
+			defer wg.Done()
+			for v := 1; v <= sets; v++ {
+				if err := s.SetDial(ctx, id, "TOKEN", float64(v)); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(id)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	// Dial values are only ever seen to increase, and each board is read with all its dials.
+	last := make(map[ooohh.DialID]float64)
+	timeout := time.After(10 * time.Second)
+	for reading := true; reading; {
+		select {
+		case <-done:
+			reading = false
+		case <-timeout:
+			t.Fatal("timed out reading board while setting dials")
+		default:
+		}
+
+		got, err := s.GetBoard(ctx, b.ID)
+		is.NoErr(err)                      // board is retrieved correctly.
+		is.Equal(len(got.Dials), len(ids)) // board has all its dials.
+		for _, d := range got.Dials {
+			is.True(d.Value >= last[d.ID]) // dial value doesn't go backwards.
+			last[d.ID] = d.Value
+		}
+	}
+
+	close(errs)
+	for err := range errs {
+		is.NoErr(err) // dial sets without error.
+	}
+
+	// The board has the final values.
+	got, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err) // board is retrieved correctly.
+	for _, d := range got.Dials {
+		is.Equal(d.Value, float64(sets)) // dial has final value.
+	}
+}
+
+func TestBoardNotFound(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Getting non-existant board errors.
+	_, err = s.GetBoard(ctx, ooohh.BoardID("NOT-A-BOARD"))
+	is.Equal(err, ooohh.ErrBoardNotFound) // Board not found when getting.
+
+	// Updating a non-existant board errors.
+	serr := s.SetBoard(ctx, ooohh.BoardID("NOT-A-DIAL-EITHER"), "MYTOKEN", []ooohh.DialID{})
+	is.Equal(serr, ooohh.ErrBoardNotFound) // Board not found when setting.
+}
+
+// cancellingStore is a store.Store that counts the dials read from it, and
+// calls cancel once after dials have been read.
+type cancellingStore struct {
+	store.Store
+	after  int
+	cancel func()
+	reads  int
+}
+
+func (s *cancellingStore) Begin(writable bool) (store.Tx, error) {
+	txn, err := s.Store.Begin(writable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cancellingTx{txn, s}, nil
+}
+
+type cancellingTx struct {
+	store.Tx
+	s *cancellingStore
+}
+
+func (t *cancellingTx) Get(bucket string, key []byte) []byte {
+	if bucket == "dials" {
+		t.s.reads++
+		if t.s.reads == t.s.after {
+			t.s.cancel()
+		}
+	}
+
+	return t.Tx.Get(bucket, key)
+}
+
+func TestCancelledRequestsStopEarly(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		after    int
+		expReads int
+	}{{
+		msg:      "cancelled before call",
+		after:    0,
+		expReads: 0,
+	}, {
+		msg:      "cancelled while dials are populated",
+		after:    3,
+		expReads: 3,
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Create service, over a store that cancels the request.
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			st := &cancellingStore{Store: store.NewMemory(), cancel: cancel}
+			s, err := NewService(st, logger, time.Now)
+			is.NoErr(err) // service initializes correctly.
+
+			// Create a board with many dials.
+			names := make([]string, 50)
+			for i := range names {
+				names[i] = fmt.Sprintf("DIAL-%d", i)
+			}
+			b, err := s.CreateBoardWithDials(ctx, "TEST-BOARD", "MYTOKEN", names)
+			is.NoErr(err) // board creates correctly.
+
+			// Only count the dials read by GetBoard.
+			st.reads, st.after = 0, tt.after
+			if tt.after == 0 {
+				cancel()
+			}
+
+			_, err = s.GetBoard(ctx, b.ID)
+			is.Equal(err, context.Canceled) // board isn't retrieved.
+			is.Equal(st.reads, tt.expReads) // not all dials are populated.
+
+			_, err = s.GetDial(ctx, b.Dials[0].ID)
+			is.Equal(err, context.Canceled)                                             // dial isn't retrieved.
+			is.Equal(s.SetDial(ctx, b.Dials[0].ID, "MYTOKEN", 10), context.Canceled)    // dial isn't set.
+			is.Equal(s.SetBoardName(ctx, b.ID, "MYTOKEN", "RENAMED"), context.Canceled) // board isn't renamed.
+		})
+	}
+}
+
+func TestEntitiesCanBeReadWithEitherCodec(t *testing.T) {
+
+	codecs := map[string]Codec{
+		"msgpack": MsgpackCodec,
+		"json":    JSONCodec,
+	}
+
+	for writeName, write := range codecs {
+		for readName, read := range codecs {
+			t.Run(fmt.Sprintf("%s to %s", writeName, readName), func(t *testing.T) {
+
+				is := is.New(t)
+
+				// Create logger.
+				logger, _ := newTestLogger(zap.InfoLevel)
+
+				n := func() time.Time {
+					return now
+				}
+
+				// Store entities with one codec.
+				st := store.NewMemory()
+				s, err := NewService(st, logger, n, WithCodec(write))
+				is.NoErr(err) // service initializes correctly.
+
+				ctx := context.TODO()
+
+				d, err := s.CreateDialWithValue(ctx, "TEST-DIAL", "DIALTOKEN", 42)
+				is.NoErr(err)                                                   // dial creates correctly.
+				is.NoErr(s.SetDialColor(ctx, d.ID, "DIALTOKEN", "#fff"))        // dial color sets.
+				is.NoErr(s.SetDialWithNote(ctx, d.ID, "DIALTOKEN", 43, "note")) // dial value sets.
+				b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+				is.NoErr(err)                                                       // board creates correctly.
+				is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d.ID})) // dial added to board without error.
+
+				// Check the entities are stored in the codec's format.
+				txn, err := st.Begin(false)
+				is.NoErr(err)                                                           // transaction begins.
+				is.Equal(txn.Get("dials", []byte(d.ID))[0] == '{', writeName == "json") // dial is stored in the codec's format.
+				is.NoErr(txn.Rollback())                                                // transaction rolls back.
+
+				// Read them back with a service using the other codec.
+				s, err = NewService(st, logger, n, WithCodec(read))
+				is.NoErr(err) // service initializes correctly.
+
+				got, err := s.GetDial(ctx, d.ID)
+				is.NoErr(err)                   // dial is retrieved correctly.
+				is.Equal(got.Name, "TEST-DIAL") // dial has correct name.
+				is.Equal(got.Value, 43.0)       // dial has correct value.
+				is.Equal(got.Color, "#fff")     // dial has correct color.
+				is.Equal(got.UpdatedAt, now)    // dial has correct update time.
+
+				h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+				is.NoErr(err)                                       // history is retrieved correctly.
+				is.Equal(len(h), 2)                                 // both readings are kept.
+				is.Equal(h[1].Note, "note")                         // reading has correct note.
+				is.Equal(h[1].Value, 43.0)                          // reading has correct value.
+				is.NoErr(s.VerifyDialToken(ctx, d.ID, "DIALTOKEN")) // dial token is kept.
+
+				gotBoard, err := s.GetBoard(ctx, b.ID)
+				is.NoErr(err)                                                // board is retrieved correctly.
+				is.Equal(gotBoard.Name, "TEST-BOARD")                        // board has correct name.
+				is.Equal(len(gotBoard.Dials), 1)                             // board has its dial.
+				is.Equal(gotBoard.Dials[0].Value, 43.0)                      // board dial has correct value.
+				is.NoErr(s.SetBoardName(ctx, b.ID, "BOARDTOKEN", "RENAMED")) // board token is kept.
+			})
+		}
+	}
+}
+
+func TestMixedCodecsCanBeRead(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	st := store.NewMemory()
+	ctx := context.TODO()
+
+	// Store a dial with msgpack.
+	s, err := NewService(st, logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+	d1, err := s.CreateDialWithValue(ctx, "MSGPACK-DIAL", "TOKEN", 10)
+	is.NoErr(err) // dial creates correctly.
+
+	// Then another with JSON, as if the codec was changed.
+	s, err = NewService(st, logger, time.Now, WithCodec(JSONCodec))
+	is.NoErr(err) // service initializes correctly.
+	d2, err := s.CreateDialWithValue(ctx, "JSON-DIAL", "TOKEN", 20)
+	is.NoErr(err) // dial creates correctly.
+
+	// Both dials are read back.
+	dials, err := s.GetDials(ctx, []ooohh.DialID{d1.ID, d2.ID})
+	is.NoErr(err)                                    // dials are retrieved correctly.
+	is.Equal(dials[d1.ID].Name, "MSGPACK-DIAL")      // msgpack dial is read.
+	is.Equal(dials[d2.ID].Name, "JSON-DIAL")         // json dial is read.
+	is.NoErr(s.SetDial(ctx, d1.ID, "TOKEN", 30))     // msgpack dial updates.
+	is.NoErr(s.VerifyDialToken(ctx, d2.ID, "TOKEN")) // json dial token is kept.
+
+	// Updating a dial rewrites it with the current codec.
+	txn, err := st.Begin(false)
+	is.NoErr(err)                                           // transaction begins.
+	is.Equal(txn.Get("dials", []byte(d1.ID))[0], byte('{')) // updated dial is stored as json.
+	is.NoErr(txn.Rollback())                                // transaction rolls back.
+
+	// A board of both dials can be read.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "TOKEN")
+	is.NoErr(err)                                                          // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "TOKEN", []ooohh.DialID{d1.ID, d2.ID})) // dials added to board without error.
+
+	got, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                      // board is retrieved correctly.
+	is.Equal(len(got.Dials), 2)        // board has both dials.
+	is.Equal(got.Dials[0].Value, 30.0) // msgpack dial has correct value.
+	is.Equal(got.Dials[1].Value, 20.0) // json dial has correct value.
+}
+
+func TestServicesWithBucketPrefixesAreSeparate(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB, shared by all the services.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	ctx := context.TODO()
+
+	// Create services with different prefixes, and one without.
+	a, err := NewService(store.NewBolt(db), logger, time.Now, WithBucketPrefix("a"))
+	is.NoErr(err) // service a initializes correctly.
+	b, err := NewService(store.NewBolt(db), logger, time.Now, WithBucketPrefix("b"))
+	is.NoErr(err) // service b initializes correctly.
+	plain, err := NewService(store.NewBolt(db), logger, time.Now)
+	is.NoErr(err) // unprefixed service initializes correctly.
+
+	// Create a dial and board with service a.
+	d, err := a.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	bd, err := a.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	_, err = a.GetDial(ctx, d.ID)
+	is.NoErr(err) // service a sees its dial.
+
+	// The other services don't see them.
+	for _, s := range []*service{b, plain} {
+		_, err = s.GetDial(ctx, d.ID)
+		is.Equal(err, ooohh.ErrDialNotFound) // other service doesn't see the dial.
+		_, err = s.GetBoard(ctx, bd.ID)
+		is.Equal(err, ooohh.ErrBoardNotFound) // other service doesn't see the board.
+		p, err := s.PageDials(ctx, "", 10)
+		is.NoErr(err)        // other service pages its dials.
+		is.Equal(p.Total, 0) // other service has no dials.
+	}
+
+	// The buckets are named with the prefix.
+	err = db.View(func(txn *bolt.Tx) error {
+		is.True(txn.Bucket([]byte("a_dials")) != nil) // prefixed dials bucket exists.
+		is.True(txn.Bucket([]byte("b_dials")) != nil) // other prefixed dials bucket exists.
+		is.True(txn.Bucket([]byte("dials")) != nil)   // unprefixed dials bucket exists.
+		return nil
+	})
+	is.NoErr(err)
+}
+
+func TestExportCanBeImported(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create service.
+	n := func() time.Time {
+		return now
+	}
+	s, err := NewService(store.NewBolt(db), logger, n)
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Populate the service.
+	d1, err := s.CreateDialWithValue(ctx, "TEST-DIAL-1", "MYTOKEN", 10)
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetDialWithNote(ctx, d1.ID, "MYTOKEN", 20, "busy"))
+	is.NoErr(s.SetDialColor(ctx, d1.ID, "MYTOKEN", "#fff"))
+	d2, _, err := s.EnsureDial(ctx, "external-id", "TEST-DIAL-2", "OTHERTOKEN")
+	is.NoErr(err) // dial is ensured correctly.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d1.ID, d2.ID}))
+	is.NoErr(s.SetBoardOwnsDials(ctx, b.ID, "MYTOKEN", true))
+
+	// Export it.
+	var buf bytes.Buffer
+	is.NoErr(s.Export(ctx, &buf)) // service exports.
+
+	// Import into a fresh service.
+	imported, err := NewService(store.NewMemory(), logger, n)
+	is.NoErr(err)                                                // fresh service initializes correctly.
+	is.NoErr(imported.Import(ctx, bytes.NewReader(buf.Bytes()))) // export imports.
+
+	// Check the dials are equal.
+	for _, id := range []ooohh.DialID{d1.ID, d2.ID} {
+		exp, err := s.GetDial(ctx, id)
+		is.NoErr(err) // dial is retrieved from the exported service.
+		got, err := imported.GetDial(ctx, id)
+		is.NoErr(err)      // dial is retrieved from the imported service.
+		is.Equal(got, exp) // dial is restored.
+
+		expHistory, err := s.GetDialHistory(ctx, id, time.Time{})
+		is.NoErr(err) // history is retrieved from the exported service.
+		gotHistory, err := imported.GetDialHistory(ctx, id, time.Time{})
+		is.NoErr(err)                    // history is retrieved from the imported service.
+		is.Equal(gotHistory, expHistory) // history is restored.
+	}
+
+	// Check the board is equal, by ID and by code.
+	exp, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err) // board is retrieved from the exported service.
+	got, err := imported.GetBoard(ctx, ooohh.BoardID(b.Code))
+	is.NoErr(err)      // board is retrieved from the imported service by code.
+	is.Equal(got, exp) // board is restored.
+
+	// Check tokens, external IDs and the board's ownership of its dials work.
+	is.NoErr(imported.VerifyDialToken(ctx, d1.ID, "MYTOKEN")) // dial token is restored.
+	is.NoErr(imported.SetDial(ctx, d2.ID, "MYTOKEN", 30))     // board owns its dials.
+	ensured, created, err := imported.EnsureDial(ctx, "external-id", "TEST-DIAL-2", "OTHERTOKEN")
+	is.NoErr(err)               // dial is ensured correctly.
+	is.True(!created)           // external id is restored.
+	is.Equal(ensured.ID, d2.ID) // external id maps to its dial.
+
+	// Only empty services can be imported into.
+	err = imported.Import(ctx, bytes.NewReader(buf.Bytes()))
+	is.True(err != nil) // import into a populated service errors.
+
+	// Invalid exports aren't imported.
+	empty, err := NewService(store.NewMemory(), logger, n)
+	is.NoErr(err) // empty service initializes correctly.
+	err = empty.Import(ctx, strings.NewReader(`{"type": "dial", "dial": {"id": "dial-id"}}`+"\n"+`{"type": "unknown"}`))
+	is.True(err != nil) // invalid record errors.
+	_, err = empty.GetDial(ctx, "dial-id")
+	is.Equal(err, ooohh.ErrDialNotFound) // nothing is imported from an invalid export.
+}
+
+func TestTokensAreStoredHashed(t *testing.T) {
+
+	for _, codec := range []Codec{MsgpackCodec, JSONCodec} {
+
+		is := is.New(t)
+
+		// Create logger.
+		logger, _ := newTestLogger(zap.InfoLevel)
+
+		st := store.NewMemory()
+		ctx := context.TODO()
+
+		// Create service.
+		s, err := NewService(st, logger, time.Now, WithCodec(codec))
+		is.NoErr(err) // service initializes correctly.
+
+		// Create a dial and board, and change a token.
+		d, err := s.CreateDial(ctx, "TEST-DIAL", "DIAL-SECRET")
+		is.NoErr(err)                    // dial creates correctly.
+		is.Equal(d.Token, "DIAL-SECRET") // created dial has the token.
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARD-SECRET")
+		is.NoErr(err)                     // board creates correctly.
+		is.Equal(b.Token, "BOARD-SECRET") // created board has the token.
+		d2, err := s.CreateDial(ctx, "OTHER-DIAL", "DIAL-SECRET")
+		is.NoErr(err)                                                     // dial creates correctly.
+		is.NoErr(s.SetDialToken(ctx, d2.ID, "DIAL-SECRET", "NEW-SECRET")) // dial token is replaced.
+
+		// Check no raw token is stored.
+		txn, err := st.Begin(false)
+		is.NoErr(err) // transaction begins.
+		for _, bucket := range []string{"dials", "boards"} {
+			err = txn.ForEach(bucket, func(k, v []byte) error {
+				for _, token := range []string{"DIAL-SECRET", "BOARD-SECRET", "NEW-SECRET"} {
+					is.True(!bytes.Contains(v, []byte(token))) // raw token is not stored.
+				}
+				return nil
+			})
+			is.NoErr(err) // bucket is read.
+		}
+		is.NoErr(txn.Rollback()) // transaction rolls back.
+
+		// Check the tokens still authorize updates.
+		is.NoErr(s.SetDial(ctx, d.ID, "DIAL-SECRET", 50))                          // dial updates with its token.
+		is.NoErr(s.SetDial(ctx, d2.ID, "NEW-SECRET", 50))                          // dial updates with its new token.
+		is.Equal(s.SetDial(ctx, d.ID, "BOARD-SECRET", 50), ooohh.ErrUnauthorized)  // dial doesn't update with another token.
+		is.NoErr(s.VerifyDialToken(ctx, d.ID, "DIAL-SECRET"))                      // dial token verifies.
+		is.NoErr(s.SetBoard(ctx, b.ID, "BOARD-SECRET", []ooohh.DialID{d.ID}))      // board updates with its token.
+		is.Equal(s.SetBoard(ctx, b.ID, "DIAL-SECRET", nil), ooohh.ErrUnauthorized) // board doesn't update with another token.
+
+		boards, err := s.ListBoardsByToken(ctx, "BOARD-SECRET")
+		is.NoErr(err)            // boards are listed.
+		is.Equal(len(boards), 1) // board is listed by its token.
+	}
+}
+
+func TestPlaintextTokensAreHashedOnAuth(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	st := store.NewMemory()
+	ctx := context.TODO()
+
+	// Create service.
+	s, err := NewService(st, logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	// Store a dial and board as they were before tokens were hashed.
+	txn, err := st.Begin(true)
+	is.NoErr(err) // transaction begins.
+	v, err := MsgpackCodec.Marshal(ooohh.Dial{ID: "legacy-dial", Token: "DIAL-SECRET", Name: "LEGACY-DIAL"})
+	is.NoErr(err)                                        // dial marshals.
+	is.NoErr(txn.Put("dials", []byte("legacy-dial"), v)) // dial is stored.
+	v, err = MsgpackCodec.Marshal(ooohh.Board{ID: "legacy-board", Token: "BOARD-SECRET", Name: "LEGACY-BOARD"})
+	is.NoErr(err)                                          // board marshals.
+	is.NoErr(txn.Put("boards", []byte("legacy-board"), v)) // board is stored.
+	is.NoErr(txn.Commit())                                 // transaction commits.
+
+	stored := func(bucket, key string) []byte {
+		txn, err := st.Begin(false)
+		is.NoErr(err)        // transaction begins.
+		defer txn.Rollback() //nolint:errcheck
+		return append([]byte(nil), txn.Get(bucket, []byte(key))...)
+	}
+
+	// Bad tokens don't upgrade the stored token.
+	is.Equal(s.SetDial(ctx, "legacy-dial", "WRONG", 10), ooohh.ErrUnauthorized)              // dial doesn't update with a bad token.
+	is.True(bytes.Contains(stored("dials", "legacy-dial"), []byte("DIAL-SECRET")))           // dial token is unchanged.
+	is.Equal(s.SetBoardName(ctx, "legacy-board", "WRONG", "RENAMED"), ooohh.ErrUnauthorized) // board doesn't update with a bad token.
+	is.True(bytes.Contains(stored("boards", "legacy-board"), []byte("BOARD-SECRET")))        // board token is unchanged.
+
+	// The plaintext tokens authorize updates, which hash them.
+	is.NoErr(s.SetDial(ctx, "legacy-dial", "DIAL-SECRET", 10))                         // dial updates with its plaintext token.
+	is.True(!bytes.Contains(stored("dials", "legacy-dial"), []byte("DIAL-SECRET")))    // dial token is hashed.
+	is.NoErr(s.SetBoardName(ctx, "legacy-board", "BOARD-SECRET", "RENAMED"))           // board updates with its plaintext token.
+	is.True(!bytes.Contains(stored("boards", "legacy-board"), []byte("BOARD-SECRET"))) // board token is hashed.
+
+	// The hashed tokens still authorize updates.
+	is.NoErr(s.SetDial(ctx, "legacy-dial", "DIAL-SECRET", 20))                     // dial updates with its token.
+	is.NoErr(s.SetBoardName(ctx, "legacy-board", "BOARD-SECRET", "RENAMED-AGAIN")) // board updates with its token.
+
+	d, err := s.GetDial(ctx, "legacy-dial")
+	is.NoErr(err)                       // dial is retrieved correctly.
+	is.True(ooohh.TokenHashed(d.Token)) // dial token is hashed.
+	is.Equal(d.Value, 20.0)             // dial has the latest value.
+}
+
+func TestReadsCanBeServedFromReadStore(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Get a temporary directory for the replica.
+	dir, err := ioutil.TempDir("", "ooohh-bolt-")
+	is.NoErr(err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create a replica of the empty db, and a service that reads from it.
+	replica, err := store.NewReplica(db, filepath.Join(dir, "replica.db"))
+	is.NoErr(err) // replica is created.
+	defer replica.Close()
+
+	s, err := NewService(store.NewBolt(db), logger, time.Now, WithReadStore(replica))
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create dial and board, these are written to the primary.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	err = s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID})
+	is.NoErr(err) // dial added to board without error.
+
+	// The replica hasn't seen the writes yet.
+	_, err = s.GetDial(ctx, d.ID)
+	is.Equal(err, ooohh.ErrDialNotFound) // dial isn't read from the primary.
+	_, err = s.GetBoard(ctx, b.ID)
+	is.Equal(err, ooohh.ErrBoardNotFound) // board isn't read from the primary.
+
+	// Unless the primary is asked to be read from.
+	primary := ooohh.WithPrimaryReads(ctx)
+	_, err = s.GetDial(primary, d.ID)
+	is.NoErr(err) // dial is read from the primary.
+	_, err = s.GetBoard(primary, b.ID)
+	is.NoErr(err) // board is read from the primary.
+	boards, err := s.ListBoardsByToken(primary, "MYTOKEN")
+	is.NoErr(err)            // boards are listed from the primary.
+	is.Equal(len(boards), 1) // board is listed from the primary.
+
+	// Refresh the replica, and it has the writes.
+	is.NoErr(replica.Refresh()) // replica refreshes.
+
+	got, err := s.GetDial(ctx, d.ID)
+	is.NoErr(err)                   // dial is read from the replica.
+	is.Equal(got.Name, "TEST-DIAL") // dial has correct name.
+	gotBoard, err := s.GetBoard(ctx, b.ID)
+	is.NoErr(err)                    // board is read from the replica.
+	is.Equal(len(gotBoard.Dials), 1) // board has its dial.
+	is.Equal(gotBoard.Dials[0].ID, d.ID)
+}
+
+// gatheredValue returns the value of the counter or gauge with the given name
+// and labels in the registry, or zero if it hasn't been recorded.
+func gatheredValue(t *testing.T, reg *prometheus.Registry, name string, labels map[string]string) float64 {
+	t.Helper()
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mf := range families {
+		if mf.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metrics
+				}
+			}
+
+			if m.GetCounter() != nil {
+				return m.GetCounter().GetValue()
+			}
+			return m.GetGauge().GetValue()
+		}
+	}
+
+	return 0
+}
+
+func TestServiceCallsAreMeasured(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	s, err := NewService(store.NewMemory(), logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	reg := prometheus.NewRegistry()
+	ms, err := NewMetricsService(s, reg)
+	is.NoErr(err) // metrics service initializes correctly.
+
+	_, err = NewMetricsService(s, reg)
+	is.True(err != nil) // metrics can't be registered twice.
+
+	ctx := context.TODO()
+
+	d, err := ms.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	is.NoErr(ms.SetDial(ctx, d.ID, "MYTOKEN", 50))                                    // dial is set.
+	is.NoErr(ms.SetDial(ctx, d.ID, "MYTOKEN", 60))                                    // dial is set again.
+	is.Equal(ms.SetDial(ctx, d.ID, "WRONGTOKEN", 70), ooohh.ErrUnauthorized)          // errors are passed through.
+	is.Equal(ms.SetDial(ctx, "MISSING", "MYTOKEN", 70), ooohh.ErrDialNotFound)        // errors are passed through.
+	is.Equal(ms.SetDialColor(ctx, d.ID, "WRONGTOKEN", "#fff"), ooohh.ErrUnauthorized) // other methods are measured too.
+
+	calls := "ooohh_service_calls_total"
+	is.Equal(gatheredValue(t, reg, calls, map[string]string{"method": "CreateDial", "outcome": "ok"}), 1.0)      // dial creation is counted.
+	is.Equal(gatheredValue(t, reg, calls, map[string]string{"method": "CreateDial", "outcome": "error"}), 0.0)   // no dial creation failed.
+	is.Equal(gatheredValue(t, reg, calls, map[string]string{"method": "SetDial", "outcome": "ok"}), 2.0)         // successful sets are counted.
+	is.Equal(gatheredValue(t, reg, calls, map[string]string{"method": "SetDial", "outcome": "error"}), 2.0)      // failed sets are counted.
+	is.Equal(gatheredValue(t, reg, calls, map[string]string{"method": "SetDialColor", "outcome": "error"}), 1.0) // failed color sets are counted.
+
+	// Latencies are observed for each call.
+	families, err := reg.Gather()
+	is.NoErr(err)
+	var observed uint64
+	for _, mf := range families {
+		if mf.GetName() != "ooohh_service_call_duration_seconds" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			observed += m.GetHistogram().GetSampleCount()
+		}
+	}
+	is.Equal(observed, uint64(6)) // every call is timed.
+}
+
+func TestDialsAndBoardsAreCounted(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	st := store.NewMemory()
+	s, err := NewService(st, logger, time.Now)
+	is.NoErr(err) // service initializes correctly.
+
+	reg := prometheus.NewRegistry()
+	c, err := NewCountMetrics(logger, st, reg)
+	is.NoErr(err) // count metrics initialize correctly.
+
+	is.NoErr(c.Update())                                            // counts update.
+	is.Equal(gatheredValue(t, reg, "ooohh_dials_total", nil), 0.0)  // there are no dials.
+	is.Equal(gatheredValue(t, reg, "ooohh_boards_total", nil), 0.0) // there are no boards.
+
+	ctx := context.TODO()
+
+	_, err = s.CreateBoardWithDials(ctx, "TEST-BOARD", "MYTOKEN", []string{"ONE", "TWO"})
+	is.NoErr(err) // board creates correctly.
+	_, err = s.CreateDial(ctx, "THREE", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+
+	is.NoErr(c.Update())                                            // counts update.
+	is.Equal(gatheredValue(t, reg, "ooohh_dials_total", nil), 3.0)  // dials are counted.
+	is.Equal(gatheredValue(t, reg, "ooohh_boards_total", nil), 1.0) // boards are counted.
 }
@@ -0,0 +1,430 @@
+// Package servicetest checks that implementations of ooohh.Service behave the
+// same, whatever they keep their data in.
+package servicetest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+// Now is the time the checked services should use as the current time.
+var Now = time.Date(2020, time.February, 15, 0, 0, 0, 0, time.UTC)
+
+// Behavior is a behavior every service should have.
+type Behavior struct {
+	Msg   string
+	Check func(is *is.I, s ooohh.Service)
+}
+
+// Run checks each of the behaviors against a new service, returned by
+// newService along with a function to clean it up. The services must use Now
+// as the current time, and the default value bounds.
+func Run(t *testing.T, newService func(t *testing.T) (ooohh.Service, func())) {
+	for _, tt := range Behaviors {
+		tt := tt
+
+		t.Run(tt.Msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			s, cleanup := newService(t)
+			defer cleanup()
+
+			tt.Check(is, s)
+		})
+	}
+}
+
+// Behaviors are the behaviors every service should have.
+var Behaviors = []Behavior{{
+	Msg: "dial can be created and got",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+
+		got, err := s.GetDial(ctx, d.ID)
+		is.NoErr(err)                                     // dial is retrieved correctly.
+		is.Equal(got.Name, "TEST-DIAL")                   // dial has correct name.
+		is.True(ooohh.TokenMatches(got.Token, "MYTOKEN")) // dial has correct token.
+		is.Equal(got.UpdatedAt, Now)                      // dial has correct update time.
+
+		_, err = s.GetDial(ctx, ooohh.DialID("NON-EXISTANT"))
+		is.Equal(err, ooohh.ErrDialNotFound) // missing dial is not found.
+	},
+}, {
+	Msg: "dial is created with its value, color and group",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		value, invalid := 42.0, 101.0
+
+		// A dial isn't created if any part of it is invalid.
+		_, err := s.CreateDialFrom(ctx, ooohh.NewDial{Name: "TEST-DIAL", Token: "MYTOKEN", Color: "green"})
+		is.Equal(err, ooohh.ErrDialColorInvalid) // dial with an invalid color isn't created.
+		_, err = s.CreateDialFrom(ctx, ooohh.NewDial{Name: "TEST-DIAL", Token: "MYTOKEN", Color: "#fff", Value: &invalid})
+		is.Equal(err, ooohh.ErrDialValueInvalid) // dial with an invalid value isn't created.
+
+		var dials int
+		is.NoErr(s.ListDials(ctx, func(ooohh.Dial) error { dials++; return nil })) // dials are listed.
+		is.Equal(dials, 0)                                                         // no dials are created.
+
+		d, err := s.CreateDialFrom(ctx, ooohh.NewDial{Name: "TEST-DIAL", Token: "MYTOKEN", Value: &value, Color: "#fff", Group: " backend "})
+		is.NoErr(err)                // dial creates correctly.
+		is.Equal(d.Color, "#fff")    // created dial has its color.
+		is.Equal(d.Group, "backend") // created dial has its trimmed group.
+
+		got, err := s.GetDial(ctx, d.ID)
+		is.NoErr(err)                  // dial is retrieved correctly.
+		is.Equal(got.Value, 42.0)      // dial has its value.
+		is.Equal(got.Color, "#fff")    // dial has its color.
+		is.Equal(got.Group, "backend") // dial has its group.
+
+		h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+		is.NoErr(err)              // history is retrieved correctly.
+		is.Equal(len(h), 1)        // the value is recorded.
+		is.Equal(h[0].Value, 42.0) // history has correct value.
+	},
+}, {
+	Msg: "dial value is set with its token",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+
+		is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 42))                             // dial sets with its token.
+		is.Equal(s.SetDial(ctx, d.ID, "NOTMYTOKEN", 50), ooohh.ErrUnauthorized)   // dial doesn't set with another token.
+		is.Equal(s.SetDial(ctx, d.ID, "MYTOKEN", 101), ooohh.ErrDialValueInvalid) // dial doesn't set out of bounds.
+
+		got, err := s.GetDial(ctx, d.ID)
+		is.NoErr(err)             // dial is retrieved correctly.
+		is.Equal(got.Value, 42.0) // dial has the value set with its token.
+
+		h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+		is.NoErr(err)              // history is retrieved correctly.
+		is.Equal(len(h), 1)        // only the successful set is recorded.
+		is.Equal(h[0].Value, 42.0) // history has correct value.
+	},
+}, {
+	Msg: "dial updates are all or nothing",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+
+		name, color, group, token := "RENAMED", "#fff", "GROUP", "NEWTOKEN"
+		value, invalid, badColor := 42.0, 101.0, "green"
+
+		// An invalid part of an update stops the rest of it.
+		_, err = s.UpdateDial(ctx, d.ID, "MYTOKEN", ooohh.DialUpdate{Name: &name, Color: &color, Value: &invalid})
+		is.Equal(err, ooohh.ErrDialValueInvalid) // update with an invalid value fails.
+		_, err = s.UpdateDial(ctx, d.ID, "MYTOKEN", ooohh.DialUpdate{Name: &name, Value: &value, Color: &badColor})
+		is.Equal(err, ooohh.ErrDialColorInvalid) // update with an invalid color fails.
+		_, err = s.UpdateDial(ctx, d.ID, "MYTOKEN", ooohh.DialUpdate{Name: &name, Value: &value, Token: new(string)})
+		is.Equal(err, ooohh.ErrDialTokenInvalid) // update with an invalid token fails.
+
+		// A board's token can't update anything but the value.
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+		is.NoErr(err)                                                       // board creates correctly.
+		is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d.ID})) // board sets.
+		is.NoErr(s.SetBoardOwnsDials(ctx, b.ID, "BOARDTOKEN", true))        // board owns its dials.
+		_, err = s.UpdateDial(ctx, d.ID, "BOARDTOKEN", ooohh.DialUpdate{Name: &name, Value: &value})
+		is.Equal(err, ooohh.ErrUnauthorized) // board token doesn't rename the dial.
+
+		got, err := s.GetDial(ctx, d.ID)
+		is.NoErr(err)                        // dial is retrieved correctly.
+		is.Equal(got.Name, "TEST-DIAL")      // dial isn't renamed.
+		is.Equal(got.Color, "")              // dial color isn't set.
+		is.Equal(got.Value, 0.0)             // dial value isn't set.
+		is.Equal(got.UpdatedAt, d.UpdatedAt) // dial isn't updated.
+
+		h, err := s.GetDialHistory(ctx, d.ID, time.Time{})
+		is.NoErr(err)       // history is retrieved correctly.
+		is.Equal(len(h), 0) // nothing is recorded.
+
+		// A valid update changes everything at once.
+		got, err = s.UpdateDial(ctx, d.ID, "MYTOKEN", ooohh.DialUpdate{Name: &name, Color: &color, Group: &group, Value: &value, Note: "note", Token: &token})
+		is.NoErr(err)                                      // dial updates.
+		is.Equal(got.Name, "RENAMED")                      // updated dial is renamed.
+		is.Equal(got.Color, "#fff")                        // updated dial has its color.
+		is.Equal(got.Group, "GROUP")                       // updated dial has its group.
+		is.Equal(got.Value, 42.0)                          // updated dial has its value.
+		is.True(ooohh.TokenMatches(got.Token, "NEWTOKEN")) // updated dial has its new token.
+
+		h, err = s.GetDialHistory(ctx, d.ID, time.Time{})
+		is.NoErr(err)               // history is retrieved correctly.
+		is.Equal(len(h), 1)         // the value is recorded.
+		is.Equal(h[0].Note, "note") // history has the note.
+	},
+}, {
+	Msg: "board is got with its dials",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d1, err := s.CreateDial(ctx, "ONE", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+		d2, err := s.CreateDial(ctx, "TWO", "MYTOKEN")
+		is.NoErr(err)                                  // dial creates correctly.
+		is.NoErr(s.SetDial(ctx, d2.ID, "MYTOKEN", 20)) // dial sets.
+
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+		is.NoErr(err) // board creates correctly.
+
+		err = s.SetBoard(ctx, b.ID, "NOTMYTOKEN", []ooohh.DialID{d1.ID})
+		is.Equal(err, ooohh.ErrUnauthorized) // board doesn't set with another token.
+		err = s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d2.ID, "NON-EXISTANT", d1.ID})
+		is.NoErr(err) // board sets with its token.
+
+		for _, id := range []ooohh.BoardID{b.ID, ooohh.BoardID(b.Code)} {
+			got, err := s.GetBoard(ctx, id)
+			is.NoErr(err)                      // board is retrieved correctly, by ID and code.
+			is.Equal(got.Name, "TEST-BOARD")   // board has correct name.
+			is.Equal(len(got.Dials), 2)        // missing dials are skipped.
+			is.Equal(got.Dials[0].ID, d2.ID)   // dials are in board order.
+			is.Equal(got.Dials[0].Value, 20.0) // dials are populated.
+			is.Equal(got.Dials[1].ID, d1.ID)   // dials are in board order.
+		}
+
+		ids, err := s.GetBoardDialIDs(ctx, b.ID)
+		is.NoErr(err)                                               // board dial ids are retrieved correctly.
+		is.Equal(ids, []ooohh.DialID{d2.ID, "NON-EXISTANT", d1.ID}) // missing dials are kept.
+
+		_, err = s.GetBoard(ctx, ooohh.BoardID("NON-EXISTANT"))
+		is.Equal(err, ooohh.ErrBoardNotFound) // missing board is not found.
+	},
+}, {
+	Msg: "board is created with its description",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		long := strings.Repeat("a", ooohh.MaxDescriptionLength+1)
+		_, err := s.CreateBoardWithDescription(ctx, "TEST-BOARD", "MYTOKEN", long)
+		is.Equal(err, ooohh.ErrBoardDescriptionInvalid) // board with an invalid description isn't created.
+
+		boards, err := s.ListBoardsByToken(ctx, "MYTOKEN")
+		is.NoErr(err)            // boards are listed.
+		is.Equal(len(boards), 0) // no board is created.
+
+		b, err := s.CreateBoardWithDescription(ctx, "TEST-BOARD", "MYTOKEN", " Q3 stress tracking ")
+		is.NoErr(err)                                 // board creates correctly.
+		is.Equal(b.Description, "Q3 stress tracking") // created board has its trimmed description.
+
+		got, err := s.GetBoard(ctx, b.ID)
+		is.NoErr(err)                                   // board is retrieved correctly.
+		is.Equal(got.Description, "Q3 stress tracking") // board has its description.
+	},
+}, {
+	Msg: "boards are listed by token",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		_, err := s.CreateBoard(ctx, "MINE", "MYTOKEN")
+		is.NoErr(err) // board creates correctly.
+		_, err = s.CreateBoard(ctx, "THEIRS", "THEIRTOKEN")
+		is.NoErr(err) // board creates correctly.
+
+		boards, err := s.ListBoardsByToken(ctx, "MYTOKEN")
+		is.NoErr(err)                    // boards are listed correctly.
+		is.Equal(len(boards), 1)         // only boards with the token are listed.
+		is.Equal(boards[0].Name, "MINE") // correct board is listed.
+	},
+}, {
+	Msg: "dial value is set with an owning board's token",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d, err := s.CreateDial(ctx, "TEST-DIAL", "DIALTOKEN")
+		is.NoErr(err) // dial creates correctly.
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+		is.NoErr(err)                                                       // board creates correctly.
+		is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d.ID})) // dial added to board.
+
+		is.Equal(s.SetDial(ctx, d.ID, "BOARDTOKEN", 10), ooohh.ErrUnauthorized)     // board doesn't own its dials by default.
+		is.NoErr(s.SetBoardOwnsDials(ctx, b.ID, "BOARDTOKEN", true))                // board ownership sets.
+		is.NoErr(s.SetDial(ctx, d.ID, "BOARDTOKEN", 20))                            // board token sets dial.
+		is.Equal(s.RenameDial(ctx, d.ID, "BOARDTOKEN", "X"), ooohh.ErrUnauthorized) // board token only sets values.
+
+		got, err := s.GetDial(ctx, d.ID)
+		is.NoErr(err)             // dial is retrieved correctly.
+		is.Equal(got.Value, 20.0) // dial has the value set with the board token.
+	},
+}, {
+	Msg: "frozen board keeps its dials' values",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d, err := s.CreateDialWithValue(ctx, "TEST-DIAL", "DIALTOKEN", 10)
+		is.NoErr(err) // dial creates correctly.
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+		is.NoErr(err)                                                       // board creates correctly.
+		is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d.ID})) // dial added to board.
+
+		is.NoErr(s.FreezeBoard(ctx, b.ID, "BOARDTOKEN"))                      // board freezes.
+		is.Equal(s.SetDial(ctx, d.ID, "DIALTOKEN", 50), ooohh.ErrBoardFrozen) // member dial isn't set.
+		is.NoErr(s.SetDialColor(ctx, d.ID, "DIALTOKEN", "#fff"))              // member dial color still sets.
+
+		got, err := s.GetBoard(ctx, b.ID)
+		is.NoErr(err)                      // board is retrieved correctly.
+		is.True(got.Frozen)                // board is frozen.
+		is.Equal(len(got.Dials), 1)        // board has its dial.
+		is.Equal(got.Dials[0].Value, 10.0) // dial has its value at freeze time.
+		is.Equal(got.Dials[0].Color, "")   // dial has its color at freeze time.
+
+		is.NoErr(s.UnfreezeBoard(ctx, b.ID, "BOARDTOKEN")) // board unfreezes.
+		is.NoErr(s.SetDial(ctx, d.ID, "DIALTOKEN", 50))    // member dial sets.
+
+		got, err = s.GetBoard(ctx, b.ID)
+		is.NoErr(err)                        // board is retrieved correctly.
+		is.Equal(got.Dials[0].Value, 50.0)   // dial has its live value.
+		is.Equal(got.Dials[0].Color, "#fff") // dial has its live color.
+	},
+}, {
+	Msg: "board updates are all or nothing",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d1, err := s.CreateDialWithValue(ctx, "ONE", "DIALTOKEN", 10)
+		is.NoErr(err) // dial creates correctly.
+		d2, err := s.CreateDialWithValue(ctx, "TWO", "DIALTOKEN", 20)
+		is.NoErr(err) // dial creates correctly.
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+		is.NoErr(err)                                                        // board creates correctly.
+		is.NoErr(s.SetBoard(ctx, b.ID, "BOARDTOKEN", []ooohh.DialID{d1.ID})) // dial added to board.
+		is.NoErr(s.FreezeBoard(ctx, b.ID, "BOARDTOKEN"))                     // board freezes.
+
+		name, long, owns, frozen := "RENAMED", strings.Repeat("a", ooohh.MaxDescriptionLength+1), true, false
+		dials := []ooohh.DialID{d2.ID}
+
+		// A failed part of an update stops the rest of it.
+		err = s.UpdateBoard(ctx, b.ID, "BOARDTOKEN", ooohh.BoardUpdate{Name: &name, OwnsDials: &owns, Dials: &dials})
+		is.Equal(err, ooohh.ErrBoardFrozen) // frozen board's dials aren't changed.
+		err = s.UpdateBoard(ctx, b.ID, "BOARDTOKEN", ooohh.BoardUpdate{Name: &name, Frozen: &frozen, Description: &long})
+		is.Equal(err, ooohh.ErrBoardDescriptionInvalid) // update with an invalid description fails.
+
+		got, err := s.GetBoard(ctx, b.ID)
+		is.NoErr(err)                    // board is retrieved correctly.
+		is.Equal(got.Name, "TEST-BOARD") // board isn't renamed.
+		is.True(!got.OwnsDials)          // board doesn't own its dials.
+		is.True(got.Frozen)              // board is still frozen.
+		is.Equal(got.Dials[0].ID, d1.ID) // board has its dials.
+
+		// A board can be unfrozen, changed and frozen again in one update each.
+		err = s.UpdateBoard(ctx, b.ID, "BOARDTOKEN", ooohh.BoardUpdate{Name: &name, Frozen: &frozen, Dials: &dials})
+		is.NoErr(err) // board unfreezes, and its dials change.
+
+		frozen = true
+		dials = []ooohh.DialID{d1.ID, d2.ID}
+		err = s.UpdateBoard(ctx, b.ID, "BOARDTOKEN", ooohh.BoardUpdate{Frozen: &frozen, Dials: &dials})
+		is.NoErr(err) // board's dials change, and it freezes.
+
+		is.Equal(s.SetDial(ctx, d2.ID, "DIALTOKEN", 50), ooohh.ErrBoardFrozen) // new member dial isn't set.
+
+		got, err = s.GetBoard(ctx, b.ID)
+		is.NoErr(err)                      // board is retrieved correctly.
+		is.Equal(got.Name, "RENAMED")      // board is renamed.
+		is.True(got.Frozen)                // board is frozen.
+		is.Equal(len(got.Dials), 2)        // board has its changed dials.
+		is.Equal(got.Dials[0].Value, 10.0) // first dial is snapshotted.
+		is.Equal(got.Dials[1].Value, 20.0) // new dial is snapshotted.
+	},
+}, {
+	Msg: "dials added to a board concurrently are all kept",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "BOARDTOKEN")
+		is.NoErr(err) // board creates correctly.
+
+		// Add each dial twice, all at once.
+		ids := []ooohh.DialID{"one", "two", "three", "four", "five"}
+		errs := make(chan error, 2*len(ids))
+		for _, id := range append(ids, ids...) {
+			go func(id ooohh.DialID) {
+				errs <- s.AddBoardDial(ctx, b.ID, "BOARDTOKEN", id)
+			}(id)
+		}
+		for range append(ids, ids...) {
+			is.NoErr(<-errs) // dial is added to the board.
+		}
+
+		got, err := s.GetBoardDialIDs(ctx, b.ID)
+		is.NoErr(err)                // board dials are retrieved correctly.
+		is.Equal(len(got), len(ids)) // every dial is on the board, once.
+
+		is.Equal(s.AddBoardDial(ctx, b.ID, "WRONG", "six"), ooohh.ErrUnauthorized)            // wrong token is unauthorized.
+		is.Equal(s.AddBoardDial(ctx, "missing", "BOARDTOKEN", "six"), ooohh.ErrBoardNotFound) // missing board is not found.
+
+		is.NoErr(s.FreezeBoard(ctx, b.ID, "BOARDTOKEN"))                               // board freezes.
+		is.Equal(s.AddBoardDial(ctx, b.ID, "BOARDTOKEN", "six"), ooohh.ErrBoardFrozen) // frozen board's dials aren't changed.
+	},
+}, {
+	Msg: "dial can be deleted",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d, err := s.CreateDialWithValue(ctx, "TEST-DIAL", "MYTOKEN", 10)
+		is.NoErr(err) // dial creates correctly.
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+		is.NoErr(err)                                                    // board creates correctly.
+		is.NoErr(s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID})) // dial added to board.
+
+		is.Equal(s.DeleteDial(ctx, d.ID, "NOTMYTOKEN"), ooohh.ErrUnauthorized) // dial isn't deleted with another token.
+		is.NoErr(s.DeleteDial(ctx, d.ID, "MYTOKEN"))                           // dial deletes with its token.
+
+		_, err = s.GetDial(ctx, d.ID)
+		is.Equal(err, ooohh.ErrDialNotFound) // deleted dial is not found.
+		_, err = s.GetDialHistory(ctx, d.ID, time.Time{})
+		is.Equal(err, ooohh.ErrDialNotFound) // deleted dial history is not found.
+
+		got, err := s.GetBoard(ctx, b.ID)
+		is.NoErr(err)               // board is retrieved correctly.
+		is.Equal(len(got.Dials), 0) // deleted dial is skipped.
+	},
+}, {
+	Msg: "board can be cloned",
+	Check: func(is *is.I, s ooohh.Service) {
+		ctx := context.TODO()
+
+		d1, err := s.CreateDial(ctx, "ONE", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+		d2, err := s.CreateDial(ctx, "TWO", "MYTOKEN")
+		is.NoErr(err) // dial creates correctly.
+
+		src, err := s.CreateBoard(ctx, "SPRINT-1", "MYTOKEN")
+		is.NoErr(err)                                                                              // board creates correctly.
+		is.NoErr(s.SetBoard(ctx, src.ID, "MYTOKEN", []ooohh.DialID{d2.ID, "NON-EXISTANT", d1.ID})) // dials added to board.
+
+		clone, err := s.CloneBoard(ctx, ooohh.BoardID(src.Code), "SPRINT-2", "NEWTOKEN")
+		is.NoErr(err)                      // board clones correctly, by code.
+		is.True(clone.ID != src.ID)        // clone has a new id.
+		is.True(clone.Code != src.Code)    // clone has a new code.
+		is.Equal(clone.Name, "SPRINT-2")   // clone has the given name.
+		is.Equal(clone.Token, "NEWTOKEN")  // clone has the given token.
+		is.Equal(len(clone.Dials), 2)      // clone is returned with its dials, skipping missing dials.
+		is.Equal(clone.Dials[0].ID, d2.ID) // clone dials are in board order.
+
+		ids, err := s.GetBoardDialIDs(ctx, clone.ID)
+		is.NoErr(err)                                               // clone dial ids are retrieved correctly.
+		is.Equal(ids, []ooohh.DialID{d2.ID, "NON-EXISTANT", d1.ID}) // clone has the same dials as the board.
+
+		// The clone is updated with its own token, and its dials stay live.
+		is.Equal(s.SetBoardName(ctx, clone.ID, "MYTOKEN", "X"), ooohh.ErrUnauthorized) // clone isn't updated with the board's token.
+		is.NoErr(s.SetDial(ctx, d1.ID, "MYTOKEN", 30))                                 // shared dial sets.
+
+		got, err := s.GetBoard(ctx, clone.ID)
+		is.NoErr(err)                      // clone is retrieved correctly.
+		is.Equal(got.Dials[1].Value, 30.0) // clone shares the board's dials.
+
+		_, err = s.CloneBoard(ctx, ooohh.BoardID("NON-EXISTANT"), "SPRINT-2", "NEWTOKEN")
+		is.Equal(err, ooohh.ErrBoardNotFound) // missing board isn't cloned.
+	},
+}}
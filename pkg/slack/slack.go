@@ -3,6 +3,7 @@ package slack
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -18,12 +19,24 @@ var (
 	ErrDialNotFound = errors.New("dial not found")
 )
 
+// DefaultDial is the name of each user's default dial, which is used when no
+// dial name is given. Users can have any number of other, named, dials.
+const DefaultDial = ""
+
 // Service represents a service for managing dials from slack commands.
 type Service interface {
-	// SetDialValue updates the given user's dial value.
-	SetDialValue(ctx context.Context, teamID, userID, userName string, value float64) error
-	// GetDial returns the dial for the given user.
-	GetDial(ctx context.Context, teamID, userID string) (*ooohh.Dial, error)
+	// SetDialValue updates the value of the given user's dial with the given
+	// name, creating the dial if the user doesn't have one yet, named after the
+	// user. The updated dial is returned, along with whether it was created.
+	// The note is optional, and is kept with the value in the dial's history.
+	SetDialValue(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error)
+	// GetDial returns the given user's dial with the given name.
+	GetDial(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error)
+	// AddToBoard adds the given user's dial to the board, creating the dial,
+	// named after the user, if the user doesn't have one yet. Adding a dial that
+	// is already on the board leaves the board as it is. The board token must be
+	// the one the board was created with.
+	AddToBoard(ctx context.Context, teamID, userID, userName, boardID, boardToken string) error
 }
 
 type service struct {
@@ -31,10 +44,50 @@ type service struct {
 	db     *bolt.DB
 	logger *zap.SugaredLogger
 
-	salt string
+	salt     string
+	oldSalts []string
+
+	// users is the name of the bucket that maps users to their dials.
+	users string
+}
+
+// Option configures the service.
+type Option func(*service)
+
+// WithOldSalts sets salts that dial tokens were previously generated with.
+// Dials with tokens generated from an old salt can still be set, and have
+// their tokens upgraded to the current salt when they are. Only dials of users
+// stored before their tokens were kept need this, as other users' dials are
+// set with their stored token whatever the salt.
+func WithOldSalts(salts ...string) Option {
+	return func(s *service) {
+		s.oldSalts = salts
+	}
 }
 
-func NewService(logger *zap.SugaredLogger, db *bolt.DB, s ooohh.Service, salt string) (*service, error) {
+// WithBucketPrefix names the bucket that maps users to their dials
+// "<prefix>_slack_users", so services with different prefixes can share a db.
+func WithBucketPrefix(prefix string) Option {
+	return func(s *service) {
+		if prefix != "" {
+			s.users = prefix + "_slack_users"
+		}
+	}
+}
+
+func NewService(logger *zap.SugaredLogger, db *bolt.DB, s ooohh.Service, salt string, opts ...Option) (*service, error) {
+
+	svc := &service{
+		s:      s,
+		db:     db,
+		logger: logger,
+		salt:   salt,
+		users:  "slack_users",
+	}
+
+	for _, opt := range opts {
+		opt(svc)
+	}
 
 	// Initialize top-level buckets.
 	txn, err := db.Begin(true)
@@ -43,91 +96,196 @@ func NewService(logger *zap.SugaredLogger, db *bolt.DB, s ooohh.Service, salt st
 	}
 	defer txn.Rollback() //nolint:errcheck
 
-	if _, err := txn.CreateBucketIfNotExists([]byte("slack_users")); err != nil {
-		return nil, errors.Wrap(err, "creating slack_users bucket")
+	if _, err := txn.CreateBucketIfNotExists([]byte(svc.users)); err != nil {
+		return nil, errors.Wrapf(err, "creating %s bucket", svc.users)
 	}
 
-	return &service{s, db, logger, salt}, txn.Commit()
+	return svc, txn.Commit()
 }
 
-// SetDialValue updates the given user's dial value.
-func (s *service) SetDialValue(ctx context.Context, teamID, userID, userName string, value float64) error {
+// SetDialValue updates the value of the given user's dial with the given
+// name, creating the dial if the user doesn't have one yet. Dials are named
+// after the user name, along with the dial name if it isn't the default dial,
+// or the user's key if the name isn't known, but are always stored by the key.
+// The updated dial is returned, along with whether it was created. The note is
+// optional, and is kept with the value in the dial's history.
+func (s *service) SetDialValue(ctx context.Context, teamID, userID, userName, dialName string, value float64, note string) (*ooohh.Dial, bool, error) {
 
-	key := getUserKey(teamID, userID)
-	token := generateToken(key, s.salt)
+	key := getDialKey(teamID, userID, dialName)
 
-	// Try to retrieve the dial identifier for this user.
-	var dialID *ooohh.DialID
-	err := s.db.View(func(txn *bolt.Tx) error {
-		if v := txn.Bucket([]byte("slack_users")).Get([]byte(key)); v != nil {
-			d := ooohh.DialID(v)
-			dialID = &d
-		}
+	if userName != "" && dialName != DefaultDial {
+		userName = fmt.Sprintf("%s (%s)", userName, dialName)
+	}
 
-		return nil
-	})
+	u, created, err := s.userDial(ctx, key, userName)
 	if err != nil {
-		return errors.Wrap(err, "finding existing dial")
+		return nil, false, err
 	}
 
-	// If the dialID wasn't set before, create a new dial.
-	if dialID == nil {
-		dial, err := s.s.CreateDial(ctx, userName, token)
-		if err != nil {
-			return errors.Wrap(err, "creating dial")
-		}
+	// Users stored before their tokens were kept are set with the token
+	// generated from the current salt.
+	token := u.Token
+	if token == "" {
+		token = generateToken(key, s.salt)
+	}
 
-		// Store user -> dial mapping.
-		err = s.db.Update(func(txn *bolt.Tx) error {
-			err := txn.Bucket([]byte("slack_users")).Put([]byte(key), []byte(dial.ID))
-			if err != nil {
-				return errors.Wrap(err, "storing user to dial mapping")
-			}
+	// Update dial value. Dials created before the salt was rotated, and before
+	// tokens were kept, have their token upgraded, and are then updated with
+	// the current token.
+	err = s.s.SetDialWithNote(ctx, u.DialID, token, value, note)
+	if errors.Is(err, ooohh.ErrUnauthorized) && u.Token == "" && len(s.oldSalts) > 0 {
+		if err = s.upgradeToken(ctx, u.DialID, key, token); err == nil {
+			err = s.s.SetDialWithNote(ctx, u.DialID, token, value, note)
+		}
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "setting dial value")
+	}
 
-			return nil
-		})
-		if err != nil {
-			return errors.Wrap(err, "storing dial mapping")
+	// Keep the token of users stored before tokens were kept, now that it is
+	// known to work, so their dials survive the salt being rotated.
+	if u.Token == "" {
+		u.Token = token
+		if err := s.putUser(key, *u); err != nil {
+			return nil, false, errors.Wrap(err, "storing dial mapping")
 		}
+	}
 
-		// Capture dial ID
-		dialID = &dial.ID
+	// Read the dial from where it was written, so the update is seen.
+	d, err := s.s.GetDial(ooohh.WithPrimaryReads(ctx), u.DialID)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "retrieving updated dial")
 	}
 
-	// Update dial value.
-	err = s.s.SetDial(ctx, *dialID, token, value)
+	return d, created, nil
+}
+
+// AddToBoard adds the given user's dial to the board, creating the dial if the
+// user doesn't have one yet, named after the user name, or the user's key if
+// the name isn't known. Adding a dial that is already on the board leaves the
+// board's dials as they are, but the board token is still checked.
+// ooohh.ErrUnauthorized is returned if the board token isn't the one the board
+// was created with.
+func (s *service) AddToBoard(ctx context.Context, teamID, userID, userName, boardID, boardToken string) error {
+
+	key := getUserKey(teamID, userID)
+
+	u, _, err := s.userDial(ctx, key, userName)
 	if err != nil {
-		return errors.Wrap(err, "setting dial value")
+		return err
+	}
+
+	// The dial is added in one go, so that concurrent commands adding other
+	// dials to the board don't drop each other's dials.
+	if err := s.s.AddBoardDial(ctx, ooohh.BoardID(boardID), boardToken, u.DialID); err != nil {
+		return errors.Wrap(err, "adding dial to board")
 	}
 
 	return nil
 }
 
-// GetDial returns the dial for the given user.
-func (s *service) GetDial(ctx context.Context, teamID, userID string) (*ooohh.Dial, error) {
+// user is what is stored for each user, their dial and the token it is set
+// with. Users stored before tokens were kept have no token.
+type user struct {
+	DialID ooohh.DialID `json:"dial_id"`
+	Token  string       `json:"token,omitempty"`
+}
 
-	key := getUserKey(teamID, userID)
+// userDial returns the user with the given key, creating the user's dial if
+// they don't have one yet, along with whether it was created. Dials are named
+// after the user name, or the user's key if the name isn't known, and are
+// created with a token generated from the current salt.
+func (s *service) userDial(ctx context.Context, key, userName string) (*user, bool, error) {
 
-	// Retrieve users dial ID.
-	var dialID *ooohh.DialID
+	// Try to retrieve this user.
+	u, err := s.getUser(key)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "finding existing dial")
+	}
+
+	if u != nil {
+		return u, false, nil
+	}
+
+	// The dial wasn't created before, so create a new dial. The dial is
+	// ensured by an external ID for the user, rather than just created, so
+	// that if the mapping below fails to be stored, the next attempt finds the
+	// same dial instead of orphaning it and creating another.
+	name := userName
+	if name == "" {
+		name = key
+	}
+
+	token := generateToken(key, s.salt)
+
+	dial, created, err := s.s.EnsureDial(ctx, externalID(key), name, token)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "creating dial")
+	}
+
+	// Store user -> dial mapping, along with the dial's token, so the dial
+	// can still be set if the salt is changed.
+	u = &user{DialID: dial.ID, Token: token}
+	if err := s.putUser(key, *u); err != nil {
+		return nil, false, errors.Wrap(err, "storing dial mapping")
+	}
+
+	return u, created, nil
+}
+
+// getUser returns the user with the given key, or nil if there isn't one.
+func (s *service) getUser(key string) (*user, error) {
+	var u *user
 	err := s.db.View(func(txn *bolt.Tx) error {
-		if v := txn.Bucket([]byte("slack_users")).Get([]byte(key)); v != nil {
-			d := ooohh.DialID(v)
-			dialID = &d
+		v := txn.Bucket([]byte(s.users)).Get([]byte(key))
+		if v == nil {
+			return nil
+		}
+
+		// Users stored before tokens were kept are stored as their dial ID.
+		u = &user{}
+		if len(v) == 0 || v[0] != '{' {
+			u.DialID = ooohh.DialID(v)
+			return nil
 		}
 
-		return nil
+		return json.Unmarshal(v, u)
 	})
+
+	return u, err
+}
+
+// putUser stores the user with the given key.
+func (s *service) putUser(key string, u user) error {
+	v, err := json.Marshal(u)
+	if err != nil {
+		return errors.Wrap(err, "marshalling user")
+	}
+
+	return s.db.Update(func(txn *bolt.Tx) error {
+		return errors.Wrap(txn.Bucket([]byte(s.users)).Put([]byte(key), v), "storing user to dial mapping")
+	})
+}
+
+// GetDial returns the given user's dial with the given name.
+func (s *service) GetDial(ctx context.Context, teamID, userID, dialName string) (*ooohh.Dial, error) {
+
+	key := getDialKey(teamID, userID, dialName)
+
+	// Retrieve users dial ID.
+	u, err := s.getUser(key)
 	if err != nil {
 		return nil, errors.Wrap(err, "retrieving user dial id")
 	}
 
-	if dialID == nil {
+	if u == nil {
 		// Dial wasn't found for user.
 		return nil, ErrDialNotFound
 	}
 
-	d, err := s.s.GetDial(ctx, *dialID)
+	// Read the dial from where it was written, as users are, so dials that
+	// were just created are found.
+	d, err := s.s.GetDial(ooohh.WithPrimaryReads(ctx), u.DialID)
 	if err != nil {
 		return nil, errors.Wrap(err, "retrieving user dial")
 	}
@@ -135,10 +293,44 @@ func (s *service) GetDial(ctx context.Context, teamID, userID string) (*ooohh.Di
 	return d, nil
 }
 
+// upgradeToken replaces the dial's token, if it was generated from one of the
+// old salts, with the given token. ooohh.ErrUnauthorized is returned if none
+// of the old salts generate the dial's token.
+func (s *service) upgradeToken(ctx context.Context, id ooohh.DialID, key, token string) error {
+	for _, salt := range s.oldSalts {
+		err := s.s.SetDialToken(ctx, id, generateToken(key, salt), token)
+		if err == nil {
+			s.logger.Infow("upgraded dial token", "dial", id)
+			return nil
+		} else if !errors.Is(err, ooohh.ErrUnauthorized) {
+			return errors.Wrap(err, "upgrading dial token")
+		}
+	}
+
+	return ooohh.ErrUnauthorized
+}
+
 func getUserKey(teamID, userID string) string {
 	return fmt.Sprintf("%s:%s", teamID, userID)
 }
 
+// getDialKey returns the key the user's dial with the given name is stored by.
+// The default dial is stored by the user's key, as it was before users could
+// have named dials.
+func getDialKey(teamID, userID, dialName string) string {
+	key := getUserKey(teamID, userID)
+	if dialName == DefaultDial {
+		return key
+	}
+
+	return fmt.Sprintf("%s:%s", key, dialName)
+}
+
+// externalID returns the external ID of the dial of the user with the given key.
+func externalID(key string) string {
+	return "slack:" + key
+}
+
 func generateToken(key, salt string) string {
 	// Append salt
 	key = fmt.Sprintf("%s:%s", key, salt)
@@ -2,10 +2,12 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -97,26 +99,31 @@ func TestSettingDial(t *testing.T) {
 	// Variables that will be updated by the set dial function in the service.
 	var setID ooohh.DialID
 	var setValue *float64
+	var setNote string
 
 	// Create mock ooohh.Service.
 	ms := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
 			return &ooohh.Dial{
 				ID:        ooohh.DialID(fmt.Sprintf("dial-%s", name)),
 				Name:      name,
 				Token:     token,
 				Value:     0.0,
 				UpdatedAt: time.Now(),
-			}, nil
+			}, true, nil
 		},
-		SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
 
 			// Capture set values.
 			setID = id
 			setValue = &value
+			setNote = note
 
 			return nil
 		},
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return &ooohh.Dial{ID: id, Value: *setValue}, nil
+		},
 	}
 
 	// Create service.
@@ -127,14 +134,16 @@ func TestSettingDial(t *testing.T) {
 
 	// Set dial for the first time.
 	// The dial should be created.
-	err = s.SetDialValue(ctx, "team", "user", "name", 66.6)
-	is.NoErr(err) // setting dial succeeded.
+	d, created, err := s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 66.6, "prod is on fire")
+	is.NoErr(err)           // setting dial succeeded.
+	is.True(created)        // dial is reported as created.
+	is.Equal(d.Value, 66.6) // updated dial is returned.
 
-	// Check that CreateDial was called on the service.
-	is.True(ms.CreateDialInvoked) // dial was created.
+	// Check that EnsureDial was called on the service.
+	is.True(ms.EnsureDialInvoked) // dial was created.
 
 	// Check that SetDial was called on the service.
-	is.True(ms.SetDialInvoked) // dial value was set.
+	is.True(ms.SetDialWithNoteInvoked) // dial value was set.
 
 	// Check correct values were set.
 	is.True(setID != ooohh.DialID("")) // id is not empty.
@@ -142,6 +151,7 @@ func TestSettingDial(t *testing.T) {
 	if setValue != nil {
 		is.Equal(*setValue, 66.6) // correct value was set.
 	}
+	is.Equal(setNote, "prod is on fire") // note was set with the value.
 
 	// Capture previous id.
 	createdID := setID
@@ -153,14 +163,16 @@ func TestSettingDial(t *testing.T) {
 
 	// Set the dial again.
 	// The dial should NOT be created.
-	err = s.SetDialValue(ctx, "team", "user", "name", 10.0)
-	is.NoErr(err) // setting dial succeeded.
+	d, created, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 10.0, "")
+	is.NoErr(err)           // setting dial succeeded.
+	is.True(!created)       // dial is reported as updated.
+	is.Equal(d.Value, 10.0) // updated dial is returned.
 
-	// Check that CreateDial was NOT called on the service.
-	is.True(!ms.CreateDialInvoked) // dial was not created.
+	// Check that EnsureDial was NOT called on the service.
+	is.True(!ms.EnsureDialInvoked) // dial was not created.
 
 	// Check that SetDial was called on the service.
-	is.True(ms.SetDialInvoked) // dial value was set.
+	is.True(ms.SetDialWithNoteInvoked) // dial value was set.
 
 	// Check set values.
 	is.True(setID != ooohh.DialID("")) // id is not empty.
@@ -177,14 +189,15 @@ func TestSettingDial(t *testing.T) {
 
 	// Set the dial for a different user in the same team.
 	// The dial should be created.
-	err = s.SetDialValue(ctx, "team", "user2", "name2", 33.3)
-	is.NoErr(err) // setting dial succeeded.
+	_, created, err = s.SetDialValue(ctx, "team", "user2", "name2", DefaultDial, 33.3, "")
+	is.NoErr(err)    // setting dial succeeded.
+	is.True(created) // dial is reported as created.
 
-	// Check that CreateDial was called on the service.
-	is.True(ms.CreateDialInvoked) // dial was created.
+	// Check that EnsureDial was called on the service.
+	is.True(ms.EnsureDialInvoked) // dial was created.
 
 	// Check that SetDial was called on the service.
-	is.True(ms.SetDialInvoked) // dial value was set.
+	is.True(ms.SetDialWithNoteInvoked) // dial value was set.
 
 	// Check that the dial id is different for this new user.
 	is.True(setID != ooohh.DialID("")) // id is not empty.
@@ -197,20 +210,211 @@ func TestSettingDial(t *testing.T) {
 
 	// Set the dial for the same user on a different team.
 	// The dial should be created.
-	err = s.SetDialValue(ctx, "team2", "user", "name3", 50.0)
-	is.NoErr(err) // setting dial succeeded.
+	_, created, err = s.SetDialValue(ctx, "team2", "user", "name3", DefaultDial, 50.0, "")
+	is.NoErr(err)    // setting dial succeeded.
+	is.True(created) // dial is reported as created.
 
-	// Check that CreateDial was called on the service.
-	is.True(ms.CreateDialInvoked) // dial was created.
+	// Check that EnsureDial was called on the service.
+	is.True(ms.EnsureDialInvoked) // dial was created.
 
 	// Check that SetDial was called on the service.
-	is.True(ms.SetDialInvoked) // dial value was set.
+	is.True(ms.SetDialWithNoteInvoked) // dial value was set.
 
 	// Check that the dial id is different for this new user.
 	is.True(setID != ooohh.DialID("")) // id is not empty.
 	is.True(setID != createdID)        // new dial id is different for different teams.
 }
 
+func TestSettingNamedDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create mock ooohh.Service, which keeps a dial for each external ID.
+	dials := make(map[ooohh.DialID]*ooohh.Dial)
+	ms := &mock.Service{
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+			d := &ooohh.Dial{ID: ooohh.DialID(externalID), Name: name, Token: token}
+			dials[d.ID] = d
+			return d, true, nil
+		},
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+			if token != dials[id].Token {
+				return ooohh.ErrUnauthorized
+			}
+			dials[id].Value = value
+			return nil
+		},
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			d := *dials[id]
+			return &d, nil
+		},
+	}
+
+	// Create service.
+	s, err := NewService(logger, db, ms, "salt")
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Create two named dials, and the default dial, for the same user.
+	prod, created, err := s.SetDialValue(ctx, "team", "user", "jane", "prod", 80.0, "")
+	is.NoErr(err)                      // setting dial succeeded.
+	is.True(created)                   // dial is reported as created.
+	is.Equal(prod.Name, "jane (prod)") // dial is named after the user and dial name.
+
+	staging, created, err := s.SetDialValue(ctx, "team", "user", "jane", "staging", 20.0, "")
+	is.NoErr(err)    // setting dial succeeded.
+	is.True(created) // dial is reported as created.
+
+	def, created, err := s.SetDialValue(ctx, "team", "user", "jane", DefaultDial, 50.0, "")
+	is.NoErr(err)              // setting dial succeeded.
+	is.True(created)           // dial is reported as created.
+	is.Equal(def.Name, "jane") // default dial is named after the user.
+
+	is.True(prod.ID != staging.ID) // named dials are distinct.
+	is.True(prod.ID != def.ID)     // named dials are distinct from the default dial.
+
+	// Update one named dial, leaving the other unchanged.
+	d, created, err := s.SetDialValue(ctx, "team", "user", "jane", "prod", 90.0, "")
+	is.NoErr(err)           // setting dial succeeded.
+	is.True(!created)       // dial is reported as updated.
+	is.Equal(d.ID, prod.ID) // same dial is updated.
+	is.Equal(d.Value, 90.0) // dial value is updated.
+
+	for _, tt := range []struct {
+		dialName string
+		expID    ooohh.DialID
+		expValue float64
+	}{
+		{"prod", prod.ID, 90.0},
+		{"staging", staging.ID, 20.0},
+		{DefaultDial, def.ID, 50.0},
+	} {
+		d, err := s.GetDial(ctx, "team", "user", tt.dialName)
+		is.NoErr(err)                  // dial is found.
+		is.Equal(d.ID, tt.expID)       // dial is the named dial.
+		is.Equal(d.Value, tt.expValue) // dial values are independent.
+	}
+
+	// Dials that haven't been set aren't found.
+	_, err = s.GetDial(ctx, "team", "user", "dev")
+	is.True(errors.Is(err, ErrDialNotFound)) // named dial is not found.
+}
+
+func TestCreatedDialIsNamedAfterUser(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		userName string
+		expName  string
+	}{{
+		msg:      "with user name",
+		userName: "Jane Doe",
+		expName:  "Jane Doe",
+	}, {
+		msg:      "without user name",
+		userName: "",
+		expName:  "team:user",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Get a Bolt DB.
+			db, cleanup := newTmpBoltDB(t)
+			defer cleanup()
+
+			// Create logger.
+			logger, _ := newTestLogger(zap.InfoLevel)
+
+			// Variables that will be updated by the ensure dial function in the service.
+			var ensuredExternalID, ensuredName string
+
+			// Create mock ooohh.Service.
+			ms := &mock.Service{
+				EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+					ensuredExternalID, ensuredName = externalID, name
+					return &ooohh.Dial{ID: "dial-id", Name: name, Token: token}, true, nil
+				},
+				SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+					return nil
+				},
+				GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+					return &ooohh.Dial{ID: id}, nil
+				},
+			}
+
+			// Create service.
+			s, err := NewService(logger, db, ms, "salt")
+			is.NoErr(err) // service initializes correctly.
+
+			_, created, err := s.SetDialValue(context.TODO(), "team", "user", tt.userName, DefaultDial, 50.0, "")
+			is.NoErr(err)                                  // setting dial succeeded.
+			is.True(created)                               // dial is reported as created.
+			is.Equal(ensuredName, tt.expName)              // dial is named after the user.
+			is.Equal(ensuredExternalID, "slack:team:user") // dial is ensured by the user's key.
+
+			// Check the dial is stored by the user's key, not their name.
+			var u user
+			err = db.View(func(txn *bolt.Tx) error {
+				return json.Unmarshal(txn.Bucket([]byte("slack_users")).Get([]byte("team:user")), &u)
+			})
+			is.NoErr(err)                                         // mapping is read.
+			is.Equal(u.DialID, ooohh.DialID("dial-id"))           // dial is stored by key.
+			is.Equal(u.Token, generateToken("team:user", "salt")) // token is stored with the dial.
+		})
+	}
+}
+
+func TestSettingDialMappingFailure(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create mock ooohh.Service, that keeps the dials it ensures.
+	ensured := make(map[string]*ooohh.Dial)
+	ms := &mock.Service{
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+			if d, ok := ensured[externalID]; ok {
+				return d, false, nil
+			}
+			d := &ooohh.Dial{ID: ooohh.DialID(fmt.Sprintf("dial-%d", len(ensured))), Name: name, Token: token}
+			ensured[externalID] = d
+			return d, true, nil
+		},
+	}
+
+	// Create service.
+	s, err := NewService(logger, db, ms, "salt")
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// A user key too large for bolt makes storing the user's mapping fail.
+	team := strings.Repeat("t", bolt.MaxKeySize)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = s.SetDialValue(ctx, team, "user", "name", DefaultDial, 50.0, "")
+		is.True(err != nil)                 // mapping failure is surfaced.
+		is.True(!ms.SetDialWithNoteInvoked) // value isn't set.
+		is.Equal(len(ensured), 1)           // the same dial is used each time, none are orphaned.
+	}
+}
+
 func TestSetDialError(t *testing.T) {
 
 	is := is.New(t)
@@ -224,16 +428,16 @@ func TestSetDialError(t *testing.T) {
 
 	// Create mock ooohh.Service.
 	ms := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
 			return &ooohh.Dial{
 				ID:        ooohh.DialID(fmt.Sprintf("dial-%s", name)),
 				Name:      name,
 				Token:     token,
 				Value:     0.0,
 				UpdatedAt: time.Now(),
-			}, nil
+			}, true, nil
 		},
-		SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
 			return ooohh.ErrDialValueInvalid
 		},
 	}
@@ -245,14 +449,154 @@ func TestSetDialError(t *testing.T) {
 	ctx := context.TODO()
 
 	// Set dial for the first time.
-	err = s.SetDialValue(ctx, "team", "user", "name", 101.0)
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 101.0, "")
 	is.True(errors.Is(err, ooohh.ErrDialValueInvalid)) // invalid dial value is returned.
 
-	// Check that CreateDial was called on the service.
-	is.True(ms.CreateDialInvoked) // dial was created.
+	// Check that EnsureDial was called on the service.
+	is.True(ms.EnsureDialInvoked) // dial was created.
 
 	// Check that SetDial was called on the service.
-	is.True(ms.SetDialInvoked) // dial value was set.
+	is.True(ms.SetDialWithNoteInvoked) // dial value was set.
+}
+
+func TestSettingDialAfterSaltRotation(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create mock ooohh.Service, with a single dial that checks its token.
+	dial := &ooohh.Dial{ID: ooohh.DialID("dial-id")}
+	ms := &mock.Service{
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+			dial.Token = token
+			return dial, true, nil
+		},
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+			if token != dial.Token {
+				return ooohh.ErrUnauthorized
+			}
+			dial.Value = value
+			return nil
+		},
+		SetDialTokenFn: func(ctx context.Context, id ooohh.DialID, token, newToken string) error {
+			if token != dial.Token {
+				return ooohh.ErrUnauthorized
+			}
+			dial.Token = newToken
+			return nil
+		},
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return dial, nil
+		},
+	}
+
+	ctx := context.TODO()
+
+	// Create the dial with the old salt.
+	s, err := NewService(logger, db, ms, "old")
+	is.NoErr(err) // service initializes correctly.
+	_, created, err := s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 10.0, "")
+	is.NoErr(err)    // setting dial succeeded.
+	is.True(created) // dial is reported as created.
+	oldToken := dial.Token
+
+	// Store the user as they were before tokens were kept, as their dial ID.
+	err = db.Update(func(txn *bolt.Tx) error {
+		return txn.Bucket([]byte("slack_users")).Put([]byte("team:user"), []byte(dial.ID))
+	})
+	is.NoErr(err) // user is stored without a token.
+
+	// Without the old salt, the dial can't be set after rotation.
+	ms.Reset()
+	s, err = NewService(logger, db, ms, "new")
+	is.NoErr(err) // service initializes correctly.
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 20.0, "")
+	is.True(errors.Is(err, ooohh.ErrUnauthorized)) // dial can't be set.
+	is.True(!ms.SetDialTokenInvoked)               // token isn't upgraded.
+	is.Equal(dial.Token, oldToken)                 // token is unchanged.
+
+	// With the old salt, the dial is set, and its token is upgraded.
+	ms.Reset()
+	s, err = NewService(logger, db, ms, "new", WithOldSalts("older", "old"))
+	is.NoErr(err) // service initializes correctly.
+	d, created, err := s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 30.0, "")
+	is.NoErr(err)                                           // setting dial succeeded.
+	is.True(!created)                                       // dial is reported as updated.
+	is.Equal(d.Value, 30.0)                                 // updated dial is returned.
+	is.True(ms.SetDialTokenInvoked)                         // token is upgraded.
+	is.Equal(dial.Token, generateToken("team:user", "new")) // token is generated from the new salt.
+
+	// Once upgraded, the dial is set with the new token alone.
+	ms.Reset()
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 40.0, "")
+	is.NoErr(err)                    // setting dial succeeded.
+	is.True(!ms.SetDialTokenInvoked) // token is already upgraded.
+	is.Equal(dial.Value, 40.0)       // value is set.
+
+	// Dials with tokens from none of the salts can't be set.
+	ms.Reset()
+	dial.Token = "other"
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 50.0, "")
+	is.True(errors.Is(err, ooohh.ErrUnauthorized)) // dial can't be set.
+	is.Equal(dial.Token, "other")                  // token is unchanged.
+}
+
+func TestSettingDialAfterSaltChange(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create mock ooohh.Service, with a single dial that checks its token.
+	dial := &ooohh.Dial{ID: ooohh.DialID("dial-id")}
+	ms := &mock.Service{
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+			dial.Token = token
+			return dial, true, nil
+		},
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
+			if token != dial.Token {
+				return ooohh.ErrUnauthorized
+			}
+			dial.Value = value
+			return nil
+		},
+		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
+			return dial, nil
+		},
+	}
+
+	ctx := context.TODO()
+
+	// Create the dial with the old salt.
+	s, err := NewService(logger, db, ms, "old")
+	is.NoErr(err) // service initializes correctly.
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 10.0, "")
+	is.NoErr(err) // setting dial succeeded.
+
+	// Change the salt, without keeping the old one.
+	ms.Reset()
+	s, err = NewService(logger, db, ms, "new")
+	is.NoErr(err) // service initializes correctly.
+
+	// The same user can still set their dial, with its stored token.
+	d, created, err := s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 20.0, "")
+	is.NoErr(err)                                           // setting dial succeeded.
+	is.True(!created)                                       // dial is reported as updated.
+	is.True(!ms.EnsureDialInvoked)                          // no other dial is created.
+	is.Equal(d.Value, 20.0)                                 // updated dial is returned.
+	is.Equal(dial.Token, generateToken("team:user", "old")) // token is unchanged.
 }
 
 func TestGettingDial(t *testing.T) {
@@ -273,16 +617,16 @@ func TestGettingDial(t *testing.T) {
 
 	// Create mock ooohh.Service.
 	ms := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
 			return &ooohh.Dial{
 				ID:        ooohh.DialID(fmt.Sprintf("dial-%s", name)),
 				Name:      name,
 				Token:     token,
 				Value:     0.0,
 				UpdatedAt: time.Now(),
-			}, nil
+			}, true, nil
 		},
-		SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
 			// Capture values.
 			setID = id
 			setValue = &value
@@ -310,11 +654,11 @@ func TestGettingDial(t *testing.T) {
 	ctx := context.TODO()
 
 	// Set dial.
-	err = s.SetDialValue(ctx, "team", "user", "name", 44.4)
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 44.4, "")
 	is.NoErr(err) // setting dial succeeded.
 
 	// Get dial.
-	d, err := s.GetDial(ctx, "team", "user")
+	d, err := s.GetDial(ctx, "team", "user", DefaultDial)
 	is.NoErr(err) // getting dial succeeded.
 
 	// Check underlying service was called.
@@ -349,7 +693,7 @@ func TestGettingNonExistantDial(t *testing.T) {
 	ctx := context.TODO()
 
 	// Get dial.
-	_, err = s.GetDial(ctx, "team", "user")
+	_, err = s.GetDial(ctx, "team", "user", DefaultDial)
 	is.True(errors.Is(err, ErrDialNotFound)) // dial not found error.
 
 	// Check underlying service was not called.
@@ -367,22 +711,28 @@ func TestGettingDialError(t *testing.T) {
 	// Create logger.
 	logger, _ := newTestLogger(zap.InfoLevel)
 
+	// Whether the underlying service fails to get dials.
+	getFails := false
+
 	// Create mock ooohh.Service.
 	ms := &mock.Service{
-		CreateDialFn: func(ctx context.Context, name string, token string) (*ooohh.Dial, error) {
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
 			return &ooohh.Dial{
 				ID:        ooohh.DialID(fmt.Sprintf("dial-%s", name)),
 				Name:      name,
 				Token:     token,
 				Value:     0.0,
 				UpdatedAt: time.Now(),
-			}, nil
+			}, true, nil
 		},
-		SetDialFn: func(ctx context.Context, id ooohh.DialID, token string, value float64) error {
+		SetDialWithNoteFn: func(ctx context.Context, id ooohh.DialID, token string, value float64, note string) error {
 			return nil
 		},
 		GetDialFn: func(ctx context.Context, id ooohh.DialID) (*ooohh.Dial, error) {
-			return nil, errors.New("uh-oh")
+			if getFails {
+				return nil, errors.New("uh-oh")
+			}
+			return &ooohh.Dial{ID: id}, nil
 		},
 	}
 
@@ -393,14 +743,83 @@ func TestGettingDialError(t *testing.T) {
 	ctx := context.TODO()
 
 	// Set dial.
-	err = s.SetDialValue(ctx, "team", "user", "name", 44.4)
+	_, _, err = s.SetDialValue(ctx, "team", "user", "name", DefaultDial, 44.4, "")
 	is.NoErr(err) // setting dial succeeded.
 
+	// Make the underlying service fail from now on.
+	getFails = true
+
 	// Get dial.
-	_, err = s.GetDial(ctx, "team", "user")
+	_, err = s.GetDial(ctx, "team", "user", DefaultDial)
 	is.True(err != nil)                       // error returned.
 	is.True(!errors.Is(err, ErrDialNotFound)) // error isn't dial not found error.
 
 	// Check underlying service was called.
 	is.True(ms.GetDialInvoked)
 }
+
+func TestAddingDialToBoard(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a Bolt DB.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// The board's dials, as set by the service.
+	boardDials := []ooohh.DialID{"other"}
+
+	// Create mock ooohh.Service, with a board that has the token "board-token".
+	ms := &mock.Service{
+		EnsureDialFn: func(ctx context.Context, externalID, name, token string) (*ooohh.Dial, bool, error) {
+			return &ooohh.Dial{ID: ooohh.DialID(fmt.Sprintf("dial-%s", name)), Name: name, Token: token}, true, nil
+		},
+		AddBoardDialFn: func(ctx context.Context, id ooohh.BoardID, token string, dialID ooohh.DialID) error {
+			if id != "board" {
+				return ooohh.ErrBoardNotFound
+			}
+			if token != "board-token" {
+				return ooohh.ErrUnauthorized
+			}
+			for _, d := range boardDials {
+				if d == dialID {
+					return nil
+				}
+			}
+			boardDials = append(boardDials, dialID)
+			return nil
+		},
+	}
+
+	// Create service.
+	s, err := NewService(logger, db, ms, "salt")
+	is.NoErr(err) // service initializes correctly.
+
+	ctx := context.TODO()
+
+	// Add the user's dial to the board.
+	err = s.AddToBoard(ctx, "team", "user", "alice", "board", "board-token")
+	is.NoErr(err)                                               // dial is added.
+	is.True(ms.EnsureDialInvoked)                               // user's dial is created.
+	is.True(ms.AddBoardDialInvoked)                             // dial is added in one go.
+	is.Equal(boardDials, []ooohh.DialID{"other", "dial-alice"}) // dial, named after the user, is appended to the board's dials.
+
+	// Adding the dial again leaves the board as it is.
+	ms.Reset()
+	err = s.AddToBoard(ctx, "team", "user", "alice", "board", "board-token")
+	is.NoErr(err)                                               // dial is added again.
+	is.True(!ms.EnsureDialInvoked)                              // user's existing dial is used.
+	is.Equal(boardDials, []ooohh.DialID{"other", "dial-alice"}) // dial isn't added twice.
+
+	// Adding the dial with the wrong token fails.
+	err = s.AddToBoard(ctx, "team", "user", "alice", "board", "wrong")
+	is.True(errors.Is(err, ooohh.ErrUnauthorized)) // unauthorized error is returned.
+
+	// Adding the dial to a missing board fails.
+	err = s.AddToBoard(ctx, "team", "user", "alice", "missing", "board-token")
+	is.True(errors.Is(err, ooohh.ErrBoardNotFound)) // not found error is returned.
+	is.Equal(len(boardDials), 2)                    // board is unchanged.
+}
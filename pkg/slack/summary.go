@@ -0,0 +1,168 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+// slackAPIURL is the base URL of the Slack Web API.
+const slackAPIURL = "https://slack.com/api"
+
+// Summarizer posts a daily summary of a board to a Slack channel.
+type Summarizer struct {
+	logger *zap.SugaredLogger
+	s      ooohh.Service
+	now    func() time.Time
+
+	board     ooohh.BoardID
+	channel   string
+	token     string
+	at        time.Duration
+	precision int
+
+	apiURL string
+	c      *http.Client
+}
+
+// SummarizerOption configures the Summarizer.
+type SummarizerOption func(*Summarizer)
+
+// WithSummaryPrecision sets the number of decimal places values are shown with.
+func WithSummaryPrecision(precision int) SummarizerOption {
+	return func(sm *Summarizer) {
+		sm.precision = precision
+	}
+}
+
+// NewSummarizer returns a Summarizer that posts a summary of the given board
+// to the given channel each day, at the given offset from midnight UTC. The
+// token is the bot token of the Slack app installed in the channel's team.
+func NewSummarizer(logger *zap.SugaredLogger, s ooohh.Service, now func() time.Time, board ooohh.BoardID, channel, token string, at time.Duration, opts ...SummarizerOption) *Summarizer {
+	sm := &Summarizer{
+		logger:    logger,
+		s:         s,
+		now:       now,
+		board:     board,
+		channel:   channel,
+		token:     token,
+		at:        at,
+		precision: ooohh.DefaultPrecision,
+		apiURL:    slackAPIURL,
+		c:         &http.Client{Timeout: 10 * time.Second},
+	}
+
+	for _, opt := range opts {
+		opt(sm)
+	}
+
+	return sm
+}
+
+// Run posts the summary each day until the context is cancelled.
+func (sm *Summarizer) Run(ctx context.Context) {
+	for {
+		now := sm.now()
+		next := nextRun(now, sm.at)
+
+		sm.logger.Infow("next summary scheduled", "at", next)
+
+		t := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+
+		if err := sm.Post(ctx); err != nil {
+			sm.logger.Errorw("could not post summary", "err", err, "board", sm.board)
+		}
+	}
+}
+
+// Post posts the summary of the board to the channel.
+func (sm *Summarizer) Post(ctx context.Context) error {
+	b, err := sm.s.GetBoard(ctx, sm.board)
+	if err != nil {
+		return errors.Wrap(err, "retrieving board")
+	}
+
+	type request struct {
+		Channel string `json:"channel"`
+		Text    string `json:"text"`
+	}
+	type response struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+
+	body, err := json.Marshal(request{sm.channel, summarize(b, sm.precision)})
+	if err != nil {
+		return errors.Wrap(err, "marshalling request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", sm.apiURL+"/chat.postMessage", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+sm.token)
+
+	resp, err := sm.c.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "posting message")
+	}
+	defer resp.Body.Close()
+
+	// Slack responds with a 200 for most errors, signalling failure in the body.
+	var r response
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return errors.Wrapf(err, "reading response with status %d", resp.StatusCode)
+	}
+	if !r.OK {
+		return errors.Errorf("posting message: %s", r.Error)
+	}
+
+	return nil
+}
+
+// summarize returns the text summarizing the given board, with values shown
+// to the given number of decimal places.
+func summarize(b *ooohh.Board, precision int) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Daily summary for *%s*", b.Name)
+
+	if len(b.Dials) == 0 {
+		sb.WriteString("\nThere are no dials on this board yet.")
+		return sb.String()
+	}
+
+	for _, d := range b.Dials {
+		fmt.Fprintf(&sb, "\n• %s: %s", d.Name, ooohh.FormatValue(d.Value, precision))
+	}
+	fmt.Fprintf(&sb, "\nAverage: %s", ooohh.FormatValue(b.Average(), precision))
+
+	return sb.String()
+}
+
+// nextRun returns the next time after now that is the given offset from midnight UTC.
+func nextRun(now time.Time, at time.Duration) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(at)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
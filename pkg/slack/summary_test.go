@@ -0,0 +1,164 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/mock"
+)
+
+func TestSummarizerPost(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create mock ooohh.Service.
+	ms := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:   id,
+				Name: "Team",
+				Dials: []ooohh.Dial{
+					{ID: "dial-1", Name: "one", Value: 10.0},
+					{ID: "dial-2", Name: "two", Value: 50.0},
+				},
+			}, nil
+		},
+	}
+
+	// Variables that will be set by the stub Slack API.
+	var path, auth string
+	var body map[string]interface{}
+
+	// Create a stub Slack API.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path, r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	sm := NewSummarizer(logger, ms, time.Now, "board-id", "C123", "xoxb-token", 9*time.Hour)
+	sm.apiURL = srv.URL
+
+	err := sm.Post(context.TODO())
+	is.NoErr(err) // summary is posted.
+
+	is.True(ms.GetBoardInvoked)                                                                 // board is retrieved.
+	is.Equal(path, "/chat.postMessage")                                                         // message is posted.
+	is.Equal(auth, "Bearer xoxb-token")                                                         // token is used.
+	is.Equal(body["channel"], "C123")                                                           // channel is correct.
+	is.Equal(body["text"], "Daily summary for *Team*\n• one: 10.0\n• two: 50.0\nAverage: 30.0") // summary is correct.
+}
+
+func TestSummarizePrecision(t *testing.T) {
+
+	is := is.New(t)
+
+	b := &ooohh.Board{
+		Name: "Team",
+		Dials: []ooohh.Dial{
+			{ID: "dial-1", Name: "one", Value: 66.6666},
+			{ID: "dial-2", Name: "two", Value: 50.0},
+		},
+	}
+
+	is.Equal(summarize(b, 2), "Daily summary for *Team*\n• one: 66.67\n• two: 50.00\nAverage: 58.33") // values use the precision.
+	is.Equal(summarize(b, 0), "Daily summary for *Team*\n• one: 67\n• two: 50\nAverage: 58")          // values use the precision.
+}
+
+func TestSummarizerPostError(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	// Create mock ooohh.Service.
+	ms := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "Team"}, nil
+		},
+	}
+
+	// Create a stub Slack API, that rejects the message.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": false, "error": "channel_not_found"}`)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	sm := NewSummarizer(logger, ms, time.Now, "board-id", "C123", "xoxb-token", 9*time.Hour)
+	sm.apiURL = srv.URL
+
+	err := sm.Post(context.TODO())
+	is.True(err != nil)                                         // error is returned.
+	is.Equal(err.Error(), "posting message: channel_not_found") // slack error is surfaced.
+}
+
+func TestNextRun(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg string
+		now time.Time
+		exp time.Time
+	}{{
+		msg: "before time today",
+		now: time.Date(2020, time.February, 15, 8, 0, 0, 0, time.UTC),
+		exp: time.Date(2020, time.February, 15, 9, 0, 0, 0, time.UTC),
+	}, {
+		msg: "at time today",
+		now: time.Date(2020, time.February, 15, 9, 0, 0, 0, time.UTC),
+		exp: time.Date(2020, time.February, 16, 9, 0, 0, 0, time.UTC),
+	}, {
+		msg: "after time today",
+		now: time.Date(2020, time.February, 15, 17, 0, 0, 0, time.UTC),
+		exp: time.Date(2020, time.February, 16, 9, 0, 0, 0, time.UTC),
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			is.Equal(nextRun(tt.now, 9*time.Hour), tt.exp) // next run is correct.
+		})
+	}
+}
+
+func TestSummarizerRunStops(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create logger.
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	sm := NewSummarizer(logger, &mock.Service{}, time.Now, "board-id", "C123", "xoxb-token", 9*time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		sm.Run(ctx)
+		close(done)
+	}()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		is.Fail() // summarizer did not stop.
+	}
+}
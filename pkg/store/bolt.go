@@ -0,0 +1,143 @@
+package store
+
+import (
+	"bytes"
+
+	"github.com/boltdb/bolt"
+)
+
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBolt returns a Store backed by the given bolt database.
+func NewBolt(db *bolt.DB) *boltStore {
+	return &boltStore{db}
+}
+
+// Begin starts a new transaction.
+func (s *boltStore) Begin(writable bool) (Tx, error) {
+	txn, err := s.db.Begin(writable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltTx{txn}, nil
+}
+
+type boltTx struct {
+	txn *bolt.Tx
+}
+
+// CreateBucketIfNotExists creates the bucket if it doesn't already exist.
+func (t *boltTx) CreateBucketIfNotExists(bucket string) error {
+	if !t.txn.Writable() {
+		return ErrTxNotWritable
+	}
+
+	_, err := t.txn.CreateBucketIfNotExists([]byte(bucket))
+	return err
+}
+
+// Get returns the value of the key in the bucket, or nil if either doesn't exist.
+func (t *boltTx) Get(bucket string, key []byte) []byte {
+	bkt := t.txn.Bucket([]byte(bucket))
+	if bkt == nil {
+		return nil
+	}
+
+	return bkt.Get(key)
+}
+
+// Put sets the value of the key in the bucket.
+func (t *boltTx) Put(bucket string, key, value []byte) error {
+	if !t.txn.Writable() {
+		return ErrTxNotWritable
+	}
+
+	bkt := t.txn.Bucket([]byte(bucket))
+	if bkt == nil {
+		return ErrBucketNotFound
+	}
+
+	return bkt.Put(key, value)
+}
+
+// Delete removes the key from the bucket.
+func (t *boltTx) Delete(bucket string, key []byte) error {
+	if !t.txn.Writable() {
+		return ErrTxNotWritable
+	}
+
+	bkt := t.txn.Bucket([]byte(bucket))
+	if bkt == nil {
+		return ErrBucketNotFound
+	}
+
+	return bkt.Delete(key)
+}
+
+// ForEach calls fn with each key and value in the bucket, in key order.
+func (t *boltTx) ForEach(bucket string, fn func(k, v []byte) error) error {
+	bkt := t.txn.Bucket([]byte(bucket))
+	if bkt == nil {
+		return nil
+	}
+
+	return bkt.ForEach(fn)
+}
+
+// ForEachAfter calls fn with each key and value in the bucket after the given key, in key order.
+func (t *boltTx) ForEachAfter(bucket string, after []byte, fn func(k, v []byte) error) error {
+	bkt := t.txn.Bucket([]byte(bucket))
+	if bkt == nil {
+		return nil
+	}
+
+	c := bkt.Cursor()
+	k, v := c.Seek(after)
+	if k != nil && bytes.Equal(k, after) {
+		k, v = c.Next()
+	}
+
+	for ; k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Count returns the number of keys in the bucket.
+func (t *boltTx) Count(bucket string) int {
+	bkt := t.txn.Bucket([]byte(bucket))
+	if bkt == nil {
+		return 0
+	}
+
+	return bkt.Stats().KeyN
+}
+
+// Commit writes all changes made in the transaction.
+func (t *boltTx) Commit() error {
+	if t.txn.DB() == nil {
+		return ErrTxClosed
+	}
+
+	// Bolt doesn't allow read-only transactions to be committed.
+	if !t.txn.Writable() {
+		return t.txn.Rollback()
+	}
+
+	return t.txn.Commit()
+}
+
+// Rollback discards all changes made in the transaction.
+func (t *boltTx) Rollback() error {
+	if t.txn.DB() == nil {
+		return ErrTxClosed
+	}
+
+	return t.txn.Rollback()
+}
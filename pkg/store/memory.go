@@ -0,0 +1,207 @@
+package store
+
+import (
+	"sort"
+	"sync"
+)
+
+// buckets holds the keys and values of each bucket. Once committed, buckets
+// are never modified, transactions copy them before writing.
+type buckets map[string]map[string][]byte
+
+type memoryStore struct {
+	// writer is held by the open writable transaction.
+	writer sync.Mutex
+
+	mu   sync.RWMutex
+	data buckets
+}
+
+// NewMemory returns a Store that holds all data in memory. Data is lost when
+// the process exits, so it is useful for tests and demos.
+func NewMemory() *memoryStore {
+	return &memoryStore{data: make(buckets)}
+}
+
+// Begin starts a new transaction.
+func (s *memoryStore) Begin(writable bool) (Tx, error) {
+	if writable {
+		s.writer.Lock()
+	}
+
+	s.mu.RLock()
+	data := s.data
+	s.mu.RUnlock()
+
+	t := &memoryTx{s: s, data: data, writable: writable}
+
+	if writable {
+		// Copy the set of buckets, so that buckets can be added and replaced.
+		t.data = make(buckets, len(data))
+		for name, bkt := range data {
+			t.data[name] = bkt
+		}
+		t.copied = make(map[string]bool)
+	}
+
+	return t, nil
+}
+
+type memoryTx struct {
+	s        *memoryStore
+	data     buckets
+	copied   map[string]bool
+	writable bool
+	closed   bool
+}
+
+// CreateBucketIfNotExists creates the bucket if it doesn't already exist.
+func (t *memoryTx) CreateBucketIfNotExists(bucket string) error {
+	if err := t.checkWritable(); err != nil {
+		return err
+	}
+
+	if _, ok := t.data[bucket]; !ok {
+		t.data[bucket] = make(map[string][]byte)
+		t.copied[bucket] = true
+	}
+
+	return nil
+}
+
+// Get returns the value of the key in the bucket, or nil if either doesn't exist.
+func (t *memoryTx) Get(bucket string, key []byte) []byte {
+	return t.data[bucket][string(key)]
+}
+
+// Put sets the value of the key in the bucket.
+func (t *memoryTx) Put(bucket string, key, value []byte) error {
+	bkt, err := t.writableBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	v := make([]byte, len(value))
+	copy(v, value)
+	bkt[string(key)] = v
+
+	return nil
+}
+
+// Delete removes the key from the bucket.
+func (t *memoryTx) Delete(bucket string, key []byte) error {
+	bkt, err := t.writableBucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	delete(bkt, string(key))
+
+	return nil
+}
+
+// ForEach calls fn with each key and value in the bucket, in key order.
+func (t *memoryTx) ForEach(bucket string, fn func(k, v []byte) error) error {
+	return t.forEach(bucket, func(string) bool { return true }, fn)
+}
+
+// ForEachAfter calls fn with each key and value in the bucket after the given key, in key order.
+func (t *memoryTx) ForEachAfter(bucket string, after []byte, fn func(k, v []byte) error) error {
+	return t.forEach(bucket, func(k string) bool { return k > string(after) }, fn)
+}
+
+// Count returns the number of keys in the bucket.
+func (t *memoryTx) Count(bucket string) int {
+	return len(t.data[bucket])
+}
+
+// forEach calls fn with each key, and its value, in the bucket that include
+// returns true for, in key order.
+func (t *memoryTx) forEach(bucket string, include func(k string) bool, fn func(k, v []byte) error) error {
+	bkt := t.data[bucket]
+
+	keys := make([]string, 0, len(bkt))
+	for k := range bkt {
+		if include(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := fn([]byte(k), bkt[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Commit writes all changes made in the transaction.
+func (t *memoryTx) Commit() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	t.closed = true
+
+	if t.writable {
+		t.s.mu.Lock()
+		t.s.data = t.data
+		t.s.mu.Unlock()
+
+		t.s.writer.Unlock()
+	}
+
+	return nil
+}
+
+// Rollback discards all changes made in the transaction.
+func (t *memoryTx) Rollback() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	t.closed = true
+
+	if t.writable {
+		t.s.writer.Unlock()
+	}
+
+	return nil
+}
+
+// checkWritable returns an error if the transaction can't be written to.
+func (t *memoryTx) checkWritable() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	if !t.writable {
+		return ErrTxNotWritable
+	}
+
+	return nil
+}
+
+// writableBucket returns the bucket, copying it first if this transaction
+// hasn't yet, so that committed data isn't modified.
+func (t *memoryTx) writableBucket(bucket string) (map[string][]byte, error) {
+	if err := t.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	bkt, ok := t.data[bucket]
+	if !ok {
+		return nil, ErrBucketNotFound
+	}
+
+	if !t.copied[bucket] {
+		c := make(map[string][]byte, len(bkt))
+		for k, v := range bkt {
+			c[k] = v
+		}
+		t.data[bucket] = c
+		t.copied[bucket] = true
+		bkt = c
+	}
+
+	return bkt, nil
+}
@@ -0,0 +1,80 @@
+package store
+
+// prefixedStore is a Store whose buckets are kept in another store, with their
+// names prefixed.
+type prefixedStore struct {
+	st     Store
+	prefix string
+}
+
+// NewPrefixed returns a Store that keeps its buckets in the given store, named
+// "<prefix>_<bucket>". Stores with different prefixes can share an underlying
+// store without seeing each other's buckets.
+func NewPrefixed(st Store, prefix string) *prefixedStore {
+	return &prefixedStore{st, prefix}
+}
+
+// Begin starts a new transaction.
+func (s *prefixedStore) Begin(writable bool) (Tx, error) {
+	txn, err := s.st.Begin(writable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &prefixedTx{txn, s.prefix}, nil
+}
+
+type prefixedTx struct {
+	txn    Tx
+	prefix string
+}
+
+// bucket returns the prefixed name of the bucket.
+func (t *prefixedTx) bucket(bucket string) string {
+	return t.prefix + "_" + bucket
+}
+
+// CreateBucketIfNotExists creates the bucket if it doesn't already exist.
+func (t *prefixedTx) CreateBucketIfNotExists(bucket string) error {
+	return t.txn.CreateBucketIfNotExists(t.bucket(bucket))
+}
+
+// Get returns the value of the key in the bucket, or nil if either doesn't exist.
+func (t *prefixedTx) Get(bucket string, key []byte) []byte {
+	return t.txn.Get(t.bucket(bucket), key)
+}
+
+// Put sets the value of the key in the bucket.
+func (t *prefixedTx) Put(bucket string, key, value []byte) error {
+	return t.txn.Put(t.bucket(bucket), key, value)
+}
+
+// Delete removes the key from the bucket.
+func (t *prefixedTx) Delete(bucket string, key []byte) error {
+	return t.txn.Delete(t.bucket(bucket), key)
+}
+
+// ForEach calls fn with each key and value in the bucket, in key order.
+func (t *prefixedTx) ForEach(bucket string, fn func(k, v []byte) error) error {
+	return t.txn.ForEach(t.bucket(bucket), fn)
+}
+
+// ForEachAfter is like ForEach, but starts from the first key after the given key.
+func (t *prefixedTx) ForEachAfter(bucket string, after []byte, fn func(k, v []byte) error) error {
+	return t.txn.ForEachAfter(t.bucket(bucket), after, fn)
+}
+
+// Count returns the number of keys in the bucket.
+func (t *prefixedTx) Count(bucket string) int {
+	return t.txn.Count(t.bucket(bucket))
+}
+
+// Commit writes all changes made in the transaction.
+func (t *prefixedTx) Commit() error {
+	return t.txn.Commit()
+}
+
+// Rollback discards all changes made in the transaction.
+func (t *prefixedTx) Rollback() error {
+	return t.txn.Rollback()
+}
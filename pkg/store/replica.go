@@ -0,0 +1,95 @@
+package store
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+)
+
+// Replica is a read-only Store served from a copy of a primary bolt database.
+// The copy is only updated when Refresh is called, so reads from the replica
+// may be stale, and won't see writes made to the primary since the last refresh.
+type Replica struct {
+	primary *bolt.DB
+	path    string
+
+	mu sync.RWMutex
+	db *bolt.DB
+}
+
+// NewReplica returns a Replica of the primary database, kept in a file at the
+// given path. The replica is refreshed once before it is returned.
+func NewReplica(primary *bolt.DB, path string) (*Replica, error) {
+	r := &Replica{
+		primary: primary,
+		path:    path,
+	}
+
+	if err := r.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Begin starts a new read-only transaction against the replica.
+// Writable transactions are not allowed.
+func (r *Replica) Begin(writable bool) (Tx, error) {
+	if writable {
+		return nil, ErrTxNotWritable
+	}
+
+	// Hold the lock while beginning, so the database can't be swapped out and
+	// closed in between. Closing waits for any open transactions to finish.
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return NewBolt(r.db).Begin(false)
+}
+
+// Refresh copies the primary database to the replica file, and swaps the
+// replica over to it. Transactions already open on the previous copy are
+// unaffected, and are waited for before the previous copy is closed.
+func (r *Replica) Refresh() error {
+	tmp := r.path + ".tmp"
+
+	// Backup the primary, from a consistent read-only transaction.
+	err := r.primary.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(tmp, 0600)
+	})
+	if err != nil {
+		return errors.Wrap(err, "copying primary")
+	}
+
+	// Swap the backup in. Any open copy keeps reading from the replaced file.
+	if err := os.Rename(tmp, r.path); err != nil {
+		return errors.Wrap(err, "replacing replica")
+	}
+
+	db, err := bolt.Open(r.path, 0600, &bolt.Options{Timeout: 5 * time.Second, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "opening replica")
+	}
+
+	r.mu.Lock()
+	old := r.db
+	r.db = db
+	r.mu.Unlock()
+
+	if old != nil {
+		return errors.Wrap(old.Close(), "closing previous replica")
+	}
+
+	return nil
+}
+
+// Close closes the replica.
+func (r *Replica) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.db.Close()
+}
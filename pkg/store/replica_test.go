@@ -0,0 +1,71 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestReplica(t *testing.T) {
+
+	is := is.New(t)
+
+	// Get a primary bolt db, with a value in it.
+	db, cleanup := newTmpBoltDB(t)
+	defer cleanup()
+
+	primary := NewBolt(db)
+	txn, err := primary.Begin(true)
+	is.NoErr(err) // transaction begins.
+	is.NoErr(txn.CreateBucketIfNotExists("bucket"))
+	is.NoErr(txn.Put("bucket", []byte("key"), []byte("one")))
+	is.NoErr(txn.Commit()) // value is written to the primary.
+
+	// Get a temporary directory for the replica.
+	dir, err := ioutil.TempDir("", "ooohh-bolt-replica-")
+	is.NoErr(err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	r, err := NewReplica(db, filepath.Join(dir, "replica.db"))
+	is.NoErr(err) // replica is created.
+	defer r.Close()
+
+	get := func() string {
+		txn, err := r.Begin(false)
+		is.NoErr(err)        // replica transaction begins.
+		defer txn.Rollback() //nolint:errcheck
+		return string(txn.Get("bucket", []byte("key")))
+	}
+
+	is.Equal(get(), "one") // replica has the primary's value.
+
+	_, err = r.Begin(true)
+	is.Equal(err, ErrTxNotWritable) // replica can't be written to.
+
+	// Update the primary.
+	txn, err = primary.Begin(true)
+	is.NoErr(err) // transaction begins.
+	is.NoErr(txn.Put("bucket", []byte("key"), []byte("two")))
+	is.NoErr(txn.Commit()) // value is updated in the primary.
+
+	// Keep a transaction open across the refresh.
+	open, err := r.Begin(false)
+	is.NoErr(err) // replica transaction begins.
+
+	is.Equal(get(), "one") // replica is stale until refreshed.
+
+	refreshed := make(chan error)
+	go func() {
+		refreshed <- r.Refresh()
+	}()
+
+	// The open transaction still sees the previous copy.
+	is.Equal(string(open.Get("bucket", []byte("key"))), "one")
+	is.NoErr(open.Rollback())
+
+	is.NoErr(<-refreshed)  // replica refreshes.
+	is.Equal(get(), "two") // replica has the primary's updated value.
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrBucketNotFound signifies that the bucket written to doesn't exist.
+	ErrBucketNotFound = errors.New("bucket not found")
+	// ErrTxNotWritable signifies that a write was attempted in a read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+	// ErrTxClosed signifies that the transaction has already been committed or rolled back.
+	ErrTxClosed = errors.New("tx closed")
+)
+
+// Store is a transactional key/value store, where keys are grouped into named buckets.
+type Store interface {
+	// Begin starts a new transaction. Only one writable transaction can be open
+	// at a time, while any number of read-only transactions can be.
+	Begin(writable bool) (Tx, error)
+}
+
+// Tx is a transaction of a Store. Read-only transactions see a consistent view
+// of the store, from when they began.
+type Tx interface {
+	// CreateBucketIfNotExists creates the bucket if it doesn't already exist.
+	CreateBucketIfNotExists(bucket string) error
+	// Get returns the value of the key in the bucket, or nil if either doesn't exist.
+	// The value must not be modified, and is only valid for the life of the transaction.
+	Get(bucket string, key []byte) []byte
+	// Put sets the value of the key in the bucket.
+	Put(bucket string, key, value []byte) error
+	// Delete removes the key from the bucket. Deleting a key that doesn't exist is not an error.
+	Delete(bucket string, key []byte) error
+	// ForEach calls fn with each key and value in the bucket, in key order.
+	// Iteration stops at the first error returned by fn, which is then returned.
+	ForEach(bucket string, fn func(k, v []byte) error) error
+	// ForEachAfter is like ForEach, but starts from the first key after the given key.
+	ForEachAfter(bucket string, after []byte, fn func(k, v []byte) error) error
+	// Count returns the number of keys in the bucket. Writes made within the
+	// transaction may not be counted.
+	Count(bucket string) int
+	// Commit writes all changes made in the transaction.
+	Commit() error
+	// Rollback discards all changes made in the transaction. It is safe to
+	// call after Commit, in which case it does nothing but return ErrTxClosed.
+	Rollback() error
+}
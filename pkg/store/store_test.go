@@ -0,0 +1,343 @@
+package store
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/matryer/is"
+)
+
+// newTmpBoltDB return a bolt db instance backed by a new temporary file.
+// It returns a function that should be called to cleanup the db.
+func newTmpBoltDB(t *testing.T) (*bolt.DB, func()) {
+	// Get temporary filename.
+	f, err := ioutil.TempFile("", "ooohh-bolt-store-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	// Create bolt db. The initial mmap size is large enough that the db isn't
+	// remapped, which would wait for open read-only transactions to close.
+	db, err := bolt.Open(f.Name(), 0600, &bolt.Options{Timeout: 5 * time.Second, InitialMmapSize: 1 << 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup := func() {
+		db.Close()          //nolint:errcheck
+		os.Remove(f.Name()) //nolint:errcheck
+	}
+
+	return db, cleanup
+}
+
+func TestBoltStore(t *testing.T) {
+	testStore(t, func(t *testing.T) (Store, func()) {
+		db, cleanup := newTmpBoltDB(t)
+		return NewBolt(db), cleanup
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, func(t *testing.T) (Store, func()) {
+		return NewMemory(), func() {}
+	})
+}
+
+func TestPrefixedStore(t *testing.T) {
+	testStore(t, func(t *testing.T) (Store, func()) {
+		return NewPrefixed(NewMemory(), "prefix"), func() {}
+	})
+}
+
+func TestPrefixedStoreNamesBuckets(t *testing.T) {
+
+	is := is.New(t)
+
+	st := NewMemory()
+	s := NewPrefixed(st, "prefix")
+
+	txn, err := s.Begin(true)
+	is.NoErr(err)
+	is.NoErr(txn.CreateBucketIfNotExists("bucket"))
+	is.NoErr(txn.Put("bucket", []byte("key"), []byte("value")))
+	is.NoErr(txn.Commit())
+
+	txn, err = st.Begin(false)
+	is.NoErr(err)
+	defer txn.Rollback() //nolint:errcheck
+
+	is.Equal(txn.Get("prefix_bucket", []byte("key")), []byte("value")) // bucket is stored with the prefix.
+	is.Equal(txn.Get("bucket", []byte("key")), nil)                    // bucket isn't stored without the prefix.
+}
+
+// testStore runs the conformance tests that every Store implementation must pass.
+// newStore returns a new, empty, store and a function to clean it up.
+func testStore(t *testing.T, newStore func(t *testing.T) (Store, func())) {
+
+	// withBucket returns a new store, with the "bucket" bucket created.
+	withBucket := func(t *testing.T) (Store, func()) {
+		is := is.NewRelaxed(t)
+
+		s, cleanup := newStore(t)
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.CreateBucketIfNotExists("bucket"))
+		is.NoErr(txn.Commit())
+
+		return s, cleanup
+	}
+
+	t.Run("put and get", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Put("bucket", []byte("key"), []byte("value")))
+		is.Equal(txn.Get("bucket", []byte("key")), []byte("value")) // value is readable within the transaction.
+		is.NoErr(txn.Commit())
+
+		txn, err = s.Begin(false)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		is.Equal(txn.Get("bucket", []byte("key")), []byte("value")) // value is readable once committed.
+		is.Equal(txn.Get("bucket", []byte("missing")), nil)         // missing key is nil.
+		is.Equal(txn.Get("missing", []byte("key")), nil)            // missing bucket is nil.
+	})
+
+	t.Run("put copies value", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		value := []byte("value")
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Put("bucket", []byte("key"), value))
+		is.NoErr(txn.Commit())
+
+		// Modify the value after it has been put.
+		value[0] = 'V'
+
+		txn, err = s.Begin(false)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		is.Equal(txn.Get("bucket", []byte("key")), []byte("value")) // stored value is unchanged.
+	})
+
+	t.Run("rollback discards changes", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.CreateBucketIfNotExists("other"))
+		is.NoErr(txn.Put("bucket", []byte("key"), []byte("value")))
+		is.NoErr(txn.Rollback())
+
+		txn, err = s.Begin(true)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		is.Equal(txn.Get("bucket", []byte("key")), nil)                           // put is discarded.
+		is.Equal(txn.Put("other", []byte("key"), []byte("v")), ErrBucketNotFound) // bucket creation is discarded.
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Put("bucket", []byte("key"), []byte("value")))
+		is.NoErr(txn.Commit())
+
+		txn, err = s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Delete("bucket", []byte("key")))
+		is.NoErr(txn.Delete("bucket", []byte("missing"))) // deleting a missing key is not an error.
+		is.NoErr(txn.Commit())
+
+		txn, err = s.Begin(false)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		is.Equal(txn.Get("bucket", []byte("key")), nil) // key is deleted.
+	})
+
+	t.Run("for each", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		for _, k := range []string{"c", "a", "b"} {
+			is.NoErr(txn.Put("bucket", []byte(k), []byte("value-"+k)))
+		}
+		is.NoErr(txn.Commit())
+
+		txn, err = s.Begin(false)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		var keys []string
+		err = txn.ForEach("bucket", func(k, v []byte) error {
+			is.Equal(string(v), "value-"+string(k)) // value matches key.
+			keys = append(keys, string(k))
+			return nil
+		})
+		is.NoErr(err)
+		is.Equal(keys, []string{"a", "b", "c"}) // keys are iterated in order.
+
+		errStop := errors.New("stop")
+		count := 0
+		err = txn.ForEach("bucket", func(k, v []byte) error {
+			count++
+			return errStop
+		})
+		is.Equal(err, errStop) // error from fn is returned.
+		is.Equal(count, 1)     // iteration stops at the first error.
+
+		err = txn.ForEach("missing", func(k, v []byte) error {
+			count++
+			return nil
+		})
+		is.NoErr(err)      // missing bucket is empty.
+		is.Equal(count, 1) // missing bucket has no keys.
+	})
+
+	t.Run("for each after", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		for _, k := range []string{"a", "c", "e"} {
+			is.NoErr(txn.Put("bucket", []byte(k), []byte("value-"+k)))
+		}
+		is.NoErr(txn.Commit())
+
+		txn, err = s.Begin(false)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		keysAfter := func(after string) []string {
+			var keys []string
+			err := txn.ForEachAfter("bucket", []byte(after), func(k, v []byte) error {
+				keys = append(keys, string(k))
+				return nil
+			})
+			is.NoErr(err)
+			return keys
+		}
+
+		is.Equal(keysAfter(""), []string{"a", "c", "e"}) // empty key starts from the beginning.
+		is.Equal(keysAfter("a"), []string{"c", "e"})     // given key is excluded.
+		is.Equal(keysAfter("b"), []string{"c", "e"})     // missing key starts from the next key.
+		is.Equal(keysAfter("e"), []string(nil))          // nothing after the last key.
+
+		is.Equal(txn.Count("bucket"), 3)  // keys are counted.
+		is.Equal(txn.Count("missing"), 0) // missing bucket has no keys.
+	})
+
+	t.Run("read-only transactions", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(false)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		is.Equal(txn.Put("bucket", []byte("key"), []byte("value")), ErrTxNotWritable) // put is not allowed.
+		is.Equal(txn.Delete("bucket", []byte("key")), ErrTxNotWritable)               // delete is not allowed.
+		is.Equal(txn.CreateBucketIfNotExists("other"), ErrTxNotWritable)              // creating buckets is not allowed.
+	})
+
+	t.Run("missing bucket", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := newStore(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		defer txn.Rollback() //nolint:errcheck
+
+		is.Equal(txn.Put("missing", []byte("key"), []byte("value")), ErrBucketNotFound) // put needs the bucket.
+		is.Equal(txn.Delete("missing", []byte("key")), ErrBucketNotFound)               // delete needs the bucket.
+	})
+
+	t.Run("isolation", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		// Begin a read before the write is committed.
+		before, err := s.Begin(false)
+		is.NoErr(err)
+		defer before.Rollback() //nolint:errcheck
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Put("bucket", []byte("key"), []byte("value")))
+
+		during, err := s.Begin(false)
+		is.NoErr(err)
+		defer during.Rollback() //nolint:errcheck
+
+		is.Equal(during.Get("bucket", []byte("key")), nil) // uncommitted writes aren't seen.
+
+		is.NoErr(txn.Commit())
+
+		is.Equal(before.Get("bucket", []byte("key")), nil) // writes committed after a read began aren't seen.
+
+		after, err := s.Begin(false)
+		is.NoErr(err)
+		defer after.Rollback() //nolint:errcheck
+
+		is.Equal(after.Get("bucket", []byte("key")), []byte("value")) // committed writes are seen.
+	})
+
+	t.Run("closed transactions", func(t *testing.T) {
+		is := is.New(t)
+
+		s, cleanup := withBucket(t)
+		defer cleanup()
+
+		txn, err := s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Commit())
+
+		is.Equal(txn.Commit(), ErrTxClosed)   // committing twice errors.
+		is.Equal(txn.Rollback(), ErrTxClosed) // rolling back after commit errors.
+
+		// Another writable transaction can begin.
+		txn, err = s.Begin(true)
+		is.NoErr(err)
+		is.NoErr(txn.Rollback())
+	})
+}
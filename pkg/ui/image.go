@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"fmt"
+	"html/template"
+	"image"
+	"image/color"
+	"image/draw"
+	"strconv"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+const (
+	// ImageWidth is the width, in pixels, of board images.
+	ImageWidth = 400
+	// imageBarHeight is the height, in pixels, of each dial's bar.
+	imageBarHeight = 20
+	// imagePadding is the space, in pixels, around and between the bars.
+	imagePadding = 10
+)
+
+var (
+	// imageBackground matches the background of the site.
+	imageBackground = color.RGBA{0xf5, 0xf5, 0xf9, 0xff}
+	// imageTrack is the color of the empty part of each bar.
+	imageTrack = color.RGBA{0xdd, 0xdd, 0xe3, 0xff}
+
+	// Severity colors, from calm to on fire.
+	severityLow    = color.RGBA{0x2e, 0xcc, 0x71, 0xff}
+	severityMedium = color.RGBA{0xf3, 0x9c, 0x12, 0xff}
+	severityHigh   = color.RGBA{0xe7, 0x4c, 0x3c, 0xff}
+)
+
+// ImageHeight returns the height, in pixels, of the image of a board with the
+// given number of dials. Boards without dials have a single, empty bar.
+func ImageHeight(dials int) int {
+	if dials < 1 {
+		dials = 1
+	}
+
+	return imagePadding + dials*(imageBarHeight+imagePadding)
+}
+
+// BoardImage renders the board as a bar per dial, in board order. Each bar is
+// filled in proportion to how far the dial's value is through the range of
+// values from min to max, and colored by its severity, or with the dial's own
+// color if it has one.
+func BoardImage(b ooohh.Board, min, max float64) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, ImageWidth, ImageHeight(len(b.Dials))))
+	draw.Draw(img, img.Bounds(), image.NewUniform(imageBackground), image.Point{}, draw.Src)
+
+	inner := ImageWidth - 2*imagePadding
+	for i := 0; i < len(b.Dials) || i == 0; i++ {
+		y := imagePadding + i*(imageBarHeight+imagePadding)
+		track := image.Rect(imagePadding, y, imagePadding+inner, y+imageBarHeight)
+		draw.Draw(img, track, image.NewUniform(imageTrack), image.Point{}, draw.Src)
+
+		if i >= len(b.Dials) {
+			break
+		}
+
+		d := b.Dials[i]
+		p := percent(d.Value, min, max)
+		filled := int(float64(inner) * p / 100)
+		bar := image.Rect(imagePadding, y, imagePadding+filled, y+imageBarHeight)
+		draw.Draw(img, bar, image.NewUniform(dialColor(d, p)), image.Point{}, draw.Src)
+	}
+
+	return img
+}
+
+// dialColor returns the color the dial is drawn with, given the percent of the
+// way through the range of values the dial's value is. This is the dial's own
+// color if it has one, otherwise the color of the value's band.
+func dialColor(d ooohh.Dial, p float64) color.RGBA {
+	if c, ok := parseHexColor(d.Color); ok {
+		return c
+	}
+
+	return bandColors[band(p)]
+}
+
+// band returns the band of a value the given percent of the way through the
+// range of values, matching the thresholds of the messages shown in Slack for
+// the default range. Both board images and gauges are colored by it.
+func band(p float64) string {
+	switch {
+	case p > 75:
+		return "high"
+	case p > 50:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// bandColors are the colors of each band.
+var bandColors = map[string]color.RGBA{
+	"low":    severityLow,
+	"medium": severityMedium,
+	"high":   severityHigh,
+}
+
+// gauge renders the dial's value as an SVG meter, filled in proportion to how
+// far the value is through the range from min to max, and colored like the
+// dial's bar in board images. The meter is hidden from screen readers, so must
+// be shown alongside the value.
+func gauge(d ooohh.Dial, min, max float64) template.HTML {
+	p := percent(d.Value, min, max)
+	c := dialColor(d, p)
+	t := imageTrack
+
+	return template.HTML(fmt.Sprintf(
+		`<svg class="gauge" viewBox="0 0 100 10" width="200" height="20" aria-hidden="true">`+
+			`<rect class="gauge-track" width="100" height="10" fill="#%02x%02x%02x"></rect>`+
+			`<rect class="gauge-fill %s" width="%s" height="10" fill="#%02x%02x%02x"></rect>`+
+			`</svg>`,
+		t.R, t.G, t.B,
+		band(p), strconv.FormatFloat(p, 'f', -1, 64), c.R, c.G, c.B,
+	))
+}
+
+// parseHexColor parses a dial color, e.g. #fff or #00ff00.
+func parseHexColor(s string) (color.RGBA, bool) {
+	if s == "" || !ooohh.ValidColor(s) {
+		return color.RGBA{}, false
+	}
+
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+
+	return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 0xff}, true
+}
+
+// percent returns how far v is through the range [min, max], as a percentage
+// from 0 to 100. Values outside the range are clamped to it.
+func percent(v, min, max float64) float64 {
+	if max <= min {
+		return 0
+	}
+
+	return (clamp(v, min, max) - min) * (100 / (max - min))
+}
+
+// clamp limits v to the range [min, max].
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/dlmiddlecote/ooohh"
+)
+
+func TestBoardImage(t *testing.T) {
+
+	is := is.New(t)
+
+	b := ooohh.Board{
+		Dials: []ooohh.Dial{
+			{Name: "calm", Value: 10},
+			{Name: "stressed", Value: 60},
+			{Name: "on fire", Value: 100},
+			{Name: "colored", Value: 50, Color: "#00f"},
+		},
+	}
+
+	img := BoardImage(b, 0, 100)
+
+	bounds := img.Bounds()
+	is.Equal(bounds.Dx(), ImageWidth)     // image has the fixed width.
+	is.Equal(bounds.Dy(), ImageHeight(4)) // image has a bar per dial.
+
+	// colorAt returns the color at the start and the given fraction of the bar.
+	colorAt := func(bar int, fraction float64) color.Color {
+		x := imagePadding + int(fraction*float64(ImageWidth-2*imagePadding))
+		y := imagePadding + bar*(imageBarHeight+imagePadding) + imageBarHeight/2
+		return color.RGBAModel.Convert(img.At(x, y))
+	}
+
+	is.Equal(colorAt(0, 0), severityLow)     // calm dial is low severity.
+	is.Equal(colorAt(0, 0.5), imageTrack)    // calm dial bar is only partly filled.
+	is.Equal(colorAt(1, 0), severityMedium)  // stressed dial is medium severity.
+	is.Equal(colorAt(1, 0.7), imageTrack)    // stressed dial bar is only partly filled.
+	is.Equal(colorAt(2, 0), severityHigh)    // on fire dial is high severity.
+	is.Equal(colorAt(2, 0.99), severityHigh) // on fire dial bar is full.
+
+	is.Equal(colorAt(3, 0), color.RGBA{0, 0, 0xff, 0xff}) // dial color overrides severity.
+
+	is.Equal(color.RGBAModel.Convert(img.At(0, 0)), imageBackground) // background is drawn.
+}
+
+func TestBoardImageWithoutDials(t *testing.T) {
+
+	is := is.New(t)
+
+	img := BoardImage(ooohh.Board{Dials: []ooohh.Dial{}}, 0, 100)
+
+	is.Equal(img.Bounds().Dx(), ImageWidth)                                           // image has the fixed width.
+	is.Equal(img.Bounds().Dy(), ImageHeight(1))                                       // image has a single bar.
+	is.Equal(color.RGBAModel.Convert(img.At(imagePadding, imagePadding)), imageTrack) // bar is empty.
+}
+
+func TestBoardImageWithValueBounds(t *testing.T) {
+
+	is := is.New(t)
+
+	b := ooohh.Board{
+		Dials: []ooohh.Dial{
+			{Name: "middle", Value: 0},
+		},
+	}
+
+	img := BoardImage(b, -10, 10)
+
+	// colorAt returns the color at the given fraction of the bar.
+	colorAt := func(fraction float64) color.Color {
+		x := imagePadding + int(fraction*float64(ImageWidth-2*imagePadding))
+		y := imagePadding + imageBarHeight/2
+		return color.RGBAModel.Convert(img.At(x, y))
+	}
+
+	is.Equal(colorAt(0.49), severityLow) // bar is filled to the middle of the range.
+	is.Equal(colorAt(0.51), imageTrack)  // bar isn't filled past the middle of the range.
+}
+
+func TestGauge(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		value    float64
+		color    string
+		min      float64
+		max      float64
+		expBand  string
+		expWidth string
+		expFill  string
+	}{{
+		msg:      "default bounds",
+		value:    66.6,
+		min:      0,
+		max:      100,
+		expBand:  "medium",
+		expWidth: "66.6",
+		expFill:  "#f39c12",
+	}, {
+		msg:      "configured bounds",
+		value:    8,
+		min:      0,
+		max:      10,
+		expBand:  "high",
+		expWidth: "80",
+		expFill:  "#e74c3c",
+	}, {
+		msg:      "negative bounds",
+		value:    -5,
+		min:      -10,
+		max:      10,
+		expBand:  "low",
+		expWidth: "25",
+		expFill:  "#2ecc71",
+	}, {
+		msg:      "value above bounds",
+		value:    50,
+		min:      0,
+		max:      10,
+		expBand:  "high",
+		expWidth: "100",
+		expFill:  "#e74c3c",
+	}, {
+		msg:      "custom color",
+		value:    80,
+		color:    "#00f",
+		min:      0,
+		max:      100,
+		expBand:  "high",
+		expWidth: "80",
+		expFill:  "#0000ff",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			g := string(gauge(ooohh.Dial{Value: tt.value, Color: tt.color}, tt.min, tt.max))
+
+			is.True(strings.Contains(g, `class="gauge-fill `+tt.expBand+`"`))                         // gauge is classed by the value's band.
+			is.True(strings.Contains(g, `width="`+tt.expWidth+`" height="10" fill="`+tt.expFill+`"`)) // gauge is filled in proportion to the range, with the dial's color.
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/dlmiddlecote/kit/api"
 	"github.com/markbates/pkger"
@@ -15,20 +16,110 @@ import (
 	"github.com/dlmiddlecote/ooohh"
 )
 
+// defaultTitle is the site title used when none is configured.
+const defaultTitle = "ooohh"
+
 type UI struct {
-	s ooohh.Service
+	s          ooohh.Service
+	title      string
+	precision  int
+	now        func() time.Time
+	staleAfter time.Duration
+	private    bool
+	masked     bool
+
+	// minValue and maxValue are the range of dial values, which gauges are
+	// filled in proportion to.
+	minValue, maxValue float64
+}
+
+// Option configures the UI.
+type Option func(*UI)
+
+// WithTitle sets the site title shown on every page.
+func WithTitle(title string) Option {
+	return func(u *UI) {
+		u.title = title
+	}
+}
+
+// WithNow sets the function used to get the current time.
+func WithNow(now func() time.Time) Option {
+	return func(u *UI) {
+		u.now = now
+	}
 }
 
-func NewUI(s ooohh.Service) *UI {
-	return &UI{s}
+// WithStaleAfter sets how long a dial goes without being updated before it is
+// shown as stale. Dials are never stale if d isn't positive. Dials are stale
+// after 24 hours by default.
+func WithStaleAfter(d time.Duration) Option {
+	return func(u *UI) {
+		u.staleAfter = d
+	}
+}
+
+// WithPrecision sets the number of decimal places dial values are shown with.
+func WithPrecision(precision int) Option {
+	return func(u *UI) {
+		u.precision = precision
+	}
+}
+
+// WithPrivateBoards stops boards being shown, as boards are private to those
+// with their token, and board pages can't be sent it.
+func WithPrivateBoards() Option {
+	return func(u *UI) {
+		u.private = true
+	}
+}
+
+// WithMaskedBoards shows boards without their dials' values, or IDs, as board
+// pages can't be sent the board token to unmask them with.
+func WithMaskedBoards() Option {
+	return func(u *UI) {
+		u.masked = true
+	}
+}
+
+// WithValueBounds sets the range, inclusive of both ends, that dial values are
+// within, so gauges are filled in proportion to it. The range is 0 to 100 by
+// default.
+func WithValueBounds(min, max float64) Option {
+	return func(u *UI) {
+		u.minValue = min
+		u.maxValue = max
+	}
+}
+
+func NewUI(s ooohh.Service, opts ...Option) *UI {
+	u := &UI{
+		s:          s,
+		title:      defaultTitle,
+		precision:  ooohh.DefaultPrecision,
+		now:        time.Now,
+		staleAfter: ooohh.DefaultStaleAfter,
+		minValue:   0,
+		maxValue:   100,
+	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
 }
 
 func (u *UI) Index() http.Handler {
 	f, err := pkger.Open("/frontend/templates/index.html")
-	tmpl := template.Must(parseFile(f, err))
+	tmpl := template.Must(u.parseFile(f, err))
+
+	type response struct {
+		Title string
+	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		tmpl.Execute(w, nil) //nolint:errcheck
+		tmpl.Execute(w, response{u.title}) //nolint:errcheck
 	})
 }
 
@@ -63,11 +154,16 @@ func (b *boardInfo) Validate() bool {
 
 func (u *UI) CreateBoard() http.Handler {
 	f, err := pkger.Open("/frontend/templates/newboard.html")
-	tmpl := template.Must(parseFile(f, err))
+	tmpl := template.Must(u.parseFile(f, err))
+
+	type response struct {
+		Title string
+		*boardInfo
+	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "GET" {
-			tmpl.Execute(w, nil) //nolint:errcheck
+			tmpl.Execute(w, response{u.title, &boardInfo{}}) //nolint:errcheck
 			return
 		}
 
@@ -77,7 +173,7 @@ func (u *UI) CreateBoard() http.Handler {
 		}
 
 		if !body.Validate() {
-			tmpl.Execute(w, body) //nolint:errcheck
+			tmpl.Execute(w, response{u.title, body}) //nolint:errcheck
 			return
 		}
 
@@ -86,7 +182,7 @@ func (u *UI) CreateBoard() http.Handler {
 			// add a dummy error to the body to return.
 			body.Errors["CreateBoard"] = "Error creating board, please try again."
 
-			tmpl.Execute(w, body) //nolint:errcheck
+			tmpl.Execute(w, response{u.title, body}) //nolint:errcheck
 			return
 		}
 
@@ -116,23 +212,33 @@ func (b *boardDialInfo) Validate() bool {
 
 func (u *UI) GetBoard() http.Handler {
 	f, err := pkger.Open("/frontend/templates/board.html")
-	tmpl := template.Must(parseFile(f, err))
+	tmpl := template.Must(u.parseFile(f, err))
 
 	f, err = pkger.Open("/frontend/templates/error.html")
-	errTmpl := template.Must(parseFile(f, err))
+	errTmpl := template.Must(u.parseFile(f, err))
 
 	type response struct {
-		Board         ooohh.Board
-		BoardDialInfo *boardDialInfo
+		Title          string
+		Board          ooohh.Board
+		BoardDialInfo  *boardDialInfo
+		RemoveDialInfo *boardDialInfo
+		Masked         bool
 	}
 
 	type errResp struct {
-		Msg string
+		Title string
+		Msg   string
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		id := ooohh.BoardID(api.URLParam(r, "id"))
 
+		// Private boards are only readable from the API.
+		if u.private {
+			errTmpl.Execute(w, errResp{Title: u.title, Msg: "Oops, boards are private."}) //nolint:errcheck
+			return
+		}
+
 		// Retrieve the board.
 		board, err := u.s.GetBoard(r.Context(), id)
 		if err != nil {
@@ -140,13 +246,17 @@ func (u *UI) GetBoard() http.Handler {
 			if errors.Is(err, ooohh.ErrBoardNotFound) {
 				msg = "Oops, the board wasn't found."
 			}
-			errTmpl.Execute(w, errResp{Msg: msg}) //nolint:errcheck
+			errTmpl.Execute(w, errResp{Title: u.title, Msg: msg}) //nolint:errcheck
 			return
 		}
 
+		if u.masked {
+			board.Mask()
+		}
+
 		if r.Method == "GET" {
 			// Display the board.
-			tmpl.Execute(w, response{*board, nil}) //nolint:errcheck
+			tmpl.Execute(w, response{u.title, *board, nil, nil, u.masked}) //nolint:errcheck
 			return
 		}
 
@@ -155,39 +265,85 @@ func (u *UI) GetBoard() http.Handler {
 			BoardToken: r.PostFormValue("token"),
 		}
 
+		// Both adding and removing dials post to the board, the remove forms
+		// say they are removing.
+		remove := r.PostFormValue("action") == "remove"
+
+		// render re-displays the board, with any errors against the form posted.
+		render := func() {
+			if remove {
+				tmpl.Execute(w, response{u.title, *board, nil, &body, u.masked}) //nolint:errcheck
+				return
+			}
+			tmpl.Execute(w, response{u.title, *board, &body, nil, u.masked}) //nolint:errcheck
+		}
+
+		errMsg := "Error adding dial, please try again."
+		if remove {
+			errMsg = "Error removing dial, please try again."
+		}
+
 		if !body.Validate() {
-			tmpl.Execute(w, response{*board, &body}) //nolint:errcheck
+			render()
 			return
 		}
 
-		dials := make([]ooohh.DialID, len(board.Dials)+1)
-		for i := range board.Dials {
-			dials[i] = board.Dials[i].ID
+		// Use the stored dial IDs rather than the populated board's dials, so
+		// that dials which could not be retrieved aren't dropped from the board.
+		dials, err := u.s.GetBoardDialIDs(r.Context(), id)
+		if err != nil {
+			// add a dummy error to the body to return.
+			body.Errors["SetBoard"] = errMsg
+
+			render()
+			return
 		}
 
-		dials[len(board.Dials)] = ooohh.DialID(body.DialID)
+		if remove {
+			dials = withoutDial(dials, ooohh.DialID(body.DialID))
+		} else {
+			dials = append(dials, ooohh.DialID(body.DialID))
+		}
 
 		err = u.s.SetBoard(r.Context(), id, body.BoardToken, dials)
 		if err != nil {
 			// add a dummy error to the body to return.
-			body.Errors["SetBoard"] = "Error adding dial, please try again."
+			body.Errors["SetBoard"] = errMsg
 
-			tmpl.Execute(w, response{*board, &body}) //nolint:errcheck
+			render()
 			return
 		}
 
-		board, err = u.s.GetBoard(r.Context(), id)
+		// Read the board from where it was written, so the update is seen.
+		board, err = u.s.GetBoard(ooohh.WithPrimaryReads(r.Context()), id)
 		if err != nil {
-			errTmpl.Execute(w, errResp{Msg: "Error retrieving board, please try again."}) //nolint:errcheck
+			errTmpl.Execute(w, errResp{Title: u.title, Msg: "Error retrieving board, please try again."}) //nolint:errcheck
 			return
 		}
 
-		tmpl.Execute(w, response{*board, nil}) //nolint:errcheck
+		if u.masked {
+			board.Mask()
+		}
+
+		tmpl.Execute(w, response{u.title, *board, nil, nil, u.masked}) //nolint:errcheck
 
 	})
 }
 
-func parseFile(f io.Reader, err error) (*template.Template, error) {
+// withoutDial returns the dial IDs, without any that are the given ID.
+func withoutDial(dials []ooohh.DialID, id ooohh.DialID) []ooohh.DialID {
+	kept := make([]ooohh.DialID, 0, len(dials))
+	for _, d := range dials {
+		if d != id {
+			kept = append(kept, d)
+		}
+	}
+
+	return kept
+}
+
+// parseFile parses the template, making the UI's template functions available to it.
+func (u *UI) parseFile(f io.Reader, err error) (*template.Template, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "opening file")
 	}
@@ -197,5 +353,17 @@ func parseFile(f io.Reader, err error) (*template.Template, error) {
 		return nil, errors.Wrap(err, "reading file contents")
 	}
 
-	return template.New("").Parse(string(b))
+	funcs := template.FuncMap{
+		"value": func(v float64) string {
+			return ooohh.FormatValue(v, u.precision)
+		},
+		"gauge": func(d ooohh.Dial) template.HTML {
+			return gauge(d, u.minValue, u.maxValue)
+		},
+		"stale": func(d ooohh.Dial) bool {
+			return d.Stale(u.now(), u.staleAfter)
+		},
+	}
+
+	return template.New("").Funcs(funcs).Parse(string(b))
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -72,6 +73,55 @@ func TestIndexContainsLinkToCreateBoard(t *testing.T) {
 
 }
 
+func TestIndexContainsTitle(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg      string
+		opts     []Option
+		expTitle string
+	}{{
+		msg:      "default title",
+		opts:     nil,
+		expTitle: "ooohh",
+	}, {
+		msg:      "configured title",
+		opts:     []Option{WithTitle("Acme Stress Board")},
+		expTitle: "Acme Stress Board",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service.
+			s := &mock.Service{}
+
+			// Create the ui struct.
+			ui := NewUI(s, tt.opts...)
+
+			// Create a new request.
+			r, err := http.NewRequest("GET", "/", nil)
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the index handler.
+			ui.Index().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Parse HTML.
+			doc, err := goquery.NewDocumentFromReader(rr.Body)
+			is.NoErr(err)
+
+			is.Equal(doc.Find("title").Text(), tt.expTitle) // page title is correct.
+			is.Equal(doc.Find("h1").Text(), tt.expTitle)    // heading is correct.
+		})
+	}
+}
+
 func TestNewBoardContainsForm(t *testing.T) {
 
 	is := is.New(t)
@@ -347,34 +397,27 @@ func TestGetBoardContainsBoardInformation(t *testing.T) {
 	}
 }
 
-func TestGetBoardContainsLinksForms(t *testing.T) {
+func TestGetBoardContainsDialColors(t *testing.T) {
 
 	is := is.New(t)
 
-	now := time.Now().Truncate(time.Second)
-
 	// Board that will be returned by service.
 	board := ooohh.Board{
-		ID:    ooohh.BoardID("board-id"),
-		Name:  "Testing Board",
-		Token: "token",
+		ID:   ooohh.BoardID("board-id"),
+		Name: "Testing Board",
 		Dials: []ooohh.Dial{
 			{
-				ID:        ooohh.DialID("dial-1"),
-				Token:     "token1",
-				Name:      "Dial 1",
-				Value:     10.0,
-				UpdatedAt: now,
+				ID:    ooohh.DialID("dial-1"),
+				Name:  "Dial 1",
+				Value: 10.0,
+				Color: "#00ff00",
 			},
 			{
-				ID:        ooohh.DialID("dial-2"),
-				Token:     "token2",
-				Name:      "Dial 2",
-				Value:     66.6,
-				UpdatedAt: now,
+				ID:    ooohh.DialID("dial-2"),
+				Name:  "Dial 2",
+				Value: 66.6,
 			},
 		},
-		UpdatedAt: now,
 	}
 
 	// Create a mock service.
@@ -400,56 +443,106 @@ func TestGetBoardContainsLinksForms(t *testing.T) {
 	// Check the response status code is correct.
 	is.Equal(rr.Code, http.StatusOK)
 
-	// Parse HTML.
+	// Parse the response.
 	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err) // body is html.
+
+	dials := doc.Find("li")
+	is.Equal(dials.Length(), 2) // all dials are shown.
+
+	style, ok := dials.Eq(0).Attr("style")
+	is.True(ok)                       // colored dial is styled.
+	is.Equal(style, "color: #00ff00") // custom color is used.
+	_, ok = dials.Eq(1).Attr("style")
+	is.True(!ok) // uncolored dial is not styled.
+}
+
+func TestGetBoardContainsGauges(t *testing.T) {
+
+	is := is.New(t)
+
+	// Board that will be returned by service, with a dial in each band, and one
+	// with its own color.
+	board := ooohh.Board{
+		ID:   ooohh.BoardID("board-id"),
+		Name: "Testing Board",
+		Dials: []ooohh.Dial{
+			{ID: ooohh.DialID("dial-1"), Name: "Dial 1", Value: 10.0},
+			{ID: ooohh.DialID("dial-2"), Name: "Dial 2", Value: 66.6},
+			{ID: ooohh.DialID("dial-3"), Name: "Dial 3", Value: 75.1},
+			{ID: ooohh.DialID("dial-4"), Name: "Dial 4", Value: 50.0},
+			{ID: ooohh.DialID("dial-5"), Name: "Dial 5", Value: 90.0, Color: "#00ff00"},
+		},
+	}
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
 	is.NoErr(err)
 
-	// Check the new board link is within the html.
-	found := false
-	doc.Find("a[href]").Each(func(index int, item *goquery.Selection) {
-		href, _ := item.Attr("href")
-		if href == "/new" {
-			found = true
-		}
-	})
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
 
-	is.True(found) // link to new board has been found.
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
 
-	// Check the form is within the html.
-	dialIDFound := false
-	tokenFound := false
-	doc.Find(`form[name="add-dial"]`).Each(func(index int, item *goquery.Selection) {
-		// Find dialID input within form.
-		dialID := item.Find(`input[name="dialID"]`)
-		if dialID != nil {
-			dialIDFound = true
-		}
-		// Find token input within form.
-		token := item.Find(`input[name="token"]`)
-		if token != nil {
-			tokenFound = true
-		}
-	})
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
 
-	is.True(dialIDFound) // dialID input element found.
-	is.True(tokenFound)  // token input element found.
+	// Parse the response.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err) // body is html.
+
+	dials := doc.Find("li")
+	is.Equal(dials.Length(), 5) // all dials are shown.
+
+	for i, tt := range []struct {
+		band string
+		fill string
+	}{
+		{"low", "#2ecc71"},
+		{"medium", "#f39c12"},
+		{"high", "#e74c3c"},
+		{"low", "#2ecc71"},
+		{"high", "#00ff00"},
+	} {
+		dial := dials.Eq(i)
+
+		is.Equal(dial.Find("svg").Length(), 1) // dial has a gauge.
 
+		fill := dial.Find("svg .gauge-fill")
+		is.True(fill.HasClass(tt.band)) // gauge is classed by the dial's band.
+
+		color, _ := fill.Attr("fill")
+		is.Equal(color, tt.fill) // gauge is colored like the dial, or by its band.
+
+		width, _ := fill.Attr("width")
+		is.Equal(width, strconv.FormatFloat(board.Dials[i].Value, 'f', -1, 64)) // gauge is filled by the dial's value.
+
+		is.True(strings.Contains(dial.Text(), ooohh.FormatValue(board.Dials[i].Value, ooohh.DefaultPrecision))) // value is still shown.
+	}
 }
 
-func TestGettingBoardServiceError(t *testing.T) {
+func TestGetBoardContainsDescription(t *testing.T) {
 
 	for _, tt := range []struct {
-		msg    string
-		err    error
-		expMsg string
+		msg         string
+		description string
 	}{{
-		msg:    "board not found",
-		err:    ooohh.ErrBoardNotFound,
-		expMsg: "Oops, the board wasn&#39;t found.",
+		msg:         "with description",
+		description: "Q3 stress tracking for <Platform> team",
 	}, {
-		msg:    "unknown error",
-		err:    errors.New("oops"),
-		expMsg: "Error retrieving board, please try again.",
+		msg:         "without description",
+		description: "",
 	}} {
 
 		t.Run(tt.msg, func(t *testing.T) {
@@ -459,7 +552,7 @@ func TestGettingBoardServiceError(t *testing.T) {
 			// Create a mock service.
 			s := &mock.Service{
 				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
-					return nil, tt.err
+					return &ooohh.Board{ID: id, Name: "Testing Board", Description: tt.description}, nil
 				},
 			}
 
@@ -479,73 +572,94 @@ func TestGettingBoardServiceError(t *testing.T) {
 			// Check the response status code is correct.
 			is.Equal(rr.Code, http.StatusOK)
 
-			// Check the error msg is within the html.
-			body := rr.Body.String()
-			is.True(strings.Contains(body, tt.expMsg)) // error message is in the html body.
+			// Parse the response.
+			doc, err := goquery.NewDocumentFromReader(rr.Body)
+			is.NoErr(err) // body is html.
+
+			description := doc.Find("p.description")
+			if tt.description == "" {
+				is.Equal(description.Length(), 0) // no description is shown.
+				return
+			}
+
+			is.Equal(description.Length(), 1)            // description is shown.
+			is.Equal(description.Text(), tt.description) // description is escaped, and shown in full.
 		})
 	}
 }
 
-func TestAddingDialToBoardOK(t *testing.T) {
+func TestGetBoardMarksStaleDials(t *testing.T) {
 
 	is := is.New(t)
 
-	now := time.Now().Truncate(time.Second)
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
 
-	// Board that will be returned by service.
+	// Board that will be returned by service, with a fresh and a stale dial.
 	board := ooohh.Board{
-		ID:    ooohh.BoardID("board-id"),
-		Name:  "Testing Board",
-		Token: "token",
+		ID:   ooohh.BoardID("board-id"),
+		Name: "Testing Board",
 		Dials: []ooohh.Dial{
-			{
-				ID:        ooohh.DialID("dial-1"),
-				Token:     "token",
-				Name:      "dial-1",
-				Value:     10.0,
-				UpdatedAt: now,
-			},
-			{
-				ID:        ooohh.DialID("dial-2"),
-				Token:     "token",
-				Name:      "dial-2",
-				Value:     66.6,
-				UpdatedAt: now,
-			},
+			{ID: ooohh.DialID("dial-1"), Name: "Dial 1", UpdatedAt: now.Add(-59 * time.Minute)},
+			{ID: ooohh.DialID("dial-2"), Name: "Dial 2", UpdatedAt: now.Add(-61 * time.Minute)},
 		},
-		UpdatedAt: now,
 	}
 
-	// Variables that will be set within the updating of the board.
-	var setID ooohh.BoardID
-	var setToken string
-	var setDials *[]ooohh.DialID
-
 	// Create a mock service.
 	s := &mock.Service{
 		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
 			return &board, nil
 		},
-		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
-			// Capture set values.
-			setID = id
-			setToken = token
-			setDials = &dials
+	}
 
-			// update board.
-			d := make([]ooohh.Dial, len(dials))
-			for i := range dials {
-				d[i] = ooohh.Dial{
-					ID:        dials[i],
-					Token:     "token",
-					Name:      string(dials[i]),
-					Value:     10.0,
-					UpdatedAt: now,
-				}
-			}
-			board.Dials = d
+	// Create the ui struct, at a fixed time, with dials stale after an hour.
+	ui := NewUI(s, WithNow(func() time.Time { return now }), WithStaleAfter(time.Hour))
 
-			return nil
+	// Create a new request.
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Parse the response.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err) // body is html.
+
+	dials := doc.Find("li")
+	is.Equal(dials.Length(), 2) // all dials are shown.
+
+	is.True(!dials.Eq(0).HasClass("stale")) // fresh dial isn't marked stale.
+	is.True(dials.Eq(1).HasClass("stale"))  // old dial is marked stale.
+
+	title, _ := dials.Eq(1).Attr("title")
+	is.Equal(title, "Not updated since 2020-06-01 10:59 UTC") // stale dial says when it was last updated.
+}
+
+func TestGetBoardGroupsDials(t *testing.T) {
+
+	is := is.New(t)
+
+	// Board that will be returned by service.
+	board := ooohh.Board{
+		ID:   ooohh.BoardID("board-id"),
+		Name: "Testing Board",
+		Dials: []ooohh.Dial{
+			{ID: ooohh.DialID("dial-1"), Name: "Dial 1", Group: "Backend"},
+			{ID: ooohh.DialID("dial-2"), Name: "Dial 2"},
+			{ID: ooohh.DialID("dial-3"), Name: "Dial 3", Group: "Backend"},
+		},
+	}
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
 		},
 	}
 
@@ -553,13 +667,8 @@ func TestAddingDialToBoardOK(t *testing.T) {
 	ui := NewUI(s)
 
 	// Create a new request.
-	formData := url.Values{
-		"dialID": {"dial-3"},
-		"token":  {"token"},
-	}
-	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
-	is.NoErr(err) // request creates ok.
-	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err)
 
 	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
 	rr := httptest.NewRecorder()
@@ -567,51 +676,30 @@ func TestAddingDialToBoardOK(t *testing.T) {
 	// Invoke the get board handler.
 	ui.GetBoard().ServeHTTP(rr, r)
 
-	// Check the board was set.
-	is.True(s.SetBoardInvoked) // board was updated.
-
-	// Check the board was updated with the correct data.
-	is.Equal(setID, ooohh.BoardID("board-id")) // correct board was set.
-	is.Equal(setToken, "token")                // token was set correctly.
-	is.True(setDials != nil)                   // dials were set.
-	if setDials != nil {
-		is.Equal(*setDials, []ooohh.DialID{"dial-1", "dial-2", "dial-3"}) // correct dials were set.
-	}
-
 	// Check the response status code is correct.
 	is.Equal(rr.Code, http.StatusOK)
 
-	// Check the new dial is within the html.
-	body := rr.Body.String()
-	is.True(strings.Contains(body, "dial-3")) // new dial is in the html body.
+	// Parse the response.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err) // body is html.
+
+	groups := doc.Find("h4")
+	is.Equal(groups.Length(), 2)                         // a heading is shown per group.
+	is.Equal(groups.Eq(0).Text(), "Backend")             // named group is first.
+	is.Equal(groups.Eq(1).Text(), ooohh.DefaultGroup)    // ungrouped dials are last.
+	is.Equal(groups.Eq(0).Next().Find("li").Length(), 2) // grouped dials are listed together.
+	is.Equal(groups.Eq(1).Next().Find("li").Length(), 1) // ungrouped dials are listed together.
 }
 
-func TestAddingDialToBoardValidationError(t *testing.T) {
+func TestGetBoardPrecision(t *testing.T) {
 
-	now := time.Now().Truncate(time.Second)
+	is := is.New(t)
 
 	// Board that will be returned by service.
 	board := ooohh.Board{
 		ID:    ooohh.BoardID("board-id"),
 		Name:  "Testing Board",
-		Token: "token",
-		Dials: []ooohh.Dial{
-			{
-				ID:        ooohh.DialID("dial-1"),
-				Token:     "token",
-				Name:      "dial-1",
-				Value:     10.0,
-				UpdatedAt: now,
-			},
-			{
-				ID:        ooohh.DialID("dial-2"),
-				Token:     "token",
-				Name:      "dial-2",
-				Value:     66.6,
-				UpdatedAt: now,
-			},
-		},
-		UpdatedAt: now,
+		Dials: []ooohh.Dial{{ID: ooohh.DialID("dial-1"), Name: "Dial 1", Value: 66.6666}},
 	}
 
 	// Create a mock service.
@@ -621,11 +709,386 @@ func TestAddingDialToBoardValidationError(t *testing.T) {
 		},
 	}
 
-	// Create the ui struct.
-	ui := NewUI(s)
+	// Create the ui struct, with a precision of 2.
+	ui := NewUI(s, WithPrecision(2))
 
-	for _, tt := range []struct {
-		msg         string
+	// Create a new request.
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Parse the response.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err) // body is html.
+
+	is.Equal(doc.Find("li").Text(), "Dial 1 - 66.67") // value uses the precision.
+}
+
+func TestGetBoardContainsLinksForms(t *testing.T) {
+
+	is := is.New(t)
+
+	now := time.Now().Truncate(time.Second)
+
+	// Board that will be returned by service.
+	board := ooohh.Board{
+		ID:    ooohh.BoardID("board-id"),
+		Name:  "Testing Board",
+		Token: "token",
+		Dials: []ooohh.Dial{
+			{
+				ID:        ooohh.DialID("dial-1"),
+				Token:     "token1",
+				Name:      "Dial 1",
+				Value:     10.0,
+				UpdatedAt: now,
+			},
+			{
+				ID:        ooohh.DialID("dial-2"),
+				Token:     "token2",
+				Name:      "Dial 2",
+				Value:     66.6,
+				UpdatedAt: now,
+			},
+		},
+		UpdatedAt: now,
+	}
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Parse HTML.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err)
+
+	// Check the new board link is within the html.
+	found := false
+	doc.Find("a[href]").Each(func(index int, item *goquery.Selection) {
+		href, _ := item.Attr("href")
+		if href == "/new" {
+			found = true
+		}
+	})
+
+	is.True(found) // link to new board has been found.
+
+	// Check the form is within the html.
+	dialIDFound := false
+	tokenFound := false
+	doc.Find(`form[name="add-dial"]`).Each(func(index int, item *goquery.Selection) {
+		// Find dialID input within form.
+		dialID := item.Find(`input[name="dialID"]`)
+		if dialID != nil {
+			dialIDFound = true
+		}
+		// Find token input within form.
+		token := item.Find(`input[name="token"]`)
+		if token != nil {
+			tokenFound = true
+		}
+	})
+
+	is.True(dialIDFound) // dialID input element found.
+	is.True(tokenFound)  // token input element found.
+
+}
+
+func TestGettingBoardServiceError(t *testing.T) {
+
+	for _, tt := range []struct {
+		msg    string
+		err    error
+		expMsg string
+	}{{
+		msg:    "board not found",
+		err:    ooohh.ErrBoardNotFound,
+		expMsg: "Oops, the board wasn&#39;t found.",
+	}, {
+		msg:    "unknown error",
+		err:    errors.New("oops"),
+		expMsg: "Error retrieving board, please try again.",
+	}} {
+
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service.
+			s := &mock.Service{
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return nil, tt.err
+				},
+			}
+
+			// Create the ui struct.
+			ui := NewUI(s)
+
+			// Create a new request.
+			r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			ui.GetBoard().ServeHTTP(rr, r)
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the error msg is within the html.
+			body := rr.Body.String()
+			is.True(strings.Contains(body, tt.expMsg)) // error message is in the html body.
+		})
+	}
+}
+
+func TestGetBoardPrivateBoards(t *testing.T) {
+
+	for _, method := range []string{"GET", "POST"} {
+
+		t.Run(method, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a mock service.
+			s := &mock.Service{
+				GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+					return &ooohh.Board{ID: id, Name: "Secret Board"}, nil
+				},
+			}
+
+			// Create the ui struct, with private boards.
+			ui := NewUI(s, WithPrivateBoards())
+
+			// Create a new request.
+			r, err := newRequest(method, "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+			is.NoErr(err)
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			ui.GetBoard().ServeHTTP(rr, r)
+
+			// Check the board isn't shown.
+			body := rr.Body.String()
+			is.True(strings.Contains(body, "Oops, boards are private.")) // error message is in the html body.
+			is.True(!strings.Contains(body, "Secret Board"))             // board isn't in the html body.
+			is.True(!s.GetBoardInvoked)                                  // board isn't retrieved.
+		})
+	}
+}
+
+func TestGetBoardMaskedBoards(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{ID: id, Name: "board", Dials: []ooohh.Dial{
+				{ID: "secret-dial-id", Name: "dial name", Value: 42.5},
+			}}, nil
+		},
+	}
+
+	// Create the ui struct, with masked boards.
+	ui := NewUI(s, WithMaskedBoards())
+
+	// Create a new request.
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the dial is shown without its value or ID.
+	body := rr.Body.String()
+	is.True(strings.Contains(body, "dial name"))       // dial name is in the html body.
+	is.True(!strings.Contains(body, "42.5"))           // dial value isn't in the html body.
+	is.True(!strings.Contains(body, "secret-dial-id")) // dial ID isn't in the html body.
+	is.True(!strings.Contains(body, "remove-dial"))    // dial can't be removed.
+}
+
+func TestAddingDialToBoardOK(t *testing.T) {
+
+	is := is.New(t)
+
+	now := time.Now().Truncate(time.Second)
+
+	// Board that will be returned by service.
+	board := ooohh.Board{
+		ID:    ooohh.BoardID("board-id"),
+		Name:  "Testing Board",
+		Token: "token",
+		Dials: []ooohh.Dial{
+			{
+				ID:        ooohh.DialID("dial-1"),
+				Token:     "token",
+				Name:      "dial-1",
+				Value:     10.0,
+				UpdatedAt: now,
+			},
+			{
+				ID:        ooohh.DialID("dial-2"),
+				Token:     "token",
+				Name:      "dial-2",
+				Value:     66.6,
+				UpdatedAt: now,
+			},
+		},
+		UpdatedAt: now,
+	}
+
+	// Variables that will be set within the updating of the board.
+	var setID ooohh.BoardID
+	var setToken string
+	var setDials *[]ooohh.DialID
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
+		},
+		GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+			ids := make([]ooohh.DialID, len(board.Dials))
+			for i := range board.Dials {
+				ids[i] = board.Dials[i].ID
+			}
+			return ids, nil
+		},
+		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+			// Capture set values.
+			setID = id
+			setToken = token
+			setDials = &dials
+
+			// update board.
+			d := make([]ooohh.Dial, len(dials))
+			for i := range dials {
+				d[i] = ooohh.Dial{
+					ID:        dials[i],
+					Token:     "token",
+					Name:      string(dials[i]),
+					Value:     10.0,
+					UpdatedAt: now,
+				}
+			}
+			board.Dials = d
+
+			return nil
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	formData := url.Values{
+		"dialID": {"dial-3"},
+		"token":  {"token"},
+	}
+	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err) // request creates ok.
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the board was set.
+	is.True(s.SetBoardInvoked) // board was updated.
+
+	// Check the board was updated with the correct data.
+	is.Equal(setID, ooohh.BoardID("board-id")) // correct board was set.
+	is.Equal(setToken, "token")                // token was set correctly.
+	is.True(setDials != nil)                   // dials were set.
+	if setDials != nil {
+		is.Equal(*setDials, []ooohh.DialID{"dial-1", "dial-2", "dial-3"}) // correct dials were set.
+	}
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the new dial is within the html.
+	body := rr.Body.String()
+	is.True(strings.Contains(body, "dial-3")) // new dial is in the html body.
+}
+
+func TestAddingDialToBoardValidationError(t *testing.T) {
+
+	now := time.Now().Truncate(time.Second)
+
+	// Board that will be returned by service.
+	board := ooohh.Board{
+		ID:    ooohh.BoardID("board-id"),
+		Name:  "Testing Board",
+		Token: "token",
+		Dials: []ooohh.Dial{
+			{
+				ID:        ooohh.DialID("dial-1"),
+				Token:     "token",
+				Name:      "dial-1",
+				Value:     10.0,
+				UpdatedAt: now,
+			},
+			{
+				ID:        ooohh.DialID("dial-2"),
+				Token:     "token",
+				Name:      "dial-2",
+				Value:     66.6,
+				UpdatedAt: now,
+			},
+		},
+		UpdatedAt: now,
+	}
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	for _, tt := range []struct {
+		msg         string
 		form        url.Values
 		errMsgs     []string
 		missingMsgs []string
@@ -650,48 +1113,227 @@ func TestAddingDialToBoardValidationError(t *testing.T) {
 		missingMsgs: []string{"Please enter a dial ID."},
 	}} {
 
-		t.Run(tt.msg, func(t *testing.T) {
+		t.Run(tt.msg, func(t *testing.T) {
+
+			is := is.New(t)
+
+			// Create a new request.
+			r, err := newRequest("POST", "/boards/:id", strings.NewReader(tt.form.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+			is.NoErr(err) // request creates ok.
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+			rr := httptest.NewRecorder()
+
+			// Invoke the get board handler.
+			ui.GetBoard().ServeHTTP(rr, r)
+
+			// Check the board was not set.
+			is.True(!s.SetBoardInvoked) // board was not updated.
+
+			// Check the response status code is correct.
+			is.Equal(rr.Code, http.StatusOK)
+
+			// Check the validation error is within the html.
+			body := rr.Body.String()
+			for _, msg := range tt.errMsgs {
+				is.True(strings.Contains(body, msg)) // error message is in the html body.
+			}
+
+			// Check the missing messages aren't in the html.
+			for _, msg := range tt.missingMsgs {
+				is.True(!strings.Contains(body, msg)) // error message is not in the html body.
+			}
+		})
+	}
+}
+
+func TestAddingDialToBoardGetBoardError(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return nil, errors.New("uh-oh")
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	formData := url.Values{
+		"dialID": {"new-dial-id"},
+		"token":  {"entered-token"},
+	}
+	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err) // request creates ok.
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the board was retrieved.
+	is.True(s.GetBoardInvoked) // board was retrieved.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Check the html.
+	body := rr.Body.String()
+	is.True(strings.Contains(body, "Error retrieving board, please try again.")) // error msg is in the html body.
+}
+
+func TestAddingDialToBoardSetBoardError(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:        ooohh.BoardID("board-id"),
+				Token:     "token",
+				Name:      "Board",
+				Dials:     []ooohh.Dial{},
+				UpdatedAt: time.Now(),
+			}, nil
+		},
+		GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+			return []ooohh.DialID{}, nil
+		},
+		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+			return errors.New("uh-oh")
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	formData := url.Values{
+		"dialID": {"new-dial-id"},
+		"token":  {"entered-token"},
+	}
+	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err) // request creates ok.
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
 
-			is := is.New(t)
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
 
-			// Create a new request.
-			r, err := newRequest("POST", "/boards/:id", strings.NewReader(tt.form.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
-			is.NoErr(err) // request creates ok.
-			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// Check the board was retrieved.
+	is.True(s.GetBoardInvoked) // board was retrieved.
 
-			// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
-			rr := httptest.NewRecorder()
+	// Check the board was updated.
+	is.True(s.SetBoardInvoked) // board was updated.
 
-			// Invoke the get board handler.
-			ui.GetBoard().ServeHTTP(rr, r)
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
 
-			// Check the board was not set.
-			is.True(!s.SetBoardInvoked) // board was not updated.
+	// Check the html.
+	body := rr.Body.String()
+	is.True(strings.Contains(body, "Error adding dial, please try again.")) // error msg is in the html body.
+	is.True(strings.Contains(body, "new-dial-id"))                          // entered dial id is still on page.
+	is.True(strings.Contains(body, "entered-token"))                        // entered token is still on page.
+}
 
-			// Check the response status code is correct.
-			is.Equal(rr.Code, http.StatusOK)
+func TestAddingDialToBoardKeepsMissingDials(t *testing.T) {
 
-			// Check the validation error is within the html.
-			body := rr.Body.String()
-			for _, msg := range tt.errMsgs {
-				is.True(strings.Contains(body, msg)) // error message is in the html body.
-			}
+	is := is.New(t)
 
-			// Check the missing messages aren't in the html.
-			for _, msg := range tt.missingMsgs {
-				is.True(!strings.Contains(body, msg)) // error message is not in the html body.
-			}
-		})
+	now := time.Now().Truncate(time.Second)
+
+	// Board that will be returned by service. The stored board also references
+	// a dial that no longer exists, so it isn't populated.
+	board := ooohh.Board{
+		ID:    ooohh.BoardID("board-id"),
+		Name:  "Testing Board",
+		Token: "token",
+		Dials: []ooohh.Dial{
+			{
+				ID:        ooohh.DialID("dial-2"),
+				Token:     "token",
+				Name:      "dial-2",
+				Value:     66.6,
+				UpdatedAt: now,
+			},
+		},
+		UpdatedAt: now,
+	}
+
+	// Variables that will be set within the updating of the board.
+	var setDials *[]ooohh.DialID
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
+		},
+		GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+			return []ooohh.DialID{"missing-dial", "dial-2"}, nil
+		},
+		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+			// Capture set values.
+			setDials = &dials
+			return nil
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	formData := url.Values{
+		"dialID": {"dial-3"},
+		"token":  {"token"},
+	}
+	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err) // request creates ok.
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the stored dial IDs were retrieved.
+	is.True(s.GetBoardDialIDsInvoked) // stored dial ids were retrieved.
+
+	// Check the board was updated with all stored dials, plus the new one.
+	is.True(setDials != nil) // dials were set.
+	if setDials != nil {
+		is.Equal(*setDials, []ooohh.DialID{"missing-dial", "dial-2", "dial-3"}) // missing dial is kept.
 	}
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
 }
 
-func TestAddingDialToBoardGetBoardError(t *testing.T) {
+func TestAddingDialToBoardGetBoardDialIDsError(t *testing.T) {
 
 	is := is.New(t)
 
 	// Create a mock service.
 	s := &mock.Service{
 		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:        ooohh.BoardID("board-id"),
+				Token:     "token",
+				Name:      "Board",
+				Dials:     []ooohh.Dial{},
+				UpdatedAt: time.Now(),
+			}, nil
+		},
+		GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
 			return nil, errors.New("uh-oh")
 		},
 	}
@@ -714,18 +1356,18 @@ func TestAddingDialToBoardGetBoardError(t *testing.T) {
 	// Invoke the get board handler.
 	ui.GetBoard().ServeHTTP(rr, r)
 
-	// Check the board was retrieved.
-	is.True(s.GetBoardInvoked) // board was retrieved.
+	// Check the board wasn't updated.
+	is.True(!s.SetBoardInvoked) // board was not updated.
 
 	// Check the response status code is correct.
 	is.Equal(rr.Code, http.StatusOK)
 
 	// Check the html.
 	body := rr.Body.String()
-	is.True(strings.Contains(body, "Error retrieving board, please try again.")) // error msg is in the html body.
+	is.True(strings.Contains(body, "Error adding dial, please try again.")) // error msg is in the html body.
 }
 
-func TestAddingDialToBoardSetBoardError(t *testing.T) {
+func TestGetBoardContainsRemoveDialForms(t *testing.T) {
 
 	is := is.New(t)
 
@@ -733,15 +1375,87 @@ func TestAddingDialToBoardSetBoardError(t *testing.T) {
 	s := &mock.Service{
 		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
 			return &ooohh.Board{
-				ID:        ooohh.BoardID("board-id"),
-				Token:     "token",
-				Name:      "Board",
-				Dials:     []ooohh.Dial{},
-				UpdatedAt: time.Now(),
+				ID:    ooohh.BoardID("board-id"),
+				Name:  "Testing Board",
+				Dials: []ooohh.Dial{{ID: "dial-1", Name: "Dial 1"}, {ID: "dial-2", Name: "Dial 2"}},
 			}, nil
 		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	r, err := newRequest("GET", "/boards/:id", nil, httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err)
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Parse HTML.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err)
+
+	// Check there is a remove form per dial.
+	var ids []string
+	doc.Find(`li form[name="remove-dial"]`).Each(func(index int, item *goquery.Selection) {
+		id, _ := item.Find(`input[name="dialID"]`).Attr("value")
+		ids = append(ids, id)
+
+		action, _ := item.Find(`input[name="action"]`).Attr("value")
+		is.Equal(action, "remove")                             // form removes the dial.
+		is.Equal(item.Find(`input[name="token"]`).Length(), 1) // form asks for the board token.
+	})
+
+	is.Equal(ids, []string{"dial-1", "dial-2"}) // each dial has a remove form.
+}
+
+func TestRemovingDialFromBoardOK(t *testing.T) {
+
+	is := is.New(t)
+
+	// Board that will be returned by service.
+	board := ooohh.Board{
+		ID:    ooohh.BoardID("board-id"),
+		Name:  "Testing Board",
+		Token: "token",
+		Dials: []ooohh.Dial{{ID: "dial-1", Name: "Dial 1"}, {ID: "dial-2", Name: "Dial 2"}},
+	}
+
+	// Variables that will be set within the updating of the board.
+	var setToken string
+	var setDials []ooohh.DialID
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &board, nil
+		},
+		GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+			// The board also stores a dial that can't be retrieved.
+			return []ooohh.DialID{"dial-1", "missing-dial", "dial-2"}, nil
+		},
 		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
-			return errors.New("uh-oh")
+			setToken, setDials = token, dials
+
+			// update board, keeping the dials that exist.
+			kept := make([]ooohh.Dial, 0)
+			for _, d := range board.Dials {
+				for _, id := range dials {
+					if d.ID == id {
+						kept = append(kept, d)
+					}
+				}
+			}
+			board.Dials = kept
+
+			return nil
 		},
 	}
 
@@ -750,8 +1464,9 @@ func TestAddingDialToBoardSetBoardError(t *testing.T) {
 
 	// Create a new request.
 	formData := url.Values{
-		"dialID": {"new-dial-id"},
-		"token":  {"entered-token"},
+		"action": {"remove"},
+		"dialID": {"dial-1"},
+		"token":  {"token"},
 	}
 	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
 	is.NoErr(err) // request creates ok.
@@ -763,8 +1478,118 @@ func TestAddingDialToBoardSetBoardError(t *testing.T) {
 	// Invoke the get board handler.
 	ui.GetBoard().ServeHTTP(rr, r)
 
-	// Check the board was retrieved.
-	is.True(s.GetBoardInvoked) // board was retrieved.
+	// Check the board was updated with the correct data.
+	is.True(s.SetBoardInvoked)                                   // board was updated.
+	is.Equal(setToken, "token")                                  // token was set correctly.
+	is.Equal(setDials, []ooohh.DialID{"missing-dial", "dial-2"}) // only the removed dial is dropped.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Parse HTML.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err)
+
+	var dials []string
+	doc.Find("li").Each(func(index int, item *goquery.Selection) {
+		dials = append(dials, item.Text())
+	})
+
+	is.Equal(dials, []string{"Dial 2 - 0.0"}) // removed dial is no longer shown.
+}
+
+func TestRemovingDialFromBoardValidationError(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:    ooohh.BoardID("board-id"),
+				Name:  "Testing Board",
+				Dials: []ooohh.Dial{{ID: "dial-1", Name: "Dial 1"}, {ID: "dial-2", Name: "Dial 2"}},
+			}, nil
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request, without a token.
+	formData := url.Values{
+		"action": {"remove"},
+		"dialID": {"dial-2"},
+	}
+	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err) // request creates ok.
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
+
+	// Check the board was not updated.
+	is.True(!s.SetBoardInvoked) // board was not updated.
+
+	// Check the response status code is correct.
+	is.Equal(rr.Code, http.StatusOK)
+
+	// Parse HTML.
+	doc, err := goquery.NewDocumentFromReader(rr.Body)
+	is.NoErr(err)
+
+	// Check the error is shown against the dial being removed only.
+	var errs []string
+	doc.Find(`form[name="remove-dial"]`).Each(func(index int, item *goquery.Selection) {
+		errs = append(errs, item.Parent().Find(".error").Text())
+	})
+
+	is.Equal(errs, []string{"", "Please enter the board's token."}) // error is shown against the dial.
+	is.Equal(doc.Find(`form[name="add-dial"] .error`).Length(), 0)  // add form has no errors.
+}
+
+func TestRemovingDialFromBoardSetBoardError(t *testing.T) {
+
+	is := is.New(t)
+
+	// Create a mock service.
+	s := &mock.Service{
+		GetBoardFn: func(ctx context.Context, id ooohh.BoardID) (*ooohh.Board, error) {
+			return &ooohh.Board{
+				ID:    ooohh.BoardID("board-id"),
+				Name:  "Testing Board",
+				Dials: []ooohh.Dial{{ID: "dial-1", Name: "Dial 1"}},
+			}, nil
+		},
+		GetBoardDialIDsFn: func(ctx context.Context, id ooohh.BoardID) ([]ooohh.DialID, error) {
+			return []ooohh.DialID{"dial-1"}, nil
+		},
+		SetBoardFn: func(ctx context.Context, id ooohh.BoardID, token string, dials []ooohh.DialID) error {
+			return ooohh.ErrUnauthorized
+		},
+	}
+
+	// Create the ui struct.
+	ui := NewUI(s)
+
+	// Create a new request.
+	formData := url.Values{
+		"action": {"remove"},
+		"dialID": {"dial-1"},
+		"token":  {"wrong-token"},
+	}
+	r, err := newRequest("POST", "/boards/:id", strings.NewReader(formData.Encode()), httprouter.Params{{Key: "id", Value: "board-id"}})
+	is.NoErr(err) // request creates ok.
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Create a response recorder, which satisfies http.ResponseWriter, to record the response.
+	rr := httptest.NewRecorder()
+
+	// Invoke the get board handler.
+	ui.GetBoard().ServeHTTP(rr, r)
 
 	// Check the board was updated.
 	is.True(s.SetBoardInvoked) // board was updated.
@@ -774,7 +1599,6 @@ func TestAddingDialToBoardSetBoardError(t *testing.T) {
 
 	// Check the html.
 	body := rr.Body.String()
-	is.True(strings.Contains(body, "Error adding dial, please try again.")) // error msg is in the html body.
-	is.True(strings.Contains(body, "new-dial-id"))                          // entered dial id is still on page.
-	is.True(strings.Contains(body, "entered-token"))                        // entered token is still on page.
+	is.True(strings.Contains(body, "Error removing dial, please try again.")) // error msg is in the html body.
+	is.True(strings.Contains(body, "Dial 1"))                                 // dial is still shown.
 }
@@ -0,0 +1,339 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+const (
+	// defaultRetries is the number of times a failed delivery is retried.
+	defaultRetries = 2
+	// defaultRetryBackoff is the time waited before each retry.
+	defaultRetryBackoff = time.Second
+	// defaultMaxFailures is the number of failed deliveries in a row after
+	// which a webhook is disabled.
+	defaultMaxFailures = 5
+	// defaultConcurrency is the number of webhooks delivered to at once.
+	defaultConcurrency = 10
+)
+
+// Dispatcher pushes boards to their webhooks when they change. Boards are
+// checked for changes periodically, so changes made in between checks are
+// pushed together. Webhooks are delivered to concurrently, so a slow or dead
+// endpoint doesn't hold up the others.
+type Dispatcher struct {
+	logger *zap.SugaredLogger
+	st     store.Store
+	s      ooohh.Service
+
+	retries     int
+	backoff     time.Duration
+	maxFailures int
+	concurrency int
+
+	c *http.Client
+
+	// sent is the fingerprint of the board last pushed to each webhook, guarded
+	// by mu. It is only kept in memory, so all webhooks are pushed to again
+	// after a restart.
+	mu   sync.Mutex
+	sent map[string][sha256.Size]byte
+}
+
+// DispatcherOption configures the Dispatcher.
+type DispatcherOption func(*Dispatcher)
+
+// WithRetries sets the number of times a failed delivery is retried, and the
+// time waited before each retry.
+func WithRetries(retries int, backoff time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.retries = retries
+		d.backoff = backoff
+	}
+}
+
+// WithMaxFailures sets the number of failed deliveries in a row after which a
+// webhook is disabled.
+func WithMaxFailures(failures int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.maxFailures = failures
+	}
+}
+
+// WithConcurrency sets the number of webhooks delivered to at once, which is
+// at least one.
+func WithConcurrency(n int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if n < 1 {
+			n = 1
+		}
+		d.concurrency = n
+	}
+}
+
+// WithoutPrivateAddresses stops boards being pushed to loopback, private,
+// link-local and unspecified addresses, so webhooks can't be used to reach
+// services on the network the dispatcher runs in. The address is checked when
+// connecting, so it applies however the webhook's host resolves at the time.
+func WithoutPrivateAddresses() DispatcherOption {
+	return func(d *Dispatcher) {
+		dialer := &net.Dialer{
+			Timeout: 30 * time.Second,
+			Control: func(network, address string, c syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || privateIP(ip) {
+					return errors.Errorf("address %s is private", host)
+				}
+				return nil
+			},
+		}
+
+		// Proxies aren't used, as then the proxy's address would be checked
+		// rather than the webhook's.
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.Proxy = nil
+		t.DialContext = dialer.DialContext
+		d.c.Transport = t
+	}
+}
+
+// NewDispatcher returns a Dispatcher of the webhooks in the given store, for
+// boards retrieved from the given ooohh.Service.
+func NewDispatcher(logger *zap.SugaredLogger, st store.Store, s ooohh.Service, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		logger:      logger,
+		st:          st,
+		s:           s,
+		retries:     defaultRetries,
+		backoff:     defaultRetryBackoff,
+		maxFailures: defaultMaxFailures,
+		concurrency: defaultConcurrency,
+		c:           &http.Client{Timeout: 10 * time.Second},
+		sent:        make(map[string][sha256.Size]byte),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Run dispatches every interval until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		if err := d.Dispatch(ctx); err != nil {
+			d.logger.Errorw("could not dispatch webhooks", "err", err)
+		}
+	}
+}
+
+// Dispatch pushes each board that has changed since it was last pushed to
+// its enabled webhooks, and waits for the pushes to finish.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	webhooks, err := list(d.st, "")
+	if err != nil {
+		return errors.Wrap(err, "listing webhooks")
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		firstErr error
+	)
+
+	sem := make(chan struct{}, d.concurrency)
+	for _, wh := range webhooks {
+		if wh.Disabled {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(wh Webhook) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := d.dispatch(ctx, wh); err != nil {
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errMu.Unlock()
+			}
+		}(wh)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
+
+// dispatch pushes the webhook's board to it, if it has changed since it was
+// last pushed, and records whether the push failed.
+func (d *Dispatcher) dispatch(ctx context.Context, wh Webhook) error {
+	b, err := d.s.GetBoard(ctx, wh.Board)
+	if err != nil {
+		d.logger.Errorw("could not retrieve board", "err", err, "board", wh.Board, "webhook", wh.ID)
+		return nil
+	}
+
+	body, err := json.Marshal(b)
+	if err != nil {
+		return errors.Wrap(err, "marshalling board")
+	}
+
+	// Only push boards that have changed.
+	sum, err := fingerprint(*b)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	sent, ok := d.sent[wh.ID]
+	d.mu.Unlock()
+	if ok && sent == sum {
+		return nil
+	}
+
+	if err := d.deliver(ctx, wh.URL, body); err != nil {
+		d.logger.Infow("could not deliver webhook", "err", err, "board", wh.Board, "webhook", wh.ID)
+
+		return d.update(wh, func(wh *Webhook) {
+			wh.Failures++
+			wh.Disabled = wh.Failures >= d.maxFailures
+		})
+	}
+
+	d.mu.Lock()
+	d.sent[wh.ID] = sum
+	d.mu.Unlock()
+
+	if wh.Failures > 0 {
+		return d.update(wh, func(wh *Webhook) {
+			wh.Failures = 0
+		})
+	}
+
+	return nil
+}
+
+// fingerprint returns a hash of the board that only changes when the board,
+// or one of its dials, is updated. Dial values are left out, as they may
+// decay between checks without the dial being updated; a change to a dial's
+// stored value changes when it was updated instead.
+func fingerprint(b ooohh.Board) ([sha256.Size]byte, error) {
+	dials := make([]ooohh.Dial, len(b.Dials))
+	for i, d := range b.Dials {
+		d.Value = 0
+		dials[i] = d
+	}
+	b.Dials = dials
+
+	body, err := json.Marshal(b)
+	if err != nil {
+		return [sha256.Size]byte{}, errors.Wrap(err, "marshalling board")
+	}
+
+	return sha256.Sum256(body), nil
+}
+
+// deliver posts the body to the URL, retrying on failure.
+func (d *Dispatcher) deliver(ctx context.Context, url string, body []byte) error {
+	var err error
+	for attempt := 0; attempt <= d.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d.backoff):
+			}
+		}
+
+		if err = d.post(ctx, url, body); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// post posts the body to the URL once. Any non-2xx response is an error.
+func (d *Dispatcher) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.c.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "posting board")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// update applies the update to the stored webhook. Webhooks that have been
+// deleted in the meantime are left deleted.
+func (d *Dispatcher) update(wh Webhook, update func(wh *Webhook)) error {
+
+	// start read/write transaction
+	txn, err := d.st.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	k := key(wh.Board, wh.ID)
+	v := txn.Get("board_webhooks", k)
+	if v == nil {
+		return nil
+	}
+
+	if err := msgpack.Unmarshal(v, &wh); err != nil {
+		return errors.Wrap(err, "reading webhook")
+	}
+
+	update(&wh)
+
+	if v, err := msgpack.Marshal(wh); err != nil {
+		return errors.Wrap(err, "marshalling webhook")
+	} else if err := txn.Put("board_webhooks", k, v); err != nil {
+		return errors.Wrap(err, "storing webhook")
+	}
+
+	return txn.Commit()
+}
@@ -0,0 +1,270 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"go.uber.org/zap"
+
+	"github.com/dlmiddlecote/ooohh"
+	ooohhservice "github.com/dlmiddlecote/ooohh/pkg/service"
+)
+
+func TestDispatchPushesChangedBoards(t *testing.T) {
+
+	is := is.New(t)
+
+	// Time moves on between updates.
+	clock := now
+	s, ws, st := newTestServicesAt(t, func() time.Time { return clock }, nil)
+
+	ctx := context.TODO()
+
+	// Create a test server that records the boards pushed to it.
+	var mu sync.Mutex
+	var pushed []ooohh.Board
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var b ooohh.Board
+		json.NewDecoder(r.Body).Decode(&b) //nolint:errcheck
+
+		mu.Lock()
+		pushed = append(pushed, b)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	// Create a board, with a dial, and a webhook.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID}))
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", srv.URL)
+	is.NoErr(err) // webhook is added.
+
+	logger, _ := newTestLogger(zap.InfoLevel)
+	dp := NewDispatcher(logger, st, s)
+
+	// The board is pushed the first time.
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(len(pushed), 1)          // board is pushed.
+	is.Equal(pushed[0].ID, b.ID)      // pushed board is correct.
+	is.Equal(len(pushed[0].Dials), 1) // pushed board has its dials.
+
+	// It isn't pushed again until it changes.
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(len(pushed), 1) // unchanged board isn't pushed.
+
+	// Changes are pushed together.
+	clock = clock.Add(time.Minute)
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 42.0))
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 66.6))
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(len(pushed), 2)                 // changed board is pushed once.
+	is.Equal(pushed[1].Dials[0].Value, 66.6) // latest change is pushed.
+}
+
+func TestDispatchDisablesFailingWebhooks(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, st := newTestServices(t)
+
+	ctx := context.TODO()
+
+	// Create a test server that always fails, counting the attempts.
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", srv.URL)
+	is.NoErr(err) // webhook is added.
+
+	logger, _ := newTestLogger(zap.InfoLevel)
+	dp := NewDispatcher(logger, st, s, WithRetries(1, 0), WithMaxFailures(3))
+
+	// Each dispatch retries, and records a failure.
+	for i := 1; i <= 3; i++ {
+		is.NoErr(dp.Dispatch(ctx))
+		is.Equal(attempts, i*2) // delivery is retried.
+
+		webhooks, err := ws.ListWebhooks(ctx, b.ID, "MYTOKEN")
+		is.NoErr(err)                          // webhooks are listed.
+		is.Equal(webhooks[0].Failures, i)      // failure is recorded.
+		is.Equal(webhooks[0].Disabled, i == 3) // webhook is disabled after too many failures.
+	}
+
+	// Disabled webhooks aren't pushed to.
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(attempts, 6) // disabled webhook isn't pushed to.
+}
+
+func TestDispatchResetsFailuresOnSuccess(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, st := newTestServices(t)
+
+	ctx := context.TODO()
+
+	// Create a test server that fails until told not to.
+	fail := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", srv.URL)
+	is.NoErr(err) // webhook is added.
+
+	logger, _ := newTestLogger(zap.InfoLevel)
+	dp := NewDispatcher(logger, st, s, WithRetries(0, 0))
+
+	is.NoErr(dp.Dispatch(ctx))
+	webhooks, err := ws.ListWebhooks(ctx, b.ID, "MYTOKEN")
+	is.NoErr(err)                     // webhooks are listed.
+	is.Equal(webhooks[0].Failures, 1) // failure is recorded.
+
+	fail = false
+	is.NoErr(dp.Dispatch(ctx))
+	webhooks, err = ws.ListWebhooks(ctx, b.ID, "MYTOKEN")
+	is.NoErr(err)                     // webhooks are listed.
+	is.Equal(webhooks[0].Failures, 0) // failures are reset on success.
+}
+
+func TestDispatchIgnoresDecay(t *testing.T) {
+
+	is := is.New(t)
+
+	// Dials decay as time moves on.
+	clock := now
+	s, ws, st := newTestServicesAt(t, func() time.Time { return clock }, []ooohhservice.Option{ooohhservice.WithDecay(1)})
+
+	ctx := context.TODO()
+
+	// Create a test server that counts the boards pushed to it.
+	var pushes int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pushes++
+	}))
+	defer srv.Close()
+
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	d, err := s.CreateDial(ctx, "TEST-DIAL", "MYTOKEN")
+	is.NoErr(err) // dial creates correctly.
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 50.0))
+	is.NoErr(s.SetBoard(ctx, b.ID, "MYTOKEN", []ooohh.DialID{d.ID}))
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", srv.URL)
+	is.NoErr(err) // webhook is added.
+
+	logger, _ := newTestLogger(zap.InfoLevel)
+	dp := NewDispatcher(logger, st, s)
+
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(pushes, 1) // board is pushed.
+
+	// The dial decays, but isn't updated.
+	clock = clock.Add(time.Hour)
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(pushes, 1) // decayed board isn't pushed again.
+
+	// Updating the dial pushes the board.
+	clock = clock.Add(time.Minute)
+	is.NoErr(s.SetDial(ctx, d.ID, "MYTOKEN", 60.0))
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(pushes, 2) // updated board is pushed.
+}
+
+func TestDispatchDeliversConcurrently(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, st := newTestServices(t)
+
+	ctx := context.TODO()
+
+	// Create a test server that hangs until released, and one that records
+	// when it is pushed to.
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	pushed := make(chan struct{})
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(pushed)
+	}))
+	defer fast.Close()
+
+	for _, url := range []string{slow.URL, fast.URL} {
+		b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+		is.NoErr(err) // board creates correctly.
+		_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", url)
+		is.NoErr(err) // webhook is added.
+	}
+
+	logger, _ := newTestLogger(zap.InfoLevel)
+	dp := NewDispatcher(logger, st, s, WithConcurrency(2))
+
+	done := make(chan error)
+	go func() {
+		done <- dp.Dispatch(ctx)
+	}()
+
+	// The fast webhook is pushed to while the slow one hangs.
+	select {
+	case <-pushed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("fast webhook was held up by the slow one")
+	}
+
+	close(release)
+	is.NoErr(<-done) // dispatch finishes once every webhook is pushed to.
+}
+
+func TestDispatchWithoutPrivateAddresses(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, st := newTestServices(t)
+
+	ctx := context.TODO()
+
+	// Create a test server, on a loopback address, that counts pushes.
+	var pushes int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pushes++
+	}))
+	defer srv.Close()
+
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", srv.URL)
+	is.NoErr(err) // webhook is added.
+
+	logger, _ := newTestLogger(zap.InfoLevel)
+	dp := NewDispatcher(logger, st, s, WithRetries(0, 0), WithoutPrivateAddresses())
+
+	is.NoErr(dp.Dispatch(ctx))
+	is.Equal(pushes, 0) // private address isn't pushed to.
+
+	webhooks, err := ws.ListWebhooks(ctx, b.ID, "MYTOKEN")
+	is.NoErr(err)                     // webhooks are listed.
+	is.Equal(webhooks[0].Failures, 1) // failure is recorded.
+}
@@ -0,0 +1,290 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/ksuid"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/dlmiddlecote/ooohh"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+var (
+	// ErrWebhookNotFound signifies that the webhook is not found for the given board.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrURLInvalid signifies that the webhook URL is not an absolute http(s) URL.
+	ErrURLInvalid = errors.New("webhook url invalid")
+	// ErrURLPrivate signifies that the webhook URL is for a private address,
+	// when those are denied.
+	ErrURLPrivate = errors.New("webhook url private")
+)
+
+// Webhook is a URL that a board is pushed to whenever it changes. Webhooks
+// that fail too many times in a row are disabled.
+type Webhook struct {
+	ID        string        `json:"id"`
+	Board     ooohh.BoardID `json:"board_id"`
+	URL       string        `json:"url"`
+	Failures  int           `json:"failures"`
+	Disabled  bool          `json:"disabled"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// Service represents a service for managing the webhooks of boards.
+// Webhooks can be managed by anyone who knows the token the board was created with.
+type Service interface {
+	// AddWebhook registers the URL to receive the board whenever it changes.
+	AddWebhook(ctx context.Context, board ooohh.BoardID, token, url string) (*Webhook, error)
+	// ListWebhooks returns the board's webhooks.
+	ListWebhooks(ctx context.Context, board ooohh.BoardID, token string) ([]Webhook, error)
+	// DeleteWebhook removes the webhook from the board.
+	DeleteWebhook(ctx context.Context, board ooohh.BoardID, token, id string) error
+}
+
+type service struct {
+	st  store.Store
+	s   ooohh.Service
+	now func() time.Time
+
+	// denyPrivate stops webhooks being added for private addresses.
+	denyPrivate bool
+}
+
+// Option configures the service.
+type Option func(*service)
+
+// WithoutPrivateHosts stops webhooks being added for URLs whose host is a
+// loopback, private, link-local or unspecified IP address, or localhost. Host
+// names are not resolved, so the dispatcher should also be configured with
+// WithoutPrivateAddresses. By default, any host is allowed.
+func WithoutPrivateHosts() Option {
+	return func(s *service) {
+		s.denyPrivate = true
+	}
+}
+
+// NewService returns a Service that keeps webhooks in the given store. Boards
+// are retrieved from the given ooohh.Service, to check tokens against.
+func NewService(st store.Store, s ooohh.Service, now func() time.Time, opts ...Option) (*service, error) {
+
+	// Initialize top-level buckets.
+	txn, err := st.Begin(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	if err := txn.CreateBucketIfNotExists("board_webhooks"); err != nil {
+		return nil, errors.Wrap(err, "creating board_webhooks bucket")
+	}
+
+	ws := &service{st: st, s: s, now: now}
+	for _, opt := range opts {
+		opt(ws)
+	}
+
+	return ws, txn.Commit()
+}
+
+// AddWebhook registers the URL to receive the board whenever it changes.
+func (s *service) AddWebhook(ctx context.Context, board ooohh.BoardID, token, rawURL string) (*Webhook, error) {
+
+	// check url validity.
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrURLInvalid
+	}
+
+	if s.denyPrivate && privateHost(u.Hostname()) {
+		return nil, ErrURLPrivate
+	}
+
+	id, err := s.authorize(ctx, board, token)
+	if err != nil {
+		return nil, err
+	}
+
+	wh := Webhook{
+		ID:        ksuid.New().String(),
+		Board:     id,
+		URL:       u.String(),
+		CreatedAt: s.now().UTC(),
+	}
+
+	if err := put(s.st, wh); err != nil {
+		return nil, err
+	}
+
+	return &wh, nil
+}
+
+// ListWebhooks returns the board's webhooks.
+func (s *service) ListWebhooks(ctx context.Context, board ooohh.BoardID, token string) ([]Webhook, error) {
+	id, err := s.authorize(ctx, board, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return list(s.st, id)
+}
+
+// DeleteWebhook removes the webhook from the board.
+func (s *service) DeleteWebhook(ctx context.Context, board ooohh.BoardID, token, whID string) error {
+	id, err := s.authorize(ctx, board, token)
+	if err != nil {
+		return err
+	}
+
+	// start read/write transaction
+	txn, err := s.st.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	k := key(id, whID)
+	if txn.Get("board_webhooks", k) == nil {
+		return ErrWebhookNotFound
+	}
+
+	if err := txn.Delete("board_webhooks", k); err != nil {
+		return errors.Wrap(err, "deleting webhook")
+	}
+
+	return txn.Commit()
+}
+
+// authorize checks the token is the one the board was created with, and
+// returns the board's ID, resolving any board code.
+func (s *service) authorize(ctx context.Context, board ooohh.BoardID, token string) (ooohh.BoardID, error) {
+	// Read the board from where it was written, so new boards and tokens are
+	// seen.
+	b, err := s.s.GetBoard(ooohh.WithPrimaryReads(ctx), board)
+	if err != nil {
+		return "", err
+	}
+
+	if !ooohh.TokenMatches(b.Token, token) {
+		return "", ooohh.ErrUnauthorized
+	}
+
+	return b.ID, nil
+}
+
+// privateNets are the address ranges that aren't reachable from the internet.
+var privateNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{
+		"10.0.0.0/8",     // private
+		"172.16.0.0/12",  // private
+		"192.168.0.0/16", // private
+		"100.64.0.0/10",  // carrier-grade NAT
+		"169.254.0.0/16", // link-local
+		"fc00::/7",       // unique local
+		"fe80::/10",      // link-local
+	} {
+		_, n, _ := net.ParseCIDR(cidr)
+		nets = append(nets, n)
+	}
+	return nets
+}()
+
+// privateIP reports whether the IP is a loopback, private, link-local or
+// unspecified address.
+func privateIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalMulticast() {
+		return true
+	}
+
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// privateHost reports whether the host is localhost, or a private IP address.
+func privateHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return privateIP(ip)
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	return host == "localhost" || strings.HasSuffix(host, ".localhost")
+}
+
+// key returns the store key of the board's webhook. Keys are prefixed with the
+// board ID, so a board's webhooks are kept together.
+func key(board ooohh.BoardID, id string) []byte {
+	return []byte(string(board) + "/" + id)
+}
+
+// put stores the webhook, replacing any with the same ID.
+func put(st store.Store, wh Webhook) error {
+
+	// start read/write transaction
+	txn, err := st.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	if v, err := msgpack.Marshal(wh); err != nil {
+		return errors.Wrap(err, "marshalling webhook")
+	} else if err := txn.Put("board_webhooks", key(wh.Board, wh.ID), v); err != nil {
+		return errors.Wrap(err, "storing webhook")
+	}
+
+	return txn.Commit()
+}
+
+// list returns the board's webhooks, or every webhook if the board is empty.
+func list(st store.Store, board ooohh.BoardID) ([]Webhook, error) {
+
+	// start a read-only transaction
+	txn, err := st.Begin(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "beginning transaction")
+	}
+	defer txn.Rollback() //nolint:errcheck
+
+	var prefix []byte
+	if board != "" {
+		prefix = key(board, "")
+	}
+
+	webhooks := make([]Webhook, 0)
+	err = txn.ForEachAfter("board_webhooks", prefix, func(k, v []byte) error {
+		if !bytes.HasPrefix(k, prefix) {
+			return errStopIteration
+		}
+
+		var wh Webhook
+		if err := msgpack.Unmarshal(v, &wh); err != nil {
+			return errors.Wrap(err, "reading webhook")
+		}
+
+		// Update timezone.
+		wh.CreatedAt = wh.CreatedAt.UTC()
+
+		webhooks = append(webhooks, wh)
+		return nil
+	})
+	if err != nil && err != errStopIteration {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// errStopIteration stops iterating over a bucket early, without it being an error.
+var errStopIteration = errors.New("stop iteration")
@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/dlmiddlecote/ooohh"
+	ooohhservice "github.com/dlmiddlecote/ooohh/pkg/service"
+	"github.com/dlmiddlecote/ooohh/pkg/store"
+)
+
+// now is the mocked time for tests
+var now = time.Date(2020, time.February, 15, 0, 0, 0, 0, time.UTC)
+
+// newTestLogger returns a logger usable in tests, and also a struct that captures log lines
+// logged via the returned logger. It is possible to change the returned loggers level with the
+// available level argument.
+func newTestLogger(level zapcore.LevelEnabler) (*zap.SugaredLogger, *observer.ObservedLogs) {
+	core, recorded := observer.New(level)
+	return zap.New(core).Sugar(), recorded
+}
+
+// newTestServices returns an ooohh.Service and a webhook service, sharing a
+// new in-memory store, along with the store.
+func newTestServices(t *testing.T, opts ...Option) (ooohh.Service, *service, store.Store) {
+	n := func() time.Time {
+		return now
+	}
+
+	return newTestServicesAt(t, n, nil, opts...)
+}
+
+// newTestServicesAt returns an ooohh.Service, configured with the given
+// options, and a webhook service, sharing a new in-memory store and the given
+// clock, along with the store.
+func newTestServicesAt(t *testing.T, n func() time.Time, serviceOpts []ooohhservice.Option, opts ...Option) (ooohh.Service, *service, store.Store) {
+	logger, _ := newTestLogger(zap.InfoLevel)
+
+	st := store.NewMemory()
+
+	s, err := ooohhservice.NewService(st, logger, n, serviceOpts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ws, err := NewService(st, s, n, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s, ws, st
+}
+
+func TestServiceIsWebhookService(t *testing.T) {
+
+	is := is.New(t)
+
+	var i interface{} = &service{}
+	_, ok := i.(Service)
+	is.True(ok) // service is webhook service.
+}
+
+func TestWebhooksCanBeAddedListedAndDeleted(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, _ := newTestServices(t)
+
+	ctx := context.TODO()
+
+	// Create boards.
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+	other, err := s.CreateBoard(ctx, "OTHER-BOARD", "MYTOKEN")
+	is.NoErr(err) // other board creates correctly.
+
+	// Add webhooks, by board ID and code.
+	wh, err := ws.AddWebhook(ctx, b.ID, "MYTOKEN", "https://example.com/hook")
+	is.NoErr(err)                                // webhook is added.
+	is.Equal(wh.Board, b.ID)                     // webhook is for the board.
+	is.Equal(wh.URL, "https://example.com/hook") // webhook has the url.
+	is.Equal(wh.CreatedAt, now)                  // webhook has the creation time.
+	is.True(!wh.Disabled)                        // webhook is enabled.
+	_, err = ws.AddWebhook(ctx, ooohh.BoardID(b.Code), "MYTOKEN", "http://example.com/other")
+	is.NoErr(err) // webhook is added by board code.
+	_, err = ws.AddWebhook(ctx, other.ID, "MYTOKEN", "https://example.com/hook")
+	is.NoErr(err) // webhook is added to the other board.
+
+	// List the board's webhooks.
+	webhooks, err := ws.ListWebhooks(ctx, b.ID, "MYTOKEN")
+	is.NoErr(err)              // webhooks are listed.
+	is.Equal(len(webhooks), 2) // only the board's webhooks are listed.
+
+	// Delete a webhook.
+	is.NoErr(ws.DeleteWebhook(ctx, b.ID, "MYTOKEN", wh.ID)) // webhook is deleted.
+	webhooks, err = ws.ListWebhooks(ctx, b.ID, "MYTOKEN")
+	is.NoErr(err)                                         // webhooks are listed.
+	is.Equal(len(webhooks), 1)                            // webhook is no longer listed.
+	is.Equal(webhooks[0].URL, "http://example.com/other") // other webhook remains.
+
+	// Deleting it again, or from another board, fails.
+	err = ws.DeleteWebhook(ctx, b.ID, "MYTOKEN", wh.ID)
+	is.Equal(err, ErrWebhookNotFound) // deleted webhook is not found.
+	err = ws.DeleteWebhook(ctx, other.ID, "MYTOKEN", webhooks[0].ID)
+	is.Equal(err, ErrWebhookNotFound) // another board's webhook is not found.
+}
+
+func TestWebhookErrors(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, _ := newTestServices(t)
+
+	ctx := context.TODO()
+
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	for _, url := range []string{"", "example.com/hook", "ftp://example.com/hook", "https://", "://"} {
+		_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", url)
+		is.Equal(err, ErrURLInvalid) // invalid url is rejected.
+	}
+
+	_, err = ws.AddWebhook(ctx, b.ID, "WRONG", "https://example.com/hook")
+	is.Equal(err, ooohh.ErrUnauthorized) // webhook can't be added with the wrong token.
+	_, err = ws.ListWebhooks(ctx, b.ID, "WRONG")
+	is.Equal(err, ooohh.ErrUnauthorized) // webhooks can't be listed with the wrong token.
+	err = ws.DeleteWebhook(ctx, b.ID, "WRONG", "id")
+	is.Equal(err, ooohh.ErrUnauthorized) // webhook can't be deleted with the wrong token.
+
+	_, err = ws.AddWebhook(ctx, ooohh.BoardID("missing"), "MYTOKEN", "https://example.com/hook")
+	is.Equal(err, ooohh.ErrBoardNotFound) // webhook can't be added to a missing board.
+}
+
+func TestWebhookWithoutPrivateHosts(t *testing.T) {
+
+	is := is.New(t)
+
+	s, ws, _ := newTestServices(t, WithoutPrivateHosts())
+
+	ctx := context.TODO()
+
+	b, err := s.CreateBoard(ctx, "TEST-BOARD", "MYTOKEN")
+	is.NoErr(err) // board creates correctly.
+
+	for _, url := range []string{
+		"http://localhost:8080/hook",
+		"http://127.0.0.1/hook",
+		"http://10.1.2.3/hook",
+		"http://192.168.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hook",
+		"http://0.0.0.0/hook",
+	} {
+		_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", url)
+		is.Equal(err, ErrURLPrivate) // private url is rejected.
+	}
+
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", "https://93.184.216.34/hook")
+	is.NoErr(err) // public address is allowed.
+	_, err = ws.AddWebhook(ctx, b.ID, "MYTOKEN", "https://example.com/hook")
+	is.NoErr(err) // host name is allowed.
+}
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbc6bafa348d626fa575a5be71b391d400001299d0f601bb0cdcd36e6367ad5e26e30f73b8cfabf1f7967565556577757f5bcdda3918e53b921d68a15110f41c45af1ac8df6fffac8aaa4ee3fbefeaf8f341b1e63f0e7b02e41549459141571580f31a8ebfaf17819ecb3eee3eb0798ebeed9377e18f7dfabbe7c1ccba6ee06c31f1e1f5f7fa79f2f1f9a5fc61f5f3f7e12f775f8f1f5e3e3cb87e977693c7c2b5feb7af80763a9fe103e3ebefecf8f3f7ffcd7978fdbe017f1c7d7a11be3efc235f6fbbafaf8fa51d5c39fb2aa1ffca288a33f05e3f0277ff2b3c20f8af84f59f5a760cc8ae84fa11f3ee28f2f1f522d6645dcbffafd1ce8cf69fdf1e5a379a671f42afed74fcff86911d6d5102fc3c7971f9fb5f4bb67e00f710f5eadba7f5af9ba66550acab8fcf8f2316465fc1a611f379fdd076392bd460fd621ee3fbe7c8475d97471df83a4f087f84745ba65cda75c0d7e56c51d28b27ef8aef80630ecd666a87f2e00ff5b8fdf84306b1e9f48bfcbd18f9551efff22c4e1afc588a46982fb8d0264d51077955f80389afd2eeaffd6ac28b266c8c25f348fd2ff41fab979e757d13864c5dfa9eac76028e25f2aca88fe4578b5fb410aa91f841f1fa07ff8c4af2492667e25d304f983fc37430ec50ff3b4d038f76b0934cf6cf9f8f21157611d6555fa4311f87d45fc28077e1f33d4af3459e577eb8f9a47fc636f207f2def1fe4e67311c55d57772f5849f9eb85f9ff48bac19b723026895fd4e01177f17f63e1fe6ef35f5e54e937fd1f341dfc675c57e0ef9a3ee2c6ff37750392ac18e2ee77507ddb9b7f68ffc6651047ffc24eff7dbb7e88ea17be87df3fbedf40d885f0b51a7f7ece9763f08bf44755d88c3f8a4939f47537fca8aae261e8fc30fe5157f79fcbe647555317c58ff2df36e9e2a488c3a1c8865fa9fbac4a8b3829b2f4f1ab51fbb50ffda200f112877135fdbdaab1fadc2dbfbcc5b81f8afaf3e9eacf0bc8eaefbee09bba7cc5996f371064e92fc5a1ffa9fcdd0f942fcffaed06cab118b2c6ff9c944f453bd6431c355d560daf98f0f1e5a38a876f57f01886e687e2e7e5a7d9fb59f913e2efba97c76dbafad3dbbee4b17bd57cbecdbaff9c805744f986fd757badc6f8bbfc7d563f4b69bc343f1740bf5683ff9a9f6eac866f8ff3bd04c2cf18f593f4f3fcf9435d7ebad8dfd47c9fb8dfe8fbf505f2fb82e9872eac3fdf543f7459957e56ad55f8fdf64bf7dfdfdf4f01eccbc75865611dfd5002e39010ccaf65f653ecfde46537c5555477df3d54e157e99feb2e050bf8293e3dfcf0e193f81fb76cea6225204eff81169fb7d74efa576c7ff2dfbfd760eca6f8a7d8f73bb68f6794fcbed56fc3dfef34f80333f15aa451d5bf7ecab8effdf49f75fbabad908e9f5bed0fd9365dbdac7fc098048fc60f9fbf63994595ff4f4cfab5ffee0aff91c56b97823e0ec72e06411665ddf84f67f3d37ce8fcaa4feaaefc3dc39fd6f9abe33f6a5bbdfafdaf2f1f66dc0f3f9f42abb128bea97e3e767e53a975f402fcf57f7dfcc1d3b6ea67d54fe7e37f7c80976ab58efe5edd77f07f2eebe8d3cc8abb3efb3c5e137f26a88fbffef5af5f3e926f987f87417c0549f73a965611e807ff7508fcfa8d7cbcee513cf859f1d949f58d1e7cb7f9f2d1675bfcf19560f02f1fe5cbbb7c25090a512c4550c4a7e62f9fdee7eb078993f8ffc0d1ffc0699320bf42fc2b4d7baff0d1ff257a3df6b7197879ba179389a78faf044e7ef93856f5c7574841c83214fee5432bb2eaf9f195fe9ce8cf615984be7cdcb3e8e32bfee543fa7e77fef297c68ff0cff2357a75867ff9b8fd0254289edf605338c7bcc43a7cf69f46fc90952f04b738fcf84ad01c45521443715f3eb4fea5c1fffae543fd1b13c8d12449d33f9aec7edfc4f9cb5fc66aece3e8e3ebffc4bfe05ff0fffa7c57aff3fe9bcabda9dc9bcabda9dc9bcabda9dc9bcabda9dc9bcabda9dc9bcabda9dc7797f602f44cfff8e9ff37bceeaf5f3e227ff07f7acec6efe26af8a5db5f5a7e8ef9af914610f6fd3f278e2f839f58e38b7cfd2ba491f84ae1ff1269e4985f4823fcdf278ddf48e2bf9933421cff3dcef837266fcef8e68c6fcef8e68c6fcef8e68c6fcef8e68c6fcef8e68c6fcef8e68cff79cef849ebfed3bc11f4c35ac47ffe5d06f98bd9cfbf7d247e26921489ff0e83e47e6490895ff4ff94427224017fa690c44f1412428265ffb5df3b12ff9843b27f8f43921c45b2bfcf21b9dfe790dc7f9b43be4ebd7fcb237f79dddf2aff1951fc85177e5b3adf69e1f7c9ff352ffc91ed7db3fe9b3df68d87fdb205ffffbd2b7fd8343fefcf0f5fbe0ec16e4edd927bc63701ed323e3dee84dab38bca972fcc717f98d5dd1ded2afcbc2b4f93273fd378aed3e38e4fbd921ba31d51ba36d104f29339ee222d20693c24ad352c45fcb4ce69488a63b8129be79c1a2fefcfaf7647e9f470c9a10a4b8e08cacbe0925c1fc02373dc69927677896bfe7c8df7ff7efcbb3d4756fa69fc3b2ee3bbcdfff6d70a2fcff1af259ed8ff8bbf568034f1bb5ee3d726efccd33bf3f4ce3cbd334fefccd33bf3f4ce3cbd334fefccd33bf3f4ce3cbd334fff0738ee77eaf61f4d3e7d1b0324fe948575f5e7a64aff399dfcd1f0274e4973f00fe6a0fe964cfe7e0e8aa1a97f470eea13e2bf3f07f57f864dbe7350ff37efcf5fed9d5f12519925e8d7193f4b69cdf33cafddee8fc33de5797ef712f974c7bb3ccf0b5b5c75f8a7c6d16e57fcc8773d1532179ee70fd7c23e14a579c7f973fad984e7dda8e1f957252fbc2ed2a79ee779e625abdff5079e3fbf46f9c5ee78e6f7bf923f1bedfa47a96caf922c150793f8acdff13ffdfbb4e43fc11f7e96f7fea3eaf8fbcbf4ec9dae07f16ef345e60aa958af87594acff9d1e47dbe551faa50ef4ec7804f66575a1ea1a3c0f37e500886736bb980fe456ff6618363c955452c6031f1b6901226e19618a02eabdb0e110f5feeb2a40c2a58d4c61e4c93473ac3645bd334d1583c4db15e71e0d120ad72d0d33c740a60bd4b63616c751a1974ca38409dda8caae5a06a100ab8ce4301eec810d4373ba9f0a81f95348e3b0b22f6c86c2caaba5dda7617c9cfedf935b17b00e011576faf87dd3f6ef7ab60495a7ae135587826d5e1cd297030b7a802b87a97c6591862c869c690e41d41251691c4b179ea70da162d2256abc31a63b166dadcd82c1e166fa70de71492f36c6da56b9f030c299a38d0aa331161133ddec26978b89821975c606b0b03870da31bff41a1ae5dd994b990766ebb718279a490c615c026752f6b24a756c40a94695b3047df2232d15999a3b87e7b72ae2a3f1f6c6c580ac61abb075e87f27eb90a986a473a794eaa1c37e3647a66e18a1402e0b044c13225256b1bdbc2c6fa2e20418473987851f7eafcc80792b847fbcbe1fad4528dbf4a1792c78583c7bc1690d1dc70cfaa0b780f3f17d1ed6ee9d733bd738fc7ff54121414755aff7ef8fad9eae7d8c53034f11f8c5eccbf257a7d03f90fe2174190ef08f68e60ff8d08f6f3aef8fdf025289f1b7afe16bef6dd31308cff70f83af4bf0e5f87fe7b7cf28ea7f21f86af22fd297cedec9f64493bd95c4ba5f3ab37e1337cc5bcdc43270e4e6244b3ba0c2f5c868507c60d54fe783a2bc74b5a1eae82793da6a57a91ae074ddaad0f53902445105c955f254f2cd2f42a1d0f2b996a4e59ab042d5e76028a4796de9e9be1d5d1162f78e43de8784c30974151e5c14b12b723d33649c5744c0222765575654fbea65255d5d754ccf33cf3fc7ebfdf7fd39df6d66c0005eb7956c97aed37f53fb539f0ee41d9ab97bdbacfe7832449afa9745df7c80bc2e391a6fc41920e8fe1a2f23b6d6f5d1a8b683cea87ba1fecf9fbc41f3afed76d7faedf09c46ba88360f284e3598efbf7c71276f3eb7ff607b0141651bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1bcb1fcf7b1a46ee68aa892053f73412e4feb1c8ef35e26ba91ab057325f0243cb43403882a89ae00320457892da087eb0a8f6a4c7608438391481c3b59756aedf7fc4c503b9e769d7336abbff91b60ea692764071ab68bdc96c618394786066c3578db360f6a6c3f684817c6996a68ceaca62014e9a89a62a1a0bdd599947318734cb7422dc6da66ddea21b97b88068671bed360ab27e5d0b3ec906fa82b13a7dbd6da3026a8861d261b6d1732ac69dadca335a0c1d0783067c3deca8865db931b67634b4ae9293c4a025d5fbbfa11761215e7892ee652911ff6d8653b6cd3a46ed5d6e372c2e8e767e49eb73b7ded2656e0b68956997c283477593781085a52ae622c70c5a22e8a7e9825b70eeec4796d9fecb8972bba8cc39a56d6658aa751a4afba932834cb6c53a2d893dd654330907837c26052a6de403b4cde3ff09e2068140415368ed3398aa2880434c6ea7ba1af0000dda9da686675b1440f7b6807b6c33d6a58b40de02671596807b314080b06731263921bb6a6e1c4602c3559d3d94e1cca708e271a4449c1b2004d8e6421c5b8d0d804ed6beadf89a3c49fec7dc07e7f9fa63b0a440009144798ac646802a120eb15c602d6004e81b0318f61c4700ed9d376a52a6b5d249ce33e1749327bb7df92483da0151186deea91d819b7a5079b13b5518869c3b457834ce6ad9e5975033cafc13db0160fb0a65812f11357ee014651ae7b93b92849085cb38e913f1c9ba37865e5fd637539c6800088d5f54cef1f33ad3d7cc4a82473ac40acb41eeb25143665d18ef046c8b00d9c920e684cdd99874d370a362a13db4b07de3d74fc93f679110cc64e6b80f84c8fe2491d7648ec330b8241c042620aca59de29f8fee120004e00607792e6cea33338897ac24a3ac7cecbf25c091322728759008010f2148c3ac8712ea8a616d2769e83e27039dbe0e6caebbcf636af2d7b7f42d5d4d687122b1303938d1539c490ded35b515a0fbcb967f8bd9b033a31d6185348304db2c44e2d044f3db0ccf14ccc4f3043eac1d3dae3a2eed763b7640e751d9a9d971fc93b6ddf29d590e7b29cb66a60b81e91b3d10f6a82081a807e48ece020308c9e8ced25f2797c8d86c4ba1328730f90ef737235ec537abab6c73b7032d108aa69322d3ae90156493495741dc9947c4a2ec615dc39f6fa8831cc5584dd26f7355d58742af0f6282ac9cec2ce4aa74793e71d77c2cd4c2e2575285c9e2875db4b7756b6cf23f2d96d7d3c618e3ecbbc44b54ac36db078c67696c31597f70fcad57978bfbb5657d5e0caf27ed015be426d4923d4979ebff484577ffac1a3f27c8d4bc9775cf051be94dc2d728e2008cb28d579f5da2d34bb8cc6d95a11f2a6aa5020b378e7f0c4658af54ca87b7ff4826170356a8b1b21bdf5341f0ecfb95328e45dbd972f7d7ad545e5fd209bc54e97a50d353d101e6e7cf1f596093563141ef95a25937e448a4545e79b3b2c803dd9bbc96874f2e25da94f37bdb2b3f2e9b78ff2f3350ee504be70de1e4bcf092990028b579774cd6dc0de315474533b24c1f6446db8c082a298253b7246026845b350a4ea20e2e930b77b885597b38da263bd2583f7c4b4d37c68305c3a1565b35a6b705ebb57301067294e23bbab0f44b93f083e1216898e2a834b569baff70be7515c78db2a628a934892d7da016c7d100d0e11538ad92c07e86a52787ca172f78927e24298294777109e8429421697d8f87c5177c5a88901bcac4fd5e753decaa57a517ad3c823eed178f56b2d5c52978795d5f37e30cd65e8c1a5dcc1ad141da2bf736098b60980b50467257e38f6232700c73651418260a0235306957d1a317288f6c3c2943b75717dad645b822028c4560fe2e537a38c1c2ec0312e545c1a8c0b0830024c36c8e03cd1a0a4a4a2b2d8c6c7ba3370e81d91ee88ba01b5c53467042ae4da491a3e97a3c46b03331fbebdef60871d483f0d2607e7d838d69a7c653acb5f7c4d3d86ba7cab038dc4c766dbcaf572b28d1b7326ad644a264cbe5641d4528f2b4170d0490ad1b8de1730ac282281d7db5a4506cd6073253338e86eac6aba8c1189a2c6e2c6cdb3413041027348465ce7193a03862908c3c5551fa789a1061c653590a624391c90e198b7446ed9a17daa7b89e667e1fc3a177c9e09d2f82209e74d88e0353b11322f958845521a4cf8730b9550aec4738cb9ca86754d57d502f1c4303775829de4b293cf4593bd37d9cd0c3b7f4495db04f7f462a09576120c3fa022d1c50e2e511c1db78a88f484554c3be759cc128d4066314e5d37720c63e05d2b2252b16d250c0384056d3ea7c4b268c69e625ba367904f671d00ba9eced71c926d621cc054f8e3689cc1fae4c0d4767da7c85dc071d454efacc4e00abc732379e43492d27b17ee65e274e1f99d7afafcb3d93b31b5e36734ee95cf73ca691fa62e4fc2cb6aa57b33c26c27ca8c1b52a2db61afba539d5c316654615c5e078b9ee86056ba136ec129c11b7af5870492de79bb9295327163cfd0eda474ac4dc4776fdba626b6e2134dc7174e3b9deea8330c5f68689f1a6574e88767c2061595191820674d579c78389867db255740d2804040a26ddd5b22f6426d892c341bd0a370bc21c4f6c31131e000bb2a906bede1ee8ac6c1dafd304ff7286a7246e52faa2a1f7089aa6e6ebc67f2f1982335b9f39494fa2564e295802d1f09b201ad9333c71bbb904fc78a8c1854d81e7221aa8cf3116f263b31b718921abaf2fb1bc9a6c420e7262c9e58241a5dd6394e90509ab7307c3c3de3b4793806d79c6ed8b63bdc9249bf28c8b97158e08bc0a1c6a1795e0f3a47bb0d2e480c4b9fa8cdbedcb6dc1683c55b36b6c3aec5303c36118f99e52ae78583b12fdfc830a962ef5d163343b668c46239f09027bd34ba8ed3182075169a11e8bede4036d8d85972d3c0b0438e718612063467eef67d48b366b7c9448719d3c932d9819889854d828e999dcd00867823a3d2b66d92ea64afc80f737c799d05a820ea858d471caa3c9aa54c592e3210830c281ec6cdd70548af7376fea0f6383712a617454e8aac94d23038db09706c9949ad89805ba962f76d409d869bea985c3bc0ed88c9b23c8e518d82ac5ad847458e8f20d97396e9fa884655a2d018cd16f07c22e8d4a2d9105b6c73b8b30e0326121808002e805259e081d65c26d9c140d8174380b82cb1b8b5e446eb86b3541faf8d485c00ae4611af275132b80af500eed85921d7f0e6ca445312f4964e7bdef028165330e56b25a9f43e253928f5746400f621cdc279cdbd5a231d0da712461c5b2561190fb3230790def67dbded71a9e9a26e44801c9cd33c1f05a2e7fdbef200eb891514203773c74599580f20909cb7940b2d9ed22585d7897a47d0db949ce3618cfc71ddca495d27c3ec362d19c04c364362245e94c00923fbd4ef9378728a9980233178808dc0646c861b3c43101f926247549578d0afc70b134abb3487594426d292d3778273ac1238476e8f38b8547c7860ede57e9df3ddcde484e59417ced87f5b73b957ab8ea44768c984446d01603788bbfcecbaba5caba6049c28c5bd5e0eeea9e2b47b006646281da326276c8289c2f5803d4a0f7e0846a9db8dd3640f2b67c52ee18732e8ef077435c48bdca617b6c760322299b571c7e4edcae8fbd8690d509a623c20cb25f492a5e806bfcdf2eaa581d941282881a34f1adbb230bccff7057777d648a547fa41d81bb52b6abe700d8be6f59b181b4592edf0fd4aee45e9b19b1f79ed1e3aa1d789a01f4a5d44b171183b547837b653af7a8de634771567f0a92da2274367368fecc9a85dec52c640ec707e1cde8b80c3afac36842439d57459ae441a5dfafc329c9ef929e64f367fb277434fb3b43edb0f7257af79682d377e56ec3b1fedf92620a27be558a0b72e0c395d56b2d36bad259618900668d2454c85f3daa4a2650c115acb21540d5125cc1c86032c36db998c984175692f7bec0a97e169f0653da7477a216cfcfbf31f21b5dc1473472732d5eeb4d4370ee99127085e5a52d9be777c147754e4ef29c41094ea77eb39461ab636d14054fcad2f5d81d8ae537ce711c61409b7114c605cf42e1f8dd8f2a773d901a68527624a82db3de606bef364bef0a1ba3b3ecdd0a37656fac241f0378e8fd26398eca5794ef1a37d50a33d5fb867b871ac5903c2a8b7b3cf82dea3a7b8a7b7b1273135d5baa5f75b3dd5baf62810d0b10a8473c02e828475a99dd59c86bce609e92e4aba3e92157fe9f9fbc4dfa663a6ae4f941d7b6ccbf34b5e7314b7e2e3a3a8a756a813e4d4471c7253450180203978e66ee3c9aa7fe4f9a51a89912c18cade1cc709417c524a8526dc04a153b2c890940ed84450b1167027427f3a7c59829ecaeb853275d66c15e566b5b2a1f98153c63ade4947e4c090f781c9f9eca1139ece712e5c8b202c6b02853337ee2d7217de1f0249906ea752fff4f3375638b3d979f385fc8ce57977b19290b8cdfa4157f37d7fb221f0492f24225428361c87d88b21878ac4ae1c61673bc9bac6d0c0e48835260a4c19b333a38d6e2a0280408d56d5169c21a874b693455b7552ff0ea3ea7089e92928f6169c2acd8b290e158a03279bf3388c974d0a469785be110302a0748629e62262d888ae740864836419b3b977fb3de5dd996892c300da5d3f357ab4361d8208f6534051ecc8081b51fbe3a21b8ad4c72cbf5f870ec173dcb74174722029ebf4ad03d8710aaa6380bab30193ebea783e6f8551e94d244bdf603062e3b2ef31628c7d8a62bc70c9eba829b5f0c8b66ad8e01e2b25224594f6b8eced286861defb8c7489482a303c432f9f7d46f7443e964bd2c58e1654daa691bd89bbac51315326eeb09d90cb3d8392e7e60f791d2378e41648630147e88b83c4a9723a32b707ac63780c5b79cbe2828e626aa882890147e286c85e200a9b4bca7eecefc39adb530260da2c7b697f9e8fb62282438b354ee8d5cf331b792211b9119bbb07427a0ab23e6678f52de725d8e471f26e3cdd3729571f374f3c68f9dc1e624bf139ea1a55587dc12cc78b627bf258008602828ef0129620689323510c49a29a202210d3032a02040b0c2c96c19a22a33b5b6cab672b3b12682307dbd91f6874e19f38bb54ee45dcc6db0dc15c232923bcf3810012620c2de238f84954741e381f9d228653028167df587604d3f9cc8ed8608fd552a26068262918e2b10de470ec9c9e44d9828c6ac9d971ec20c62123a716b62702486fa493dbf68d981d0776360e3820d4568eda255b609f9d2071c4157e7cd4bc3624e63508387cc3acca03c8d10a6eb95a05e22c67c0c604895c8df6dee0e7933b22b0e481b2e1343bda2b46d5d2cc60d86c5b4492a0ca858b6bb8e595818f1b05125873b672e62d874b76c4def229f3be851c728602c3eff76269492df4b78033032208c878783c1dc441f4a4b1bb938045269dce00744e26f9bd41814ba627d8a943548dd87182de89277cd5b99b9cad465dc73709c9210e754ad712ec104340f64c41c46cac97ac3b8202c92ace70a4e3789eed0431c7055a0e111913be9111b34cc18ead330741c5cf1e193676a83968d3a63cb08e411e635f395890d6c50c13b20fa72d63b8418512034e889e3a5f0fd0daf45c3ee565b63256659cd3a8bdeacf7b076bc16775a9ef7c0c0fa4b29a1892d4418b4decc01c966966636c93346ff1c6a36c428960b0316084c148003bdf4026a752178c46a697d78e698653baad52d26155e5bb54b5c4894eaee8580ac46ed5767879530b8a2f5cbe709d71d6632406a11eb5c453f6c6bc55fc7e509ce19c5dc2db9d078ebd5e22808563932fc3586aadd0c9719e99d36d3862d4e028530d621a538c11096bef7000c12e357183f27dd98b92aaa4e92731f995546ddead99fd987b6e89b032759469c17ce8d4496b88cdcc27cd8d315f8b491b47b092999093950dc3da02e670ca0961849862c0d1a924a5a3a764c32fb050f7a4e6c1e9acf5b1bae4e493ee652583b68d9b861c0ca2663286a4c85a7b3a6ee7db2905908b818fdf71c7c7e4bb376275bfb4a316a16c1032e3808cea9ab32d99005489a991a7ca4d738255a924f9b5ffb1474abb15781cb2b5578c3672b755bd10a9eeed762c9ac04e3ce4e2cc15b918775d1ae704aa7c3a97f707b2181235886cc73757e75c0ae2786ce64917977ced913175d7be65259c2e06479a037a1911ecea48a9a660c66a3a1df7ba099935d8e7dba6045c46c263daf743ca4916263c5ae6481f7b300470ed10533b7b8ebb1ff19e933b510f2a00d8f69eb27cacda5d1773f4460c36b737586a9c32339e86c171ea47b01320a91fa27d8754d3857dcc0c5a3ee543c20f2d7bbdfb5db700c42509a92f57b48706662cc82410e03066b29eb82cd7ac4c2e5d34acb02a8684dc8f2e565510d735d7dde8a982831978583224bc4318cee0a47b8560aabcab2193d73d03e9783fdffaf592ae735142fa764d5fe715a27e248ad1d017afc43bf2297b8e1d6af946f636944c6c75b95611b6a3d81334141f01832d4d155fa255189f3addb288228d7527a22b1cc5e1b2eeddcd7b1c00f2771baa6f93019cbbd6f76d5a702899eefc7085ead2cbdda534ae5c62101ebacf7746539553c84130c4647286093794e97dcb48c59aad0b165283792a7725d1b1cc585605c6de10cad47814a2fbd1ce6d7651a6237ba7f14aee5cb0e3ccf85479563db7ae76f7e2b98fef6ad0cf46cdba5b90a09203f11aac8363b32debc4041edf772e87383a1864b0a5857af757c98f8955e93626c382ec22b4147ddae45dc851421357418a0d9a1120ede2b29bcf52c885c2a8741590362a1faef23d56e72d2f5492446777c8a5a607a7f9616e71cfe050ed9ac1839dac8ae04961fa901424ee38459e954fd554136471186296e25cd130cf73db515682c51c253bbdcec416855282f46cc53c8f17c4968a116c02c8c1d06e09e75385cd05835e78c4b59a58d8dfc36d9a400d1f916cef42bac2fb1d204369ea203076325fb84947b33bab0be76376d5962a3dd9fcd94e3547deb1c201493b9d82d581b7716d27f431166664ba5048d249fe5cb9edf860d47da21007fb9a5d9f472e3b8e696f54a24252f25321d3a26ae9851787e416e2dd841135cb64196f50a507c125f4b2a78e9decbb4bc8cf0b9e2dc5103a642dc486a81bb38ae55a2e167c34c2bc326cefc0b9a76607997daeaa295cd42d8b3b7709a66b786f1ce3e4dabc1776f2e7fbb6bc4ba27a997c6fbb07b681504d82f24010dd78ed467d518628e8312e9a82a68f8d2da721ae52c49a5c97f3cc646b30aac8df2e273b77d0409c463841a2353c4c37333598960cf55a160c5775ebbd40a1fc7dc789329b04076c31fd2c8800825a70f0894eedcac9b1d91ad3269b50440ab90fefde70c6e677ab5e86c27eb210e40b975b0257cc732447a4d9c1f48139e4068bfc640ff59e78719fe441760773ab7a97038943ea37c276d3c0a212219e4f9971dc49495434fa37dea515506e92860d2d3db6b6e45c7ada723267f85cf93ec65c4531374d26da7bb155be817bdeba4ca6f87896e1ad6fd1235e351f4e315962fbf339daee8ed44ca291b3dc8e0ca60470904c6eaa87cfd15dbbed9fd5414a291144fd82b76952d90422fbca5d60e55d46d11422855e72290d0ec12e3e3cf46d67d5947d449e7d56cfb601c8608b952b92c6055b637d27d4d446e8ed66b7729b5549ef132be62afe4547b6bd46dd41c499e34825400f50ac801ed3ae9d3e2c77ca8a1c8056829773fd0c6dce4c779762dbba1ef6a222de0255cdf2de6d6c1adf59056e50ddbd371b29417611823a61c6ab74659ef8a237846f0df13e31efcce2e2db236f4527dcb781b8f176beb57be58e29c6c97f6244b523f268aeb38b2654f9e79cf38afd5070374fa0d85fa9fbe28a85734ab9f419b3f196e383b1d173bfe32b6af48ec9d45d7d2327948ca4080e866a889d1ea43a088f0b5a6b5b44c3e86e89ce73adee2e59b5f4362265f9ca3243e74a03dcc5dd0c5597db4adcbe1f7141b5f5418854cad50125ab09c95d0f02c3ac2739b9fbf787483ee8c652d5cccdd7534a5c79e7e234a42e3531b3103b8e73ba3eb82f0ed52df968f935c8a5668343af86ab5970fb8dddb5244d52f4b341b96628756f77f193444604e86d50b4a8cdbb80d9e43e2183e9bac9bae3615c733032490b0e5ad8f80c66823048c6141df447b895e8944074e0263c04e3306dd9f25cb9bb9b4f66afedc9e538eb2d9bac5a2a3b05274779763c836017d0f27c6ad5e34e0f236bd0f9c67f4d78111fc5134b1d19d90799a590ee2992760b710c7139e064e770b1486d070d6b8ebcedf65cafd97297d74716727edced36e7e0dfa3a41aaed74466c29cb8a55e04b93064e7e5bea9f4a19df7aa137466be2e30b4c7155fa5e68af2585a1043c91e99869c2f3df7739deeac66bd91973e09ed1d330ccce51853ad6e9c4ce1112f89c2c794ea89eb9058a4d2df1b42d24ed2de1ad7912485bd5297f44665743002b019dba44d427922a83ce392965fe1a1f59ace695333e84ee592a9e94da5cd76b8c928e9f44ae90d0aeb72c9e95c7828fd9800177741b54930727fe8f2dc8711c675c67eda5ce5b53e93335b1cee59741932677f913a7b49afcfcccba7efb983b5bbe4d369354ef1c932fc7dc48e8d631244771c8cbe0005e9ea5d75cc82437437b34881455ea457c17431101743546415435d7a565ba4b015d0e3c446ee12c1ad683628cb8adc8919d25c802479c82b4b91ada324c521a56b23f20bcb8bb8d3e9b685a64f5c84f34a854e1822a289480e5d19a9d01d9ed384a51c1f682ef7e3ca7083b8702b31eac0bcb5f545b3e59300c0a499fd4db2af0974ef37ec0849cf93cb03676319bd07131dcbdac3a4f1c788bab874747a80c496b2f11983517864ba3873f69309d10d5d9bab662ba2a947140c71d6ebe431384327a22662b030d85be8001d21d706e1b2e19067f205f3ca9d979a16aaaefa6ffdb1da7bf955e1509ed53039b7166dbb92299e447cd4e4d819448265a30bcf261e0f30d642e7848a7590061538dffb0ee87631dd76146ec4f13e3c163ed7f5a0d5b9e5415e98ddcc918c7eb407a7545be4ae200e5dfa520c874ec9a51bea633cc81dfa7a103b0d0d505797008204a3dd61362efa43d2bd007664cfc4a36f26927a9d19f770ef0177abec6955aac57e24a8da4176738930c6399f98e4647295f04e46fa095c113ec2969a12bf34b32893823d82f0f4f49e56251f15d7d2998e5306948f36c1a04a9713f2332f476cd8304d87a2160a0fd477c4330f4f7a9ccd93b463851bcbdf7ad5a3f0d395784073a4a8f678e00fa5f9ecbd1b5acf0ca5a8a0794c0fd2bc6cecbd091d8bbc6a4b116c55bcba28912e62096877b80371c2f578c18d4493bb84c2f053a261fbea482a432b24643084a68dd5bccd0386aa9a5b0c115e03299cf360ecfd69a48aa6ba2d47febadce66e2ad1b4f6003030393f26432f87d6b962fbf6464b24be6608d210c9b1293d1d9e9c986b4e5fad09a8c88b88bd9c9f88b1c8c2354fd2388f6f889abab37647f9996908ecb0ee9811a4a8a43b48e658955059d92b51d316043f5e6696be49638899d20299b26290d94ce1058a49b7c95aeb8c37f6d693ad9c4f355fb82a5199fdbe25299bd7ae8570cdf36e68e683c28bca2c74d536b04fa06f0c2abb30b5b7a479c2c8ac303760e28c4305e30ad6b58da1ba50eb18f772a9e54383389ac48535a94009e014aa2c16e1ad9653cb2ed401454f0569d911831ca8df472f648d64cb185e548dac1a7282749fc44517ca40ea36a4c26884e0fab824d4140d7178ef8aca2dafdc2cf61123f858e84cc3bea975ec2810fc624c7604364fe242400ee66b29336dc71a52645d737153790a35376aa3345fb808ed866c25719828974737f1f862366e07173a933a4e41c692c6549ddc05eeef975bff9954d8fac22f42ae088461029de7f536953b724f5512be439d93eecfada41b5574e41ed46d1ce5a8841997c68bd7cb87bb93171a3576dba0608145e80b01c968855109414f4d9d4fe46a2b121b52e4fd83b3e2596b9928ee20c7f9d52341bd47e32c3e843d27eb32666c8244b23dc921a21b45a3c1966d0c22732702443ece80433cdc773dfb5875ed6e02406016dc276722bc74c41e5233978718de324e62b5c765e57e9b7b1788787764241119fbeaa986a216ef490b12547f8da26eab8c4ba6555eedb7ebf186ce381ab71ee3ba837d8d153e3260d3d011d1d0db0d3672c42ab95c84f89448126a1218b70ce3eb25d9c3d9688e184e2d175a336e8f240943a40c4554dd4e7e200380e1d12615fea1850ddf3340a7b58e1e43ccf20904c74e59da3b3b302c4bb964d7cb5377e9c7c392af65c0904fef7bae08c51c1664b5c4dd65fdd95b550fc886cf84e2e9631c00ab8d6a990f59c532ad767404131fb8ee81c0c672d1344054ce4ec301a6e8e2bb69c78913b9806dc402f8656556f0eeb3441f0ffeb60eaea2641962c8d23c58ce9e1984e5e8f81883a9478254b9834088f3b933340cb103e614ca06562ca7603d05921e0553a6b24b3059dda3a0477cc3a01ce60e0aefd411e36b7e7089002e3999ec0ea765a19bd22142f171d85fd9a5dfec2c2941cf0c9cac551e31a218c1f4be787d89dbdea5c312ab973c0e914738b0f83c33677cbb8274abf48d2187b8a40607c72ae832405d8d06f31642ac569f42947b6bb4592a07682ba44d6ee71663fb9108f612bef2c38be0963b5b59af0718a6ce98e51c1e886ecd4d7382191a3590884033bd023c5e12949c3744e2de62043c6604375e8cc2358f12e2486dce65e2e051a36be3a804d7849b4c5da3485d1f3a8e5dce704f04369d8f283eee66b153ebb31a6adea05f0af7757e1bc25c4a484f4659ce8e799e0669bc1da93455ac8d037a54b0f8909003b3e457c8e852a6c245aa0ee1c26e46c33ac683c439c09a4e5f05d7cb72bc509701a12188556d17a93a998a4a95c85634060c54c4c457c3695f9a24b18deb6297171fe3880e9d5b3937d55c24558b046c5c6aceb25924dc07778840c71058524e388124bdbedb2779baedb167b39e45c5c09801565cc16d66022626c3e6cb82cb37285ffa18e7d05a754c830bd44a2f194544cefeca02bbac06bbf7077bb4f7d7e7dd9daa218b8c942add9d1a68bdac8de7c3e5d6eb4aa68f5421ea1232628c71b9e729bd6a5745292b27761b731f6b3b7c19dafd717665d320a26acb0e18aea7ade67a5ccfdc1d307de6e82b2f77741546eaae65f0e8405b4402e28eed57d891aa472d07d19e76874b6f0f66eede037982b5581cf344bdab55f0d89801592d1e3a7c3c4df9c540fa91a0194d279651249b6453773b08a72af12692080021b141959060ba555ee20c010dc8e37e89276e6a9607493e6fc4118011e42b7d84a0b3155f114dd3c8ccced9127f1dba350ab67c98c0087da8c33031b0715bb9b2b6390a497a950ce16e22630c2258d358cb4866a448665772413f9a21e66724dcd535376c93ae6a48ce86e03a90581834ec1d1399a0736ce818607b20cbecba0e0388618d1d3cc81b4a859b02f2fe7906afb8293efd43a33e5d657ec5cbf36c8d9962d7b7f3287b67d3b0138e14c8fe4a5a76c22602e0176a6d74cd7e40d9afc1c860513656ee612776d81c027d7f0f7c3abd1ce664a77a7a2d3f33550baf07f39c0abe1371c556c6187e7ab40d30160a655ddf8f3ed21372c8ad58731c5273645c0a5842287035d6eb2951c1765e5dc6918cfb5ed64b73bf40fdc4ebebe928eb39aee2ae92dc49358936fa4cfacdd5df51e384c2a98fc7a5df5149024b7ac3c0a2ae431dc5c2f85872af1491ac1b6e93ce41bf3be184b6831850149ac639e27e4a6537a642229fe6e9263fc2ddb159a4fc8877cec4c67d7686c470ca63743e8b45212715836de37abe6891964b0a4741ad07ec5116b6c8597c528589d9b56ec87666a38f24ab1fef50e4bad320125a915b6c389bf9a8fbb43c404473a773342cb07b6aaa32360012830f6b823c45c6aa1d96c461b18e5282888807ba9c28c870cc14d29ba1117d44f7717cef74165ff7357b0db5a923cd19f440a716e44ece23cab18506db642c2c318575b9b040576548d1f22cb6606ac9c062a36e3557e0727b630878b6cb43527c7409a984cc5ca503272e18184600034fe3392e1a6c4ae1d820badb1c22e18980913b70f228816a9bc61bc4814c605ec11d7fcb5d77032ec48c2eeec3784274ba808b7989fbdd5e58a48240d1269258ea7adbd2b2d6601ba31aa8b30e58ee4a1b9b7a6e0730c70425ce860228d21b6396deaecb796068a6a658e149494f429e95b4b3eb7ebecc262f1963548142b51777af8421565438bbc107d49ee82161013e39be7feb70e9da8c8b988661926c630909edaaee13109101ca78faca0b7dcec4313114a57b8772d34cdcf43cc42ec6a1a6e2ca4a3ee2d6fe1679ab270265a640e045915240560bd07499383b4a085dc934c9bb5c97dae51a93d7e35243e29edb1a5d254192c9fb225b12b75c4aa4614b948be52c2c076a9de185f24562c2fa80ecd0e002ea219ac12aa802daefeb21c7b9764d36e41043cfc481c2a13a760a96d4162d1adaedb40c04b87524276245316ed60183d950a6b6174593e60d2d97500c38951772562860c230a0a46d411093c73e5e645e80545e3fe2f95ac951971631c175cd83e4a80b13a5b197133bf1413c4a8c3d9be54806d7ad400490b72afe7bf92141e7fabdb1ed9c0b965e73199d53268c0a8261a21ba18c9d211bf9b2eccf47c40027bc5622ef34e8849c18da54dfa37d3bdb253b2e5995abb68e9507142cb8ae8fea8eac0600747da59b47fec839aa75d9261a77106023d83f429ec276ac33b159f7e88921d55b5dcd3a811bf68fb06bca4bbf90e4fd2ee0c5d06cf818e7531f281d03bc31c6c2f56a9cfda0bb8ff9ae786cb9eaada401870045a6c3bbde3aa74be00692aa0fbbe43e2f5c37b49b0015fdd1cbe3de56f7e67defb30cb53875c2000c8bf326adb41a5fa5d594945e0b233e22d8cc3fb02e1358497b89f5b834ec0b26def0123eb15dc1c199cbc808319b8dada72074b72a975d2dd376be4146c0a1d4306d82ab52440ea057c04d129367bba89861cb92abed2b85ac8d63ee008e9815fba9ab832be0b4f08459f4dc7efbbd080fe934c74e0a992d33d78fb54854867361eea7863b08e1b5b6d0746428a49d3b17b7838a90f39bd2bbccc28b917cba4eed23443916d2cce89851734bb8b389998bee19b6cf4d6cb4b50bb7ecfdf16a841e530b224ce2061f983ca6e88af0e8fec4ce00a84a276bcec4b535b63849df9ac33308a742d284e753eab7ee7847536b8838b758ce5eb817334184fa5aebd818704660c8f53825cb22cdc756c190940c912ab8dcec319b21514c651da30ae412c0135a45abd6191c73b75d9d5b1e8a0ec205d6245e0b96476e072ee3cac3cc6cfb3e214f078a437b65efd9811a8f2a35dd883806db725b6a4d98941b91e087c4c1a94c9ad4c8ad3636b0962070f447790aec964bc473d22e4998b0be1e778f76e298698aced1c8105e347043f3746419afe86a177a6a53d55af3f92d860ee1e63cc3929956603189eda5fb6b947e7e0f9d815c2be4dfe60d8e4118861d9556ce61592e72755629fb0c169f1b451106096bd1cf49d7f8b84f441186cceef4401721def4f874cf2b51756dcce808261dfa4158bbd5e838ba09b5db66469dee21fc02445e9e97c38de9d799b0d5e3ce912ee2236e65d02d4b84972e0a4ebb33db1d6a7640c542f51abb1f2c8a2d0da27a1cafcc0842fb50fbd1d37c40e35a10f3b1e5ed03c8a1bc2fcbbb3ecd24ecab09f6d4e56407d8d69a97459a2c74e0723ea6d6746ff52b464eebad98e7e86e8d78c2e5fb473e16c3233930b3930b33730d11a4232c4e25524d8845e849d0633c24fa1da6de8121d08b960c7a38e6e246192002608889069ce63c1916caa5c787a80187c3b086443b82430f79c4696762c2e34097772e74b320081c6eef77886321bd1524665282bf998f6bc8bf6e646f4e63b7d8bfcd811d8c5e3b42f4b0c3d0d8b8f2c6e00024575de78a8dc737b51a4ae0e271609a468bcfd7ec0acd321a6f1b85ef9c0944e549db2aa3d5a3a7b9e32e089b602ecd70814c49119171048c32395229438bb67799cc24849394c7d3225e858a66c358b09615b3e77b6a3c46230272f7c03648e885b860e38da53845566b663f9c1e9aaa289e8588a533a57e33fd5aa49c294681601c592817ac222db87113a288c0c3b0d74fcb3290e5b53ccf7901c37927eaf00acfce85687b1bf70977d3495b65d67a6f794327322eb3984797bb1dc1d46d5126a94e3fa17474b8f0a256a30a64784cb9884afc146ae4035a0c0c709921c3186a45c661e1ad9338b26f86c222b4c189e3214992320c97a4c58005b0c6d38da1e52e1d0b2cfa6a3a5cd58f6399e224edc443571a0631f1ed103f1818b241e7f50eaf778f3b2ef8ec63b8089c5dc01a9dc9df7eabb0b3b879999e3b710e2982bb21a2b775ba27486a74e9e3094c3e570b8ebaccd274ef9f446a47b718736cbd5d3932a4ab2a0c6d7e66c6febadce9d5512e4c3279af397768586f9544c3113b18c9186ff66ad85bcf81a44b3db7038c158c1b754b8dc73009f7d31cebfd269270dca2dd49cd7b3136346c24d0ce1bd9258751beb02e666813ad754bbc38d79c912a69e97a53b03b3863a786e9ff3f22ce2be9599e6bd603d2811049e8108c0936c936f98c68c0264733fa5df7f37ebbfe29a8d6eabeba579564fa274accca8fe7250aa2f6d9bc33c4517a445a3b16e340b2583d0db5ee4729cecafd4ea6c7d730a78bf5702ab322c2d12e2193e06d748b90d7e636c67b81a4f69bf9655ab656df82ce5f815574a74da285be273f8e6ed34f622cdb144619a3a90649f99c4140c839069bcc0de6e2372199738ae9c9ea7f8cd0d9cc2f546d17b482949cf74ba3c941ea76a4dc45517420bc8b26fbdb45f1214ab7e755f1b0c530dd2ff6bb17dc0182192288cd4939dd66efaa8fecebf61a6e1ffdb82b927b9dc4baaff5ebe7771ff6cbd330e7fadddf2fd14311fda7af5da2ee629e0fe666ad80270bc01cda1ea7d550f9f9b57c570071bb650e2cc6a2ebf86210f82147412e9c6de76e34f3051c7ed9bea195aeb49b186bfe40b0fbc1bebe41d282a908219363be1d7bcb735898e77e89904a6070321c77b3ad6dc03c5380512908e79e21734222dc1c4f203f08faef40481efa1e86b04c9ca5660c25417959d0948f49e0574cbd596e99511a8fa76918732d08c9dcde09e699ba63bcc2115a952584e0cddf44de19d2cb8fed982d413933fb8861be9d053808676c117bc12a6340e1474f3733cb3df7c424b76dbe8046093193d86a6e5b21d0b464e04a68324cc074b4c1b158dbca8409e7b4616967536fee9b51e1177194172ea415f87828ca22483afa0ca30d2b248d60beaef895a5e1ca23b0711b8342a6a053da49bf03a2837123ea5a603fce4b67b8f32a7955f8b4993b4801f2a1ef4c86b3816f26c0fa75cb838b820516e03d08bf499750b5cb03a7f631e0ebcdcace899f1bf0714a5cf1fc9bc09f2c94709b4214df9b46c0a0b99dcbcf69d3558e36a7683ff799874dc2e1249dcb101fece8d08f193129bd4013a6261c5164af02d4969fd380add475120adcc93334d84adf46f1c6c123d059e0952fabefa15761be30e7f7bccf121477f5ba55ef476432d9aaf33614eee1961c7cb486776e60a881e3c76ef9edbd3f5218639f11599c15665a2cd7280c539e2f283e3720e7a661f57e146391119037b9e4aafdf5744057f23d0d9db33b9fdb8dca25833e3c0bbf7879094a2f2c06d108277fe4fb4e63805034068dca468d0bec50c9375927373dbadf6f5e314d935949f8d6ddb6fbe542a5e1cb83dac1def37cecf15747f2746f4edf290cf91cfb7ac633d018975f8b551c7f54e9813944c242b700265f5ea35156ce7f99012f8db532e545010d4b7ee354a703329f38af6eefe18113ea6bd97ce9b2f2ab3058aeb23812b407fdbbaac1baae34fbc9f3126d6b73023638fd278485c6b396fc2e08112066f663153161ab5c0ed972fc38ceeb856deb695d1037d9e5e9926e009b7ae597d0df1786c18cfb964bed75a077179e3f4a0b7ea0ecfbfd3e39ebe33e375a4addac22d4ee56cad3c2357026ae2cbaaef9014d346773b1d88ff0becfed0b08d44bca8bed3ccee7c25d23f97ab1365946e509615bae7babdedad91c0ca9a1720ea38359868ae62f17b108713207936c18cba7a189499b964e1bf9719e60bce5c59ce4e7ad6941afc291c7f964591fe2e7ede69d1076ce74a6768b32d23ea76f7b6cfd5a283eb450241821fbb93a3ffb1bb86956b28ce0d1c3f37b530f42168ba2877248b3e5f9ec8f2dd7f6f5863a0801f1dd7b50cdbe560cb035e823d599ed6c717fd6cb4a5d495e3ddfcfb40b1866db865eeab14c9f4e0776cdb78486710cf6633a53cc096cdf59659f2c1ba8de13734280d765c1da825fae587ff0fa7e87febb2ac06c6db1f454333c1d27c9dc1f17519ab2f622cf27e9f37b63c2b289c2856dba0b823fb4fd1e9cc086c2eb7db44bd91ec11dcd651c45f9623785d525f9d96087a70db86ded54403894455eaeb9c6f7271b7033f6b4343229ca72bed8a2327bbfcf6dbec0a0f47be99b76ef5fc61117dfb743795f5259954e4280df8ab74c7c4557e6a93fb7af14f8d31666d4f42d3e727ba3f24e4fb7a7038d9625d8e983b666467dbf3bdc496f745331e9e688d1efa7f3aff13a6636441880bcac193651b571eb20d041930049bbdf54a04d1bc65d54b9d6e4fc04961fb9d9165e97472a6d60f207df08cfe14565211cfdf3f14454a811b2d2e687090d1714288b68c5a5d1acb34cf2f6e33ca36604b410ae8d2e31cdc98c6f231db0e1f1fb756426e10168fb9e990e7faadb916152d00b046da4198b91e694c6cb2a78e13c9b9cc3c7642c0dde58bf1bae5ec01c6c9e67aa3f6725bcf5a5793700916e14e056c279c60e1dba5591e7dfef9971ce9b7c0b00f653d87fc3ee7a2cf3e073fb905ecbceacab6a7f38500cd87a48fed0c7fc33bde41f3550fd66e15b61ecd076925d9f453f2e1fe376704fe97d49c56b2052fc036d67b4b8edfcb3af6a31066fb1966edda1484c850eb075ae32b6aa43138ee3eac7d05872bad2ac0ff826c10609cd76c40beff065acdee9cbefebdaab14b4f579a9f0f79715ea7d2a9f9ffc734e820a4273631e6beddc57c781421fc48f5453cd431e6e215c120b3ba1b4165df3becfbcbeaf65ce4c7f34e4aaf1f45ba062dcef54f38159b1f4e36e4cfbfec1a69b9fb6fbbecff9f537db3610f0946d38df38084507455109717398beae050a04d956e2bc901d828f8715f1f217ff3e45f7d3e9f07560144361b0fc5035dbb563d44d706e0c24a40c16813ada5af0bf71f53539654ce2fd03e6309e498ff5f5a07f94fc4a8e40218ddb20ff2dfb37c63d654a3ce186dbdc147e7c0c9cb496afc6790c117540cd043f392ae3f05c84fb2c3e2396b5cb6ddbe75dbabd2fa9d482e0feca846b4ce7e47a775eb276baebedea6b8e97185fbab095291b9eecf19920532b64562ec9e7b9343a7fc8169265dd4c450cbd77fe8edacb1300c0bf9f82b16a053b3bc7277f9edf30f7e947414769689c674c5f4dcdebbf56b2d69bd1a125bc6eecf684590160413fa774a647b38c2c614ba6d5f316d07ecdfbfd247979898f4cf2e7537c49ccab4ab7056dd45ca75afab4cd60257baca26d66c1ab71be9cd1e9577fa137c5488ee15342bb21305a04c2fb9307e20fd332238bb6c58c38e07b393d2b81b242f678571e37542bd02409dbdbcc28565816fe24ecec6c28dc94be2c155bf2f0a2edd9ad1db9e2af6618db6a7ce2a3030e4fe897cec28cc86dbcfc469529fcad0c36e6a04a9bb89d4dff3ab1a87be52e3946e7f85b42cbfec1b2e26fffcddbfb91fd166d63affc7f9aa57a5433470c36d2b8e3ad0ac667e74bcbdf50b53de73e13afb70ee5ba3e1abe67f63789c6381c89d8e4c514849a65593b33a2ab02f16d2b53eb949a6b4c9d0a9782eceae4d5bd978b619857f2cc85ad041947d3479f77a57b5d7f7c01a4c262b771083ca4e589b37a75be9d4506106496e0b06d88a726df9aaaf1ec689d6ccffe58878d9a4c5ab6f8b3379a3dac4b5aab26556ad134f2e5477e07b31576df9fb2daf114cdd1d16cc543bc0b77f80c3ef966729d6726a0291d63c1d807b7b71fdd4257887eef24a797858e9c6d794e1e64fae769afaeef3992767e749e5eeba7767106b66027630f21f7a53b0b970f748bfc5796ffb262200eb1492ad58cd3c756f1f54a6e150449a6b06748fefb3289dfe5ed2b7c97318cf5b62786d26c524dde7f5a759fbb2f4f2d53253635e5693ab8a7dd68b804edee83794f4be2d3ad9e1e80368afab1572e929d4cc7e2141f210c75f22d203d61597d32e6e2c84b3540e1a62d5e591eb4acf1eb93af71f0cdc75041d664e6fd792d75da79d01fd21485ca987a5fa5ed9e9ce52f06eeca439e7ca9244cf5f946578f94f169ceb934e5181c5fcd093f6b7e32d5253c7f438a2f112c9be5e7f49cdd7f267f7931d7ce7b8a568a98ab2afa61e195b091d3b650e5e7afc5d1acd9c243d686717d208b7a4b21cecdf685ab7cd7c8b3b41b188a65fb78679ad8ef97a917c5497e5d66b0349a3092fc1af39f36da0e41a70611082c6ced20ec7af141acb818d66530d51ba8af2ec51d862e884932d28b2de3ba7f7503993bfbec5a39fa394df18fe1e244a0d742bcaee2b7b8ce8b805c6a116e7fba553e7e9ca4bc2f86a804ee8f24013a448982cf84984783e61bb552464df47ad61c17d0cca13e992076a90bb43966d967c27e9375d1b980dec5d6ae42d46fb7a51bf570f72733fcac0e58b1a08c8f1b4931477ae8dd2542057d0aba2f9bd6e0bd15e6d51856ce73cb267bb988ba1ae775598a1391db52db4a4dcdf046c749b6b15d73932915f62d156967d3cbf98abc4da3935bb8dd6fa490a7887be3d17c18d7d60e5df0f8d5b419e9b365b8c79950adadc4b92efa33296b3e5906655d3d3db3dee11972ddf25b5efbf2b676c4497ef595fcc6389bfd2c11544160586d3b3dc36eb1ca606f35e60a8ae27e1c5caff1b42ddbb43a6f0d12dae1fbca1e28063484df2f5073edbc8a57d02d14e564cffd361bd3818ff492aec331a8b4a747bc418bacf47d4bc6fb12cebcc74414559a36204ce2048803c7a3e30f63ed6a6faea9e342a74ff191cf97e6f143d88df4eda9f7fce14c0a97e750d2a8f7a7c869755579f779f1eae6f097f940955cded312615bd7baeaed01f150de224557c251fca86054786ab4e8254e3af0ad0e42cc6a9b93267c355ddf6b51de259c3adc9d82023f1448f688a2df9e3b5b5557cffef9b631e5b2594c6f0912cafa48af7ac66ecacf5ee3e0d7f561f91b7d26f855df9a6c4f4a099cb016168b0aefc5d2826e9ced4cf2c7df3203b5730e26bf520f25b89b641a8b93861a444559be77a1e03fed750eb86c923f081588b9e6bfcd76ca2c9f32471ef9340b38c7c46cb03917e5c316c79bb1e875a40ec2fd88fd7c1a383a558e1303c02babc11da0d8523a5d149ab7071ef1ba19f04e71ebf8f66f37505682de3590213c4e919a7bc42d0fb717118c0226fd3ecaecd34ce2652da6c67541f57155cab7e097625eaaf544633c059f67e3b863eec025b65e025993ce37eebb3e6b693ca49cd2cff4dc896f5a19736d320b90287ab726e04610a020c4fe871520d39cd7fabb5ca889b7b3a9e06faf07ff1008c827aa6c172e5106dae22fb27fd95f4ef18e83b6a599c8cc729a0dfceb538482f7f4a7bd74518ad7bb292585302f1e93bc320659a0cb898ce5fabb4c18f3a01497720ce59b2fa142b4f2dbc98ecf76207d988602541d495f88fb0d6b38d2c2560a4332c4a054d5b61bf46774ca6bf5913f9ca2cbdb97a29a46bb35972942b273bb545d43c75ab5ff84b7aadecbc412d58d275fb096bb1258308802024e21ccdbc8f1b64dd274cdd7ff699138ee83fa799d59a0c66706faf43763b66fdab7175d1f95320eb7cf3079b6d5c8a323b5edcc92474bf4264cb75331c090b66840766b05e7bc9461433f95095a38966633d3319a94a8bba66dfc0b45ba2b4bbeff582a376d98ab962fcd3701ac7f5fecd9b3aae7931b4f3af124d4caf977f9e8b3755b3eacb08556252c0b136d5ffed54cc5107f1f6659ecc6acf48a26ab729c519675be8afd79938a0d7d7e695c01c5ed9cb7128c90c2c4b95228c43dbb654fae8e3a9518334376a9156bd1ff229e2f1e92a71c305f68d46d94804bafebc2b2f6671725318ddb98bdaa0360c0324cb71d9a6c972ab9bc351fca9cad43fe36f74c3c3a1f27e067d8b3c4b3c77af2cc6af0d88c03c707575abccdfaa31147b2ec9128be58f1bbdb63bda7bdb55db33c92104e1d538c2be93d78e15b013f21c3cb43e76d10756bb86d18e1873d3f0f7ee01ac8acb153de07f5d042bf71e4b057007f3c0fdfd1835598b5e2aa36d76910b6f437a6095c6b111b8859a1ffea1b9b4abf4c9e05035ae98ae25663f8fe985066d75812b682dcd3e7c45ed1b2e098854e7792f78db9ebae62e67a6906a059168582d77697c6e94369b9ebd8a49bfd838dbc86ceb2cbe470e83462d5bb19b80db56ca2d6530b9dd012379c60b8575ee728b4c2807c2b9dcdc2c3536a77ca74fc6c73a73c76cee67916ee85fd1cbc606a8ab5e071c99cf368497fcf5e7cca0defbcb3493d5f1e45d968e31eb703dfc0f17e679d35d22aa5f55e91d8ee433b386961436bb70cddf0164931e47434ff7b776b520d8a73ade2ddc2895bbff708596394ef313df4ee18bd5dcf708f694cc53808be0fbeeb7190bce34052de87b870bfa820bd752176826c6b1daee505ec5b5d7da307fb7ba7447d37c7379a732e564e7b87dbb51c596a78a9fbd9f2ac3d5bf724b194671bd49862a0865df310e955a7e9821e8dad27f760e3f38452c3313d33695c7241f9e3b63e029cd2d2b260a423dbaf67473da23d5b9a9c00f22b9b687671c4347539e16d59aa8f3c697d3e56afcbf16280f934a7185fbedbe8937d1858f331778f49d4511ab10bc504cfbed96f24f5926d131b2e455bf1334d3a014778ffe10896ffd8f1c2087284fdeb24187f7ab1b3b14c0b4679c1ffc795b72fa7ebe7eb6685ddf86c197bb4878f9c52faacc6584e76ca1b4ebfbe717341ac6945bd7c3f7bc52b53552ca468b4b461fd542ead23122640fa3ea3d709baabab8c30822b4f0abaa69ead41d3ddebd4a540f493f9fdf4a7c33cd4b713d40a139cd23709798efaa7671f8e698e8e0b4f2073c25030748db22b2f30345c217bfe90a172c38fa1f4d7637858331fcfe0337c81294f4ae5dc0f76fca46a81d618898bb594f6b66d61a9d25e550d13b76499ce598fc4f13bf8fb908fe4f7cf8de41660999e2a97b55b65deda3f71ceecf7d95f4b9dbe90552f8e92ba40f1da4acb85d5bcd99afbff9f57e7f88aea3e4e71b705621e4d216cbb80fbb5fce3b0d4b6b932423177c6c3f6cd434da660cfa92447f4922cc6e7abab68b3ee47a3c577be597a6aba9bee27e0d3523e7367f9b0bb3ef135fa7061c21bd6d52368dbf85d74525caceb737c35cc62b9173d8f653afa95448b830ddb39c583e7bc7b2496702a9f0dde535ccced8bdb667e9f874b47ddfa6b599ac1cb73cf9ea0db499157ea20f1a7b673359ea9cdb39110c7d660e62abc62d18f632d83bc763b564520e0738d4cd57c212ba191ede064edbecbc0b597d978d3077bc64c984cdc861988b9812c9969f2d548c132f739501e7beecc05732cd6ad4a3b9265c0b682d313a6873d5b1f61c1ebc05b243cb68811c25ef251d77dd48076ab3efa37a751665769a16d7f59e98fbb7776b92847110421edac77665612d0db24b162107c9aaecbbe55ba51f4900fface377b3a085d41ee787e3a7ea74d2dff1e38ad1722e67c761e282e83dbfeadd3c7ced4f96bb8675571224340973e03438465ce7d486ca015ba49133a9dbbd13d890e1258250a3431d2e1eb70b00e0aeac65eef84e3df048e4a0c58d16e642cbfb2e73ddfad84a9999874fae9b3b4530284c1a4b337e23765b43e864aa7cccb24f3082b537e6174a7808a15d85d974c5ac63afbc5605c21ed59e7f561c5d1959639e04a9b0eb7c66d72576a8b86a1990206080faceb32a93dd29c6dd3a8ec44c2d045e920c661cffdc4a2f197dde67a2b480add8e6bbb717bf33bb39dedb1e6ea7248ab3db14ae050f8dcdb5d529af4518b08cbd1fd3fde3806f513c8cff6b16d73e1360ae6a92e61762fd06cd9e763daac84a9aff2127374b0eaae94984f3d278c5f4030966a915ba47b4e88f8f6975e58f5bbdfb3e09e35cfc5d792d75494a0bddd507a8c8e7be3ebb0cb895231507ebf91273c520989527dbe689ee4596cd39a9b5a79b818031346fb8bcc218e2296580c6cd3abc892882c8d350777a50c1607589d87ddc4ffe30ada6ceda92e1e4b0dcf6f3b45fc349097dce5a12a38db1693d89c32d6c1c540438fc21c05bdea315dd8cefd364ecab20ecf99485876ad5f7f071e1d95e86ea1167cea2ec502ee8200392a616281eb592c779e91fb47f7ad0e86b93f0cca8401ac94771e5af3055ff8312be36c80a7fdd067314f7636bfd082935d0c510dc4cbda99f9a86dec57c50f16fbd20249b205266307439d79c7abdace3938f99c5cdf8c8b14bfa02e587ab1cc89905fc2d1731e71035ad0ce27f87997661acfcce639fd0043338d53877a44d15447b18fbda54aca83729c4b821d56653a8384e7d1b4fdcea0849bdb335938579969270cc691f51cf84556facd3fb62b12f6a578bb7d0a8c591ab954a40bd3b4e862e39d8bfd68549391d269623c234a2ac14c536c8174141149ce81dcf9d86a1976f6cda1532aaa15dfd1183deeefc4e7c74cc41384dcf24d85bcfff7df5c0f4bded160d7c59bed31aa5d3f447866156f36c8cfac290e586b7a921fdf4a89d9f025d5744340ba27e0dbad0882227ee7e16b7b48c6dbf0964a2a68eff5eefea7e549b85dba043d7c12c2a7ad31c1ccf71621b0c4df6455f4e16a4bba757cd5c0a0f9a6368b3b77a6e0c6442c2f887dda6c58d0337c89ce113fdbda3c0d9f7c1ebd05effc8b02cfa0ebaacab5e8b04ed363d6367ed9ac6d6b94f349d7d6b6f269f52c73459bb0ff6500a0f3d424541cb536836fae4b33c5f5395eb5d89e0dd7c93901b3e53f7fca52d14fa1dd46a7d65829c8fb82fbc684dc9d780f293333dd9a0f55d01886affb4f95377a2ebc52e7f64eceb8d8761e065bc75c557f3c87e00e88552614fd68f2ef84e9a5fc48e5728cdd3b5582060e0b08403d8d76c51c575d2ed8eacd2027f61a34559960eba1439bd6bcdcad3c45441c4fd0bd4a6b970c9ac8dafe8f9fc567643c173b74b3f7731bc0ec36938ff1cbae9e499e7dbeedb1c53ad98ad6d1285800e642b6fa3bd1d455db296e59d69850f15afc7ea8d64c7a58b66ef01eb72af59b077e7a3ac3ee49c557b238c0fbc6647b2ec54feabef8df77fedbbd7a13513acf716e19095e9c5b58415c642166085c55fd9bfac43aac8e5d5ae10312be29ff6641cbec2324e8421bd0a67d59a32e47c983ed64aaadf86894554307d15dfd8aef3fc98adf471773a66d99e6a435f9d813826f1f59071d5ad3ec82a66c1db6a061abfa26deada2388d939e3445be73f1b43a12ecc932edf69cdbdc929b941ba9d4dd0d9cfdbe33fc5106d073293bae044870979f2e2e5e63a42e538af0aecf621c892fd2b82dbe740d5731cc0a1f7cbb3974a2de3f30a0cbf91aa332d6749af8a7fe1ce8a6bee9972bbaca19337f4a7fd61b1540bbf06924104b6b977353fba9761cc42ef67a11ddfe7e5d1beb07d6ce7a328d569df230867b73a1b54b09071edb3c9ecfc5fe9cbe17a127a483b6cb9f0e181e5c3cddd6007aafd557f234551c53b9be0d7d2e2fbf1bbddccfe0c69c53bf3a98cfe75ccf2f8d2b1b153b09bc1d3f9460c4e34ce84d4d6fd1c2e82866e4d3b8bdd0eec4b7c622c94d38dc8bbfadec4c5bcb67b32e1f58d8ba3a1ceb86af57ea7ba67331edbc14d53222e5f7d8fef534f724f6b4ebd2825f66db1c532348f81aad138b9d90cb0aba915dc34e2d041f14e08755470728ca949b3816e56be033319ed4e50953a7fc2d9843c774b38348b84c576695949ad8ef5259bd9778cbe431e260295f7e55a8928bfdd082f3ee4cd4ab2ada96778ae6ae5c9149736684eabac42d68f4346cdeef5cb32bfd8b6cebeecadf791924caa27acfe0297f5f51addde55eae899ee8b8cd3f59374e139747a07b5139df5e6dad143852a6f1ce4c8861bfe790d7304d276e4cc92770cf653ec3ca3ec5403fd84f60d02d728b710ad8deb4f9faf528cd9496ddc17c7362bf4fa05df8fb87e5331b92dba1cc653adc84131847b5bd017dfaa6ae926010d5ade529abf3fbfc608a026a811ef81a4d5e86155ae343abb2a72baa668becde934d8aadcfb810a05dfad0817c0ce88b929c0f8e66fd3151d8aa1004dd4757c3a319b7071e78a3d5c04a11eaf4b77a45bf20a683241f99bddef9cd1e83bdc139c77a7f7951529a4bb7f0572764ae8629df5747b4dfa386786ca995585848cd8586f2539fa74765c202f7b2d2c5085cdae38728a580f454f3334ef4f3d3a4d1e59d9cfcbf5e8fe3a4a4e15c13519a03b7032307ca623ed9b9d75a80253f2e0f5b7c71e058c6e2a575fc25ddbfd60c614d86b49f6c37389004bf473237a7ff88c638c4f7bc5b6f60644fe720587d67dd78891eafbd7b71a3614ae392094a20756175f2dacb5f9b54d3cc6169699e81ec8ecf78562dc99b77d08c057c0eb59fc1edcd8febda6ebf4cbd0a05d38521df77fe4867422704aea43acb883bc8328249f0e5951b7c9a3a69e8d9652e6fb1a50517820326def339e06012b1cf658a6c5a10a8aa0326476f8f13178ffb0c07aafb779f90310d3246bcce13ba0d0f3752fdac506cb9e8d884c999fdc3419396e5a2efafcc369ac5c215dfc5a5f82eb8fea6ea9a62bcf16dbbb45a47151b45b9e5884329ed5876fea4643541c2bfd570f9bd843cd8ac56f80c346d627fe23e11a156c434bfcab4c033ec3acb5e71ab5b03e34eafe8593d48e8ac361d01638aa66fddc559144b4f8766995a2309b5cea92cd4375e540b8039ab6a5a4178d590c2d6c73c9ac9b1f7c5eefd5d09eeec7c99f473da4f57c382dbd0293cf9c65ee3fc73b6f5ed1aa46a2d7f957a68bf040fdb40e6ab57cc1e9708c31935c3c6c93bb1b8c80f1c7a704958b9e9c3619b9d86cb8ef90c1c5bb686febe9460b92fda3650d75656b05de5f9d28d71de2607178977005866987062358ed2fee79bfb808b4a46e4b89ce26fdaf6e7c7dc9eed914a8fcf5029dd4967c0becd97fbe7c2e4f2a41d5f4c6605dfbfc716bc74968fd6562b7bc30cf2b4acb1e1a4c86746f5fe831cc7055da90cea290d4863720bc3185709bb6824cce5d31d1fbcd634e3e8bb0d159bbbd99e0006dbe5a2b0c7e79d373c6dd65c1a14fce7c2f84cfc38f56a8c68fcdb24bf5e9aa18b96233e4ebca8a99c86c7ba5013459f4160c1c5994653d9cb386c9be632437257052b09d5b8155646a59962909aa35da84e84ae3d5c8f885ec7363510c7c0fd7d5ac5fcbbefc1061a8d044c31e7ae5458994d7f7babc49982b30d6eaf8ebc68e7cd4ae4ab791c132e9aedcfa4722f48e1b8684defc5eca677eb997477cabcc15f29ded74766e70c97090dfcc72bce14716726bf7e4dd822c97edf7c868da8279b785c43b28241971c68d6c86809b72d2e5b7d979901a992fc7290ae3cec69b544fde51e40be4d96da81c29975b449c6ab8f31a2085d44c129554f97875bd2695c4c1f989e786505a0c8b34dffde6877f638ef6b386e00e45a9977cf9199226ee99576c0d01fe1b4ea811b734ff2bba6b932128b3e33e8b8df81154c67d9179b4549966b07da3aea322929b6cf9115c79f90088fe0a0d234a237810736bd340c3329495b1de7d5b59e6a08bed2edba38f65d4b05e2b06971ee1c1cf842e887a258ec63f22ab47d307793c73920b01062a9166b162d074561b76bb73110be05c8d604a9c0163a2e5203e79e20e24e5111668f8a3aa3d51a4668de2f92d30085a38fea73a2a5a510a62c951f14007ea79bbe13e45ec7fd32bd35ababc0abf80b45d740d4e7c823db3eb020aae277ec736ccfdb60df39dfc9c6c45e16d75aabf6a2afb5904e02538055e1b6656eaebe5cf0c82230034d425631388443acd42a3e5e42cc6c8b2df4f1395a6e5fcc9eb459468fa774a5041b8caf1f1f67e993ac1d2a9cfd33e531a79a895e00dab1f2bc400c66a99d3f27afbcf32274550ba4b9dd38fe1cabf670d62bbb35a3d9d44bf3a8f7a0a226713c4d9e72b42e296e1cce784aee38ce1e0707f287f386d73594a3892f2103c1940adb04b69dcbfc198330338bf58753e87f2e9ffcd77d1e51894d612a3c8f3f354bb918becc2e7aee764bfee2730b4c68913821b3e333382636083b49cabf9368c62060c2618869468c27af00d458c5436c0a456b77a013e6f24dc72f2662314babd50b8f5ca90be2c89e67cc85acffe7bb60693a6bb78cbb55a4de9517bf21ac9a28857cbdae89e47d0efbfecbe2a53c95691827b36872312a038c0643880e8fb59019685a33beaab6f1c2f0b167037c59ea37528c6c3aae065b80f3c91415e2eb46011542c72f43c7a524ccfd93e0d502e15eb829f33538a0249659d7119659c13499bc7055b2380ffaece8b59bf22fcfb59117f42a3bb70c03e179a3852cf450966f531eb8afcc0d5ecde4892f56445d4a79b7f124c25074f269de795d0e1f997dbbe124e19e8df48b27a75423ea405d983f1fa74b1c1716fa50a2aefaadb6e3d33384f0cbdf96f42a08f30907c6164ac543bb8771d334242d00d3c1fa7a5a05fe998fe2b75b72f37ba255bb5ff2a59e5527e6932c8c5eacba0d51967feb29a1960cd006f9ddd46cc30b6d250673a5e5efc2db58be7d55e0b3ff7505d72da2b8f5df5ae882cc83cea2c75b7aad559b5f9d3559df5cfddb600dcbd2d18ea0e857d39353ceff225258b04950579aa3de2a1329cbdc2f30edd183973215d3ad13c808ef373e54a4b4568677b82535a8dcfbf4386f1693d056951b2e10184c1faff722abe9affa5840a9c349097665a0ebe276f0f7050ab44496a56d7f4848c1eb0abff4769135eca607df364aa0b950838063fad65fb66592b665f6386bde6cd35d750fd0f8b5b1dfb79153893ae7bea69065a15b03b1243ebfeda3c88de5457b379556d6f0ddbf16a681bf320d4d831834cede934f337d1626f953318f3bfa0ae8a6334e481fe142678fa5eaee969a7db99b58be781b707de91c2ae305a2f502cafa65c2302e3785accc7237c5e478da6b7d4cd0e79a03307c7e65ee41f5268c778b64ca30af8eaccde9be9ebe6bdb71dac1201805dab6c798bb8bbfd295bece60826d4f56476d3320cf2133e0232a3353eacfae943b6dab53da6e03b2838e63459ba6e2e16c3bc4843c49c8769f6154ae5bb663f9dbae01ecb8bccd1228087a79a2f6974e17ae891cfbf9e47b2509a4b37cefe6591a34c0fb4243e6796347e095af27581ac6e3d3c5e4c5ffdd6e6737187e594acd1d1ff91030a5df33903114e8a2592bfdd07abd7fa0683697c28cc064b485e63ebec30b9bcc41772daedf8405765842361c3336451a756fd754387312c87c290e0a97394d680e60143978023e7f89c16004afdbe8da9fb1c92a79dbdcfbb2c31bc5376e9b5a96571e66de565e09ae32fa3143aa92674ecff9f9d9721696b7f31c99da2b2ab7f4531bf3a630bf526bb49735798ea8843d83d308d9eb6f722033f0abe6bd6ebcabb68d45ba605c8fe76ce82c620b3de840f376039f548b00b5427b28987b9c653b6d34476bd7036ff7f4a4f38a9b025a5c5e9112680e104621414b467124a0caf02fd7905442efc9eb45349c7ce83a566c92d0bf39428971c757d5d276baf74325ef444c481a86644e3931104b5c783e4e8b0a38d8f4a4bc2bdfb7e1b5925f9d70d5b79500e6439e1d850d8dd4cca09b52d205f1fbf43a2d5d9ca53599f0f45a49f02ff2e5b4af3ead52dd86286ef8721c18a832a643d45b4269e3ac0d0a290e0fba8c7ee6f9b8a45f3a119d78b2f7d48a3bce02fef038d0b731ef85e571f07e782a57154ba3eff4755a3caa85c96e376cf9623a6877bca7a1f0f1884c44f01bdf9eb933095b1c62dea2ae1a5df3d27c9b8dd4e9efc526c682f86265bfdda5e98b1f9b4c4803c1c4326532349b89a283ca60ae93b12bb2421911dc540bf936d7e4f14ded386788d2f968fc69fc0001e78ccfbe603cb953cb2520125247c39cd0505d22a43d24e34a8a12398827cf5b2a0fbcda3323c3bba1036bb743a986d32fcc8bdf5aec006985cd2449523614fa3a4ef8d6f9fefbe2727fa3f0b7e327d706cee6610a7ed3145f0e4056b9ffa1cc791ac7bae21ef3df939e7a48be13980c850173518f166364b7e8ae673597cd93c77240f6fdaf0a5abb6d78087df02ab76e7fe4d05fc1a6164b7fed1c942023afe27c2be1a8f25b4d4d2c31735733ad8f9f6f741232c93c31b02be85660d3a95fb2f237bbd87cb25d7d67e931c259436856eb2406b23d984a958de1467dced3c6e01868b4e4a7739634a60dd6250dbfb70deca82cd50e954042b6314379e11df4937cb6950329609ed78d4e19fe9869c677aeb15ba6e7844c169a8da38d43b1904cd2cf96d11cfbb7257dc9843e43e2ad1c3ae7eddb49074ab45198273c8c37ff53d8bdcdfdf8b3e9308bb3388263dfb9e6e27e37d824ca9143fe37221f5b76769233290f272d4acaefa889a6df8c2d2bbbd04506fd2d3e0191b322ff1cc8b523c4f215156e539bfa64c31adce9c8d920c7db159b03a19510b4311e56bb6ae434348b4f46ae1229db8396af611cbda5d90fa7c74febb4ce7fe75b6753311991cedf56b6a1edd7520e3a6790d58ecc73f5531af89f0e8204e9293e2fc53a7638a4ecc9e5dd91cf2c2cf0a34be49ae7bb9bed6f0980f5c93d894ed70d7143f36a73cb44c85d907e0fa71c2b296ae40ba4bf3ea519649afc3cec52c88dc9b8533c7958eae43c13a50de3f646a0d5a723a21c3b9d922812e4b12a003b4ae3c03c059cba5b07e31b947eb0205a21a1d0a181ed935cc84a214d47bc805c8328b77ee01bddc53d9c6d70a24be5bc7981309d9fc15240619ce7b2f7838e4bbe509850ee53e135cbbf9b061926439b721d27e830da2d5d0af09c7838e1b78f35bf595653f0f2b474f63c3cbdadad1bbd28326d40bfb3806122741e110af34369393c3a774bcf14391a48ee4ec98ff7ac09960f39c3234dcabc4cf138162533b9ce6d598bc9025ff8446b3ac109307eeea8d0f151eab87cccb89ae79d73bcb970c76505a10653da29268452bf5c8d6fa27174a0ac8a9fdae5e6d10eccce1c0ed1d424e5d1e55b77f6f2c90cadf3b5764a9e0c9927ee0a3d924b5f3207f8085382ef7233ee45113e0b9611ebf36385a16635c242933d6fba1036f734629c3c57aa125eaa103db6320fab8034635fe3a673ea1c13909e7569d90b802fb8d14bf7580b87d5f99137843753e82c261607cfbd98cae0384eb6708d85a042130a99fc18aee05cce280afa0e1d56a0af1128e39ca007be3536c7455cc33754b91972de9b2acb530b0b7099e2aab9adaf17e34a0e34e68125115d4e02d458b04cf44d68268517b26f88dede06977c42ecdd1a63a815994fdb2c7c2ac541b2ed55e52f306d7c0e9fa8eba4fb8eb35c45b7f228bb766bf06a2502a7cbf2d8508ecdb33cbb3cce1117000a8ecfe565e1d90812bd434bd0d46428774cd7380cb13f1c5b183d5cb711b8d2b311cd5bd7c6f640e05dcb946d92a84c4322b90791a1770deedd93298b7cd24e5af01f6afe61ad09a2326376940adae5de0cfd7ea6d4b14142fdbe66ada748679cd61f72c2afd3d82a8e3d3a9100cc661c5ead5e840a852d2b4ccdac900eeeb995231a50bab91133d898e01c7bd5e99f5c9afd0475c9d874bb9d955895ee457bb8ad5d9a288857381163fc48001b24440c61cdccb16c8e92e73edefd85baa8ba8a2c8f298d88673db48d887faabd0cafad360716a37080a1c725f7a4039dd116d099c06fe9d6255a1e8e56b04d68678a01740cad4286d9ca666dcec3f6199f0added0a425ef816f05b4d77fc30c4bb66cd10f4d4e5942fb4e5147997204c6bda30dd3feb67cdeb4dde3f8b7ba39dc0af50a6698e96f01f66b3ef057f7ff8e18b8b9611cc2b3f71c39685133bd5b1cd906ac96175a0fc70ae28e49a145ae4bd39f43b8e2cba10c73df740319e696c92658ab68571e7929ebe6f2db8f13cd787c393ff3c2d527d97722b6ae601fa6d79fc7667182e934497855fa1402a17ab2dea04e6b6df91749e459e9e87dd1a0e49f398ccdf52a86d1a32be906736273dd01bfaf37d56efe5b2b4011d0e4ba717b6bd7e2d1445160e222c0b9c9ba53c666ffa54d503ec6e5b4d4fca0f153d9559b2e0af9d64cd2ee2a79b13c6361d95a4261f3543045367678f21933fb6ae76d44b1dfd25c732a5581bfba1db4ca7967bcf1b77045a78ceafd0cfb5cec85e79584dcc223379c4990fe6da959a9df11bd339f0c98ffa348dbf15823d1ccf266406d2aa35b923e859fc3345899d09b058bb91b71ca7b672b40bf3b06055dbb3cd75b6b31ed73539fcf7e95112faf29a26072f01b86c5a06e7f6a6649a1724318ec451a88df1e6bcf08df6fac961c9668240ce479a495b7ee788daabc6d152142d71962774db5640602993008b1ee784b847f17b867c20268049f2d2d733d9bdaeb03119f2a8f65ee71b7db559f5e4e8d707b53a813bb74e4bb10583ffe9b03b2b5450dbf4c377066b9694df91f916622005c7a2dff8f557e6eaa61a1543fcc91f89dd7b6d0b46b666393dd5cab901ceebe2287692a4626af939be67fad74ebe478be78dd2b4d1ee98515f7685638301cdb8e48ef5162191b5b612301c223b5c4706d30222c10f15f374439a1696cf5fd439f5f7fb492970dea9e8b9f667a9b0cee3a9cb3eb6f67e57872005a6956fda49aaeafd8c2ee0e457677a466bb09cfc26395d2757a50613de43d6db597e285d8b3fe3c2f02df0aa7bb927448a31d3952fd3d887992b3e6dace2d86fdd6b807b813237a885fdad7a2a56615c6eb05ea77d359e77787b5319f2c99f414da841c1e403d887bd707a172741b299f5346ce8e5599a013242b6043f1aa031a7bde8158be3fe3b3fb935856d0eb352448df7dea5dbfbf4470d652ac81f90e68b1ebe87f7e430c865ca77c230d52430268546e350c0f27afaa21f42e584170c2601e18621cba60894fe2140c0cb4bb41c72de8c81af1960b0774338b9dfaf88557eab96044ee7304da925eac2682cfde35a74fae3ee0eab8085303cec5c659d2d97151af8ed733ea20e8f4101467b0f0eed97af21674107fade5a7bfbf7f5e1e5611bd3afd3e9747c3f0a906e76fe99d816fd8041267caec7f77bdc0469cc21eb4546306d5c67bf7107b7935203dd46317d13fbb55b36d63a3de590231ccf9d28a81ceb666b0f63eb991770fcf081d40a21e76c594eebba5de8570a6c31eeda72e366a8a0ac39411c779adad87b1bc7db6af9dacaa77c5bd08c10e223782ba15a046fda707a1370a3443d3f8fff6e4d6f3355599d818995b2f487757377e7f100a9ec6d3eaaf7b3a1e478ea0deff1387cc4c036a3cef76d0ce2332e159619d48b0a84694c97b5ef8bf7c378ef97fdc74a03d2bc584b9e29c6f5195615a275fcc96f490b2628c55c2da1e4906ea634fa2d718be0e401a08e5d1ff9ba334a6805dbb2f34ed469cb8b98354da1958996548d04003c846ac9e8209f76bf5b8d5461b60ff5d7ed613b8c51b1864771323ec771f636d250c56af51c828fe086ff3a71334386c761ba80662363e9fd8cf6f72c296f1bbdd39ae856d2813566703b0a94a35da565eee181aae7f9d01dc6886f676abdafdf290de8a5a6de14bb3a533513b8a6d8efaf4c17dd9ec8ea9a8d36f8880a43c261ce4b6119e0e8f591f2dc15b5a63a4e1263812c7af6ba07d40b0805b3a1600647783d55c0aa80aa4554ecac428b68c4c59a066013fd945d7f0f42ee8e75de254994f40aa992f50b34dae50e395a18b583ff8fbd37eb7155dbf23dbfcb52bdb16f4efa664bf5000603a6b7e94ba5147d63fa1eaef2bb9722f6399979ce8e5377dfd72a879656fc816918f3ff9b63304cace5b86682c4bc4d3ec24067cc857a4a1eff0a7c3bd6d2fe226354dbc95df3fd2636a11b3578f515e4285eaa869ee8e228610b03852d077f7bd22f21e9ef4425a49820a5e14289edb33ad03c9d530cee4b6405a3250a5636d8b0e9e15275395d3f08519dbae9d23e703daa4143dac6ee6c2f6bea7cccac882b5bb2e6feec0a147d2c2154818dc0da5af0a06b4533e4264eb9c3184d7448278443868918957e8cd0b3c8e08d944ad4ba29ccbae568cc786ab69304079321c4b9f5728dc26d926204a1e315d2d375daef5e63ce4b4841807e6a247eedc17200b15ff923910107791c8d65312df106462edd4df5c8a33af875462032ccec09ac9e13520aa8e2f1911858eb514c06720ac3524641cd57f4d0d8113f76b87db66046e9005503edfb19fe7d6568687c3b69f890d1c839383399dec314bc5d7e8aad5db61481f3de07c69b79ea12a3a501a86d723b0b5031c1ade88e5c27ed0f25735443bc4c8194f853b084aeca535461cdc01d99149e2c5bbfc3e962b680345e69630f046ddb22aa41f5281b2d75b4a57f5e154437534ceed45bd5101a1dac0ed799d00abd19180dab2ce16661749abb4cb6a33b3e0dfbc1b6ddb07b00b9924c94870bafb2758d496589ce88ea19dcd192214a31ee4180647910f61be8e5646258ef6cb673f0762f9836f5ac11f25459b48699c54e327cb9b748b3dad0d08dbaa5ccda4c703d157720433aee1d6e96cc36fb6d44d94c1634b3b7f7fb13911e58b38efa716d9d0b4c0bdec4023719a4ba8273103b9b073c7e2bd9daa6b62bc3529f3b88c3890d8d202b425c5d008629754d1831b7719ba6d9bcef7836ccb4941f4d5fd0feebcd6114e9483e1d79b95b23f416a471149a64e5007bc46168d8badce4a13ee41098b1bdc1bad33a542373145c6165eadd78650ce41f68c4cfcafb8c43e0aa749c2b013b320de104bc3be924c94c27c335c573340e86d4258489ed07fade9ff9fc9a71264bc1128e038546e609a3e172ad597e7b32793b9f7e2c40b66954510611297ad1db4dd137bcd2f59d5183653f511443a85c4b81f8c64571932013f83de71e75dbd7af765b2c3a5434836585957d245c15083d0fa75d002e5f1a28efb53505d3bc23a33f3580a2b3f3f459574ed93a39c805db50ae665e983d3fab4571f3a7a8bc8d63321b0c1bc976ba9f838f28129f6c750659f63676a4c10ba369be88607dc1500eb52fc4a37a4657a38951fb78329813eb93ed15cc7795acd3cb8fac7b42d6d412bd9fa42f51614c4952384bd9b04e716f07aa3be96cbba38b9bbb1089213b3b9115b8f530cc31267b259049330cbb4924819ad96d3fa6c4e4b7b8acdbd6c2e515c3f4b0b7f206bfade48844eb64e1383586c4624ab5e94c8c9498d56bb3a0559d6a1867bc93786d63028679410511c1de9c5b765d7f5716b4c221512b3059635f7e69e7adefb2c401ac9eaca62e35487b9106c38c1c0d5a448a1cdc115a58398deb8a8dc35e923c224f0225c02ca5508212a38e44452ce89c0d231eb3d4f6fb5386aae98e82f07a25011c3ffb244b3b3d16a1ee441491d45f9751e24d043909d25774485c6aa45c4441e5b08454d1ba4d088120c626850db280dc5b9375c699ce10f86d4ed3e6426687c9fc1c1be1b8436a65ef51b220655d202a6c54f3f809a3b93a1e93d5e7cc850a60de8db742a017a96cc78aebbb31a1796315ba3763d3886e3c2c799c90e499e879c77c7f5e773c3510bd2381597918f6f22e09a5011328c61d338c0898b74d1a31ff2ccfe4cd0ceb4596b98f30aa333435b1053a9908cd8918d692bf49537b708357e447ec8d52ff5411e219d4d03aa4324c40ab75c1954a8716753b3274709103f19f18ac7b9d5d064c9f4207f2921a73e44e448398fca87d315f0a88e935f685df10d75e1abbb6e3ab7fb7e1ec0ac469de5a6ddd362526614b339175219e82b66fa3f2bcf7281238ca027d25da3b3427653e6e0a52d5d1bae5061c23ee966718ce44674176c84c596259eb1ed154bc9cbcc5e83ad2be4c1940ede88078fd323a469cebca483daedcf5ada727c4e37bbf81ec014721be3704815d3dbef62e19462ea4efb29c2e3360423ace2e4c11cc84e954943ba1f9a4c2f9087af252265512a5e26d90556053bd2f589608d13684aa485bfbaeef75f9f1d0161c9166c13d5d781182948bb71cdc3ae409007dd16819dac3aa6cfc395f1b00be642221966ff91acd398c68d9caa0cdecd33beeabdc9ee44e9e839a77f41951341340110ddc2685a05a47363f63724fea9b11a4feec763c4f6cad0100f404c0c9b1f36d7708bea17c9e4a8d3e07043e5070a8e6005a75a02f23040813a5ecfc25a056dd19e5b95203345195b89958722327108bc59b69ba7947b66d550d5b53f2897e6358dedd2728717d9f6fe13db913d4f282d3c6f135b4ae3b696285085adc31e6461ed0604bf9cc5a738ca2a6ee1d9a5678ae711bd70092830a32eb9d7f15029397ab80304732b8693ed7645d479a2f309519cafe5dafde62673d870e37ad80c25ba69a6fa8a7f735a216e9d2c73dd3e8788dd44b3cb747c8641c6f92ccba018d425207cbd590a476c74796ec74c2835fc79abfa92c9425b08ee48099001d9db35ff8dcd481a6e69dfe8650b1bdac185f9bd9b5a176f0fd5ee89e624197c1fcbee67acec959c9df9f2e10700a2d64acbb262a77499b110a6ce20e1c0a9e0710f4b57f832754bc2a54d9fc785da549a2dc669819d7f431b7d8ae893247c65cbcb1b5a5104d9d7221ed68d7f6a1c6a575a68f177276142014c63373a0dad1d62a4dea93fc7346f528b42d6ca8f20003296e2d42a2cc1e4883c5e78f77f992313733af09d41bbb8df8cdbf1de5a3e56d6fe8c92613a83c8fd9d1f11bdf54a4cd872e28895b8c713a7fcbf3dc5fe00262969a5cdfdab10d780b5def085da4a35299bcad7bdf04d7c132aeee8313bb9b65da686ae84063b1b014b36c8a3692dd882707d621c9b66f10542347c4e7b36b43304d45d826baef00a82ae323e87dcfb3aedc2d6d45b18339209c54d571bd9bb92f235591ef72e2d0107d604878d9d1d2bcda85a2301638bc3ac6918e22900465844e6175674e8f7146518a5247fe65260b8a29fa46224946aaadcd1fb2fc6e89e78e1b9937a72871edfe899bab1dbcf16485c00cb2b643ba4d034ec528d8e2239a4e759399d131542e864da7fd9925741e2ed34a49227e318291e3335796ee70d82641b4528a901081b9799e83e1d15f29823382fa24997022c572531fb015dc5350b7e58a3549aa315c3157b57bc399d8c8300ac7a1118d9923f4f1acb69ff69625fb10223635949da0c210963bf12eb2f7d6d70c12edf3fa646cf76244a8623a740ea1587a3007d5810eba08a7a1da29ce6548a372150905125d70edefffa6852bfbb012c2e012c807d7bc053bbdcb06e07b819cfc6e8bbcb595e83d98d7e9a408845cc79cc24818f8327e39583e1250b1280de9473b8f81d98756837ab7838575410433ba4c3c24c401f76a0f33ee9e3f67e3a2ceb830d8c64c9f39760f987bfd34afc0a1a1f9eaea6e4d7dca04e3918d16346caa04bd371fdb5e0463be28119a908527a3b0c8ef5e7b3b2a037f3e88d3bb1701145607fcf23d9f994dd491d2e751968f1a26645508f22e78d53636d8693ec5174919b66d9aea36e10323cfbbb74d0fb413633b79d65a34efd6bc01e4895c85e3bb5106a86c27731ddb68431f432040f393a93ac25ea71597ebb87dc44f0a1d69f806f30d22d0f936eb2b0c65c0cecbba31c78ac7e23bb3e5efab246bbe73d53059c7824c81b0b5ede907a6cfeb911181899881471a122ea1ccd567cc28a1f0103e987080295582226ac26f1acd231840313eb599dda7335a9ea1e172abc1f779507bd325594eea241e8c3f341835215580a1250695ea54ee06eafa3a6adf8b8988abedf1426d9fb6355cafe3a449990583f1c7c62d2f3b5398419a6388c25ac86e2253c51877791f717746b5a94eb994ed4d278a5cd11702d76cfcad0c22ce2e0e413ee1a860c4a3d2e298e8c343976d31c7114454744e1ab023ba1d4bb4a374f5ca5fcb0619f43be51792a4e81055e8dc417b4708bb00ea57a4ec4608eb627add30e4c0c617990921a14dea7230cf142c2482aa53dce49e1feb8e1c060af766318111ddc3c6b73ee2f1f311c16ed242db46a6e080d07ae23565d8bd455187744998fdd8855bceb3d55e72799c661966efd9354f05ae252ccbeefbbeb32ccff3fcf7ef926774ef3f7bf58215be7fc73e6b054120b31c5796c5d7beaa0e8a9d55e048e1183b20dd932ce47f3e8fa6b12c0bdee21f9fdd20783f1edff7dd512d8e7d46c5d332dce79a75703628be2dfdc398efd799b65bb0df9f45c006fd7fc5f20ff1f53d2a6bec6d11ab227750a349d6fef6cfe7f9bebe4970c53360abefc74c3f1cd734ed7e83594bf07ac1e11b27ee06461a5f0d7cfc790e178cfcfdff2a8bca9fbcfa23be48d12b9e65a767df4b885e0fbe3ab2c59fbcf83a376405df358a7d063f1ee779ce5a5881bb8fdc9d255c9120f327f91ed20fcb0fcb0fcb0fcb5d915e52f76659be295ece93733f6cffbfc3f6c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2c3f2ffa72c8b1ce45d89d92ccbb31a7bd517d63be93b72e5e83a9bbf9febc1a2b2c8726d11915e4fa5c46bbbfdd9279edd75882d6eeed79f9f196b1c8bf63796f38a68e426666244742e9d3ffbaedd46d6baab3ba7ec3f79f81d776c71acb559bacebbc9baaffef1b3dfa0080495bdabff7aadc42faeb0664bd759242b411d47eef4fc61ad37817ebb17b7a6e7ef3fcdfd6bdfc0c2b227dfbe6d0ab09ca8ea9fd613bfeb27f76dd3bfcc85fff27b7c6eccb428c87c587fe63f8b03fbe51177db7f9cbb28b245ecf02c5b5b7aaa3ac94a2f7ef9d3ba9445f097fdbeb308d44075ec39d39f6bc28d732dedd6f46c13f03facc3eff8f581dd65af17db6284fcc08ff1eac7bc33f7ff0dbfa72738866f9bfe9c27e72c96b72f8fb86aff798d8842110b2acbda5f3625f94a976ef953fee222f397fd76650c42d0324683fecfb553e4dd42669be0cba61f6b0ecf5afac816acdb8b6b391a7ee44ffbcff52bdfffbadfd1f602cf6553dce2877a42cee297472ffa5fd72ef82b9704deb6eeb93ac70bfdfc294fca1a178fbfecb7db031a419b08fde91ee3f14ef1e5115bfeabda7cb3f48a2bd8b017d76634b0cc99f6e2cf79b26b7cfe97fd1e8b0844e0b94cb2fbe7fac5b36321702f9a7dcddc4f73ffcea5affb980af3b685f8eaac36f4f3c77be786ff75bf75bfcf71146d22f887fa157abc5506ec10b13fd588eff8154bbfdd2b76f8b2a9d53128e87fbc9fd07cf297fd1edf3188a8d7126bce9feb97ca8d96c6be66d69a7f9c7b5916b288b03b2bc3bcff422475535ddcfaa1c7b871dbfe57fd7e5a7a3ee43d8a34cafe43fd721dfecba387c7fe5023fe883fb2b4db7de4865ed91af48e42f24f79b25ff4ff86df239cd351fc65d30ffdc2c4f5d697476c30ffdc5f95a5fc954bacf0651326519bec06c10fbd98c16d7fd96ff7a58325ed3da655fe5cbfb802096e5f1e3dbe9b8e9f7c12f8a890d97bc44d7d54376843d23ff72f18fdd7fd16277883e3f86c821fea97317ddd9f5967fbd77de8228bdced606198f34f4aa472c1f9214f8200e2e6bfecb71b99404f076f697fe81bee05228b5f5393ff656ff6e6832260c5889b86a85eb046a1e51ffa219ec5f0bfeaf7d88b1bbac17174dead1f7a2fa5e7be3c1226f6c7b97fe5d2571f7b635818d6dd93ba11b9f0733f04fd75bf799794207d19bce5a7fae516b0ecb18a57fc5423feb837bfbf72a950be6c0aeb14b4ca8ffd90c9627fd9ef711001bac29377fdd037dcf6e8cd7f7924a8ec4f730f82e0c62d96c6de74168197e7499d4826fcdc0fd17fd96f87f7499fd497f035ff50bfee2ebb2bde77d3fc438df88e1f7df36c191551749b88b44cb74afeb11f02ec5ff77b5c2420b65f36fdd037287b24a85f1e89eacf3d7310bcbe7389fbb6e980a8f3ee893ff4437c11c37fd96fa7ce293fba6f6155fcb97e05baf797df5b90624fa443b6fdfc5e54077fdd6f6e64544644e9c1fba97e8d7b602801b35b23d729e29bfdfa7a39aef154885b20cbffe7afdf7e0dd19475cbafdfffe72ff35dfcfafdd7afdf7ee9519b7da9fff88fdf7e15d552aef1bf257d0bd2a6add2b4c9927ec940dff765f93bc8a7be5bb22e05f3122d5502aa362ab219347dd1fffb3245ddfcc7e9ff6de88aaf4b545dde7f7d4fb325aa9af94b767f5cecc757fcf66baeaeecd7ef3885a0f06fbfda3efddaf89bfcf7a5fa7e250aa3f0ff80a9ff01133682fe8ea1bf6348f8ebb75fd5fcef6935fdfa3d8f9a39fbedd77c7e5f8dcfb65fbf2330fadb2fb9eb7ffd8ee118c69004f1db2fbda9baf7afdf91df7e69df57c13084a67ffbe554e9afdfe1df7e897ffbeefffbbf0f510a7feb67fa7532f8b75fafff1e24d7bcff1634cc905f9b7df29e7ffdfea5d9a56abf827865c9afdf1182c15194c151fab75ffafcb507fe8fdf7e69ff34046308142390ff3ee4f6bf1ee2fffbbfafdd3a67e9afdfff2ff837f837f8fffe8f2f9665367d7bf065cb2fb0f7d37b1ea2249bff8009e2aafbf5db2fb91dfa6931a3a5fc87c5f0c741be4ffed86b4753912d7fe867dfff4d69d19294bf7eefd6a6f9edd76b899aec3ffdffde7a66d1dc777f8c15fb7bd564f3df47ff71d9ffdce4b3e13fb59dcdcb3f8dfedaf54fafd0fa74fdbadefffcf5b7d8ff5f57ee77b055f7ebf7655ab3dffe9525df616a7dfad3b1ff43344cd696feadedd3ef616e36cdd5f7e4907f43f0efdc19be03f97b62fdafe2f99bd1ff5b29f51fbffd4aa325faf5fbafcae58ce70e2b62d17f25b9fe724ac129589653bf737ebfb101cbb2fc24c7a6f95d067cfdf58465769af184b45896159e8d2734adedc0ac527c0d285836480796fd3ac8725f7f89dffbbfde897d6d6b7fdb2fb0acc2defedb38615658fe1fb6d93f64283fda2f25898d6023dfc76fecdfbf9aef937f057ff3febe7d7ff592ce2b9ef57536eef114ee4ec64a3366b58eeaa6a18a71f83627716e0c2479be378b63cbfd6559ade594ac851456c173d6a3902c2fe49ff79765054e7fd3aa427eeb565839caf3ce8a02d1bee49b35bf58f9b6fa663e66c06240bc60f24b78985ce6ac804a0760a7526a3064738d6f0a23737b9b3ad7c32e8229c721a7a229821086a2a8c99df2bdb66496652d59b8716cf1d11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdd11ffdcfbac89dd5b9fbee1465dd80816a5dd7d500aaa29010fdbc0e6333c6713c0892495dbf3b14f349c7db4053daf53868c8e44b1f6f731e9f7c6c4d290c6c99964b203711d37608036c395e9b108c95e862ee7fbba4706359f6bfb425cc37c1be9890df3351969c3697ae0248575f54553afb2624490d938bb8622933390cd334b6eb96c7115d6bb7f11cf38ac91351a6f3435c572a3e201fbbc463ab190548d403323b34bfe3697e01f17987bba7c5a177aca62e3aac306a6aca383f8e7ff4045643d635945c227c48a77d53df2fb212427e5b99217e01dbdb5a3a32597a6bb57dbea3c10624ab77f4eed943ac84cc1389cec4edc9169a30720647658f9e9c6abcae71e6fd7c8aa85a189d489210d8e649622800803764d6c3295dbf138ca88a005440dd455f973648dcfd79596e0ee3b731470096e4779406c48053f254438e7f407da62725c2e8c53f7a6bed8330e4e8ab45493272c4fbd6ac0705924b2c9790ce81f3be430cb1d01e1fd2e6000b604008b0014914c9a51feff013a0e44554e2fccd89ebe54394e09c7e1104eb3ca5068de4d4d175e6b0f6713056d52a7a79f2977ca074a856281aab93eae26fc73a5753f2fa07de3c3b165ea1f98fd25ffa74b81b2f97526a3ac70ee7bce2b53bb6092cb836e0d1719ec246c794caddeb7a1b9c45da855b7fbc641cbad53d9e3b3a78d070fd5ef9ced5a42cc037b360566b7f063c8235c636cdfbfb1fd69a78970b65636fd34d5ab87bc9efdd3b662d75f25b6e8cd64992a9928cae0bc228d6779e81e40808b8a3b70542e547c1b27fb3976383c77ee7ee351f1f30876c1c8abd5b76377bb732ee24b816d2e860b4dc2731f16d376bf6859f76c13356ccbe0fd7dfcf51b0374e7fb1c5ce0d90bcb77b39e5975dc80232a62cb6c25b3d20f9b9716312dc6074a2d5590fbb36b6bdffce38829f6cc1fb74593e0ade035d6759f2c4435cab5ccc01a9eb78d0e101d871bf340ed9fae9cd44c17fe51d57f0d65b9ee538f75865c633947ed35c74b74b9c366ecc44756d3b19d583b94f0d36097326ce861a412aabc4806a3c04d39c9380edc148691712ae744237ee5ed5a82143effdbfbc2a849bca16ca8cdbc2ddd3cc387b65dcf2eaad3ef52979a846ba20dfaf0b81204dcbc33777a581852cc83a914c87266fc6b017bcb4cd5802f76c299124ac04075f56ab060cc184f71b82089a39a911b6cef43f7cb602ac3c58d7b0d3d2e7647b97bcb7c467cff6a6dfde87690346a0ccc4068e12acaa9e71556823084151cffb94deddd887761ca1ce758a445e74a27b1c7a7973819a39804820b45d935b95c4f5b5aad38549fea10106725012e395eecc73933fa0dd6d3aa012a7266708352910cd7fd583ff8a8febd5f2e66685e4bde5eb1949c3a6f7ea2d3138499fa62a0fd334491c7739ba93a4509d9505f7de970c182d79402985153d02b824bbf83cffbcd5f0463fa6b901fb421c0383d2a13efb20c62709811e977f7c494bbeafb40f28e6e2fae0d84c03d592ebaa8c6248510bba330c11ab578b6d122940fa11d51718cc9ae8b79a68b79a98b69aa8f29a6a20b323ba1ab8b9448627926413fdfca7dcf6d846e4ee28abaf2faf825f262b2eaa81eed5fc0c04dc9f48c2aee88a4de3dbf4dc4a98d3c83e47cfdd05b8ba7598a1139ee892772a4baa999f86a2985748a3d50df5710c7d08a48276c31842264f61cc2468e6e5d30fecddfdd37dad160eaee01d103f7b0e895c9f6920d1dff9f921dc8ba188109771419d99dd421ae2156af58bf7f8961dcf11aae969f1af1af058498ff7322317d44e35667d67cc69dfaf4ecde0f0a57fe76ef21471262ee6ca700a4be6d876579f86cef2115c5b7a21cfd65e498799a06c35cf89589e74211e170039c5760eef7a1e54bffde0f1e64924a0d2f5c2634882317be23044c9309c214692a4c971b8c6664b5f1d4297c9c2d42474f1fe586472cfb2d6a39629fe181533db56b4ef2992a65f04b626155fd84ac1ebc7eb9c6fe2554bcbae39be8ff92e251d5afe5c9aea98fd245897686308024636c354e51b4a0f6ae7fb016d2e719773a0334dcc67107d3c3d318f4e4487d66ac105ee8400780cfa7160189191e8686ea9bf26f8c09e73c0bd7168ad54bd3c8e8375296cf3d6cbcdfdce34d744b82a73d22f6287d04ee26f8fe702a835f7b0fc7e4cdd95910eeec7354610c2ba9a125f94785eabfd5d952cc8cf6d2a5fd0d45c72cf712812a10302db447e9d195f75493775964180ed206af128e25ef76f3ea5a5c4754d91f8dc52d8e4458639f4fc1e2b05ffd8cff3cd3f0fc44556bfb85384253df173e7fb844d6367cd520fc52834446942ad7d155283ba262f3487581ec98492252f5ef4b834970424c9ce03719e9d77d5a6a1d1238bea01574f14411ff73be211d889c74b67f3147bee4b8b4e4b0d0a36f5852e8368aa274dcc5768ca21b0b18bf2bceb91542adcae6b3334cf9f3512c6c87a278675c6ed1abaecf0b9f4b8b73ce23f726ed70b3996f729c6baaa7a1081549c0eebf945d36822d529fe5c11366f1973febc17715cd792f7b7f9c801852952845e3c5b2dc48624615d8f6df5a44592c674c38dd240439dc21aaa85c796408f7590636e536722ffc86e0a6b2c1677af620a0340e986624a37c6c0a6cead970c182ab9bb34c63e2e4cdb8d597a31c42bf4258c77edb01db3271357d060a14efcd28f312ac3b7f0c7fd54115956989bec443ced765ec9b2623c5bed62ed89dcbd46e798a2d01b8a0bf19a213586312ebae5a23fdcdcd7c4209ea8eeca9c7487be12e896696e916ee66d3ce4e09a0f073d286414079ed1b8c886dcd5d753b750bdbdcb0aecab62d40ff4ebda75e8ee9310862aea1d0e573c83fa39f06c75513e69323655bb91c9c44ebef596ed2368421bfaf28c0ade89031d8398c55b118a404945f234ff4ea55edd5deda41607094fdd7a4e5e4950983e0bed4df75dd3654ba1b0c45b59b09c2aa35ea214a4f7f49b79b27679e67aa467dd80997d8f991d8877e27cc687464d94891798365d31658578b7058cc293271140ab785339740314826462f466403ec969ccc9922d5ea1d3420c43a1437ddddcd647ea7b1eddaf8e7b7c5b00f5f0771d799c3ceb6da83d58a31a47ac562bac7653d9480f02c1bb69fd1b579267ebbf925865b4a1a3a000bfaee39c19c26123124ff49ddcb72d63a2ada730f28d629996b1e37e1836aecc5ab48edec87dd5e7f9ceb185ee42a5cf17af2e4017d378ba0c736acb2b7078270f6d52b836a0d2a0c3a8769308c102b43a1ac1d48597ebb51c8367340493e10ba85810df898888997774002746dab859e9b49dccbea372c334f209637500322f8db885817aca785cc7a9b264ebf934fd7c43c975ae65ac125685a176e54af47c729da78aadb3d4202538ea4c5ee6d16e81d95e8c074326240a8ff87a9d33874c19f03d8c2c5dda0a74a79fcee5f0b9fb9f3ded5d25ac5f243d122deaf0daf38a6f7a8960beea13e6960dcb70ecd6e4372e463668379d67ea7bcf8b3c8176e534e8e88cc257915a0882d84eb095a1d83d6a9465b397e3d494fd28c8746864100701b9e90c35f84be6713c79a2c09437175900e3a61de5e0c4900e27fceedca6d4cd898f730aa3dc61daf23c8e6f464aaf22ad4ec88bf24196826d19e00b5b3c0fa38686ec171e6be425f74b9e80908cb6ea7184cddcab102211cca98e015e315e8be3605bfa6021a24ca1d2909ae3749af4d43d7df636ac04b0cd951e061fc1b4dc6f7654a793851456e29260f2c45e6458151757fa84ff20dcd55a4a4a37531cd9d69669f7133cc2738d860109e319bd4429d5cffc048d365e2d653e708d0dd16694c4ccf65528ba998b1fcdd7eaa693efcd34851c9d369047242e1ebdbe2941bb7bd27dc5c2aa4c066c13210b5128742999c69b6d3caeebaa423794447618943c4705a2c736b9679b4a6204b5ad45079c43045e73f7b2469017627d351ef7a297b5dbe8a4f715c7ed3c4ab9167dbcb85db3ecaee1976cf3a6918273d0b1489a530492dcb75c31937737b40c448ce10ab2a4dea6d6d2a4b0032d05ee3436a924ae78bedb1839849c08451cd7b8205de7baba6962c323e21f5ca30f87e8de93d199f216c3553309d188d78f0e9d3d1ab7e70c3d6a1241c1466d2eb6e50c458c39bcd319747bbdc881f7a8c0e989379361c45bbf1c24bade84f7c4a26d8249484336b7d173202a08856af98845800e30976913b0eecf44c46922dce24b465ef491360d67cffc8a956f416511b6bfa59a7bbc2f6acbdc0c0388928d2c028857ed31896784007d3ed603a65e854fedb2e3bbdb0ce8a019e916d9462ca271d6697c1d81176a6314fe92cfed79a298d800260e7924987779da94fdee347aa2395d0e5d2be3640c00a09ad2aa9356141363687ae4293db4f68253204236d73501d30297d0b7dc6f117075ba82dec7dbbe9cbecb0c33b6654b24dfdcb4ec46e2c2b3c0f5bce9c2b6bc4b1026ef4c9731739051f336211b868399de40d93afa364c143c78939931844dd9755df38a6fef2d1ee31d454fc7321353d30ffe56d71ced20077c1a2621cb8c9e44c74ae48bbfd7aa927278ce212dc8456ca8521d86121953a10986205cb3fd882605df6d9e62c573cf2c0d12cb7e1f8e073d6e0714c8a4b93008c9016a8f2985529804d99a676271276a4079a327d663a14f64153bda625872c78fd5a1c3c756078bbc9f088f999e17048b712e1695a93417a102058bbb70e3b4d0b06416d90b6ef2477d8ec0ab4237ff6c88dc9235c3d924ffc4b3756d3124c92064e66cb9730c18c257d030f344cc6049407f29d77ac7f33c63b2d7d7fa2efba122f15e9f98b2ce9c44c9e146eed801b91e1e6db8752a5b75c6361902e6cc14d5f3ba496482c6e3b9d5cd62a64cc2943c9e6dab17a36427f96314a65b4bb7e22c4bf27e8b81b282b18a324679beaa041533a873973e5ece92773c199a5131e8df3532cc930012c015e26b7801363a2a4cf1fcd9a14e147576eba8f88680b2d8ef6240b6a52c85f20d41b3a74b48c651017a6b22ee28f2fcaa1fee112603729a34c46a5c7f8af13503fae8c662e709646bf26642718468128043521284c71aa3edb42e50351053f3629be4ee727362db18322e199d034a20efb23460bcb0d638ca50ea241dafd3c732bd6c8c44e3cc2ccf501ca1c357d7187a2ebd0d0f13a9da11621fbf1e02fc80cb2bb80300f2d111f624afcadb9b1f4d4c49d16d7b35f3291d62c7abc98475946aaa73c41bd0ea4ef4aaba5d5df8cd8ba351c9d7581e211f507c985bd6e40b941234f74290827eaabc4161c23a6d5a0ac2b0a453e66a5f710e8c38a4e976e70b835671065911eaa81a39f7d47d4e7d620b1fb551eea45ba107b2b55b8fd02fa829f39dae0fa4a2164ba76fe8d4699020fea9379a74f6ee45a17d7ff97e753af46afae4192cbdce67413477ce58496e26d0777d39441f4f088731eb008a57e091c4dec05c894993c828cddc0861e76a0a66e6d373085397eb8806f88a51b79716ae0652bfd714a19f5de3b1ad3574895a64006cee88a04be6602337091e2534c77d3f5bc96e437374cce78a0e2bc8e531a317f4e1f2ba755b6f0d752add3dab12a82c951c9aeeeaa5b92e1fdfa65b7530e48b4fa6597ad6531b6304df5dc88869a5cf741249bc803f8287738b551c3ce125cf7889d897ea10ad2b42fc0ea0dd946688d0deeeaf3cdc29bd29c8cd3230298ea2c8f1a3617499042fd62155bb2c3f3520e342e6d0d951b76f0d3d297b68fa171c778cb96f5c47d979b4b6cc96ad0946992c05703677bb2ea37bba274a261272c2de58ec5e4611876c8628464b36f98b33afda9b55f0600a21cecf9a18493c84b6d71a831c93debc6b4a29e23ae210699364cc26bb634030951706b0392452abeaba793d1123156009ecf33c0b1eef1bddaecc658608dbf1c59d9f39f619f0afadaa604844a39bce8a52c5e8bacad9f0242a2ed7849e6214e83e8ce061dd42ea2158b55a18f7eec5509ed7b06961207344e121aea1536fb7e00ea8be4ceffaca77cac6e87e36422636874fbfb27ab743236d23fa30d0d423dc20c6a74d7fe64a17c9b284ce9c146317f609a569c690d97a3e10292052bf9774c53d91c76dd71d85677a65561ebea3d0e7db5c9cab842e0602d054bca6358590b507dd569e5838806c51da28d3273bab285e31f3ce2d9499143d9b26af54d56e0aacd0da96b7ea46d4e975ada5832e5014a6cd9c2ed3035baac90d085fc3ea7d28a11422cc2cd8fb0364d141c0f480fadb5aab393c542bc25c906864a729a56f95acb8724defd903668ae79a4ed4ccb9c579a8d65db253e03113ec6d156466e71cba43c09294b7493e9e8d47ec65990d257eacb0a9ccb2dcb37f394fce9528637c4df06e0c3971a445ed223662c94f59e08a3e60d9118047ac102f74cc5fddc33f3b9d1e33187937805b6dfcf674e1d31beb0329abb831853c96b60c472cd88696e6ce5e6677231a9a44167057493871dd4b0917e8c25272772e70608c63101400fa5b7cda4f851ca2fbdd4752a67b29c7d6ae2e459eb616c662ef4cf7b04693f9b2f4b7c98a9c626f56a3242a1b6db114dd2616a207312e9d8c98d07cebdaddba1bccb3591cd98d20ad0f692245da4d1611278c509735d6aaa2a7370026df4347d9daa9dbf2d3b6e96f113f6b35dae2a036f4e2ea1a3feb02654ee24a7c848d82a733ff7c16874f5a042527720d01534647c0e724c69e4f7c0c027687970a6085233f8d2c33b4e7db0c38b7e01b4ce0dc6e437021a6ec9021f1f7c02c053705f6f68cf7efc7b7146bddbddb99af11f76cf64beec219b0324967fa4a7ad83907a2a91014f73e05421709e6863b9b68de5642712d7bcc05fac142ca1ad45d98c6421c063a6a695a220ea93ffbe68bc6e1e7fcf0284e461e8fd7b2bc4ea8b8b1c67920fdc3564dce5c59b25e2f44b41ee284378c16a40e37ad7a563a5ac01062c1b99ccfc62f1a376aa7a16a7e14a5a026d160c25fd8d4a4f261e760b93ab5a93c63dbfc59610649e4efc92beffc5b9763ccdb7bc4a76264b0e177d7716e2e5a9930c6401a0d662caf6783cceee04ad020bb614a36774a36711b74d4a40521171a7313063d3115aab1a30a8cc50791553d573d3568d8942c424059dd57e967c0fbf98027eb441b090ae7b454481ecabbaefafc7e86eddd8a6a17071237a0bbcb2fbeea977243dfab07b7a2054688faca16363d92528b3de48ba8044f6fe4aeab5b0d4dba7826caa76cac083cd2669fd4cf5715a61848721d50d716bf4549b88f6fa2d10c143557f949bc08b400cfb72776b5afc643f428972caf79f70ad9e8d6b167258658c6d62219c6781f68999b73489f684cd5a92569702d6138318eeee9e9894eecbc02cd4fefce16bcf5b9aaeb86684898f701755a3cb27955bb89c12401f93669cfd7754d244d35a42d3b1beac823217b4b4ec9cdd22ac034f258a41cf586afa80fc194615d8dbc3fd00dcbe90d302a8ddd2e566879c418d2b725f67210a1a79cfc733fc9bbb2c62bf4ad8f1675832099e908a20f0606dfb657bfd3ee29deecd67cb194ef41b0a5c00647250ce5afef545f585a76d6d93b671df31cc880b0f36ada580237d6b5a0036ea93838089b6a2a0c7e4b8aa651a1f1d8b25991a26c1d078549ec918459e5cc0c7e05894f4f298cca017cb23a8c8a74d30d66338b034d431b3e150cddde9dda32e6f8c1016831aec0d1d70aabe76aef8ed709d4308fae218d1832d78924bb17cc834fef52defa06526390ca060cc6749d2e1c212c0f1440a6446a2bb9b7146c2609acc13a0d3931a2d5a01439731289e3ce00a7ba95610b15cf756ef7af34dfa199dbfc428bab00414b40c612cd8ee711914324b3b270bbed8e20b27a0ea9c6734557138cb5d22de2a4f80f6732aacdcc92040de2fd8188f932bb8544e6cde1d3540c88967135e63ec470d089e215252fbc706930ccc646e1ae370b21d5c5eddb0c829e1ce60c3b105a230a2ad0665f319a4bce9d0052028a393586cfbd7ddcd729bd2ed4cf2086c2a6a667611496892bce93240867cc8ceaf4b627c1cd0df23700aef60040b9b4b5cca78cdbae8af4a25c350176bdd3c270e1e3beeed43dab87c28fa121079857688324bd1ff096254d52d3e5e1c734edaea73ad78c9c250c820229de1884c6ac67c08e7b463010fd520ac99d3072fba1666e4f33c2fbe12c8b22dc8dd7de3c44c1bddedb691ed2b44f8f5903cd5bad7b29bc63a8bbea0ad311ddb3632fe38af4e706f1f19cbd2864db9a0dcac9cee7f3862e9b9a0a5a75d30610bc8a8c85b35a8522cbf03b8a88e468afd37e3d1bcc48e3a0623d4e65d37835016693fd9883b51921cac8cd78dd6a92d378f721be60a89a306421a4cc8e2a9345b70dcb1166590d641949b4eb7899f26fa74417288151feb90d87a464f8bed65d8ba7b21a51e585418f0b8f6f20049db25a8e448ebec3bf6e31d3c851e855c141c0c1d080364b66e26eac0e739dedf30e5719e3a0fc96dc3d5699fdd6358abb47f96cb51f233944bdf0b7c5776375c76acc915c891293f9150bb4b4de4b8474ab95b8e098571ca5718849975cf80a7ca9ef6c07f29f9d069920c3a86e8d80b10bf774cde9e06a5d6c9722eb10087fbaf28cb0a5f89ae72db8b562e1a410a2d4e4b3bf695778f8034a2553ee157d4e6f0bbae593ab30ab2d43d04d94f202dda0f0da2f87bcaf281b3a50927940d47c935ea6f91e66a672a7555f5fcff320592302ce2827dcf2bc1747acaed5d62d708da9d4c3e375a18ce9752440f6c0e71cc6f2d678972d2c78d296d1047e3ec47e2c4bc5c3912c0d9088ac53766d485d23399c3b27beac40a2272991a40f4aa607f77a32c17ac7def4d9cf92184ced5b27eedd95a01b9d1ec60a9d9957abcd363215a4254d8d8c3a030098511ef14856b7df0404b01478edd0613e7a666e363376a7773544b330e64a5b062554bb9fcdd40cc2599ab77926271a2b1c9f2fd2b0843c8ddc8ee56dd011313944694eaf179c42b8a7cfcc6c442845f97431eef729b08113b1ac2c1edf3f43738be70587872e465dd0a995f59a32f3b9965ed43ee6dbc0176bdb5306459142aa2a0f598751b4ebdc70c9d3577d967e584509b4a41391518c4fe518cb91d310630c952e658005319c945e37e99cd063976d66e4117429722e5c45812d43687c9fd873eddff74b918693ca813390539a03b943f2b833953c62a65abad2894147c61560867426e72a5b7fcef28c1a773d07609845bdd0a321bac83cc36edcede498d67bc344345bed7da6ef7e391e62e6b6a769ba31b552b98105170432ffccde1d065e6d3427aadf708b0130385ad65aba30aa529b369daefe99fa6f94213c2928cbf7d18e61d32cd28d7813f6eac9f486bcb8b58aad6730c70441504c3a61934b6f1b85973784242e2ac563937c465b145fa93130b1b83d8e8d2b9b9b5e8a85ea531720e7b6ab4998c8b7e8d9358123caedead41915b6a30e211580b0b0cca5cdd28f3b6dcf0f57271d3bec49f33902a95ab1fa8d9c289e7550722b201c9ae9cb546fa9ed83fa164bb58bc640baea853c3d9c81323bacf65b1a2028989b85745de27c454dacdf485bf45886148446d066d5f0df123e3a1966cd2e08c3ed8c41774432cf11310d140f5a1870ad19d8224f83e6c744a545bb2715659b0abde194ef6024a5dd704c6df415ca8ff589e36a53e73ac61453921ae751770b73f60e1b4167349691ccb27f541bcf7446944194a48ef0176aa0e5f3f6e6eea9efdfa505c81a50eee93cc34a5e27315d9787a89959c0608791ab5bbd60e4e40bcd24c397ccadeadb636812ad6ea583bc7b3e8c7bda75c6e47226e6bdb840954bedd0b1505bcaf77d310c458a0419158d7883c96d40a844461414b7e91cb17d7055b1016dc33c5b7df2825a8e03cf20a8c20802abb9259741a54c04461511cb6b0e5f7a3da19d84a08bedaffca3c4aa6ce9ee74f68896a59690add56fc3d5d48e7697544a4a8e3291de57911d2132a63723b5736faff6b913d3f430555cad660655edb8bc0572d60380192171b692b886027c684fae0d82cec610a6c3681c728ca2c5e619f5f2caa099457227c323b573648e82f3a9271e2b0e01406e80ac69c5585a3af92ce69be8c74ba5723bde37a62adc47ae01a175e65bc78f4ce4ea94ec71747cc5737294170edda3feac3012722e0d2d083a798ee10c65d078c725296b3760a4d162a6d445a275ced239919747a125323c17c00200bee3459eecc7c3b1d5168ddf9d3750661c0b9067fb0a423d21aa4221bef64990061b74decd4d7a3f6806420e3587a195822edc87d0385315f768ef29e64313dcce4b6d2d4ce351af2c7b0ad345fa0daa53ea98329717c5f534c571be61fe89f09315438059d74b35acd434355196dc1459bb89bb172a8a37900c7fffccf5715359f1a6722774dfb42e7ca4c09fd1705b5cd78819f3b9ed68a68de5313a5f4d4cc864a32bd9b7b2384fa6a3f682329839ec052d7d5bd7400870c806d1f366078e2cbcd8bc523a63851095731f58379332ce44ced6a48479bca8db880ce86c9dcd0103b8d7e6ec09f94a4ee899f6c430fe3688e8536b91138ed1b2bd8582d1d5cbfd35c11db599bacf34aa5a888f3a178965dd0b9e063162208084ab818a6b3fe350990a67709bcbb31e6f05c6c7ec49ca5541d1ecfd0eecd974bddb2ef1b281a7c8830c73817db6d9632853397ed13998efb610eea8426ec1eaa1ef08e97090f9cfd66a6c99612726a1d60b31fc5778a3980a395a636d6f4c3294b0c4d257a74dc4ece15e3f93d88dd6927438ae7782cd58ba29b381240f5a93b9abc6ea88a343bb7a5aae6d10484ea9d3f3d86e38e3c329b3b5e3be4d5d4e99136f1e67df60a277ecc77e8276defddc9492ed421f7977d82744d07456ff3f849dc7ee843a97ed1f8881c98621140514198a3c2387226778faabfff96eb7d4ea23f59809b6ecb57f4bdef6ba77d354b5042cdf410899ec39f90587c99de250cfd0de82578befce676d91e575752a12a47972f5fb94a7431313742f2bbb742cdb2c73fbc020f4785d589846574bf85a89829682cbb86d255d0cf4aabd52415ae66eaeeccafe68bc6d7f78ffb8d452c842658357877071ae3082cabd12198d83a5c18649a2d3e6fd91c37a687fb26008211816ed07f0739cd27244e68f7fbe7a4d48b3228961e4845951cca955e9b23361f6fa62546c5422677c57520f0065969ab135b38945117b0cc59efab8f3bc30dc25c3baefdf8351753cb5e0c56b64729428505caab4021211d83fc6afe25e8ff66674fa7b23abbbd5e88a30695cf710221d13572495d4df84dd6be51723e189036dd28ffcea0ea2a04a5a2a37dae8e987c73d294271599492d620591989fb6d1f82e02024aecb8a701377b4a4fd3cacdc4f8ff33ffc4b1bd700538b6409afa071ab84d77a65b659c951d5bdd6ecb917067be294b688d81af6294d420acdbfcb9e845871520192a20d628c43810577f7d137662946d3a461f961f6a6ec117efd213ad646360564c4a30a8d82a005c4613f5c0d491bb5f1139f1a22398923c143922cb6d2c2f86a4f5e6b4c72be153ecfb87fc762d377e2889110fedbf9c90b039f6e853fbb14920a2f3ab63bf1610696315070fe3cd40381f49e336b407abde85a3ba6f7f893e4304bbf5f644d94a7c7322fd3a7c867edc0dfce7732b83dcf1e367160dd76ec2cdb3f79114dfdcd2d80b02f76162bbe279fa73f989a1ad13418f5490adb2f2e9d813fc4a486e77bd77af1774edd61d272a3602d11210a86f9049b6658063b0738375f62ec7e8993948e72f1f4cc19247d3423f467b6774ada37e5e2de25f76dff2d1f3bde79c9348a9edbb2f560ada304f6f3cef44f860bb9952ae69f044b7986dbdb6b8d2473a6de23102b761823921647e33eac933adbeb38d9204b7160f9a570a53825c82d9231259c1ac70e6a266609597f5b913e7d53734718642d2555cfe2c42c982009c2c79eb6f5b9f03404c17a3a9190a26220840bcae1b90f1452d5b964a79399ff2a5ca5f3af7f3c606c1cfed06abcb138342e55f5a6314e774b2f412e1d86849898465eb80c8e5c7f95ded85055d09ca3377bf36430686dcd4f9d54013c7d19c84d761234200176a38519e57ec2ac9110e10ccd1a5c4e1eb3b622cc407bc32302240e0fcd48914664b6885aa0fa095763c3114959cd37df129a7dc2a3b104f4140b046d1aef91d53043f36ea4c1c0323721592e18cfe6d47f2e0b8752cb742163c938a1ba7bef3fe5effc7821d683dfd36c47c614655ad50fb0deed1eaa2582f50be7a728aeb76d08a23ed53e1605debffa224d7435ba677e165cf80529317c6c2c420dbe990fee4ae056da34d4338abf9e8a741f249fb300272a7d7265746a80fa2967aadcc332734ecc7dca142e836597e9866ba5b6ea0d43d25d1eae4afc43fa9e33260612bb91d784913f26959e1903c2a9f28343585aef680e2ed913a233b7d96a00176cb5c9f22bcf55aee4fe5e9c5f58bffe244ca35e967708b722cb203fb3244bf763fc3368e21906c1b03f817157374b6666ecb4a2484437d0445e0b04435468ad0359920a1bd1241d53ffdcd3cd67412aecb74008c688565fbd96821fe511444b313dede2f9febbc24eccb6e17ec640a69fd4db7799070a132bbabb11bfdd46354726778d9d4c94534feb2de911c5c4dfc13068b961d1387e6c6dd5263c734c613b0a2d159e9fbe44712485c74ca147490ced5b0bdeecc852aea6d75b8e71d62bd1e567fe84e25300813a47e5c0cd28afcc1c8682d93e81520b8c6630c2a67da09d943e8b91d1d317d49ead086ceb7a530435674384381a494bbbca665686707df9ab6445a749ade7ab290d4c3d3970f6f1c6ec5457690880b490af458e329fd6740ca02697a791e17bdbfb9f5ef0487d69023d10f7b3b15f1c45c9745654d6fc080ad1ea0f02129f689d4db8ac4fb3b719ccdaa13e4d016820fd20322fe034e1a0ea600fcc08eb3ada5005e870cb5a58ab878bc1f024bebd5a3b48766dbf4254eaa616348cc10ffd736ca8b4b485c4f435e31217a217897223926375d7929afc36e1f15e6d39158679f639d1e9a8a8ca3b86f8a82ea8f5cc165623ca4bb4c3aab4bef158430d724fcc7eaa4646db09ce75d312dd6675c107359de2fbe29839823ef66b61355723239a72e311470f8bcdbea6640c0ba0d28ba9cea86648823900a032ac6f2d01bc1f9b50093f6c2571192431dbb7e1c15768d4a581df9d5eccf41bc5e4b06497fc6855ab248e10c0ed34fd1653c2c6ea29be1e9a60810328d88382edd772d310117faf4569e46586f293268699f2eafa1ab864a8f4591483eec1a5d614586ebe45e87dc1fbcb739179be79b1655d08e3d3f8e5394d68cd9e1aeaf066a5fdcb869d9e64c620cc055628c370b7bf89e90363fe692bc5faed9ef20d6a39ace34fca83ee0b034572df62b8b0c38bc6593c31080afa61d45cc602688f21e85bc4411976cf186fc6186bd1ddc8f593c48746a4dfbb106e47f20eff29eda23883f4313216442c94accd3990bc4cb4c5aaf0a1d17796f2d7450fdf24b5b76b8e259c4274da30fac6dec7bd2ed7b349bd7b63c73b878aa492ef5125268efac2713368409af8595dca199135500688840fad9eb179e69f40128a703670576718194d7414e2536a9ce766a5389db3f782e7fac2d2789611923dcc5bacad07958e9c262c03e810396772da091a50d488960dbc250b0471d911d79de2e44d5d72830fbcf7d3892236f7368fdb5ed605388ee300602389fa7eff1389d8bd6ddf5d09bb20e37790abb210866159ee3b7c606e1198e0b5f14f14dfeebb561ba53ab5972ad9babad44d39d324557df809e436d6e01de6641eb69c6ffb4d37511caac81b617f59dd8e2c404ada813b617627323d2e8b517479182c2883bc78360f249339240b9893e9a9ae8374f097ce9be9b12308f50565be3d217087f2a6a5e07c1e7a638322c7f289bebfc1a6f93beecbd37a94ee175b97f048480cb209218fc3731847362d211d5dec92a6c4bafe96e9269eaec07240d6e606d4bba77f06824d8bebd248b98c2c13cb0eee7da30500bf0cb04eeab295a0d79496017d1a2e98bfbd81f709491cb02fc2245d0a965d9e1408b2336918eb324f7a6ca9bf690390746c8a37fc5ed2341dc6714b250b20d4160800f8c7038956a6362dd6c603b3c0e42c81416050be4e9bc183aa7834bfc6e879c0eeb63071c71b86ad1ef776e97aa45f0ae40cf241cc96f2e80dec785202c06b1d7114a60c621a4b17ec787efdd10ffb81c22c0d5549a8489ac32116c1b7cdc12f4e577c98d02d7df1cf5de5da1eb0818f0d6f731e7824b4cc4e598267b827a7cbbcbbcc4565a19120ec70c6da70f6d0428246d10d3854877672670cd31e1e05272ec105548a203e1b9e76cd19cff5a34ee641b3d34401d0588b1f0add317865ff2d0080e4c5262f50c60b7b3ce1fc80853d4d8b0a145d94cc567796f9b6c77feaec17e5b804e564f34c91e682f7cf487b3fd967815589b4c7b63ebe56cd850c63240c5fe597f76c34ee7479b97e14d68e51110359df3e001829b3b377d77bbccef35c40987ab16443099698569e1f2c2669875245487e9b3e5f52f23e6b89b06dd0fdf034e8f67b51635c5b0f64f898739ced09cc2e39f407620980ffa21ff12313bec992d7fe1369996734e1b94f49e403a157bb22af8ea27cdae0e9a3c11ff12da47675e47c3adf99c8331626d00b945acac89ad508a4a24653c4f34ea9f8e0309ea1b1451bf5e4f83cd2d95597cd6f7828cb46b830718bc5bf355f3775f35bf6a7c7f34351af5c8eb61c0da2e7a1708fed414394f75a86e698b568967909cdd2ca2d974701c78c159c5878ac8299422fbb512dec198a39083c958bc07ae82dd81c9b0585aaf344ab61935d5747f9126dea58cd965123dedf964a082a81423bc2d0fa72a47a9a714e7e3eb20466ef1777d6d5e7155898756cbf3a2cd335c6ca83923c4e519113146f6bb143e290b2a74ac45d8e3e9fbc3911a8f1cd286298ad647cf326b40e66813c5c27fd3dd2d25de35f128b2d51bf1360d279ecc97ddcf7e36881f593613f3adef3565e624664e16625a6cc2209baa1f79063a8be4a0b50309809e16f362dad2d467a4acc39059aa107faf441c3b0ab0eb7900856a95b67c8b1e3427ff8209866826fefa059633e47cc726b906c2bfc61ae70ad2c72e74c5f3ddf1d6d921508343951863abab1e59de5564c3fa93fdcd7abc0b7fe290ef54004730f39e4f253a2d3561c6eac107d4f7de2e1aa8a4be4c9b221acf0f3d1079e423de9789a3af0b5fbf4b8e4b1ca21fae53fc26b21d1e9502d96b015556694cb5465efcf9a3c4467ce093f66f1c922d90fe2748c9b18819bafdd043160172104cf90ed39429bec2c8e2fe630b11da242a1bc5cca7c72e6f0645b5ac180037d4c29a992d6b87eb462056ffafc9ed7f03a55747dbf3e7758717c0028e3c8672abc2aeee29fad3321e18654ae09d456d88b257fdd53908f02c9f1a33de4c1e72eb8d9efcbb5e3aa41e4725dd340282913207fe523270e6b19cdb28a3a729111a8590b1f48cda0140098a1217c2ac8653d7da688d2e449c33fd898eb1280ba30b80a669570297447d1d27a2a9fcd338dcdcfeda9d5aa6f5a8082eaefc19ff5d72a1c9fac082a679c19aa504f255ac3b3bbf19765f4f29c6321b97fdb705f37f0795e143b6f65ead25cbf63b9a60d31a266c00fe081f670132143be97bdbf7135b36824729d52633f9142f1b1c67400209dd275c027c98138663642f2208656b938159e7ff20cf2ab73d3f013d8630f4e1820d62613bb1f162a27a67aab7ddfabcc94ab929714c85e811532e6483db4ffe312f8487ba80895ce45afaaae58175071cdf7f3cbe325fb9bb06bc533e62cb10c988a45b366c8007048d92bd3567a6b7da184a2be8cfddff7b82bbc8c79a4990fc6567e7a6faafaaa1a44d2d4a88766fdaefd9d6aa480917a28eca4aa255c529c4c123b95653dd6a02213adc4107ab8afefe41ad5c8f4abdce89e01dbe6ed5710fb163ef9d36baa955e77120c9bad4413f57cbf0a9e0e2b25715d7db9eac5a0d49eb818e2c1488544826248b495be40494d25041d0459a975383d8121fbc9bec59105a20bc1d4883d8a87f15242bb26975863ad0506103cc47463700dbe88b93cb560c07bf2eb5b2f1b5ced1933c112f4b0e6d773eb8ec1563785ad6e2b1f662d725d9da772b2d8a7851cf35eeacc8dc498051be1a73d171551f9ac728177b9da801534ca617565e67da0701ff97ad70247d780bba71f2511f0fe31b330f1f748b161237e83433c3238db900e30a884c7de69aca7296f7b4845cf6c24a3457f726920edb1930ad0ed57242fdc8800a4a92826dd85825cc39bc8a51c5190699f15fc7133921ca977e5729195edc8fcc86c3c23fd71d307a421002096b79d8daf4753e270c88e09bb7b91913ed711973749edef535a87cfdbe15845acafe70b5eb7c2b69a134a4a98afa924f75ec6263a19ee6523a857b64cbe1648a60b98c422ee27ddade1534f27168409856d0e1fc1548bd23ce0d40d3759f66fe820a6b829de6a13ec533865c8537854cec2e010d2fb5c83fdd83e68fa3d0b9f6d5b69ad898de09a964862f4c6f702521102831f3ce8f2e7adc1badf5b81644bdf92cc970378434424876dd1677c3f56460f003fcdc594a3f7caa5e8e81dde154019c51fc4adf8e5d0132afb99dcc957ea4b1230b3ecfa0b4c058b4f1bb08863c690ee798b6ecef3f26e7714857e4b3b4232daf7169a9784bdf54a40548f5c8cd1a80200876c119ed7f3a6f007c3121614b8c11b36ed6e7881e2504c30cad56894423f3f48b4fe7541b08a7c4e3cc944fb6eb945d38ba0aaad4c6448618b6d26ae7816cce4aa555e37abf8153ef16dfad55ee1c4ea8eee7606c9a6440e95bb8f8b9576fede567e9b15560a34052dbf7cecc0257b7aaecb2470a56f2bdcf547d58c7525080a02642ff5538d2c8f6251145ecb8ef51e81689561d010226cfe09437266e710220c2942eff242ee47227b1e517a649e3df2e9b5712d80f942d270bb84b1ab7c2d812809dcf2005659be6e2120be2b730d305d4e4f0e0fbb7aaf5c72c66953aa98b9f6616372469408a1ff6b6ce9c5f0dcf74406b7c1af55a468bc996f78a9eebca3b6b8271d32954d3990bfa9e2e55dda479fe0b7a6b73ee382e345118480eb6d4bc7ad1a67a6026832e697c1489b2d13fa7493e25d31bb78b698408f63f5a69f97ebf6083002fb4b6deaef41e8bc2cca10068f946a7d220bc21ad14be5d3d150398bf4cbf2ef83e1dad987e5783f0796bbc93350b3e9b560f8c87277f79fc97374fed455bcb2f940f70584f690747086608e0ae758eecb666135162362c83a5d297eb70fcec45c3b9983bb29bfe48b7aeb92202c9867bf9607f647f45cc2730d4c1fb647c1ece5fe28e4bbd543068952cbb68aa27c064dc349ceb3fc209c21fd9bb2e155effa260dda97b875fd2a02a263a837e64647f78b70a2789bbecf6b2fd38290363d86fb9efcd2584bb040e6c100d957825f90d84d7d3cbe1238efbd5a134ab61b8126eba3eb48c76b221385e2cab5eb61f588a7204bf33af825c4d68356446d0f3ee5928e7ccf5f9ea81c2a067b2dacd2203be284304768b26bbb15ebfa67b68aa0b4f5c3790e2123509f250970e072f7f5e992c3de72ab6c7cf160f8278eb644f3f2a583cacc08e5d3e3c20bc9668ee89045ccd294f42264969f679384b2ea39f93d98a18b7077e34abab49ada1e513a160fad49db7cf07450db6920694652d8516c06be4fb5c34c686dca2df5f2133361ce107af16a12232663c3ac8febde57d96d5db188831b1ccfc7011162cc0612cbfb5d94044d8f32c6ae41be4896c81c1ca2d3fe2d6e61b2107757f2b041f44ab33ee8de5f742370dfb39f07d0d51c4e3eedd75a47fb4283de3ef945e00ab8febedc2957ca9f1e59c7d06b4a2b3a174de934ef643f44b3a24f91c53ec6208b7fd93c503e56a57ecb7efc994945bec860b964731b73c693808647ce1002e41979d93c797b0e4c6765f3bb4216b141d64db37730792c40d6260c78074eee8330a527993f2cff7990e8353c2850cdefeb77709f6ce8fcb8c7641bff1e7895281608b69b3185bc5e96775cb8e2a39e2afbbd20c4df29fe7e1d5f1ce3b899adb36305dc96b086c5e897800b1dd1ceee0120469b64a58e1c86ef261db2d88648ac8880fdad9c17a6305a66dc9018cc19032f5a889aa344f498becf0c520cb6e2b82561cc4710f6aa684d1cc553695d833b3dbeeb281eb87ef7d8a70d3f2352adc32072f4111ea81c8f34c1869f13bf49b7644d2e798ab268277f2b42706e559b67a48f939f375f0b9c2dad4453966367b0ac21611c565339edf3089ea159f039ccf94ba9c1e15b5ffc5e295a78aa754f74dc329fd2efc7c0e7925b106e3af81adfb88b4dbefdfa5703bcb5b913bd1830a93c60f321a931cd7b740e475ba58ab72376c3d1afdb2b1b785424766f72042cf13b336f3f9caf5e76f91a635c10536d1759c88223e03b7eac8814277d8479a37e1e197c2a04e5112c965d2a4731b9738e58c5c92d1dfb2d728cba445827a9d71f5c4272d8af4d2f51c176b18c05eb804f155881a884ec7039da8b05d777a31f061a56ae354a1d091ad27887d0f85cde17f96d1946fb7a05d72eef2f51d0c3f54eebf366aa55b7b2e9c5a91b41e048b4370887454fab9b495bceffc24603716b6e3294fbe4ce6786eacdcbb3233ceafd0f9c0da97d3f0be628648e6e8f3c7bd0feb00864c202a8548463ce8452f39dd50f8d6eee0f3f6e147e8ca17df52c8cb4f76f591febcb090ba31b697f67c34ce5c4b3245a1c7e35f9506dbc9256e25d2ccf467d1cfbb5ac38d05cee7b46dbf09b7e8136af089e502586b66d51acf8d88b4bf805e85a89de722aef273092ac1c964efa9ef1f705764dfc1192412fd0b1b8b90b9029a2ebf0880df0d0c2ee8b96d25b78cafe349dff71fdd05a051ce5cad21806be6431354b84a2c0b811ca1455693421d00eb3d753b029883a2be790880a3531d069328eda8d6fd5f246cc9747d1444cebeb1f0f7f31ea8b0fbbc5403d0ede01f50a040cfe2ccb59b09d6c5771d5e36578ad04cf35fa18719cbf236e7ac95cb147cf7ce69559492bce3908e9bd65ccdee68eafce589e4339dc56600b810c40142c42596e78f89d9c8fc34a7cc34176fce9565af8f9fd9a0ea8607c1bdf3e8a7cefb583d373ca9f282a7dfc663a1eb516acf684095b3427d4cf8e28c0a27520d04926b0a0059e87b6522141286c0b16be225ffcd9a2a22d3716756b9a4925ca75705db579286d0c78d160bf6ca2cefbd77e82d39cd8b4af9b9f693ff9511a2441e58f1cad3b9dc7d8816321b81a0c440d8dd0aa0f599a9172f12da5b701d969899a0eeb56e9f19f3d346e45ba599d60cd8d7ead0cc2fe20722e71777854dc1e5be893eb1d6f656b2d8dabb274f78ba9b2a952dd385bf75422ae6b58a4b3428665b4e843d49158042f9e8bb4f3cd2f060a515f87051a0c869546b4bc8a39c6cb9bab0bc999ee86e14682fa44beb01c6d172f7c1749abf5510b1d378ed4728f978b4ff584290f43e02292e9f025ed1f1571c80c8971a12bfbdc78f4178db0582adda61d48191e3af21f3d3bd9ccdd9b4f1260d0f781f9c5c15b5f077610b42a6799a2212686b0693712662ca15eb880596da6874e7150a749f7597d604ba1a028b994dff22568f76a81631dc28029938b40a76143d2052033c273c9d5c8f4f8a976ef0ba85bf18bb8f942e957e67351083f4d218d4af2dd74c48905fb951ff20df6d90f89ba6630baf39c535ea6ef991d6b7107e0b8734e89387f2468ece9020a511fce88c936b4baba7af1ba63dba739b25fbdafbe05543cc2996fecf2619e3af72904ae89f0a25fa85f226876da2388edf0e4aea254bd61fc93e4b6514fda82d630d512bac0cfe1e7ed4b641f4a9983b2011ee605d18ded2fba110fd55db3f22b6e611941b502a5ff8c3c7680155bcf3cf8813d7f059c12c9fe42b23350f1f42cad415b61f24e6ed224a4e8713d9f53e202ed5254f9da8e6d1c907a9ee3c8da1f5629214c0adf50bc659da0bf0c0c1f5d4b5913b22e3fbf54353b7d0da82798f6541c59af443a61e2c44822c8198222a51269490aea2e9deebdffb3fe082814e2f6c23fff87eeda4ae45c184638ea608d66bd549251ca84798f1d3bca1fdc9b71e3f03634fdacac568ceb2b6ad178cd68f67e4f665563e61651cda7ee7ed4d62081db71624a53c99d29472ed2bb187556d8ca5d712cca4e7f2662cf9cf215958940fb29c8961746b6c141a794ec7288fcad09c1ed7e47e8cfec221891e99587dd572e4ba9cf774eb096f12aaba07f41b94b6f8d989395be9132217886bee9305e299379aff25c22f78c719050e7c23d9a2722bb710f8f4994c256f864f8b16e6e8b11dab4a474ce5729c71848a1e39e6e26190eaf2f863f0799e81f739e0d1d20d8e0583fc6b0a4306170ba3bef0b8496193fcc35339afd898c804a08d1a5e0fe65031da7fb6f0c2f97ebbb63fb2abf5b0276c225cf25eda52ad8ef57745755658403a51cda5d436c96942a0cbbc0ad1e3dc3baa4fcd15b198b6a6ea9b70d9726047497bf0a1a675e3bad0a725734d3501abc02f6bd4440d6258379fc2aeaf3a14c66d1062b003fb9c49a3da8779c0725c6e01d6682e6708ee129367606de37f6eb68ab4cb9883ce4a62d2f47194dde1db875145204871fb00c8d547c20bf32eaa5de6ca8b91349bbdb6e51b2758f60c6cd2482f2fb16d18fcafa3c979c084525779ac905ab8d4c7c5d64246d2eeef124bf106d21a2dffc5c4c4ed4064b7136245f00d0119e62a48c4e43a47e98f519426ae8553c2f6e0ab7f28c78fad2028b835184b552689c5716ddf3386456ec90072225d412113969ed20be66db36be0ad924b1954bc09398b6b4eaceb3def587e776bdfb7c049f42be69fe2834fab1662db2342e14d1a2cf9b0f509b0f08f08d0a0b9b76d2e865cbd03c4ccb32e967e84b61e087d31030d35db0cbc73bbaa185e6144c666b860f8dcc39c08b79ef5376810c9042ab24c825a5c2e249421d121a671dfd12c2f0164615e3488eda1177092f867c997bb874500b9d8978defc016b042f07029c39829206bf271988c5d7168abf0d4c2bddcf8a1f70c9955ce86966062eae3aa0227f4cbd79df91f9ced322ce61a7657baaaca406230a41489226a185dbb6a6644fc73696c61eb21f4ac823cf450fb5e1181137b3cbc2ce769c21d08500df9ea6e29e22ca02004ad012d96498e71b3ff8429f29aa6381c56bafc44c237155a66b503b3a4462c86b829450e7629cf6a755258e1393f287b3787294e501c3cb2590d9728d21c8ef5a55c1ddb51de50f9891ffeab0443881b5950875bb12a49215ba59350be6f95a187ac598fb14dfbab841f29ac472f66848ce945f5149ce72c30b6a732393b235f76154f8481f33e860613dd48c9ca13bb35d888c2a105971691a2905de88d057d8b3e9c0b8292b744cfea3a5924ff919cbcc469828a4d83bb60c23ed6b269774cab9104736d6ccc2586ccc50c6b4b0dc442fa03e42a97e38cea14ef53dafe8b4787f243e91efb1532e91e1da76abb0e23eca1d89dce1aa2382dc70301c25ce7666ecec2e57ded12ff53ad82c510ee280626fe77be5ed091ae68b20638c87c4fc00d12c40a73d9930e3092b32c28c92c59ee4fb014678a7a712454f1b755fefc5ebb16673afe5617e844997106e7b62990853fc76ead334df339657fd03ef493934d55271b2f72060757978879caa14f9103adfcf29b59f161384774c4fdf42c81a9368e0f659dca5eb3e92d0e1d404ad7b0f24884f5fb049b5e0abc5de78c433cf69e8ae089512f8f8b34ae2fe5526a0be2ced9501efbdca080dfca30f616902fee0ba06c3eb955f0438d4fb319dd253e9aea521d9481b40da73ca33093313dbed64acfcd01e7175ead7e8e406ea871f340e29225e7fe149b375cb3390bb5b346f204ee043913e8990ae7bc64cc4c95954915c6870e143492f7e1724314a2059c3856696199bf3502f8bd8c587acb872ddaa320e55b67593dce2a483c17f3f7eb1de09c58c06672badfa7a714621fc7a6a7fca1d5bb6c878bd11166169cacc373d9f45bcec1eb378da7e08176eeb9047740e21ee7380ed8e07efd5af289938e60b7f07e12149aefeadf5cec8438333447b3806c3b722fd7c97e7722f3d6f4b9e23aaf3730624541597c740e7f27d87ddd04d1168663e849d5606e6cfbf69692d634cc3d67d20424f63dc539d30412aa8e0f72149ebb2d700cde61a1e4ece3a9bbc1ee5204f19d6e0187e3ceea4523c160fd7e0667d28d8827a3104e340021eb08e45b264fbaed2c3ef9557401d11479038af4091c092cb558f8ca55b4702c5c8f7aa6adb12bae69b1112c9758d06632ff40b2c407999094d8b65f763cf0779c5bec335fbf99be1929391768d7f57ba48beb8ae65f9bb8deff8f8f42eef9b77167f3f9549ce94c9ce152d6e1d7580820daef5383481ced63213e5d29db6b4f990ecaa28bc2dbc63d354655acce9c98e1b52c5c0f01d8676486c3dd79d16e4ddd05d249917fb4875214b655949a0cfa99df16f0fd88476b7bbcebd22c9e6985bde75d6d33a613488d711737ac923627d07fa425b3379e95228504184fbcbc9075ab637e1fbd0e3ad6f89255cc8998ab545e659af3189fc1c9e0efd24947f454c9e744452df7d3e7ca449196470b7b3d375bbee9f899035028e580c437c2980b00afa8ca5926133660c960a158fa5d11a6a33b020c6f88674a646b451983b82d847bd922c89436309444c2d091c44d9e3c4a21ef5b07705c34c9ec0b08bebd9fc1243d43df979319a367d57d2ca38cb705728cff5faf8f970d8e7c3f943a174ab7bcddfd31e8a21fd4dc357dd21daf384ea521403f0e977ee362b13667f62bf34c1bf62bea96dc46b855246f5ad6b1cc9d6d5645eed1477d98a610d9e6aae260a1a9953006e426a0e1873207c212c6b6015deb584932376999ca63b5070fb4549a5dc5c65f815e5d20f0e8dbc9eecfb33cead8c51debbf34fa74b60fe6d0e79545335b0d7607ad5661e44ae51476fe626bedde2aaa81327a620438d960dbf91d912fb72618dd839d731b6b14a19d748bd98172e98b538e65522f12bde3e560ad8343878aba02f077f61b9c80d0f155c5ef9bcb8931cb94e5c22642047950f297007b070f4f4f53b67990e9efe4f9b51cedfa4fd6fff953bfee38c281286b86bec17cf381ffbe4a793d9d177ddc6ab2afbe26e180a51a09481e3792f555bb1d78203e329b9cd674894f6f8be5fef8830bab5e5bf06c17972529c3898508ac62f36bc6d8740d781399663047090b1b8ddc49880ed21776239ad1f50fbdd9b9322210cc25a4fd7037b8adf68fa6b4d09c797c4179d613d59ed7d2a75d3234030861f909d7d6c24202bfc2d45fc916362c5cd5812bf70ce3085422f1e7e320e35bcf047df36e2442924e84f22151ab783a6c920f82aadccb8cad8c3fe22164d2feb7956dd2ab07a6190c5e7d8fd3914a382038a412c21a49dea955c2ef2f8600dff0b715488ffd8ce87f89c9bfcd6c2888d41dbcb5d25cbaefb9552f281aeb6b26757b53e03d588c3c2ddc8f6afc845dc29f21a270df2ddda10ad969d32c1a186dc734805e19e5305bd8ce86268120ecdddae1a1311301e5f068024d5c3f2581d8545de8494e0bafb51ac31b843cfc0185951aea91bfc9df939d7f884c9665acb10e76f427189f4e743580b65b866153204f2fc3dd3575daac92f020bd43b9c72998393ecd0bd0771b6be77b0efaec43426cde131023765b0168bd6b4f74a8048bfdcc794aabd98e695f3679c563f1c461a8f4db14d5e360f2c8941b394c21f36c9b3e3afaf926bf55d4954cb67c4ce56f983347eeaa796d1ca49eb2ac4c3601befc700d10d9179a590677bd30d30b8033aa37dfc1a9770b46d246113defa9cd23af018cbe8d2334c656c69e47bd5c7128746ecae06b39d422071141e473fae2adfdfb7ce25a5a5196d47156b82fadf4f553dcaf2c7c128979cfabafa77f3c1012070f6236ee83265089bf4ac09229fefaf69031ab6d0ec209ea620b800c912822f0c607eb10b0b419943364eb9baec76a8d5bd478999d944113e8a354c7f8fc6892f6cd4207194bfe0b483eb4ce338dd586ebf6b9fb024e7edd3dbbdaf75cacd43a8c7c6905c327122811896c9e6fa67c11a78f2c4c3e015ad1d853cdb0dad69cd2ca49e4181dfc51fd29ba42945683e6bc965c417afa168c53a8ce8d677b7a34536c8b2a4f05b382c4acb033ed1dc1144e79d383bef76b24fa9cfec2178b0855cbb964239e4ae3684c0706ab182d06eda03f214964ccdb58d238bb56b4716142364fc220c5fa7a2669b2cc9fd1482e2833d54147195fbf6fe6bce5546d3eeed2a0a7c92f372b1d0b4dbb201996607275c03d99f601f4a1cc4002f65eb48b8bbf1c1e12eb8079107ea0825b36e739ed49226fdc30be631eb25ecd9292b970275c01858e5534f71a2b3fc9d6d5ceef4192b758cd0bdd7f36349f8bc3e79d80d8cbec99f72a3cf61477cb6e1a81c03b988d4e97b88751cec6f8bb5d56177eca19d8184de05e7273b9391dc520921090c01c3d51aa66f8c2d6f6a2918bb0de6a351aa8c7ac3f0fbc1a9f7ba5fd483ef7819cfc955c5405b69e29fbb2c1b502ca1fc59032bcb2c9600401c023c3f3f5bda8d18b0a981e053577fcbcd712a90ef8f1c81ad8a23e407806fdeb21f444a9ed19f27ddb767628433b399632a00ab7ac253ce057b474340ae2528dac549d1040bdf73257832be56124e21abf22f0c7ee93f8247160859665e164d8ab46da8718a5b5f155ceead40d8a959d95f6f62564cfa14bcea1eeb90a17bafae549d6dbc665527b980b4cbc0cc96b63f5f3a41eb9899d306be96f27169476995fc5c40e6d610fa2d46e6e0f997f300256298cec27e6cf908fc823413a5db3c7d7b87aac5c49407cf9b895dd977a8d2b563b379c6a612c325e0654e1dc43d91db0a53b14d3d3fb4465c75826cbe7f859bad25e2f9d078186edbfabd0fba4c9e809816709347515f48216d1aff2e55e2f33b43a837eca0a920ae243e96d41e0dde710e9e50b3d32ba492032c3ea9459b76dc6bb166f0bb337c6f7a381343c230487996940253d74f32258999270b88204331c133aceb152302392b1aa16c4c5b091e81afbffec6ecffd5efd2b90715af3960ed0cf15ebbfd530e8d6a553068e1179338d6a4458a4d876028e1ad0e31ea2919c344bf968e9a3eb4c9f3dee86e0ef65355b2857aa7647dc18430c2457ab51da809f17c6534d9e160c34ab9cc96a361849f8a41a790b9cdc46d4a17dbbb53cb55d61becc68731d7d2d2471e886ef6ba70cf4ccda942512851281c7105138d8f1b1b9b2a29ad031754bcc6a95b49445b756ee351c20ee8926e0afa4bb375eb193076e97a5d72b7988183d99a199d18eb1c4f84481cf0d1427aa8ee39369634048c0344577d26986d3b2019dcdac1bc1f02c25e2c091ac2642fc090baf09476fac6d652ac32fc12b0228ec4ad80628d9c55f78c2becea04e95c82ba97d0d08663bb01d3389bc11a18bd3a654c72fef2437245e7a74e045df53f6b4320bf0613603f3cfdb3a45a3c922cef1e5d99ed5f874a1895bd2a328ef2833c88437b2666cbd0a7e1b934c41992a62f7aa5f6c67b300a2efdc68732319d3ec5a6bd65d30170dc31bc12aeeeb057204d02bafa7b2ad1a1fc9b96848e2e26ae3284bd8345f45bcdf6dffac6a73924e88df77f859b6f5de17d29d7823f0584aac9c589145c49a6b370ab935fc860a8381138a166fddf4f548c5dc47989194e173574507f49921cab5ac57e842befec8121de2d3d0ae1fae0a7149b842d03f5f6da8c04c622f5511ed635c45022c0b575e72e93daa40be13f7dea762c12d786f6d24c631e70265f4c93b3be744a209039a434af07aeaf599e48d2ad3f6ec635c05d574a34d2d5e0160986a82793ce86b536b2f94d3cdc1999aed67db1e1de3c68ef0023884ea5c3a46f9fa27b88564af02d2ba45f8a73f3b1e89fb0273253abf2c772459a4a25667557f771776c4723ba2ce060f6c18d94adb0f02cc33683fb65f5030cc61e4b6539fc7232073d753ffb0f25e70dcf5383d999d51a640b46c13586c6afb46b676a892001c28799d2027dd01f782730fb199cfd795fbfaa8afb57fec6b2345f6d5f8858843f8e75bdf597dc9503114bf895bd068ce409d2449ef7b5e6b00f6539cc0d9e7bef0dee0f5dfe0c4429993ee3235f2af37b66932fe2a958225d4ccd07db83bf24662b595559c8669aa7f4ebcdee41e18e390c61bab1ed84ff723243d58bc6d97d17d24d2389fdd00e2b4731e64929965d56485c54d826c008682d5cc91fe5cd2cc4967e9353270f6102946c9e5dacbecf05406f2254f05bb786df791fc530151c60de2582995271c0dc507c4f4c465ac1140002e9d6dc917fcc7523cf3caf394f3c5fa2a5092ca6302ccd7d2eb8640ed9967019b158d7d152a224e4f56af7993fb8be6d314cba34b54635503eb7cee60cd55a61bc10934fd22c985239a91c63a13080c16397de0345ec146eb2314b3d4718d703f6fd9980b8fe78c3326fc924b2744396d542b4dc2420e71dfb2b1e182bd55c8880d827db40011b353d66c2647dbb144b0d67527ff164e7a6e938d1ab69c9efac2730b940c80f480442132962797811c3d452ce1d632b0dce589d1bf6698a029af5111a7c89a73000845bed2923c2e2478d7b1254f4acfc996578bbc7b0b9197b59cb4593fbd75d9821be2cdc3c630ad0e7f764371638d41dc6902d72755d0a76389a5674da0f21256a35a8ff7953e5a8ec8320caac7867365d18520e893ffb8408b55b40dcb682d2fab62ebbd365b9fa383eea8469b14b81a646775348af97263210065c9cb264991b5b8bc04891b33d3232e1b494785ae42669467fc9a9db42c8b3792718d53d24d54e382f8d197802717f7ca95b96c5f348de75e823aff44d334f809ac19136e2c50443da6022eb458de4171a3be3305ea29755a2164b30d4515a0edba3b1057ba2fcf63cea24f222e08f60829fc23c898e78c34498ab7e31a941334581df2284e7aa5f251eda3a02279d253fb275087cffc42b22614ed811eaea33740ed00d262ae2680455568e297757d4bd88a710b10420b2643c54f5241992e74bc016fb31d7167323c6808374eb6840339fa2ecd6d25f5f49176ec00cd81b3f162d9d5cf96cd95f1e6318d9602b1a2e5064cae4e33cd7cab033a6c23cb45ed1de7ab28b7400616a5d4b8941bb2ae2d1115de236f0816cedd7643216572e87f98aeaa95891de5bb602db4bbdb5c82843eba63b8c7dbb2be3fe68ed35047de5e57338c74cf7a4737c3c4e371c66d24895bcde344d8ead47a52c4550c35498381d3febf03c80e5a66553718f022e2121c47251c97d751654d680c20f1c3a28ed160b768029f93666cd060b00adbcb3c446befd0e67f16f9eb8adb139cf24c9c571ac88aea43d808b59047eba8958ead330db8fdb4889e71a70f6ac88f5384c6cfb827c607ff53149432d1780acccd37da2ed796276cf3e16cb5c6287862ddcd69cf0e117d175aa94181200daf0697cc681fbd6d223f88e2449e7bcbbc5a24511ca8a284cfe36dd285958a920fe7ff841d8a0b69c9b56d8494f44e1f931eefb2e69a9e3e72548a7631327609dcfb877e9727ef6fdb03e5044426ef1d233e9778645544d63490771bba637918361c70fa23df33a8ec2b3d2dc47c9b1507629cacff7a35055017ba264c3eedeb2d7340df7c322eefb411e902b5a7eb99a4d46d14f2e9003981962b742778207847e21b12cf49538ecba75103c1dc497b0acebbeb7e9445c3f271214b37861f316a03e6b7d43461b87ca031e5acbdd05a4276115b13e163027b4993d9046c9e6cec799da0900bb7963585a4687ad9bc04a0c5a57f97e05e7d0b9f81e65bcf020649bc55c168189a6012a2ad7d266bf8f8a286a7e969e966efc0cfcd08717408f9548b7011f9261ff22d14294f860e7cc2d1e384291e3d8926c73f81c15e743cf383e092ca7bcbd3632502f62b71f5d4c6c1ae73541b9db70c0e5dcbbe52c3d186ebdb5b0486838048c77e03dc38a946a863defc22f73f0bb0ae933c458b2eef822b788c3ee5b407d551cd9b00a03b8b4e4b9142c79c46c4b9eb65b87b16cb001a370a8634b099f689feeb7ee858ff927ad6d049cb0bbc893254516c6080b7bfcef8c9248e33961c5b6774d383191515858fb7b4e3fc38514ac567d5c96edc9acd08b3379309a2d0151b83f4a8357df9762024907c2bd693c0ad393363eac9f5bfd3ff6bebcc75564c9f7abb4acf71f359dec4b49f38757c0c636c6d80646ad164bb26f6633f0d4dffdc9762daeb3d5e9fbceccdc7bc5918ef96510994466464446442141c83631adccf5692abbcbd6729cb1c174286f2be9bcdb2d26f21ccc1994de5e36e184d57a15913b902c0b33d0936543e280500de8103b56f62b1ce78ae6dc5ff47eb6475182dbba1bce55018e1c5cd6866e7cc074aa6b695a05e7a958da351ea23b4e0e7734aa42a991fb1893778d56f4a4c5acea162fb7384371c8c50d4a535fd67d5b674177b2aae0422a86dae0dc41461dc4c57a0caee32e2c14c4382ab9c9b9b2af1da6a7c53c25c2d5999b6b50a6e2582eb43a96716aca4f2adc5d59d30e616d5e15f65b958398664565ab3502175dec28e97055cd234c3e0b5bc4b45c2cc5a34e3565a4980616440da7eea5b3bed2e80d738255dd88acbd4db6cef29280c0b6a64e9177427122fa6d73e92c3fe681dc4824cdb7800e8bd44076ad40c476b485922e54bc3c21ed86e10a79c3d64de0ca1706ca4dd93924004cebb27258ba3e0a1d0a363db27017a4057a70063cc122db76d9502e27c841c92d91069b33989cb2fb0d6b9d735ac37102a009c5357d9eb331f9f69da70b4f4e512ba6f5543d39a1aa94363ed9abe5c5e68fa8bfb4e624ebb688a0b34dbe060aedd4badabaa9285fb86c4a3b894d6fa9b3e2775a2b4f37137f1593e871ce88b3738c981c2e17528f3196949c7605090415ae54abea36c060b53e45d60a0e8472c3d34133931cd6f14e2981b8673731030309590248c8868e4f1aad14d859ae784f3e37cb1e2badb0117c566a1937a801a9ab3d907cd25a3380aa036bb562d8be0b9669a4a0dc1c6328c1980b63b35be18bc5761c7b47c6c8599c23f58b78c6491f04f882380af544b3eac6b45c66816a4cb5c53b831a9f94fd7a038e177cdfa615915b8d63f82c664d721eb88548729cb0cddd830399a8d2b6b56554ce1ce05a872c9b057b0c61cf08a0433654570796932814a5b0dace00acdfa921a1ae08d675b7288fad9035c7b51db6a30839041751df1c26dc827cfbbe92e1ed8303386d1961122b5d49afa5838ba8a911c0cbfc5c2cc3b80729c39d1d7653b8114cd73d596ef5b075b8e5d4889ca23f75aba627f12d131e3794596b9a662609386fab7523071e854f92296c4eb0366a9d98efc6ec725c36203cee054eac27970b65af3d1aa363c2746c633f538e0dd8f624e35308db8a8cdb9df652393e46dae53c29a7a7895625696f117e38867565114431db0a0638a61a6eacdc8d5900908e5bfeb84f5473c5c1b476a9be768ef044bb5e476feca2656275bf46425c6919e7901e8443dfc9b264a404ad03d685764bcb44eb5cf8f270b4c7298abc7c37282c859da4882e5dd08b96a3edac28e8fe584342f34e0d6d7189d2af278ab3dabb6bae8e1742c49ced68ac6f3627b4679b2dd719441e6d2fc2aec8bb00e854b91ecf1999ee4284e8a96d9387826ad96c08732252ec49219ec903b417e5b430376e82372a3f8e354a013d75dc10e1c12a64a0715a17c83a12f75212ae84150552bcd5ad1875ad23de226733d0f966cad6417539b3054cce7a19a8060af05a9f9ec6f2aaa7d8e5fd1b5fe3c9f87c58d81532a5798573154593a8e63c11746489bbaab29985b4efadd3a3477bdd86d5353501bdc84f00722a09e93c1b9334a234b17db110bc8e598b36187fdac5725f949a4fcec24434da20ec8952492930570cb7c3e405b53298a9bf402d7a628dcfa7c834a6a7c9b1e29bced2aa623c6dcc7ae16a5bcb4de05e3399b20e6796af61ee4ae41a8a8f74caad287b7cf4f280da771744ecfaf3d9f09715a9d15a35c9d3759dea8d652ac238c9a215392bdfbe67d61c6c6f5b78441831dd82d986a9ce6f979ec5aa1ac74b1e94da5653f62a564bf9718d6f0f50a41d942bb06c5b711b7741a5f088b15894184491468b0bef9357b562fbcedaaf9aeb1882072a7273d0b033e54044364ab164af73f327eef8ec851abb6970cc9b9c04a996fb633c39eaeb3363ce540442db46e9ea64204a98cbe7c946ea9a23cf0b384d9e4295b6b5455de3023a43e9266291a46fd9e949444fe5eee19b628b4b3a5fe2a021992dded761491098362e136ab25a4dd76219b2b682d147d26ad08313c03961993442a128a10bdbd3112a454028a5067c62b262a4f0a0b4426e4fc4fc841db133ca28cecc27fb7a62ce22096ed888423134464197764b7d764c4f96675d4e97892f6dbaf17d8e945cd5b6db9eb482d62e869fd578e3e6aabc6737934ca7918a8f4289457db6f5a2fe10c767779edacbed2e896ca7a152a939a7c9c69d19f8da3c3993707b31f37d5eb5e2db37ca102f9ffb4893d15bafee48aa9767ee29329bfdca6ec7a7900c6cf9e84626c970738214643f9ccd76db59b196708f274e96269d0149d1e380d1d89d479763eab259135343d8ba506e09cdda990bab127d6251accaac438f8a85bb5c11d7cac4e3cba638a55c8b8484359d9733570e652cb62e98d75a0b062582b2937532cd1cda90c7217e9e16491280353356090621f761d6112e8fcc04d25927eb635eea5aa56ff4944da4fd51ebb7786b705acec589c0aabb69a1a431bdbabc7f132f9b4fd031cf1a82090805ba54e4b511a54f91e3c5a9161290c5b9ce7b506228f64808d486b0dbb34ecfd2ceab2ee1ead0421e496124e8fd75df19bc020cba3f907695985c6cc550120d75a580dae1eb3d58caeb1e55d7932d393dc30ad54d353e13564580b3b192f38a55b943343e77cbb3305e3ff8e7626c066c2b33eeaadfb43802a0904e084759c4f32d905a6b0105f9e408272a6c70a548a94d49443a6d20628723975489cf409279d792930e4988801b6f8c752c59cdfb77dc0274624abd6380ac11c852c8eb7ede87abd951ecd9e57272463ab4052eb709cfb64412a9cb04c8223223d70022ca9481c06afb5ada4b048947730a7758aaad628755e40e59d969d5f76393e95ded683504cdb92c512c48151a89064f85bc9cd44bcd30966ee49e1a6d62f11ca080ef973d1d5baded8cf7deb4d093d376ed6be373126ad48bbdf3a5d52260516aa4be09a4fd4c356dfcb8a35a69d6b58de99e0e7c9c4f3829d92847d1ec3260a8642a45922dba12079250c370db08e5a51dd5078bc00ef3aecf34d212d738e2725dde5c62364e09c799e315d8150937c374626d03cb21d334b736bd97f334d218ec5b5c7f2085ccdba2aa60a89a82b8a501e636b067527e312dbfca0b31ac48dc142435e2f06521abf10c080ce6f04bb0ed59b79bd3d3108404eff79bb8d45d4b8e4cd66d6519ef0599367636c1c6cd52aa106d6740b4639165cf91bbb097438ee560ad4931a75ad98621d6e2252464562e132321e0bcc5d9bc974d6386ad04ca3d975ba7601b4d6534a9cc4239c4b806410516d83bddd87034f48be4d2801969b545c1e0f879061d9d06a5ddb692e9ee3707780cf5440aac6ec3ad680441ea6245ef1ac221c9b37be49114ad67654ca91ae16e368e130137ec57b20d02a67335f5c8211625129c23188e1b51a88ea48c12b624b5a28c491c354890a678dba9ea06e3dd05ebba2b5506873ad6120aef53b2ea497465edeb9330f3e36aee3a318d1d8fdacc389daa062392a8102ca1dd624bc2522aca9d67a05188b8ec424ac82fbdd917cd76a525b09b43b648c31acc1097059dc2b0b8a71574c3b629458c199452d010638f8cdbe272c12a8c9a314026b6f584b083e284e487c8ee092ec57d04316b831170d3279a7cfda20753ddd0858cdc5c2edb2425828831a68ee06f55143624b1c50d836cce79df6a8bb38f3374cfa57e40b9a9e6675a7bdae440ee2a28508c2390707e917dd01312ae341486da179363f56e825a6ec3c210e3e62074787046743c4fa05559947b312cb2d072078d583b4dbd1922f66ad6a44b5413588513d2c63740b6bcd4847688086435b61546e4b8b4654171a9f94e584cd050ceb89668f64cb12409905db6bd838064ca7258da2204966c4fe5ace9993d62786493931aad52383e8788841442b6db827c2b4a594490dda5dd2a13bc4e25464409f4ec36c4b8420f94cc04670dea39d120a425dbbc1b0b8b05aa70f2aa3d23551e2360bad27a3cb42e8d8063a74ac4d294e4c26d9986623835e96c8f48dc06f17715d937ac5d6a0ccda27a49b1462ccc8cfa54892c4e8bf38d6df7748beca9e5a4db0692053444a664446a51c22bc37465865ba7b2af7e3b63d7caf6e88440a6d0d4b7172247ee5dde5cb8422f20525a2212b50773525218a145b622dc76046cb473829eac03aceb48dd6e16db14ca6c49b1a18a6ef75671c03c04d145492a42455db916d7cedfce57df5f896a75de94e15a4f0d9751766e76163c440a73b620a92d3a6942d0b90281d2cb94636d9961132140964d8f52a9cfda0553a98d6c273b823012092527ec9152d496657cb4f659b76711893a42a1f7d3c05e0866da36c0e543046c1a0ad79b9ead040f59cece92431229e88f8930dfa4cbb6ad17814584528176f284749a9e0d8a09edaa1d10294a0e1d84930cca5d000e40f50256fdc54d4c4496ed452d340658bb3b0d7aebc9fdbb7ad838f54060c335997317b6f72b2210e405715a1b0841b544e501a1696200e4e0405d8095cb69ea86d4b21168a5d163beafd6fc7e397efceeee80073ce0010f78c0031ef080073ce0010f78c0576c28824478e3b1581a4b65be38fc33c834e0010f78c0031ef080073ce0010f78c0031ef080073ce0010f78c0031ef080073ce0010f78c0031ef080073ce0010f78c0031ef080073ce001dfb01c02c699b6e3ebbff5d87391ca67a6c97c36f12efa7a3a1eefd6fc80073ce05f8bf36c3eb6e114ecab25d16ad9cdfcf687e3565951535d14ff73f434cacd02a6d5e8f9ff8ee4c81b3d8f464fa38d99c02bfaebafa79117547e6dfd6e670970e224709c18da5905419665beff0cdc224b2b983aa0accc2ab0416917415e95d7e182d4cdae5707566610df48e97de057a6a75119f470f4ccd14fa32473e0e819c7488664498cc46e943fabe0d6014771f43f50e63f504ac5f067027b466963f4340aca3f9da0183d57450d9f4665777bc60c36a3670cc59f46629a8d9e0992203814659f469b3848a3d133f1345adf9e85d12cc33c8d0e81337a469f46fccb55fbf3cfdc74d01b569ceb60e8d368ff26e7248eee4293e8bd99d95179e31957417215600fedd1334671244e9234c93d8d36e59582fef5345a7fc14270148ed3f423cbf47316edcf3febb42ea1337afe2ff4097d42fff8ebba533e2c6ef3bfaec8085cb2222a73d386e57dab464f2331c9b3a292cdca1f3dff785fdf75e0b539cbecbb6ea866e1c1ea8e952cabbef3acb559d9fee8f9bf46bf8ffe781aed2b3386affb746b28d02cb374f43c4ab3eab7202d2b338ea1f39b5557bf998d19c4a615c3df82f437ab0e62e737dbb47d387a1af1d9228861791df7f6a0dfbdecaac191079d2bfce3758e370efbaa996d35faa0c38959449659c1125c7b153fbc79fd0d520f2430193d8d6eaaf8c7d36806f3dbf056ed06d7a75b5d05afaa6c67495ec0b2046e6c56f091e0f5417e6ba79519a4b0007150562f84bb8076d1e555f60680791ff1deb083dcbf49fad2761e6f3aa5f9de80f6c7a6835314c67d4500415ac1223563009d8b5938e5976c711ce45560bf53fcc47c68bd752fccd4a9ab20fec6adb2b6aa18bedf481ceabd71edf7d0b2c987c6e3044adfc43eb4708afed0a630fca1fdc523abf8619d5a0ae53eb6401e05ede86904533b7382d47b80c02c53ecb16d9925a4c90f9420358bee91e2c3c7d1407855ef87767e53225814597115cb4d3e2ae6ffe1b7f25815acda75cd38033e2ce0ff87e27edafd7da312332f7f92b5322398a5e09bac3ecccd5f340c7083b882c52752dd6df3a7ec17261674fe86a57fce57564e7695cf374bffe502ecc226aedaf836cfab633063ef9164e7f563d34daa322baa47520aabaa306df848cbca9bda3c92f22c8e1fdb5f7629a01b43bb8a83ea03b90c522f866e1c78fe87a7965d699b710c600b6d9836dfba55a7376b79df45585671769b5d76fb0141f6e20beee4e47acedc2fc00abc77783bf86ff8c50f2457cf7abf80a48eab20376f8b72239cebac824e5e0469753d13464fa31456f75fe05755fe006f3fafabf7467c95f88576f5b87991ddbcedb55d17d73bb7ddcccadb02dc62a29becd7cb551be14bfb65556fc8836dfe0640d9a595795d9fa24eabfb745e10b06f67d46beb6dfdcc2a4b6e2ef6ab3b2f0bf715fd1adf3c8d5e14a6ac0a3bbbed54591541eadd6e75a9fd72791ffe65ff5e0fb0a7519d06f635fe7943a0ae5c8cfed8666fcdd274af7c0d4c9dac78f150b1997abf6785075af07a3ef9a6ed9b38faf39c7916771881523fd1e376b95ad2dfe17df5df9f75a88b06be9e7d9ff0fa91e37ecef5f5f1f749879f5889ab923a6979fd9fc0b234bd1f0dfbc114bcfa666a3fc59b1759dbfd04330efcdcb4a34f380327357fc05276e58b2bfc1ec7d54a4109edba80c00a9ca0a87fb89a37f6aa30d3d2cd8ae433c6573dbf0efcb3bce975dc3f9e462a2cabb72834ade3f84e7a0b3befa475e65c05bee6543f176dafcd207d8d8fbf1fc0f3d93a73be75ef45f8df93ccb9b11d615106b7f01afb1d236f79dccd85bd25793f19fd7f37bdfbeb69e49895f93adfff961c1204a903dbdfc34f92c937aed76c92405fb34912477f9c4662d4631ae99a71f9491e89d36f7924f69a471204c6b27f2b8fbc4af89d3c92fd561e897324ce7e9a4732d4a779e447967f288fbc46be5fe692efdb7dbff9a364f13d37bcabce4b6af8b2f61f73c3c78cefcefd859ddd73b177331c2cf3dd68de4c74a4e35c6de35c69eca9d2c2ed4c8cfcd8e2db465c388d9db4911490b51844ccaf2b065530c9e35bd86c6566e1fcee5749fc632b7ee07bb5638c24c94f2d995571ec9920be28087d66c9384a30ccafb0e4bb8c7fc7964982e3981fd9f28d85fd614de81b2c832dff3bd8f237ede6dd90e5e922b2f00d0ab5492cf23e6a9d2ec8d4cb5853502a6b3a29f51395cafb656c05e29dce1f7d6346aec4e9d813676d65688a2ff29b4cd796bda1ed38313da246809262f8c6839a9a521a2ad558389799538a70789d95082577f83696bd8c95703fd64fbbdbf87ac245507deb9bad558a81ddb8de259c6f27bb5a4d1695b19f70ae7a69ccd91c9906634f9c8e597d3fc9ece498c87bb1b192232186d4d6d06cefa51f2be1efbca620bee3d91ad99d94489c2bb97ebab012eef5b2975def79326f3476827ad6e9889a3c17c9fbe54ec536073198d4fa098be5fdd237f85d65f0b16f4d45cf4a385c3fb5b9c12f50e3750eb7ff130676a8e7e0316a4e5fe7a2cccdd3a2dc9fa8c4ea269cbbcbbc377ea162a4fd8430352513a7d4c24e978d9daeeb037e445fe6e3b91afa30fe9835f8187f95fb916e4f27a1c52f7abb4703435b76162106725a31e294bc8ec14ac4f8752dee739e7291a9e91f6890282b913fd6c6f49b72c6d64fcbf3c93a1018f7f8dc1fad85725df3793cffe2b9f73908634fc75bdf26d69c981c3b3be13a31bceb9128609c3ce52edf93cd3851d195e73b727c6baeafcf4464bef5f5e458cad14d97bcfd6147cb53aed44fcbd89a915fadf58b8e5fe4b0cdad748239d3c9cd0ee5fd1235341f7dd0b3e8b646875d200a866f09c72b8d81789c88536a6ae18bceb8e96fbbb412a371358c81ddd77429ba63e5ba8fdaeb7c14cedd8bc8576b32bb345fede1acfd4a37beb147df90e7ebf5bae994e0e40eef795274df23fb55e685d298f8b1fee7dcdb65a36bcb487c91711b5e1a8b5f04c6e9f2f51abedae08c64cd137571b49de708f1c550d1c0beda24710d967681c853be753a70627a1f530c26573f825df92051e546f06d5b8144f90d5ff2710daff37db35f01e3defcf2f483cdffe37bff6dfded9dd3b232b50ffa3a31f85d208797c69e7d908195702577dece8b4b63245c673d9c0176f8701ef4d46dae767ff5ffeb373fee5ccfad2f7d96b0f4adc489df7472fad2f74b7f3b6b4bf3e59c793b5ff6e3eaaa17a230e9cc13851ac13858eec9d608444f8aef737ad1b7d2fc86afff42d758893896b297ade4291758b842bde0cce1b152f6fef317fed5f4fdc0bf95787f22507ee07b0b94a99f899331e699e49e29ec6fc4c91c87a238f54be264ea6f86c9044a139f84c9044a71d88fc3e4af588630f9df2b4c7eb0867fbd3059d536e98b5bcad62af93fea6eee6582cfddcd03df9bbb61e99f29b0a1cf14f14cfe1d7743600cc5fe1a77c37eff4d8d6fb81b82a3298c247e5c61236912ffa1bbf906cbe06efebddccd8335fc4bb99b8ffd70af7dcf9ae79e292c6323440309a762a71311f59a91cfef91b33ce5fcfbf8cbc610a2dbf8127e9fe37f875b4ae1e5672b861f59df9c138a323fe39da867927c26f0bfe59d389c217e8977bac9f88bff0040132c83ffd83d7dc532b8a77f2ff7f4d124fea93cd44b6ebe41e1a98d4541a16cfe9643c750d8353ab1e9bfc875eb97fa97072f5fd507420b6f1b3bcc3c3b3946dbc7fb02fa9697da844259fc01998697e6753ed3b07d0db05ebc9fd7cad1a6334e0bd4d84fee79e7ec63adf296dfee27d735c82c7cc789f1443e600f7580e4b53f16dcf3d6cf6a8b5fd7c776c432d63525fe5faa177eb3d622f30f79fec75ad05b4d50b93d73d94bd16d2dbe578ff9076b403f5be3b93dfb57d5ee6ed78f359dd27b98dfe735914f6baa5fefff91e70ae344feefac5ffc3f551b2b3d297eaf7389e1d7fbf42dfdfc9e1eca7c5cdbc2117df7251b4c4fb0dc998a9e932c4ae72ac79b6d8b3f7a0e2be1b7bf1ddc7cc56bf474f31b57ff38233f4dc0ee67261cdebe1edebe1edebe1edebe1edebe1edebe1edebe1edebe1edebe1edebe1edebe1edebefeebafff070000ffff010000ffffcf8709e42ba10200`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecfc79afa3cab62d0e7e97a5fa8ffc9d000208d852fd01b601db74b631ddd3d3157d63fa1e9eee772fadccdd64eeb34f9e735fddfbf4ea96a5d45acc3907c18820222631a672fdaf8fbc4e9ae1e397fff591e66336057f0b9b0a44659547511987cd1883a669b2ec1370ccfb8f5f3efe5fb2ae9e40d54453197f7cf938576dd38f863f661fbffc9326be7c687e157ffcf2f19b796cc28f5f3e3ebe7c987e9fc6e3b7eb7bd38c7fff18d51fc3ece397fff1f1b78ffff9e5e331fa65fcf14be29743fcab758ffda1a9bf352135625ec6c327fceba3fe96361f5f3eda571a47df2ec7e615d79f97fff3b70e7c05874d3dc6ebf8f1e523ecb7766c40efd7d11fd690f924cd7c674fc1f8955c5c874d94d72908fc2166a88f2fdf0f44e5f7afc01fe3017c12e87f1afc847cb653c5d5c7978fea7350bf7cf4711aafedc7978f61ecc3a69ebf5de5753a7c7cf918f3ea93c154e76113c5601a13f673808e71fbb547c194e49f1d0eb631fe8487d567436153b57d3c0c2029fd31fede91eef937403dfa791df7a0cc87f1e3cbdf8dcc1f83e07f6bf79b11e66d16f77fd8d1f7c168f0ff30e230ca7eb07e0846244d13dc778eb2ccdb310ffff024793b1014fe87237b45c97756e57f07ceda57fc8795d763dcd77e0982a6cfebf41f064010e43f890e7f190c9b7a18fd7afcf5bdfc391cd763dfb41b9889bfe17fc3ff02f077fdfa73e4c701ffab2848c3ea678832f77fd64290a75513fd04106671f8fa493cea83f427e11fdffc5f8507ff67f13fcf8dbf402c7e1f0dff111848f2b8fc599f7f9c5d7f1ffe61bafd5db82a7fdea7aa7cc53f7b65753e8cf1cf1ef00d0092dc1f7f82ea7f4ae2cfbbdb5f01e0cfc33441fe0cf0db76f98f006339fcb481cff84f18847e98fda4f9286e07f0b90f367d14f7ff0417b6d33f41a44d1407d34f26fa57d43fd8067e8564fef093a5d0d4e5f617d1bc6acbbf70ff98aa7e704f63fe57770cdbf0e34d55447f67fc3867ff34457fbcb10fa93f6efc61960d994ffc2481fe30a3fe3c81fe3c5fc6f2bb6d6b2c87bf1bb01f002b8d7fb7fa3f2dd0bef2f5bb6cfd7de2f6879af8defe4ce490fcb387a17ef0e4b5df6fdf7bb2f8fbf641f1f941f227fb77d2ff30f01596947e3afc1cd2b4e33f412c79ffc3d7c9e75d5f03bfa6f21f03f30fdd6dbf6e4871df37fde753926afcf1c3256d822949fcb20159dcc73fc6fe435f3cfff4f63ffa54f9edf02f4247ff153735f84b6816b7fe7f523320c9cb31eeff09ab6f1f75ff0a06c4551047ff12f2db27e23fc70d63d47cf2fb75aff9fc05c23efc3ab97fefa71fe43f98835f7f6f07f91087e30f9e6d8cfd32fdb3ebb7adf5776798f961e6b3bf6e177fb89b39eefd3406fd1836f30f9176fade4cf2326efd312bf331fec15f8d43d3ff40296dfc3ecc7ef4fcb645ffd935fce88bd736eef32aaeffd460f303aefad3a8d4f138f67ef803af66f8ba64be77b54d59fe60f7cd67affa386cfa1f06e5cf6df57152c6e1f8e7aef753fd9955803f36551efe55244cfb666aff2a12aff99835cdebaf62e95fb695866008fdfaaf42bf2ec7bff08fd95ff9dbb66f1250fa415cfe5578d8feb2b5611b42bf2c4199d7d3fa3d60f093b8cf9b1f5c799d967152e669f6c39bfce3e0f4bdebf304f5e7c11db6fa8761f8b4c778f8b1b55f19c56b1cc6f5fc57a1a9ce7fe0fad944d9fc3013bf4e9d6f3fe71fd6e2547ff62c8bfd5f97d2d71e3620f93a3ae357dfb7a6ca26fd7da7fa7a5a6c873f0e8d9f6747f0edfcf2ebe5f85bf4b753edefd7e02b81eadba7cae72f504de598b7fed705f6d5d14dcd18476d9fd7a31f7c4dca75fc19ace31164e3d87e77f9d5fe6d61fceefc8ee8dff9803f8479fe97914f8bfc8791b0a9aaa6fe87e121997f8dd5f198ffc6f133f9b57df3f50cfb199bfacfe9f875a937c3d797faa9157c1bc5cfade7f73de8eb51fceb92fcfe50feed740e86ad1efdcf77feebbcfde30a845f2587a1ccc3af27b75ff7adbf3accff3afb3e7ffdb1bc7f9d537f77d6fff1d44f303fdaec37b39bbeddf139a53ebe7ccc711d353d489bd2afd3bf357d0a56f0eb17d2b79d9ac4ff3554db941b0171fa9fa0bf36fdf9cdfdafe27efb10fb09f8f757fcdb41f65fc1fe13be9ff320aa0710d543150f839ffe23c2bf4fb4cf1fe9340eff0aaeed9b75fb27401264ad1fbe7e82caa3daff07e161fbedc4f257d1cf090f86389cfa18047994f7df84bb7f081d7bbf1e92a6af7e06fa6daa7d36f8afe0ea6fed2db1fffad4a7cc78187f57e9eaa92cbfb97ed7e2beb9d46f22e32fffebe35fd417553faf3f7e19fb29fef2d76aa5d4a84df42737489bbf7d533ca4c68afb21ff2a23127f23a88f7ffff77ffff2f1b9feffb940fa0b48fa4ff9b08ec030fa9f5ad52fdfb4d5cf3ba378f4f3f26b23f53709f457cc978f21dfe38f5f289c63be7c549fcbfa1792a010c552048dbe7afeed7313f9f8e583c449fcffc1d1ff83b32649fe4233bf509cf79912867f8b3e7bfaadd39f49f453a98de78f5f181a27a92f1fe7baf9f88520088aa0c92f1f5a99d7af8f5fe8af831b7ffc42302c07bf7c3cf3e8e317fccb87f4eb6fe7dffeadf523fcebf53dfa6c0dfff2f1f88eaa50bebe672e944df81a3e7e61bf7cf0635e7d76f411871fbf108823099a8224fcf2a10d9f1e8e6021625848fcfb970ff50728cd5124c5e138f71b14fff72f1f879fb746b124223812ff6ccdf9b77f9bea6988a38f5ffe07fe05ff82ffcfafafef53907c8bd76ff1fa2d5ebfc5ebb778fd16afdfe2f55bbc7e8bd76ff1fa2d5ebfc5ebb778fd16afdfe2f55bbc7e8bd76ff1fa2d5ebfc5ebff66e2f5af5bc1a77efe4aff7585f3ef94ec7ffff211f9a3ff5bcf5abfff2c95ffdeec1f777e7de67f4c2607e130fc5c2aff04fc9fd5c9e1ef3a39f9df4527a71045900c07df3af95b277febe46f9dfcad93bf75f2b74efed6c9df3af95b277febe46f9dfcad93bf75f2b74efed6c9df3af95b277febe46f9dfcad93ffc775f2af52f67fb5560e86712be3bffd53d5fc0fd86fda3941fc2e9d5390f98f68e6bf4ab03f17cda9df4573e237d11c4212a7fe43a23941fc5fa599439a6529fc7f4333076115fd5937ffe3d57f0bfe4c18ff430cffb648fe552dfc1bfa4f2bec9b4efcc702fcffb735f9dd92f97d757ef8f27d0c0e4bea56dc2b7e08e890f3e9f920349e5dd6be7c63cec7d3a21e9ee850e3d74375993df995c64b939e0f7cea55dc141d88cab58936905fccf910690149e321696d6125e2976d4943529cc28dd83de7d27ac570fdbcef2c5d32971cebb0e288a0ba8d2ec90d013c33e78326694f97b817afcfe7fdbf3ffeb3f78dbcf2d3f89f6c18bf62fecf56dae8ff7e953696646882e2f077a5ed5d697b57dade95b677a5ed5d697b57dade95b677a5ed5d697b57dade95b677a5ed5d697b57dade95b677a5ed5d697b57dade95b677a5ed7fa3d2f6ab58fd5f5a6cfbf60c90f8731e36f5dfda3afdb980fe3df037159de6e07f65cd8df9cfa8b97de5f8dfe0ffa9bc6b6effb7d4dcfe62e5fc5178cb2d41bf2ff8554a1b9ee779edf1cc4ecf94e7f9c3a7c9a707dee5795ed8e3bac73f1dbca33deef899ef072a646e3ccf9feea57d2a2bf389f3d7cffbf894e7dda8e5f9cf202f7cfe90befa799e673e6df557ff89e7affce17bdcf9ca1f7fb03f7ff08721ab94fdf34a96ca93497c8d1f7e6df15724ff95fce977fbe86775cf3f3fa157ef723f894f9b2f735748c5663b2d527a2dce26eff39d9aa94273b89c033e595c69cd424781d7e3a8100ce7367209fd9bde1ec316c792bb8a58c062e26325254cc22d31407dde743d22325feef3a40a6a5836c611ccb3473ae36c5bf33cd3583ccfb15e73206b91563be8659e7a05b0deadb530b6be4c0cbae41ca02e5d4e357250b708055cefa1007764089a879dd478344c4a1ac7bd05117b667616d5fd21edfa9be417f6927ef6150078c6d5c767678fd9e379172c494b6fbc064bcfa47abcbd040ee696750037efd63a2b438c05cd18927c20a8c4229238362f3d4edba245c46a7dda622cd64c9b9bdad5c3e2fdb2e39c42729ead6d74e37380214513075a7d25226ca6a747388f998b1972c505b6b63270dc31baf5330af5ddc6a6cc8db40bdb8d13cc238534ae0136ab47592339b52636a0ccfb8a39fa1e9189ceca1cc50dfb8b7355f995b1b16129186b1c32bc09e5e37a1730d58e74f29ad4056ec6c9fccac30d2904c0618582754e2ad636f6958df543408208e730f1a61ed5252b46927846c7dbe9fed2528dbf4b3792c78593c77c4e20a37de09ed594f0197ece1cfef1b4f4fb953eb8e7f37f55d117944ddafcf3e4f53beaf7ccc53034f15f99bbd07f4aeefac6f21f642f8220ff4fe72f8641348513d43b7ffdff7efefa7d4dfcf3e425285f97f3f22d791dfb736018ffc5c9eb34fc98bc4ec3afd9c93b5faa7f98bccaf4b7e475b07fb325ed62731d952e9fad0b5f9357cccb0374e2e0224634abcbf0c6e5587862dc40e5cf97ab72bea5d5e92e98f7735aa937e97ed2a4c3969982242982e0aafc26796299a677e97cdac85473aa462568f17610503cb1f4feda0daf89f678c5232fa3e329c15c0645b5076f49dc4d4cd72635d3330988d84dd59523f93994aaaa7e0ec5b22c0bcf1f8fc7e337dfe5682d0650b08167957cd0fe2efedb3d27de3d2947f576548fc5729224e9f33bc075dd332f085996a6fc49924ed97853f98376b46ead45b41ef55dec3b3cff9cf953cfff78efeff183407c3eea24983ce17896e3fe70efef78e1b07cfecbff052ea545946f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2e6f2effdf7349dddc15512d0b7eee82429eb7259c96a34cf413d708e646e04978ea6806107512dd016408ae163b408ff70d9ed598ec11864623913876b69ad43a1ef985a00e3ced3ad77c51ffee6f80a99783909f68d8ad72571953e49c191ab0f5e8edfb32aab19dd1902e8d2bd5d29c59cf4128d2513dc742497b9b332bd730e6987e835a8c75edb63763f2f4100d0ce3faa4c1deccca6960d9b1d8515f254ebf6f8d61cc500d7b4c36ba3e6458d3b4b9ac33a0c1d078b0e4e3d1ca89753f923b67636b4ae9293c4b02dddcfb260b7b898a8b44170ba92c4e47ecb69ff67956f77a1f703961f4eb2b72affb93bef7332b70fb4cab4c31969abb6ebb40041d29d73116b862d994e5302e92db044fe2ba752f763aca355dc561432bdb3ac7f324d277dd49149a65f63951ecd9eef3311849bc9f60302bf360a003261f337c20081a05418d4dd37c8da22822018db1fa51186a00407fa9779ad95c2cd1c301da81ed705903cbae05dc2cae2bed60960261c9604e62cc72cb36349c198ca5666bbeda894319cef9428328295916a0d9912ca418371a9ba17d4ffd277196f88b7d0cd85fdfa7e94e02114002c511262b399a4128c87a8db180358053226c2a6218319c430eb45dabcad69409e7b8af5592ccc11df624524f684384a1777a24f6c6631dc0ee445d1462da381fd52097796b6036dd00af7bf00cacd503ac295644fcc29567805194eb3e642e4a1202d7ac73e48fe7f62cde59f9986d2ec7181000b1be5fe963b6d05ae623462599730d62a5f3582fa1b0398f0e843741866de19cf440639ade3cedba51b25195d85e3af2eea9e75fb4cf8b60340e5a0bc4577a162fea7840e2905b108c02161273502df241c18f998300b800803d499abb4ecee824ea05abe802bbaeeb6b234c88c80366010042c85330ea21c7b9a09e3b48db4501cad3ed6a83872b6fcb36d8bcb61efd19d573d79c2aac4a0c4c3636e41063fa4c1f65656578fbccf167bf0474626c31a690609e65899d3b085e7a6099d395585e608154c6d35a76538fdbb95f7387ba8fedc12bcee493b69f946ac84b55cd7b3d32dc80c8c518463541040dc0302676701218464fa6ee16f93cbe4563623d0994bb27c80f05b919f625bddcbbf31338b96804f53c9b169d0c00ab259a4afa9e642a3e2557e30e9e1c7bcf620c7315e1b0cb434397169d0abc3d894a72b0b0abd2ebd1ec79e783f030935b459d4a97272addf6d283951f8b887cf5fb10cf98a32f322f519dd2723b2c5fb19d1770c3e56346b93a0f9f4fd7eaeb06dc59de0ffad257a83d6985e636f0b781f01a41c8b2f4acbc04de9e28f9890b3e2ad68a7b44ce19046115a53aafdefb9566d7c9b85a1b42de5c970a6456ef1a5eb85cb15e09f51cce5e308eae46ed712ba48f81e6c3f1b5f40a85bcbb77e605e1e5d53795f7837c117b5d9676d40e40c8dcf8e6eb1d136ac62464c55627b37e468a4545d7873bae80bdd887d96875f2e6dd2949382cc2c62e8a9065697a965f42fa182827f085eb9ead0327a4400a2c5e5dd3adb001fbc450d9cfdd9804fb0b75e10a4b8a62d6fccc1909a015cd4291aa8388a7c3c21e2056dfae368acecd9e8cde0bd32ecba9c570e95256ed666dc175eb3f9381b848711ad97d7322aae349f091b04a74541b5cb2d97c735c398fe2c2c75e13739c4492bc350e609b936870889853cc663940d7b3c2e32b55b82f3c1157c24c39ba87f022cc11b2b8c4c6979b7a28274d0ce06d7ba93e9ff2562135ab329846117159eb3507e161de529787b535f07e302f55e8c1b53ac0bd121d627872609cf71980ad025725ce1c3b2b08c0b16d54922018e9c894416d5f268c1ca3e3b832d5415d5d5fabd8eef3afde22b6ce08563f0a514e8e37e018372aae0cc605049800261b64709d69505152595b6ceb63fd1538f481480f44d382c662da2b023572ed240d5feb59e2b591594ebd2e4b3b0a0ed889f4d36076708e8d63ad2d36a6b7fcd5d7d473a8cb8f26d0487c6af7bdda6e17db783057d24ae664c6e47b1d441d95dd0982834e528ac6fdb98271431109bcc1d66a3268479bab98d1414f6353d3758a4814b51637ed9e0d821912984332e2b62cd019314c41182e6efa34cf0c35e2286f803427c9e9840cc77c2472c78edd4b3d4a34bf08d745382c5fbf09d2f82609d75d88e03dbf10322f558845521accf86b0f9550aec56b8cb9ca8ef56d5f3702f1c23037758283e4b2b3cf45b37d34d9dd0c7b7f42b5db06cff466a08d76120c3fa132d1c51eae511c9df79a88f484554cbbe059cc128d4066314edd76720a63e0dd6b2252b17d230c0384256dbee6c4b268c69e635ba31750cc571d00ba99aff702925d629cc05cfad3645cc1f6e2c0dcf543afc87dc071d4dc1cacc4e04abc772379e23492d207171e65e272e3f9837af9fa47b30f626ac7af683a7efef15855bd1cc3d4e54978dbacf4684698ed44b9f1404af4381d55776e923bc64c2a8cabfb68d1331d2c4a7fc12d3827784b6ffe9840d2bbee77b256666e1a18ba9b959eb589f8e9edfbdcc6567ca1e9f8c66997cb13f586e10b2ded53938c4ec3f84ad8a0a6720303e4a2e98a138f27f36abbe406481a100848b4ad7b6bc4dea83d918576077a144e0f84d8613c23069c605f0772a365eea16c1dac3b8ecbfc8ca2b66054fea6aaf20997a8fae1c647a698ce055293274f49a95f4126de08d8f191201bd0ba384bbcb32bf972acc888418d1d2117a2dab89ef176b613738f21a9a13b7f7c906c4a8c7261c2f28545a2d1e7bde30409a5792bc3c7f32b4edbcc31b8f6f2c0f6c3e991ccfa4d41ce83c3025f040e358dedeb7ed239da6d71416258fa42edf6edb117b618acdebab33d762fc731db453c66d6bb5c940ec60a8745609854b18f2e8b99215bb662b99e78c8935e1adda7790a90ba08ed04745f6f211becec22b96960d821c7386305039a330fc721a459b3df65a2c78cf96299ec482cc4ca2641cf2cce6e00437c905165db3649f5b25716a725beb9a79ea7826810761e71a8f668963265b9cc410c72a07818b7dc5720a5fc492a32ea887313617a51e4a4c84a290d838b9d00c79699d49a09b8572af6dc47d46bb8a94ec9bd07dc81982dcbe318d528c9ba83435416f80492236799ae8f6854270a8dd16c09af17824e2d9a0db1d536c727eb306026818100e0022855251e68ed6d961d0c8443390688cb138bdb2a6eb21e384b0df1d68ac40de06a14f17a1225a3ab501970a7de0ab9963737269a9360b074daf3c6ac5c4dc194efb5a4d2c794e4a034d09101d84c5a84eb56788d463a1a4e258c38754ac2321e66470e20bd9d51f99baaca475c6afba89f102047e7b22c678118787fa83dc07a620d05c82ddc795566d6030824d73de5428ba77449e175a23910f43e27d7789c227fdaf66a56b7d930fb5d4b46b090ed981889172570c6c821f587249e9d7221e0448c1e6023301bbbe106af10c4a7a43c10752d9ef4fbf9c684d2212d601e9189b416f493e01cab02ce993b220eae351f9e587b7dde97e2f0303961bd14a5330dc2611118a6f01ad591f408adb990a81d00ec0e71975f5c57971bd5948013a5b837c8c133559cee08c0c208956334e48ccd3051b801b06729e3c76092fac334cff6b87156ec127e2883e179427743bcc95d7a63070c261392591b774cdeae8d61889dce009529c623b25c42af588a6ef1c7226f5e1a983d84821238faacb11d0bc3e7f25c71f7604d547aa633c2dea943d9f0a56b5834af3fc4d82893fc801f37f2284ad961c98ac63df5c2a013c13056ba8862e334f5a8f41e6cafdef5062d69e12acee8537b44cf86ceec1e399051b7da958c81d8e1fc387c960187df596d0c49726ee8aada8834ba0dc56dbcbc8a4bcc5f6cfe621fc68166697db133f2d06c4568ad0f7e51ec271f1df93620a267ed5860b06e0c39df36b2d71bad23d618900668d3554c85ebd6a6a2658c11daaa31540d5125cc0286232c77db998d98414d65af47ec0ed7f165f055b3a4677a256c9c3a940d5fba6748ad0fc53cd0894c75072df58d537ae6098297d654b69f3d1fc53d15f9470a3104a5fafd768d91866d6d341235ff182a5720f6fb1c3f79843165c2ed04131837bd2f2623b6fcf95af580e9e0859893e0f18cb991ef3d992f7da81ece2f33f4a883951eca8627f807c747e9394c8ed2b2a4f8d93ea9d1912fdd2bdc39d66c006134fbd567c1e0d1733cd0fb3490989a6afd3af89d9e6a7d771608e85825c239609741c2bad4c16a2f63d1f084f414255d9fc89abf0dfc73e61ff33957b717cacf03b617c5ad68388adbf0292b9bb9139a0439cd1987dc5c530020488e9e79d879b21eb2a2b8d51331912543d9bbe33821882f4aa5d0849b207449561992d2099b092ad602ee42e82f87af2a305045b352a6ce9a9da23cac4e36343f70aa58c77be98c1c18f23e30399f3df5c2cb392fa56b118465cda07496d67d44eecafb632009d2e352e9ac705884072b5cd9fcbafb4271c58aa2bf5949483c16fda4abc571b8d810f8a41712112a151b4e63ecc590436562d78e70b09d64db62686072c41a3305e69c3998d14eb7350140a0469b6a0bce18d43adbcba2ad3aa9ff84517dbac5f41c94470bceb5e6c514874ac581b3cd791cc6cb2605a3db4a3f8811015039e31c731131ee445f3904b241b24ef932b8c391f29e4c34cb6100ed7e985b3ddada1e41048739a0287662849d68fc69d50d451a62963f6e638fe0351eba20ba38909475fad103ec3c07f53940fdd580c97d733c9fb7c2a8f26692a51f3098b0693d0e1831c53e45315eb8164dd4565a78663b356c718f951291222a7b5a8f761474b0187c46ba452415189ea157af21a707a298aa35e963470b6a6dd7c8c1c45dd6a89939170fd84128e48141c96bf7c7a289113c732ba4b18023f4d541e25c3b3d59d823d6333c866dbc6571414f310d54c1cc8033f140e42010a5cd25d5300dcf712bec3901306dd7a374bc2e675b11c1a9c35a27f49ad7958d3c9188dc882ddc1321bd04599f72bce69f337fea059b3ccfde83a78736e59af3ee8927ad58ba536c293e47dda31a6b6e98e578516ccf1e0bc05842d0135ec212046d72248a2149d43344046206404580608181c532d85264f4578bedf47c632702ede4683bc7138d6efc0b67d7dabd89fbf4782058682465844f3e1040424ca1459c473f89cade03d7b353c6704e20f0ec07cb4e60be5ed9091beda95e2b148ced2c05633c75811c4ebd3390285f9151af053b4d3dc4386414d4ca0e4400e99d740adb7e108be3c0dec6010784c62a50b7e62b1cf20b24ceb8c24f59c36b6362de8380c377ccaa3d801cade4d6bb5522ce72466c4a90c835e8e88d7e31bb13026b11283b4eb393bd6154232d0c862db6452409aa5db8ba865bdd19983d2890c086b3952b6f395c72208e964f99cf3de490339618fe7c966b476aa1bf079c19104140c663f6721007d18bc69e4e025699747a03d0059914cf16052e995e60af8e513d61e7197a179ef055e76972b61af53ddf26248738d42b7d47b0630c0139302511b3b15eb1ee044a24ab38c3918ee379b613c41c17680544644cf8464e2c32057bb6c91d04153fcf726cea517bd2e65dc9b09e411e63df395892d6cd0c137208e73d67b8518512032e889e7b5f0fd0d60e5c311755be31566d5cd3a8bbebaf670f1bc1677569e87d0c0fa4aa9e1992d44187cdecc89cd67961636c97346ff5a6b36c428960b0296084d14800bb3c402ea7521f4c46ae57f79e69c74bba6f52d26375edbb54bdc6894e6ee85c09c461d30e78f5504b8a2f5dbe749d69d1632406a11e75c44bf6a6a253fc61549cf19adfc2c793078ebddd228085535bace354699dd0cb71919bf3633c63d4e8287303621a538c0909dbe07000c13e357183f27dd98b92baa2e91731fbb554efdea35dfc987bed89b0314d946bc172ead5596b89dd2c66cd8d315f8b491b47b096999093951dc3ba1216702e0861829862c0c9a925a5a7e764c76fb0548fa4e6c1f9aa0db1ba16e48b1e642587b68d9b861c8ca2663286a4c85a7739efd7c72505908b818f3f71c7c7e4a73761cdb0769316a17c1472e3848cfa5eb01d9900548ba951a4ca4373824da9257968530ecb52daad4176cab74131bac8dd37f546a4ba7738b0680607f154880b571662dcf7695c10a8f6e9423e9ec8724cd420b21ddfdc9c6b2588d3b95d665d5c8b6d40c6dcdf878e9570ba1c1d6909e87542b06f22a59e83056be8743aea2664b6e058ecbb12703909cfe9308c292759989075cc993e0f600ce0d623a6718e1cf73ce30327f7a21ed400b0dd3365f958b5fb3ee6e89d186dee68b0d434e7663c8fa3e33459701020a99fa2638f54d38543cc8c5a311763c28f1d7b7ffa7dbf02c42509a9af7774840666acc82410e03066b65eb82c37ac4cae7d346eb02ec7843c4e2e56d710d735d7dde9b986a31978583226bc4318cee8a4478560eaa26f2053340303e9f8b83c86ed966e4b5941fa714f0f567a209a2c518c96be7915de932fd973ec502b7672b0a164629bcb758ab09fc581a0a198050cb6b6757c8b36617ae974c7228a34b68388ee7012c7db7674772f3b01e41f76d43c6603384f6d18bab4e450323ff9f10ed57590fb5b65dcb9c4203cf45c9e8ca62a979083608cc9e40a136eacd2e79e938ab558372ca446f3521d2aa26799a9aa4b8c7d2094abf12444cfb35dd8ecaacc67f649e3b5dcbbe0c099f1a5f6ac66e95cede9c5cb103fd560588c8675f720411507e22dd846c7663bd689093c7e1e5c0e717430ca604f4bf5e96f921f139bd2ef4c8e05f94de828fab2cb8790a38436ae83141b352340dacd65779fa5900b8549e96b20ed5431dee567ac2e7b51aa2489aeee5848ed002e4b66eef1c0e050eddbd183bdac8ae04561fa989424ee386591572fd5541364711862d6f25ad3b0280adb513682c51c25bfa4a26558144a09d2b315f33add105b2946b00ba00063b7279c4f9536178c7ae911f77a66e1f00cf779060dcc22d93e84748d0f074086d2dc43601c64be74939e660f561f2ee7fcaead757ab1f9ab9d6a8e7c608513920e3a05eb136fe3da4118622cccc974a590a493fcb576bb2963d463a21027fb9edf5f672e3f4fe960d4a24252f24b21d3b2eee89517c7e411e2fd8c110dcbe4396f509507c12df4f2978e5deca74bc8af1b9eafe5183a6423c486a81b8b8a155a21967c34c1a2366cefc4b997f6009963a1aa295cd53d8f7b770de67bf86c1de3e2dabc17f672b374ae6679b744f572f9d9f519b683504d82ea4410fd74ef277d55c62818302e9a8376888dbda021ae52c496dcd7ebc2e45b30a9c8df6f17bb70d0485c263843a2333c4c37733598d71c0d5a1e8c77751fbc40a1fc63cf89329b04276c35fd3c8800825a70f2895eedabd9b1d906d3669b50440ab999f76c3963f7fb4daf42e1385b08f2a5cbad812b16059223d2ec619a610eb9c3b2b8d8637324aa3154938cec4fe65e0f2e071287d41f84eda681452542bc5c72e37c9092a86c75fe62f3175b2ba1dc262d1b5a7a6cedc9b5f2b4f5622ef0b5f1438cb98a62ee9a4c74cf72af7d03f7bc6d9d4d317b55e163e850166f9a0fe798acb0e3f51aed4f476a67d12858ee400673023848260fd5c397e8a93d8eaffa24a59408a261c5bb34a96d029143edaeb0f66e93680a9142af859406a7e0109f327d3f580d659f91675fd5ab6d0032d863e58ea469c5b6583f080db5137ab7db9ddce57532f8c486b98a7fd3916d6f517f1271e63c5109d003142b60c0b47baf8feb93b22207a08de0e542bf429b33d3c3addcf77e8083a8888f4055f362705b9bc60f56891b54ff1ccc564a905d86a04998e92edd9917beea2de15b637c4ccc27b3baf89e159de884c72e1077de2ef6eea83c31c5b8f82f8ca80f44112d4d7ed384bab8d8fcc5e6153b5370b748a038dca9e7ea8aa57349b9f415b3f15ee0a3b1d3cb70e06b6af2cec9dcdf7da320949ca4080e866a885d32521d85ec86b6c616d138b97ba2f35ca7bb6b5eaf838d4859beb3ccd8bbd2080f71bf40d5e5f60ab79f675c506d7d142295727540c96a4272f793c030db454e9efe3313c98c6e2d55cddd62bba4c49d776e4e4bea521b332b71e038a71f82e7ea50fd5a4c96df80426a77380e6ab8992577dcd94347d22445bf5a546886d20c761fbf48644480de47458bbaa20f985d1e123298efbbac3b1ec6b5272397b4e0a485adcf60260883644ad149cfc2bd429704a21337e32198c679cfd7d7c63ddd623607ed48aee745efd864d352d92939392af2f31504878096974ba79e0f7a1859a3ceb73e7fb1f9323e8b17963a33b20f724b21dd4b241d56e21ce272c0c9cee96691da011ad61279fbe3b5ddf3f5296f591e727edc1f76e7e43fa3a41eeff74466c28278a45e04b9306497f5b9abf4a95b8eaa13f466b1ad30b4a70ddfa4f68e8a585a1143c91e99869c2fbd8e4b931eac767b90b72109ed03338ecced1c539d6e5c4c218bd744e1634af5c46d4c2c52199e2d216917e9684ddb4492c251692a7aa7723a9800d88d7dd666a1ba1054917349c76ff0d4796def74a919f4976acdd5f4a1d266373e6494f47aad0c0685f585e4f42e3c557e4c809bbba2c624187938f545e1c308e37ae338efae62f38a9d5cd9f2f4cca3db983bc79bd4db6b7a7fe55e31f3b7817fcefcd6df8af9b21997f86219fe3162a7d63109a23f8fc650829274f5be3ee7c1297a9a79a4c0b228d3bb60ba1888cb312af39aa16e03abad52d80928bbb091bb46702fdb1dcab222f7628e341720491e8bda5264eb2c497148e9da84fcd2f222ee7279eca1e91337e1ba51a11386886823924377462a7587e73461ada60c2dd571da186e14576e23261d988faeb969b67c11009835737848f63d81eef3819d21e9797275e26c2ca78f60a66359cb4c1acf26d4c795a3d32324f6948daf188cc233d3c7b9739c4d881ee8dede355b114d3da26088b35e2f4fc1153a113513a385c1c14227e80885360ab71d873c53ac98571dbcd4b4507dd7974b6e9c0f521295adce5f6cfe62ab8357dc150e157903936b67d1b62b99e245c4274d8e9d51245836baf16ce2f100632d744da85807695083eb73e8816e97f3e340e1461c1fc373e973fd003a9d5b33f2c61c168e64f4b33d3a95da21770371e8d2b7723cf54a213dd010e341e1d0f793d86b6884baba06102418ed8e8b71d33349f702d89303134fbe9948ea7d61dcd37300dca3b6e74da9573b4b507d80ecee12618c733e31cbc9ec2ae1938cf40bb8237c821d35277e65e6512e054704e1e5e5bdac5a3e2baea5333da78ca8986c8241b52e272475281b9ed8b1719e4f6523941e689e8867324fcaaee6453ab0c283e51f83ea51f8e54e64d09c28aa3b9ff85365be06ef81b62b43292a68b33923cddbce3edbd0b1c8bbb696c15ec79b8b12e926568076c72710675c8f57dc4834b94f280cbf241a76accfa432764242066368da58c3db3c60a8ba7dc410e10d90c2a508a6c19f27aa6cebc77ae6efeb63e9e70acddb000003936b361b7a3576ce1d3b760f5a22f12d479086488e4de9e5f0e4ccdc0bfa6ecd40455e441ce5e2424c651e6e4592c645fc40d4dc5fb5272aae4c4b60a7edc04c204515dd43b2c0ea84caab4189daae24f8e9b6b0f4439a42cc9456c8543583cc760e6f504cfa5dd63a677ab08f81ece4626ef8d25589da1c8e1d49d9bc762f857b51f463bb9c145e5416a1aff7917d017d6750d587a9bd27ed0b46668db90113e71c2a1957b0ee5d0cd595daa678902bad185bc4d1242e6c490d2a00e75065b108efb4825a0fa10e287a2e49cb8e18e440fd3979216b247bcef0a26ae4f55810a4fb226eba500552bf2315461304f7ec96507334c6e1b32f6bb7ba738b38448ce063a1338fc7b6d1b1b340f0ab31db11d83d890b01399aa75e2998ae670d29b2ee85b8ab3c85da07b5539a2fdc846e47b692384c54c8939b787cb9188f930b9d599de620674963ae2fee0a8fcfdb6308628e0bf6a1f4cb902b03619c41ef79834d158e3c50b5841f50efa4c76b27e9461d9db98c7a4c931c5530e7d278f506f9f4748a52a3a67e1f152cb0087d2520196d30aa2018a8b9f78942ed4462478a7ccc382b5eb48e89e21e729c5f67091a3c1a67f1311c38599731631724921d480e11fd241a2db6ee53109907112032bb020ef1f0d80f6cb6e9dad30480c02c784cae4478eb8923a416ae0831bc639cc4eaceebc6a5d16d286ee3e5555c62fe62f3175b20e2c3999144641ceb971a8a5a7c242d4850c33d8afabd366eb9567b8ddf6de707bae268da078ceb4ff63d56f8c8806d4b47444bef0fd8ca11ab147219e2732249a84d60dc318caf57e40017a33d6338b5de68cd78644912864819cba87e5cfc400600c3a35d2afd53075b7e60804e6b3d3d8598e513084ebdb2764f7664589672c97e90e7fe364ca7b5d8aa80215f1ec9210ef50a8a392cc81b897bcafa6bb0ea01902d9f0be5cbc73800361b35321fb28a655adde408263e727d86c0ce72d13c42542d4ecb01a6ece3a769c78913b9806dc512f8556dd6f0e9b3c4108ffebe8daea2e43962c8ca3c59ce911985f5ecf81883a9678254b9934088cbb537340cb123e694ca0e36aca0603307921e0573aeb26b305b7d56d213be63500e0b07854fea8cf10d3fba4400d7824c0ea7cbbad26de510a1989d8e77761d763b4f2a303023276bb5474c2846307daede50e1b677ebb1c41a248f43e4198e2cbe2ccc15dfef20dd6b7d67c831aea8d1c1b11aba0c5037a3c5bc9510ebcda710e53e5a6d91aa11da0a6993fbb5c3d8612282a3846ffce87288ab0eb6b2dd4f304c9d292f383c10dd869b970433346a241181167a0378bc2628b9ee88c4bdd50878cc081ebc18855b1125c499da9ddbccc1b34637c65909ee09379bba4691ba3ef61cbb5ee191086cba98507c3e2c62af365735d4bc51bf952edffafc181652427a32ca0b762a8a3448e3fd4ca5a962ed1cd0a392c5c7841c99b5b84346977215ae527d0a5776375ad6313212e7006b3a431ddc6febf946dd4684c62056b543a4ea642a2a75225bd114305011135f0de7636592c43e6dab5ddd7c8c237a74ede4c2540b91542d12b071a539eb6e91f0183c21023d43604935e30492f4e6695fe4f971c45eed761515036346587325b79b0998991c5b6e2b2e3fa07c1b629c435bdd332d2e501bbde6141139c73b0becaa1eedc11fedc93ede5f4f77aec73c3252aa720f6aa00db2365d4fb7c7a02bb93e51a5a84bc88831c6e55e97f4aedd15a5aa9dd86dcd63ac1df075ec8ee7c5954d8388ea3d3f61b89e769aeb7103f374c03cd74e4fd65ee1e82a8cd443c7e0d189b68804c43d3b6cb027558f5a4fa23d1f4eb7c11ecdc27d06f20c1bb13c1789fa54eb20db9911591d1e3a7c3ccfc5cd40fa99a0194d27d64924db64570f0708e73af16692080021b1419d90607ed45ee28c010dc8f3718d676e6ed78c245f0fe20cc0048a8d3e43d0db8aaf88a669e466efec89bf8dfd16057b31ce60823ed4619818d8b46f5cd5d81c8524bd4ec6f030933106116c68ac6324335224b3afb86098cc10f373121e9a861bf759573524e763701f492c0c5af689894cd03b36740cb067c832fbbec7006258e3004ff28e52e1a18062785d81391c3bf1e59f5af5e52a8ba82cc275b1a65cb19bc77592bdab69d809470ae470272d3b611301f02bb5b5ba666750f61b303158944fb57b3a883db684403f3e039f4e6fa72539a89edec8af5cd5c2fbc9bca682ef445cb95731865fb2ae05c64aa1bc1f86c9477a428e85156b8e436a8e8c4b014b0825aec67a33272ad8af9bcb3892f13cca7a651e57a85f787dbb9c65bdc055dc559227a926d14e5f49bfbdfb076a9a51380ff1b40e072a496045ef1858d56d6ca25898b2b5f02a11c9bae1b6e9120c870b4e6807880145a1699c239e975476632a248a79991f72161eceed2a1567bc7766361ef22b24c64b11a3eb552c4b39a9196c9fb6eb4d8bb44252380a6a0360cfb2b047ceea932a4cccbe7343b6375b7d2259fdfc8422d75f4691d0cac262c3c52c26dda7e511229abb5ca37185fd4b5395a90590187dd810e4253236edb4260e8bf5941244443cd2d54c418663e690de0d8d18227a88e367afb3f8766cd87ba8cd3d692e60003ab5227776b2a8c0561aecb3b1b2c41c36d5ca025d952145cb8bd881b923038b8dfacddc80cb1d8d31e0d9be084931eb13520999a54e474e5c31304e00069ec6735c34da94c2b141f4b43944c20b012377e4e44902f53e4f0f880399c0bc923b2f974e3d1ff430b2469d6f7dfe621f465c88195d3c86f18ce8740537f3160f87a3b04a2581a25d24b1d4f5f6b563add136263550171db0dc9d3676f5da8d6089094a5c0c0550a437c52cbddfd7ebc8d04c43b1c28b925e84bc28696f37c3725b4c5e32a6a806a56aafee510943acac71768719d45e2893b0009f1ddf7ff4b8746fa7554cc33049f6a9828476578f0988c800e53c7de785a160e29818cbca7d42b96d676e7e9d6217e3505b73552d9f71ebf888bccd1381b25020f0a2482921ab0568becd9c1d2584aee49ae4ddee6be372adc9eb71a521f1c8edadae9220c9e56399af895bad15d2b0352ac46a11d613b52df046f92231634340f6687401958966b009aa808ec7662c70aedb921d39c4383071a070a8899d9225b5558bc66ebfac23011e3dc98958594ebb75c2603e56a9ed45d1ac7963c72514032ed58d5c140a98300c28695f11c4e4698857991720553459bcdc6b39ead33226b8becd488eba31511a7b0571103322ab30f66a561319dcf7121140deebf84014d1d2e4374da88b8bcd5f6c5eb1059d1b8ec67e706e587a2f64744d99302a0986891e8432f5866c14eb7abc9e11039cf05e8bbcd3a20b72626853c3808edd6257ecb4e675a1da3a569d50b0e2ba3ea907b21e01d0f58d6eb3222b38aa73d9369a0e1060133866214f6107d699d9bccf06624cf54e57f35ee0c66316f66d751b56927c3e05bc1cdb1d9fe2621e02a5678037c558b8dd8dab1ff4cfa93894d95ea8de461a700c50643abceb6d4bba066e20a9fa78489ecbcaf563b70b50d1b3419e8eb67a349f479f65a8d569120660585cb469ad35f8266da6a40c5a18f111c1e6fe897599c04aba5bacc79561df30f18157f0851d4a0e2e5c4e4688d96d6cbb04a1bbd785ec6ab976f00d32020ea586691bdc95327200bd016e9698223f44e5023b96dc6c5f29656d9a0a0770c4a2d82f5d1d5d01a78517cca3d7ce57cd929ee995b071ea50367ce9f2904e0beca290f9ba70c3d488446d3837e67969b99310de1b0bcd678642dab577713ba809b9782883cbacbc18c997fbdc65212ab0906626c78cda47c25d4dcc5c75cfb07d6e66a3bd5bb9f5e84f7723f49846106112b7f8c8143145d784470f17760140557a597366ae6bb0d54986ce1c5f4138979226bc5ed2b0f7e7279a3b43c4b9d5728ec2b35c0822d4b746c7a6803302436ea63959576939770a86a4648c54c1e5168fd90d89626aeb1cd5a090009ed02adab4dee098a7edeadc9a293a0857d8907823581eb99fb89cab4e0bb31f8784bc9c280e1d95a367076a3ca9d4fc20e218eceb636d3461561e44829f1207a772695623b7ded9c05a83c0d1b3ea12d81d9788d7a45b9330617d3deeb36ee698798eaed1c4105e347263fb726419afe9fa107a6a5b375a7bb4e05ceb10eece2bac9879031693d85e7abc47a970ddb335078556ca3c93795276352fd281151e2cff18ce4118863d95d6ce695d6f727d5529fb0a569f9b441106096bd1af59d7f87848441186cce192a19b10ef7a7c7916b5a8ba3666f404938ec3286cfd66f41cdd86da6337a35ef7107e03222f2febe9c10cdb42d8eaf9e04837318b3b19f4eb1ae1958b82cbe1caf6a7861d51b95283c61e478b622b83a8b3f39d9940689f1a3f7a991934ee25b19c3bde3e8102cac7aa7aeaf342c2a19ee140dd2e7680ed9d795ba5d94227aee0636a4b8fd6b061e4bc3dca65899ed684275c71cc8aa91cb3e4c42c4e212ccc3d44908eb03895483521566120c180f190180e98fa048640af5a32eae154883b6580088031265a70598a645c29979e3251030e87612d890e04873279c2696766c2f348574f2e74f320081ceee8f7886321bd9724665282bf9bd93de4fdddccc8c19ca77eb5974b6e9c0f521295adce5f6cfe629f8c413b4394d96168ec5cf560700092bbae73e5cee3bb5a8f1570f138304da3c3977b7e8766154d8f9dc20fce0ca2eaa2edb5d1e9d1cb3c703784cdb09016b842a6a288c8380346991da992a145db875c6612c249aaf36515ef424db3612c58eb86d9cb3335b2c98880dc67d80e09bd14576c7ab014a7c86ac31cc74ba6a98ae25988587b531a76d36f44ca99631408c6998572c92ad28a1b0f218a083c0c07fdb2ae2359ddabeb5294305c0ea20eeff0eadc886eb0719f70779db455666b8e9637f622e332ab7976b9c719ccfd1ee592ea0c334a27870b6f6a3da94086e7948ba8c44fa14666d0626080cb0c19c6502b730e0b1fbdc491433b9616a18d4e1c8f49925461b8261d062c80b59e6e8c1d77eb5960d177d3e1ea619aaa142769271efbca308899efc6386360c806bd3738bcde674f5cf0d96cbc099c5dc2065d4981386cda01af1e6a49f1a5cb97eec1e296757e1dc425a408ee8188c1d6e98120a9c9a5cf1730fb5c2338eaba48f3737811a91d3d62ccb1f56ee3c890aeeb30b4f9859986fbfaa43747b931c9ecb114a738346cf65aa2e1849d8c648a777b33ec7de040d2a79edb03c60aa69d7aa44636cec2f3b2c4fab08b249cf6e870518b418c0d0d9b0874f026762d6054acac8b19da4c6bfd1aafcebd60a45a5afbc114ec1e2ed8a5658623b9f1029c986e3fb8b65bdd8b342468fcec7295eef19e2d68d43970e47ac34563a2b71d36e8e444643cad46a6661cbb56a3037d347766ec30f25079688909a12a432b09924a6b2aacb6264c8beb5de7dc91bcfa1b4d56c1cb57c6b977dc10ca92c2054c04098c8d6888547801115ffa5c68ec7cb053679b57ec5a879b23e92656b182bf5f0f857cb403b3e69285e77903802baf52dbc2f3375eb8dc4fe2136910d69b67d50fb0600408098ea0222ee92fc3f374eea8c7e5d15e5ee7f52a0ae6a9e7f3263f9f5edbb55d0e77451df2b4b91edc9bc85b774b3eb8f541dd6ff0a24d18c38d18a289f9b66b051eeda566992ce655736880b88beb9a895b966923c28ed8bdaacd99842546a3876e2972620a8b8a906cb51c325fc83acf80abb03e76008c1053758df6342810455642101207ec1dd2f445d7e6163130c63a31e6687377e00e38f6623c596e035853b61c1739d6130190f8c6984345f489288949dc429c6d65309f3533097199417ddf76916c3bdc505d39c4c0bc86cfd8602b89e2380ecdd6cc33461b1c36aa86b34f4470b00808cb5ac3680006a471fa8824a8007623fb8b1a464f73475ca4eb4c0c940420e8eb52a46b0e26cb7e4b274085d08635a9d01492e7c487ce10141469ccd2c54ca1044a82c69fcec8552ce3b57112db7e4dee8e3b23910b5c104d137a848133310436d333241c1893016904654b90763773d21423cb8b12a3bd3212f7c8d0aec32b166084052ca3578c192b4316e48f4b641f44c452185a6ca7f46b1fcf4d0633720b614c3e6be1de334381bd8c04650c9372603bb209987b87f0ae45c122acb8ece36654c174746723ae5ed78101854f233f181207ad546790b7818001390215042ae808579f58208f9b516073723e730e4bef0c24b139b174c29b69b0da670a7b260fad69c033434cac0ee9b00c02e017e93467e9cd5561389d191db05767f657c69d9c2bdd42bca599ae1eb7a5b13a1c2164419e4261ac06f178721d27609818672205d066e064e92deee290c3a222124ca939ed0656274c430263aff7fb7cc1234121d7a7861ecc71b493a713b7bce2f456c734b50c31362e1492480ac98b9181fba53ff566b0d6db364c8824b941f499ca9ce7ebe18007cee309e495ba4651d7a0f24c1cfb6bb15b46ac1cf7aec9073460323499299ef86ec393273638043b921506fc929149224c0692a36e682cb4092607112b286eebfa3c6809f58ea2ec92b637e4e3a5a63389491d1fb142d199467376b592db49b2a76922a9571425c43c153b46d9bb75072096194a3ba631c7b100c1659d78c451597474a8a47b19c6e38174edae1d08bad793dde4ea169ba513333ad6324288a0991e13f9b11269edec1b2edb1b9634cd72ed8de9761d0a39c02f5aecc8572d6048f6641b3d9dc4755d6c98ccab833a6ad48b4daf43f5c058fc2144f1bcaffb7da44feef174d0e6e391487600aa645a2ae9520d6aab08051ed18858e1d866247338f0b183fcc1ee8f8a32be0a925349553b934ab4ee3bd65da278f0a3fd52545823818e4151af692fce8aaaf9b903501bfd1ee8151172d5bd2fab756ea658b48046b8ace250af93b1e9a56d06614241f649b6f7f222ad1c376a38d9266d108ef77bb3ce91bc4c17a20600e32cf36a678325c72da814720dce70de2bd4ecf938e1272ecaeee93da86d08e7b96d84061dc9dda8b145b634b6808642bd54a3f768966a6a2d69fc71c6b2b4873bc0d0348e481ed1c3e4f3179ad2d4b1d22cc6066df684bb14a27eddb9d0dc681797c5a9e119c60feee5053a49e13a2355d407026cc4bcdd689672d847ba566352adf6951812cf75a3512f62adf6a3bd4006432a609eab3e06a04de2289922996976caa607f4940357c571cd28918687fa721daa68047662354219d4e916d29c89aef32aa687e028093bc76156c55f42fee19ee0fd7c9f4bc1b6fad909f1be8c5fc7ea8247f53998ef06502a8a4346635739eccecbd5a07772268b0c06b3c1bbdb76661edda90b7540200c8b921c52be2477730db03356f898205f2f1226f73342b59b995a6f6c2cc574f4a0b38fc32d1066acb75a4b71f6f681870ee8acfd76277047e6b889545fd0514c2c264297144d9218ce14f453cb8b427c2030d90153711660b1c32e558216294f663975b0676f18a95f43d56076695e43c4c5e408b0ca95955109225c668e12f64051d81bab85b82e5118652a67943d30b5d51906661197f41ca3952463da987b5662ec9280614006e998591c4565b987b49172658c61cbce2e5bbb984f0ade98485f85c7b8c06992f4178dc52dd26e82d5361e730f0ed14bb225ab18998aed6a62deb9e53cf09697dcba79a5ef427a08f893cde3cc8d98777734ab61d34f52dcd9299f0b977a150598112b36d7a6d855924172344de7b7b6d08ec1445216c6143e523847ad3a3432069378d2953c6ca5a94f825de5fb2143e516c6d2b54feeafe8b5f7ac8439ea0c6f536e5c27c3006c637bb74096d4f5d85e1c30fa1a321c618aeb22bd0ecc79999208f643e52153f2fa6d04a272bde2c50b84f1d8748bd22fcb0ba966b4eb667a1da2d336e83ac6a23e9c5134d300f006e1ba0940c5aa5a67d9160116ce098ae2a3c1a1f5a6b9ccb144db2baeb733e93c564478806d35cb91d46aaaa134b3c605028e4bec91c5d72a67add2cb4a95163bdf5b5ed8e07803d7a0f3b4921b7e7cf8ab2d72855910567ab42ed0dc8f38bf83195591ca6e8c8719417e3c29fbdabaf80a6415db8e6ee239fbc85e07feee52949eccf3322cc2253d044285d9d747c89e3c32e24e57e371947773ba9c2cd978fa4a49c6bad887ed9d5a5f3d80b9c80de2c17fddc7e2ccac478d381ecf6ac023f04ca3d4ad0e770cc398f4ce2a931c5383b1bea2fb5e3a9145de62d20d1c65df3df2a4cacfa6d4fc299f959a189dd34ccd7710c61888c97b1f0c64a726aec6ce7e3f3d9f235695eaf5ba735172f0d650b0869d7f08f09105f348ccf890077270d7557be2164f22e681c21e8551d24a7d3e5923398b8abfb6af04e80507dc91e518ab7f62de0b56b0a38879545d1ab39e113988b638016a4db327dd6613260b02d2e7018a9a93c456cf2ed4a088741f3c340969c7f641ea83991bc78c39a98ea74bde8ed61a33188e7c9c291072c7ca1bb74e82b13527f60c573cd139b3d6c9ade6e3bc11af82a1d48635fbe4d15a298adf966d98d35bb88df24c9d18fe12f20f577ae2c5e042a4045ecd6819f0f6da1246808573d5d0e6dd7f36da2a9ea65bc134704939b7f39c8ee38b28ee6d47d6346d811d711201bacc49a0ed4271f2f05da4032c3c1951766d8e71db0e13778fd839c1429a24d726aa13f3346d4c8c09b146cd5d6b3f0939f28de99947f31e871801e068afba0e505f447391154fdd9d7afda9bfb455278a501867efb514b2de4e63904b2a9ec86edf31c9eb98da83e6d4e5264ebad7bb83bb16737ce3afec15dced5734ab74fd547dac480c6544c8c22ea9e55e1c9375b7d48fc871245d631eeffd13c0e6beeb93693d0d41de5f67869cf2bb7c305a2aa67a6571005d92b586921b7171ad47186d61dc7206a773819043a3f1b4b87c26f4c4b2c211474f45b0d243cf97636a894c1d42a8a57a0f7159e7b2fe7908f893cd5f87ba64f0b1cff822c79ff219bb0675a7981cb1983798f6a36f91d53958315289f3db9299c4d108cf88efbdd501ce992b6340f6e828dda13a1ac7316b017b91c76792ace45166a63b9323bb8c3a4724b45e8d9afd949c49e346beb8228e25a89e9b2ca8167f4f360f332706305c89fb4e701e2e64760ba045d2c6a1483a7b2d65c3794dd10eb383b36f6d800e2e488a71331a5a6f5ebd353ee0a97ede792d20e049e22d277e26a03806552c1def5b85dc41d6d9db516ebbe94668782a382852ab07caa245e6ee895e00874faa5b1aca7cb31cfa86e7fbe3e330606321b31d179d3ce655b9f3ca9ef196c7580a54baedd40d7fe3342f6ea7b155a50b969f4c9c5e9533cbfb7e478efa11e5cda36eb9a1d6f7ba3aba9b51c42b91d68ee7b3e414f3a7892fe3d330b284898fec65e0ef6e72db68414c0f0a2fdae6c6f936b1f2020eee3ea7ae05315cf0095772ee9c0fb26162245ca53bb43d133f009d86e3327054e94fe399b6c985aff4cc219af932d6ddd959ac5e755e93814d8815bbdb850b10cd35e0791538dc6e02ac2ea920c7d239564f4a3bd14f3329c28749e027653f8d63bc13dc65cc7531c70770213d3f9ca9bab81c71093415eeca7bd11ca38978ce32e95f9cf97ae1e263efd229ead49b72aa74c7c46e5b4eaaee79d01473dd7dbcd2452f3af3d6c02539e38fad384dcf73a8a5ceeed0f5b88d8f654cb585a0052b2b05ab50f662d913024898ad6855d5df9d7ad4127ba96478c2e2f8baae742333a47ed44969980b82addaf211de080f2301284b4c8ae4fdc49fb07ac47123bc2f9741e957b40687606ad756229f679751489e12ca5450d28333304fe8e278a2ea18077dc326686cbdd5ccaa4c75fe1c727c3d90c19dbf45c3a1b86d0432ddf37c3f37cc6af4221d454090f1f41547a434498c793f3cf362b5c66125b263720d1202e967b9ced227c6af62cae364c6aef186db9dc8e09d468e9e5f6365b6729c9acd835f388fa26e1ad98d6a9f96daab11e3d846d882de11eeb644c69ce5d9bdb9a73ac24d2af4c8d927d8245f83d339a46671d327cfdeeac649b6ce82f696953937df71d1369c9c1d35dcb9c66385d5dda08782d56de38049b5b1c2e884df44fbaa727d17ef249001112749bab031f3aa4e834d87fdf1451031014fd136eb4612467d681c3b26086dda501165cfc6417c51f19a428d9c3a7ce5e88dd3ef7705b9bb44d3bc8d31e2a4d02b16cf01198c22c9e82d433067d5668cf85233157f9a78224cb0c6540885bded3c3e34043ddecc8627806bc3a0bc25e1abe8f9c314f7856962d9cb94704b03250e1f9276273aafb75ff7c230bbc800a3a73d586ef26b4bb92ee7410ebc36a0c56620879a4f49b18be45e8d315f3cd7938f5d3802c30140d68b62012cf6535e8e87ff0f3bffd52bbb92a56b63ff654377dc5fd3bb027411f44c7a26bd2034e84dd2fba4d0ff5d58bbaafb5455ef3eaa73a90f736162cd871c61c6785f46642412339195b2f2b5a45e6f877218162a56a41a76329566cca47821e0afb75dd649380c181e1b7981116120ba00667c3758afca4333fad00c2e2d996df7f1f49de3a8098d052bd042dbef2b4d535005f66a898457c0a125308bd7432cee30b35394450c2cdb9cbab35e1fb5f0823167c5cce99c40952c0fe3acbaf1231ccd47f890922a9c3d82749df2eaf8354605fbc5376387254a737d995a96b52a35817c526c0f1dd52585261cc6210b3d4c540cb1ed9f27a7a84aa0be36e0c660b966f9f37ef2504e9e1c9ab2ef46135337d47e2c3a8db4ccafcfbcfa96d9098bcd0dc346b0cec0aa5d949d8fa4437336a0336a0d66f86c7b1575982badb04927dc66e42a8dae523c8ad9907c23808d55454d1f5326d793269bbdc7a82e84db6f4ffbd626abc5ea25abcafa1c3a0845bf7fd4cd7ced1f823923b361f61d8fcf9e7a776b3927bd6354e5a56fd22429822c2439629acfbbbcdc17579ee8e79b250d2479a35d4be10223346b8b081ad11371e62ed9c6a3ccea1bce5edc005a10f42845950ee74b375cec183a9e084357fe3846551b6c1e9a26183d248428cf100eedf3faba60831833a910ceee83189b790b7da7e5e01e033a85bebab57114711f0f851f3a451b496807908881d7a63a1d58d8fd8a017813a0bfaca5bdb2c93cc5bc883994ce6c03240d57cf7e544bd097c9e9dd51290b8ac7233a4f1aa51d6b736f6a263b183f12bbd266f956a2a0b385689220ea76efc056c383d99452943b719d9933fb2e590a1f2da075143fe0e03d751692f5789187337a600d421efadc7ff148208e8463ce92d53277254474dfe98480edf161eb17aea99e64146a658450b7ef12028bc3c52deb07510acfb6d8710b6e22f63b2ccff9d526d1474f64ffa5d343a4e42b723c4a64472638e99486afc61f6d099370a8382bfb34e9d9e5860b31ec203fbdb548eca7739fd2e389cf4d318622993bbdecb9fddc033b69f99bcac8b92e468b6d37de29ab4e59ae6498a90e5e348db08f5869a4c17fc760b8e661b6b3d282cdcbd455dddf394917b2c5006f02f497b9ca3a427a66590ff04a1ebd16a3e612175782cd93b7c4b5e7ebdebd2e1948c2b077a871a2c3b44e424eaa6fb093dfb864279367ad14b5cc63162b1ebaceb6e96387f8d6192bd7ddddc75b4126d2635df029560b81cc6ff97a068ab036534b53537287b0a5111c5668cfb80176a81856628b7e4eac169e54912272b4644fce2d7bc14821d0b629864869c00446cf16623a9e1171e22bdfbb8285d86fd5c59b47c778d7562b7dee7bf31156652a96e6cddf6f1c325c634d68be3f9780bde699309c6d7456a0a2594cec081eba5377bdd8cc4fcf137464869ee5d730b014ba23ed4bc770c567400c799c11623a105746df801b5f4422608c5ef1341043a06d634f21af9e54d5e7fd32a3717107dc5aacf9236488bac9092da417e2cf4fd0bec8ad64cdf54027417b26c9af3219945cbc98ca7c7c1a0f5351364a21ae77e3f7038da2272d700c1f145b622de20e3a868def47e54210a45bed06eb6ddc726d87ad84870fd7a7114522af0db8f187c4bb7b24a3071248662e71ac45739162700c3e60e2f9a2ba4cce5f1c51dfceec981b956cd067ee214358a5c6d66e62f96472891e090a7673afacf33ca34ac6fca6995772cf7395349dd40e46f8fb613f5c30b9275b9810814f48b51fe32150e6f5490afcd2b6e0a8548c670fb5bc2b84878138703b4f28fe666ed3c5bd6a3ee3862d11d1764a327a3c4350c46b040f63487e07cab94d79e8449c29b75177acc0b8e5740daf02490b14dbd35dfff4aa8c9ea676774aa251dd3e21ab66789f90ca2ae129ecfd435cea4ab5e8878c524a37459f45cf93ba809dd1e571b8cbbbc377d3e3d52211b0f85bb14a129eb4552014e46e97cf261c9d094f475f195d6ec39b3c37eada667e445e93585546f8f6bd6762d1d783b0efcc46c1571eb66679328b22622649ccd9286458a44190244a0e53caeb3e2486853e626cc8c61b35530cb56c3a3dc67e9fc981dff41abb8927c1a374254f1a87697266f7dc30a86641e0aa0848a8baafc2de4afcdecd57938d6c9e4396193e3eb33ad6667e989d3e66ca64a3fb8c71269ab8001dc78f1c625e33c56208b42dcead262b95930b6a5e075278113b2fdd651846987d68f826a5d064b1a99940e1a71bc7bc6fb213c1e662562faabbb299194b56a337d70e46651da87a26958989f1c71d7da8e4676fc04377fa58b9bcf5baf7340d0970161a3337d4015c15e4874d74f480bdb48becd13bb1898d6f36342b345440acc2efdba655a8445e84a8b12455b3f022251001ac4ea08577ee6ac565a6788b27eca83e0181d91584d2d0aa122f36e8aaf870e646450c7e15289496d682c755bb842533b4c6315d959cb02f5ec73d269b08fbfd21c0e271fb169295854567e77585c70d658763780987283d139e8767d682919685a1d508581c2b2b1bc56de2b91e1ae8481a655b7b966c067b23398ccb5953177e3ec37d14f27e7387b512526823f4730d17277599d3029416626d036e0c967b963fa1e00ece796ea5d74934858c297ef9a1620901956046c3ac53535434898587ea71a9e1aa058b07250c2d955c16263bba2bca06568fed84dc5f5a1e6a79e7ee8192bed7b28286d70bcdeec5f65e541b8d052b35382cd435ea334ec6a1806b9f3746b1459e5898e2656611edfa8c47f1f566b7888e6382357178c84440b031bb77e6166a5215ee36648e3eeda5412d850b660cd6da96cedec24f3fac31b5ceec5b188b4866ecf34cd8c45873c2a6cb19837d842611d86f9c952746afefec8c206c8a345066bf94e9f8def462cbacea95724965de5eee90c6303089a478c29013410ba3af17c13df6cd8de39aa323061cc28d541791b9f1344f2d7995e433fc588eba8122bd8882c7183be7752087803f46a3589493e865da21e880db61363d4303b7c2b9cdfdfbdd9ccf163e54c18a35eea15250223ccdbd09fc41d1a08217df76920e1aa0617ba0afcf77ebf2e41645aa373477ebb28ee8842218322284730d4885f8b090141c63454d956d504a51e87a7e9fb0824f6fc2f3686b72c34a719a8e4d77a676419acee03e4594b9f6b2f6a60cd2376e21338095866162e549d9bce574b28173d9bae2be1ea70d6364196d42d90250367d6656a31273c0892d30e6516a9001d4f1123b5a9d06d492037a856172ef33a698501425e809ae285b81c731392d1f97add601f0933794d1a1416eae494898abcb7ea9814b8d8eaa906e9c437674216a38cb302c93ba88dea7c3e9b5eeef0d5762febb1e2fee55f3199746273fa6a813b011ec5a0a1e6ed464b22c5cd17d7a48ea2c5a9c6adebd1cea18d5b546a9914f06bdf098a0183065dd49336a4ef3f1b3d0eed01a8f1eb01f6732618d7a23901b8e63d3782616b559766fca49eda7799e9df4b8586b9e0795356e5548ca4a073d0e415891192c92c48385d32fcfc3f05274175149ac4df7ec826468a2bab42d38f20c04196c0df1a3746606155349f609cb6a76724588911b5e4b4532d4e97aa006ae4ce9131989c873d6e99394e745c1e139e2a21c2ccf1c6a106b562982395dd1af34b6571faedaef65ac3329ece0798742a85d17abc16f51154aa2a971d44efc0e5d9b9cb1d4c8c60c73db35b3c850162c0f34be2bf3e2748c15944bc4c06b036eacbbbb157979ed9e33b479dd1ad0f4db6adcb4c83ffd709f89ca9ee5602b085c4238cf9e6dbf6288a85c08b9ef47c222c9517ebf68ab18d8bc9fe3ec3baf260b3a87767d1527aeb4a11a01ccb076e2f95570898b687bd0d7c5f7f2db13a0d9b62585a9a7f46ebfa206a6cb3ca271163e64b5cf02d6bccd91d807e603a55457f119972ab975472cca633a6c6181a020fc5d51d0f9e0cd597e14c46c611bc5c6f69d685fce4cea7b4c48c3320d6355ba629958967e7d04151ad123cb7974cd8ff90c3ba2695f4033cbf2d11f6c5524412393f5b0397862f7f5b2b6c222f7c240bc5846342fb42fedc2a9bb0a61df43aca46160961e8bc7a3cbf712cbfb9aa1f4a56e2089c19bedbc81e6c78e6c70fc801d6a386d2c95b50f1c62d526266895282ac6068feace58d7be545e444521c7b74f156c6a2743b0550618cab0a632eccf294f6b6bdb28b15b070fbc49138fcefc42c768ba78a7348f302fd1d5f198c257f04cd116456fcf6e7d9ec08f5117c6c2612c5c1b9a1d32595f4708fbef23908a2c936c43126b5ddd2afefbc276ed095ff8b34e874d53c556a805df7982de102b43598923850bbd6c2c76cad92bde71154d70e1d15f6ff4b2935767b2e98bb93d3e380f62c3ccfd739afc072e2d559eefe3a44511e99f6c2bd78be2e25640d9aabfcfbeb959564b135f11f701fae69645e22d0ab3548b1e2b41db119997582778ba959928ec2010351f2a7a436595912b49a0c511067842aff2eec2995d7d779a44eff5658531c3af227e7052cb5a75251dfe1bbc72618949b812f86f13c92c6f394af868f68abc9b721828bbec3449440d8c3462b46d2b7a803a358bbaba2e14ab517bd432354fe8b77de61013997c9d4282eb405b451326a165d554a587e2938fcbba92450c8d6fa4a006d1522a8664ab2cb9f015c589fe998b16ceb2955c32f6137acfbe3d51633d20546fe213ead8807ae5b286c4645854fb762a23c3046f366a124cd70a0d3ba57d082ab760f6754b5b95cd2fe681f4bb396b087b024395d97006f239508839065371e365092ba11a060ac6be75333217476972d703b231a0d6e40b0642b44f5232b05505b00d530984f152fa382446044b2a114dc9306a808aba8fe1de04f9508d1e3a2d95918af5cd3bfe860916a6c5825fed459dd6125e1d5d90841f83e1e2a48e1f774ab4235cd40d413b6c60d58b8252b42937a03451b9603a24c8020a5ba49566c8b799ed7ae8613e35c7190271ae5c3c49aa3e9f2e8bf93a7da811ea9b9b24b9b4233d0345141b3e6f1ab5610114ab55f8830911ec972ca281e66d78a912c00f76b067576f6e30dcb27336ad9617de2807f777ba754fe0c44b12d15a311e2f68211efb6669b9cec7858f9df735bec94537b865cf1929e4c6a87928e51d1c5da628c6bc0f1885c3c4453fc9269b9cbf5d50b794b03bb7410e9f35b51cc7707e7359644a7c8c226a1a8305cb9991093d4eb6f7851e6102670c96e6df854e65999d45be5515c299984ac8b374882757b185249cc684bbafb185310cd28c904162a7f3d0a5a36df08c8c5c50f3ab4063508e03715bd1d7ec78b11ce4a56409e548a478815f1f1236304128a749c4cfc52877b2ec770fa1c650fcae8d98d1f4490dc33e2823529e08e2550b1d71d94810db27630f034aa95a8ef6ef9b29c2d31c98cf8c61061dace427669103c5bb6f6398901b8da3691df4a09a33eeadefd86d1c36b20f0b8b217d8dd7be1d933c4e38d7c608bc55d81439b64c60da1705e412a249b3e9068679b730429b1fe3ee56dbba766b0a2e29d4888d5fd567bd1e4fa119afc332f8a13aeb488acf33b42f31cce456e8a5761e7a969ecf99dd44bfdc7c3265e627eee693142ed624e320b4b1d963a3c6cb1c9be82e0cde2f9acaa1fbcc8f28b8f60adab55d3967441c0489b69aa2d8c7252986f42663a0411081cf2b9d9a9d2d0de0b50137be66ba6c0494bdf9077cd7f3723fc6e90e77c6399fb991c607cb21ebb5f1da87c70b6155ee9e663789d6fafb0cdf2a41c5c7a054936e844556b5b46e6768802fb2f68549920cc74a9ae5879b51052f4c1a4ee8262576858d0ae1f1168752ba6e5902af4312e332860782c393e725e2fed4454761464b6661497d783cc013e7519b25c6e8efc905eddecd63bcdfc9fdd0bb9c0959741f3bb222d8138626bcdbeb624857954443d7f11bcc6a3263a6919c0ccc81cb185ece5c770f3b3202d8b366f18eb16319321d2571f8aa1fb3dcbeda159e50a7b0215e6e85c795666e61fd6456742ed1f9099fef917d63764d706caff8241eed8a156c6ce3f1a84492f19169e5e6659ae9a6a386182ff85b01ed7072abc0c99ce9601103afcd5e63f2c985f7b78b0694b3ea57801331e212a94f766c5ee2285f408ab9e04a4a9ebb4734fdbee15026a5df02ca0e0a9e30b942275e0b616a48f7d68699271f31835dc480a65184c5ca387cb8c6f528f84c47854cb09bc6564a3a20a82cf253ed5f98b7f9a4dfebb61742422b6d976f0b78990cd881d9d03cddd17aa8a197902efb15b3425a58137b72d8f6fa99600c7bbf768b40d3bc506ef41c117e9532da7a16022c5f26659cf046b22cc64e86822c6cef707c95d2a1b91fd1335d39827aee25eeb6a52919c3da44563e1709cf54c94c7359eed6bdfa0d7a7e68f2252c5bc8c22593702d680974bf1184f6c6e15c42a62fa1fc485119b298918ce5d0d65294f5d6b88c72a7419ef830e70536349eb33b4822b1bbf93ce83e20288d9832354b10f47dbcac4e514f5c2e7ead15736ca07799f33a1043a06eb1cf9ed74c407193d4494012136541d7450676bea4d6be7be6d10cbc7ab44cb63278091d1279ee5b27064249a1260be75097b207086fe6068ddc24f79b49f073b798297916d39bcacde74e539fe8353b10c68296f7974af2cc658f112dedebb31609291ba95a42986d164589e234815cd4b3fa954601d8934d282bacce0eb644b6e6a73d88b35b8caedd3ba7bdc20659c1f21814622b635abe483aa7106124496b996d98baed1a168f488857aa8271185a33e65ca1f322f360a3a12837cae34b6770f0e13fc577fc3871451bcc5afa3ef528a6c4eb8140ec6ae18d7bf1a60a135ad19d2399dc4a9ef05e89301a39aee8576024508847f39c60384856bf8490a549e6c460cac11aa191d9aa1a4bde784cd00426376f7a212b95010bf13c091911410c860bdabbd1bc4c5d33cbcc1729d04770d3c5194cb5c79172fee7b6b46f9eecd523adf3b21a655780b80a6974d699f8f60913354245e9967733747e1439573ec36f53ee6349cfd75bd489127a5cbc6c50aaed24a841d1fb9ba3375fb1b8f649e9c384a2abf432bcd749484a4da36d635a2018c3c08bd293d9dd76b067c48e712d7a8a1c623f9c64621b701c869f17c6e4918fe6c5b916a1f7cebdf0ddad3e7813001d33c47f2d0fcbcce5283c8646a942e4e4d6eb45a729e976dc3759ed4a8e911b1da3c2751e8fb53db854e70a1d9bef61d901b6c130dc53af3d1319667be019b7984af2d1cba7e9aeebd8ac84f0116ec5c72ce9afe194dfcb14baaf8b1e8ac6177bbbc97642a57914bf09f99ce3bce8db3545f61cc274f6fb92f393de3133d5711113fa9db268e1d5cb5040f03a9043209e31421ed74c40b1ca0814349ad8f2cac456b6a8c33ed2a326dbef09b77055d9ca1d96d361f84246063dca9626dca5e858198b3a482b5b554550d2988fcd7e8637f878ac50ce524117c01297b5d25c4767da428da7adcef332f114339b42f72006a7b1fb5def829c7d9b8f09496db44ae125cd585bbe6e4adb6106e3d87d1f862fca64d05b847bece40585f6b29b1a3a29543c588121129f86603ff7953bf7cd27cdedb40cef507d08a3df27d1d77a81a4f256048ac4ee3b36e828c1264f3f3865a1ef6fcc7fc9987444f5f4def10efe565964e8ac8ed179bd06183ee5515ab892716b68cfa02f15b723ec8e762c77f666d44c39efc917a8de9405915365df32ee87c07c43d2d1e3519454a7c41ef8ae1920bd5deb68ef150ec8ee8670aa10712d6c6a16f75fb180e886680bca965dc71378969564231c860b8359d692901af8561ed7dbb3019d577ad8f29043c216e1337dc7556e70d33356c2a89c6d865943c85ed04812c0c290647e8611c5238a4dd953dbe0b83acefca2857e384278248b214f618651ab071dbed9ca935d6c5bae4b4d521a724f555fc653e918445f3b06e3ee8b5820bf7abbd0dee13e95ed06051e806c134a6e5e387ff30cd9462a0e6008af820987715d823d7453aa2032dff5172abbd343689cc173cc44b729d1609e48b770144bb14f09c88a2a9888969cc85005d18623639e820d05aa02b344e676171933b400127e20aa788370d6c3f76bf1accfd2e58d709e9eb65ff00ba13a6fc84cd3af6ea3181abf824401fde27326b36e816dc5f3390b02ae5ecfb3e0ad5f365e1564164d19ccf6ceccc5da8fd45dd00a9e703a8b51ebf8ae368ccfd4a1f8ef17e5c94367b263b81cb7bbe92a8112a51a8e50577b61c0363b032ba5e24834e93cd5b09e188929e24d9f5af6604543ae2106f6772c858a0d310b93a27b8e906c8854910d53099b7168bdfa1340e7878a3cdb4c0c360a5e3653d1f44835cd3e8caaff452bca8ef188ed7036b7ab1587698e8c1ee731919084bb89ad34a9af757fe082e6810ff53c5808ffb0ee88d0bac2b6f8ac1a5c3a8649fdf8a392edf63e1878f4f803c704bcc03f96186032329e2842ce3d494233522558844e265359743e842597443756c55fe371f8acc7526027ab75656632922614ccce8df69da195a64fc2daedcb6453ee9d7a61e2bafbc800a797d511d51b1f616ba47c058d1c27365096aee9975bd82b7326114d9988a8602dc56daf4dcfec492b4f9030e04d08c170716b4f3ba7c0b21d0cad045ea573771a687c23395ca8ec329679292d287cca261a586457242f7924ed39ceb6bb0bd6e50331748107c41b4e566f1dc8148a99cc566892e9903165b2096697832d2bd44629d67d65c24cc913bee09417d970eb8d68a6d0590f17e5f7282f08554a0b4fd3b4ea10b4b7eda856a9a97f93457022743f52ab6741f6e9d308dc6719cddf107b08d317cd6d57bf8f839e68aa7fb07582d97e85565dc2a1ad6c1713d7f357aca9794be6dbea13242404412f43833574140c07d0bb3ac7cb29e0e0804eb9dc2771b4d114d58b2629ce0a5e64ea6c9195608dc2530cf3131427964678baad383c96d8c010d93aed79f5dd3cda7089b1d5086c89e9bc63314219391c262668ad6422814fe4f33c160ddd3386eec5633f1546633ae1b11d750d1d3c2279a6dc320bb3ecb9e46851fa37e6b29ff320a10cc25df1c4329cba370c0f6c31f1aaec595183808dce5696b9dcd99c539f01576ceb7ba653854701ce2667358f761d58e90855e889d0144bc1c9197c4a6bb2c82ff574234dd07912c3cb347ac6eef527dca5d25dc0d4774103dab4f2877dd2eab6b3b24282115931acc62d41ba9831d6b1befc84ac9097c5e7463d2b4609aa41a2731db2803d6905beb0d83e6192b21aa280988143618ba6e7c36a3a218b8cf293b322c756c38d096294c435b705d1ea7c95511983ba38470b49d80555a9d7417498f5deab592575f6b062e339820c8382cf084329aa66f4c397c732d21162ad1ee52d546ed20cb578acd052d4f8b2823385e0f6215d56c5da8ef52243b4c87d65598de1be8e5d2d0d1777020f637d80283abbae41118d194c2ee9722114eb98f26abba93444c9f0626173ca1614b1ad6c4de3981196a68488855b66dc65e8cc3b473879c1dc172e59a5e4d0c8c6202b600b26af982c5be81d2a14182dcc2fd4c71ab8a2cd821e946fec9a62587c0c72b862d028290ac1ffc2b6c7f630b3a2458044625ef4a702e3788e9e92b8acb08d2baf6c2f2177a5e095ae035a09bafd3018bfc82afb2aa2c73f87b653cb325766f4fb94709432a3cf32a5f1419402be47efcc9e0cb51528d5ec8a5ab4bc0bf70ffb447796564595d1cb5256f8ead9affd285713ea61173db2155e213c286c19b60334b33d2ac13dc5f79f2d393df8a2ab06861538c3ec7245d12ca80ebd4f15120ba5430a32ab3a7dcc86f3a780e778edd2ea1e8b737c26e1c1e7c1eecd0b11565da058ef807db6e07a760be93bca585a13bae52acbc82d091cb4cfc78c22c5ec981d63afa21b23b8d3b218b78b426a2a986f22d439ab226a42b65ba696ee46bb2d6816ca9eb632ad1d82dff089eda3739436a1520ba533355eaa04cd9a24fc5ce55a85f7fd10a5a7ef2c5a2a4c29b05f9c2c498fd4cb12d3609b60303186aaa46051877e751649c66447754875ea4231193241213b01d1554637ddeb78bf718fb3617d9b0936c6aa95811502da57ecc574ab4431791fa1b57fc27bb1a284662e09ac9479805904ec4ae5cde6e7bb29ded07a5205eca2e3c869179d1732faaaee6a1cce8e3ecc94215541583ac4b628822276e759e81282193b208baaf42d1466d511ddc3ae65e7eaa2b1968e223a98ef338a1dcfeb18b2f22d14a34cb1b37c28f4c52a23ba34aeb288e5bc9b15605f0cb5d1c5abb25895076302472e3e84b9c26895e3179a310aaf75f3743d19729f308b7c7ba3553354c5ed21980b963ad665906c6bb1630632ba657e0f6a1949086d9a5166e4257793ee592de88c66a717e3b345b37441fbcd133c64967f1979cf89ec7c3d0d682a8f571c6fb02a030d93035e597df97010adb3118ab38491dba64522c2362557b023bcaccaa8e9e3951e53848f9e0b4ab9f224c0e2a06ca1894b248463cb5ef8dc8d3ef1196239439dd939a64a112d6678aee885973334e2980ce3f8597547f7dc56800748e49d2214514c5fc27db36ab4a3034d3137189a10fe1178ccb4cb624c511a539479d53ec7e728da53b83ebbf7c2ec3068d05c516c25a53ef8696925a53941f426e37d81b6835ac9f9cca39558dbc4c2d9662fe0e6468bdb16d188ec32d864ebd3c6ea2436b1122c57e143e5f26489c1ee6b7ceeb8b755d8dad74af8a228728a6697fab826dbf47b75962dee40d3b93bdfcb9e677ee5b0aa0c1a34e4aadd1cca36850b2b18d96cdb00856df365ce37a7f8781e9c19ac9c0aaaf730859f7676a3351c6cda266bd5be0f2116cdfba8969675f4261ac7261dc6b4c0905e9e5134f152d7a69de1f175b6d82a7dd172427253608263e4cccd43a9f565478985c515dbb21f3947591a79466b89f0c239c7d696f93636f0a2ac3284b0e820f2baf5510adf7d9177a844cff68e824219f5fc5d44cd8aef025ec4a4e1e0e25829564e9df868c32eb5a8ebba7c0f18baa2e5e9227c6607b9653514f64dcacdd0d4ca19b83cc685326dbb350bf462b679a765e5ca4fcf3e9f76398ef40eeac74738b4a7144508df0ce41159153e678d0818c5702089c1c2b4faf2b2dff40bf3a7d526d8d38042a158303c1ba88b64e549d6ef0141308e347d663ccf12864c6965e072a20b96f5eef2eb46540852084f8b2a5073c1130fb83370d669ae49a53af5b008f921b1f7071d5416bec863ddcb339c83cf487b9b8484ad8539813d9b1b277def3c30511c46e0a59c4eeaf856857cca7a83b3c11a2cac35f9c3002d444b906aa6545b07d96fde96ac34cd40660605ade56a6fa5fd3dd0db8928ca628df8a2ee974412e18c6e7445dec72b4601619e158493287bc1c782d31883b2e1172db7f5852a4a54b9df78b4dbbeff6408f46848ec1ed3534984edb8aa10d0e6355df21c66906116a7f2b04d53bb310f3dd461af6e7c84fb439d9c3d8e425329704af9a859dbfb17cd8e12510a98866b86923d5e4b592ea1f1b17a1bfa356f64f91912994e82c113437a6210e38495687a35ae64963aff82db63bd0eddd5e0578de468c0ae289aad6887866b00c15334318fcfdb292a18f984c11db6bb9511a27a4454d01783d0a5c0fcf89d80e5fa3e9fc25ca3a180f30aa09d5f5fdcab7e82454173192a1c18a3ca09aee77ab571d4c3ab3ac5f1666570034117fd96a0fded06208860e981791a5a1994ee70763f2506513f2cc4d0fb1b9836fbbcf4996a714827349d79c8efb74c64ea6cf6145e9f795d3313a8cca2ef932396a3ea68de7c30341345b755c8847d16828441c1e06e773cd24b58428b7585b7f22d8e8834611b0efca3f5affefda184f95cb2de1e552cd1ee12ca4eabf8acc4807e219d5de9e7b8fbfe7e31dc52c0841febe17a92a355d3237c3e881caa169a602f301de37e12e6e34bb710d3c936024626092f3f263831dda284970f1572031391f6991798aa5aa52a22d099d0e3509de4064b68de3d50928c8adc59d79024e76106ca4165d450623813d177584b915c8635a6db9301910b87b81f2706c3c549b591c9848ac3a99911d887f00aefa2e81946f2da709adaed10215927dd779c3b407178c891a77e2d61f2249544e0b3cccb10b32ed97e4c53593b7a7df1d797e06654f1132575339a6e9fa869504ca53fc52b1da015e612b2e5d0f4e65e06b70403eb95e1434110725fea42b5a35ec16678ee1765c7a3b2bf59a3c510f4c0e33d936306827c146d397d84a96cfcbe5a546636eb96bfe3150df312974774970f1e9024699d0b06cbb4dcb873f861bce85b01ed307254f7491a2b61a31368ae76e3abde38a9b6d03a6b59d54c47e848707a5818c456446edf26f8461bf771541bd793d79399b5d8af5988ed2d5223c461afcdc6c247460793888ff1cb45cdb13b319d8a912862499af433b80ae9f8fde18ac2034ad7dc0f9bd00cbbabf95b0b9137c494f889861b7447e22343840c212d40cb8b9030802e74796421748220238eafc3b29a6d3e1ac7014e6d509933bfa18279e42dc43b2e8ff0b391141e9eea9dc4266befb4ab84c23b8ebccc28a687ca30e3a22e238afacc86787a0ebb27ae30a2d12d3337e545c177164a060ee16a37bea63da1a28b6cc502179522d9697970db1bab8aadc091a9410f787164d129670fb14342691f7f9c6631ed8aa0d8871761a61ddc539e75f9e7db59c708b75bf229f7b297dcb1c6b0d79e402d7c92f8d08921f41c5889f2f25af9acd5a7b7f28508c8b251ab35ef0572eb123929a5c11c5e638fb3c23236d4cb8b2239844a202ee8f66711f955c95094c90ec82c8ef592c2dedef6848660c63528e2b9e2fd86e5e910ee5c853928e418bccc1845b0706a1f793da4eef6168e0d85a8a4f456f808fd84d6e0365b5eb9850f21cd967045e378c16a98fd4e5f065888fb42067780378c89313d36781d88a174b00cb47cfc2279a958eadf9c9daf9f798d3f81b066cea53a9ac8859f1b17ecaa08c8c5316068e82baf8c3139279c54429f2f13cd0d7bb773b6afb192476bbc27812ed074ed6c70b0b005b23a9e2921c56e0f3065bd8bde9b49c6f364cc80ba45b506fa1e9ae8fbb410d3af1975d11fdd40196c7646c26413270937d8ea81b627a783334515b0e5855dc43a5f3718c6f90a61f4c94b599d1fa22d8f23a3b43dfda6f4c412be91cf6981732f12cef717e97d60b3596d1c9ffcd3f36fc19b44dba3dd0ea5be3ac03a84ddbdbc24766940fbc383e671311d6d3336929be86c844b74e43ec9e9a8a0bff80503a52a1af6e45d928b2a2fbc3f16f37ece31806d0739e59ab059b47de2ef2c8f9e000b04df80cea3cfa7c48b88bbc9dbcf2c83a45a523e02189ed722b011d43e97735d375bba8872de18a5bafba966a2f787c369ca5722260daba04399332eb234b1a9d687bd8540a0f91c2b5b80a6844311d60b6747624ca843b7347e92d636c7e5295928bab154d8b4cf374be04067b24a8bc1c2f6a41f0bc16a5214bb7e59aeafddc5ba59ca545036f35ed8e772abedbd116c59c07bb2cc3496da5f044bf6e7282bde65ab61fb46990879b6d5a6254416d8c39cbc669e446b9a17abc7fbf5c5301ca52ba380e50f21cba702d9703471c1dd0d53f71ecedd6112cdb000100ff0cab93616270129c6187e2265a6c3f7d9d76cff49ade96bc018b6f96e0402b5005d7e533b7e625cc7be716f73db5d0b2a57d63ed6bdda3d8e2fd4b04adf39423545c8cfae841cef5c46ca12c4c5b6df647cbc11a88286371ad2136beae9caea53b65aec179ff2f31d6f924e75c513a58e94531dbda71f978a143ac968454936a59c8f359bbc580f56130c17b607550051387a81956a617e42108eb5c1934336c3b2e0542812b34bfebad7dc16ceace986c121d403c7cd6472aa9ee00f6a41d3637508825e1272b795cef65756c9edf67d3ad0a1af1d42b0e1977c9f4b0ecfdb8e89328a7fb8a019c749d276ac2520d9a871d500efa8f1aa210a0079c3ce44b5eb585894b72bb365a7be01ed324dcdc102ed402db2ae055932294a485679acc5b8a3d55adce0f49deba8037d37cbca96b2f0a2e9ab42ed2a6170f2bcf318c9dc292f8bd1cc6468fca29a4c99efc76a883e85fc1c9d5a26211f3dd51eb2a62b4441dbf43857944451eb54921edde12a3cf26323d8d11285732b8afe41379f2da30a5f906c443bed9a30aaa65453245b7cd1abcc45b04a5feed5992af6c14478bbac8f46620fa59df7419897b56255efd466b8e1eb829d02a2849c9857a51c86f726b98f4414166a8e146cb7218ebfc347c118988d354bc22d2b856dfe54163cfa36dffcc3cec743355584b2ba3ff71d79c626958bfd17b59cbdfa50b6f1e2e6b0aeee2c5c94c9d551d28d3be8980b1521a1c3799056671287e6ef129b03f446231747cc70f49a989d0ae846df4a6f2fdc173520b6babb48aef61a622703bc091e0dbcbdf73a2f7ba6cf906c81487e6d7e308ef3d4320a710c1b3d76d2158deb5c34579a3034f6b51d324fa2fd24f6aa6d37afa16d971e676521191a9c5589136cfaada911dd68476e3a3324fb5650f38f9c3e773135050bd31736a3e1b42fbe9555a6b6d0afa70a22c70dc56cf95c3c5cbe903421ae9e24f167228e29a0923480cc4b558b7d83d984c9ca07d7443b67471de3bed0f6a593ed8e27ead1565d9195fa63516decd153243a8e0c311e84e9e8d04541148ed5fd327602553631f806c82ec605979d15cc8fa80bc3ccc3604de2cd87760adfed396138526c34c1abb33ad2ad4250a33c58acdf22e622229dbbf2caaf2ab8137c734335c386a1948183be80a0ce44cfa864ab5099fa052ea22d1805813c070b86211786fd0aff7ebc11254e4ca80aa537b79824661a49f40a860e1336f70582491ba3bdea2d624e375acdf7a06768a55bf9b4f19ca7563893eb0fdb8fdb859ee7a15b9ea1552bf3c1f16a9456280fa24818902b97487a7f2345ef4706d675a3b2023185f660c9b8458019f82c84d2392a9ca6d7f193d84ef23db221eb60488d5bc8ee2ee15d8b6cd51c22cadef91c14d5d6515d971a91c8b676a24692d97ee4d171e791b09d982606b6dd782c34a70ed5eb629fb289ed4da243bb3ddb863b614b4eb029f6386183460b1faff484a22f3f42f7f2eb27b7702c9dc0eb002a73c4442b985d6126fd6e511d71eb08f79de04f3c4a67de7ee0426797cf8979717d71af5a2af0782c11e13a2ab3e2d4b2112437804582c66a151f9f95ae02ca63c51a5fb99b8062f78645f3983eb00bd5ef16d3ce283b0e6555e8a09f3736b0233ca8cf67a5ed85b5f770193c25c10abfd9292fbd8c6bee08e5d898fb8d7e471a263536b42b58f7d273d0fa22a20477c3cc34f11c7c6eab18870bc2d9c55cdb42b888f748b83fcd5bc583d27e56b83bc1b9107cc4dfcd6b10bc709ea8be14e9aacac0e2477d646bca19410f946703cefa6374565515ed480db17b471d1fe33e6762809e4f8aedcaddea6c35745364c3cf0dd8c08ce02f2ed94dd11b7ae2434bbd039add4fcd58a87121f21b1fd1fcbc4e08ead03b15aa2df02084a153fc94834f0ceb3a1ba1987455e5d85c8e7160f8cdde1041e9fa72487615a9685b57979afb0cc4dc389a3c5ebaf7ef61a7691cc0bea02f596a6228a4402569d27837daeb6bd9308ca6f54578dbf98ee19a7952685e52fae009b7aa7e06d2bd08ab0cb702239f2bfa12f6e1c51f223f207883cb6144c7d380fd96d5f03d420d931e57bb6432a8d92d8f29a66f993355b2af07adc8c4c38a56456c5cd304f3edd9243928054a41241e545505cfafe9295082157597629395929b537f214e2c1570373407dee785c172f5d676014fb09955e23441400b96b177121165e7b9de59e6d79ca01e3d37a3a823105ef9d9250369880c8bc2a6aafbb25ef0b032d4b223b62550a6bcd89b1ee1117a48bfa78735ab54c8a02b1d4d440adb0983d781180275e39a2969c5247e44eac5f51fd12b24d5828549a4d6683cd3f01814e68ab763fdd2244a1d4b45e31402472af1f878b59050bd6b3d15a59780c35b041d16fd1966071fe314614d957c29a80f4bed95949c54b99bf5d0dfacb6406f176e854b312b75aefdc43e036dcfd88d4711d136bcdce5e240f3a92bd0e78cf0f34db2f69b96a115dd052a4dea4a0a07fe6e2dc27d91df50aa6328696fe41d8511bbd998af14eedd34af0e21555d8cab317e771e3e7b45b5660f455b9e67dbfab91233ab6e5778ae2f6c94332f773b23dd2e673b61d4459fda8f82b484e9f2a22a133f19cb5c12588436976d47d23bd68350bb6c78652e8d2d0cc223428f8a4c756ee6814025ec554dd7db4b2be099c49ed5e769a84e18033dc98fa5a60a583c87e11bc5762498a995c22bb9c12165298482b1cf54b28b822173f2629319a175054ae995e00d46407118c385c263af8829197583e62768e72812e02e5c1fc5f10b3f0f11e26520980de92202ed19dcea7e1b9c70d73d7717851999729db73c9817319e41985d96f705bbe308f13ab9fded951a3b2b5b06d1f800797d6aeb381bec9f3b1bbf6967eb6ba594573fca32574fb5c8f5a7c03771ca79f52daa5f24ad59f96e8d2c23a7e436554fae08149535935366fc4ef97b4f2f8c69dfd57b3f218bf914c24e513493601a53f9d8e48bc91843d38136e302e163c61c278edef8f2a64a31218d55df6fd62de09d42317dcdfa2a8c32d3579358e33e00175939b83de29c5281f8be5224c807e83ca902be21ac5b05439baf70d7f4b9d873f6ba2f91af04d05e0d57654559e2de553edb5a13460e00b8aeeb0240100401006018ac1902201ee095736d0d44599679009c388e55c0714d53d74094db2eae2fa021a9c6b15e4c055faa56c1755d17008220080000c3300c0000fc9139a9e6752086e0baae0b00411004008061180600e0ba2e5f7738e0a6b5eb58817b942352ce5ae4290000c3300c00c0755d170082607b410d38bde623104f80e39aa6ae8128cb320f8013c7b10a386e9a30d500fc2eb775e563569f1f130f0cc3300000d7755d0008822000006c92abdd18b41727d58661180080ebba2e0004411000008661483c021c319c445fe8fd6c9c596579f7c87d012008820000300cc300005c0f82c600bc09d05fb2068013c7b10a38ae69ea1a88b22cf30038a966b60200ab3b4d0a6a7673a42fa016044100001886610000aeebba001020271643a06ec08d05411000008661180080ebba2e000441e09c1d889cb47012200399a42a97facc85910300aeebba00100441000018066b8600880778e55c5b035196651e00278e6315705cd3d43510e5b68beb0b6848aa71ac1753c197aa55705dd7058020080200c0300c0300007f644eaa791d8821b8aeeb0240100401006018860100b8aecbd71d0eb869ed3a56e01ee58894b316790a00c0300c0300705dd7058020d85e50034eaff908c413e0b8a6a96b20cab2cc03e0c471ac028e9b264c3500bfcb6d5df998d5e7c7c403c3300c00c0755d170082200800009be46a3706edc549b561180600e0baae0b0041100400806118128f00470c27d1177a3f1b675659de3d725f0008822000000cc3300000d783a031006f02f497ac01e0c471ac028e6b9aba06a22ccb3c004eaa99ad00c0ea4e93829add1ce90ba805411000008661180080ebba2e0004c889c510a81b7063411004008061180600e0baae0b00411038670722272d9c04c84026a9caa53e7361e40080ebba2e00044110000086c19a2100e2015e39d7d64094659907c089e358051cd734750d44b9ede2fa021a926a1cebc554f0a56a155cd775012008820000300cc30000c01f99936a5e076208aeebba0010044100001886610000aeebf27587036e5abb8e15b8473922e5ac459e0200300cc300005cd775012008b617d480d36b3e02f10438ae69ea1a88b22cf30038711cab80e3a609530dc0ef725b573e66f5f931f1c0300c0300705dd7058020080200c026b9da8d417b71526d18860100b8aeeb024010040100601886c423c011c349f485decfc6995596778fdc17008220080000c3300c00c0f520680cc09b00fd256b0038711cab80e39aa6ae8128cb320f80936a662b00b0bad3a4a0663747fa026a411004008061180600e0baae0b000172623104ea06dc58100401006018860100b8aeeb02401004ced981c8490b2701329049aa72a9cf5c183900e0baae0b00411004008061b0660880788057ceb535106559e60170e2385601c7354d5d03516ebbb8be8086a41ac77a31157ca95a05d7755d0008822000000cc3300000f047e6a49ad7811882ebba2e4d792be30700a1afdfbecb050008822000000cc3300000d775f9bac30137ad5dc70adca31c9172d6224f01001886610000aeebba001004db0b6ac0e9351f8178021cd734750d44599679009c388e55c071d384a906e077b9ad2b1fb3fafc98786018860100b8aeeb02401004010060935cedc6a0bd38a9360cc300005cd775012008820000300c43e211e088e124fa42ef67e3cc2acbbb47ee0b00411004008061180600e07a103406e04d80fe9235009c388e55c0714d53d74094659907c04935b3150058dd695250b39b237d01b520080200c0300c0300705dd705800039b1180275036e2c08820000300cc300005cd77501200802e7ec40e4a485930019c82455b9d4672e8c1c00705dd7058020080200c030583304403cc02be7da1a88b22cf30038711cab80e39aa6ae8128b75d5c5f4043528d63bd980abe54ad82ebba2e0004411000008661180000f8237352cdeb400cc1755d17008220080000c3300c00c0755dbeee70c04d6bd7b102f72847a49cb5c85300008661180080ebba2e0004c1f6821a707acd47209e00c7354d5d035196651e00278e631570dc3461aa01f85d6eebcac7ac3e3f261e1886610000aeebba001004410000d82457bb31682f4eaa0dc3300000d7755d0008822000000cc390780438623889bed0fbd938b3caf2ee91fb0240100401006018860100b81e048d017813a0bf640d00278e6315705cd3d435106559e6017052cd6c050056779a14d4ece6485f402d08820000300cc300005cd7750120404e2c8640dd801b0b822000000cc3300000d7755d000882c0393b103969e12440063249552ef5990b2307005cd775012008820000300cd60c01100ff0cab9b606a22ccb3c004e1cc72ae0b8a6a96b20ca6d17d717d09054e3582fa6822f55abe0baae0b0041100400806118060000fec89c54f33a1043705dd705c07f7b7df77587036e5abb8e15b8473922e5ac459e0200300cc300005cd775012008b617d480d36b3e02f10438ae69ea1a88b22cf30038711cab80e3a609530dc0ef725b573e66f5f931f1c0300c0300705dd7058020080200c026b9da8d417b71526d188601c07fdb677ece423f67a19fb3d0cf59e8ffdfce423f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efe78f9e3e58f973f5efedfd2cbba82abb1c13d00046080a77bf0c92f3e69a0a6cfb7ffcfb15e005365c00d754a85135d90ef93ff6f730802b84c08d47cf0ebe7bfc5feda9e03d8c4032eacd3855bd99595b1adf1ff5b5d97c12fc091f48bd3ae3fd3f0d7bd3a7338e09c8e690a417e5c4774ffb9de701d8b3a90f4fff959c9de5ced6c8e6902b46ce02e4b83d5fdfbf8dffaf4b1c94b35df4f82f467b5ffba3703440d55fe0f9962bc22dbee9feafaab1697f9e57e69c4ff8f6be17fe9bdb827bbee1abaddcedfc5ffd6679367f04b238ebffeb476590675e60b00748e59e87e7e307bd4fc735dbfdaaa32fc2feb2d0114eaa12e0bfdf5efe37fedc3738163f0fd04fa58f893e7f00f9fcd195c6a38c943bd40511c6544fbcf75fdd1d6befe0ff45e5df89eff90e9efe37f1defbbc90d7f71fcc5b5d79fd4fe4b0fb1ce441d00ef974c7975304dd0fc635d7f6d4bc8ecbfac77a0e2108a3519164f7f17ff5b1f59086a15f4f12f99feac7641008eb9801a04937c348b15a5d17afdf9fe555dffbadee9f986ddfdd482faefe37feb436df22f8ddeccffbc7721bfd69228788e54e95bb633ee9fad93a623e4fb5fd63b986006c5fa14fbb3d79850f0eb5f1a81e67fda9b79c76cb91a24937cf48b8597fe7ad5ff54d72f4d0da1fa97f55eea144e61775fd5e0efe37fed2380a516b93703de1bd7d47faa932aff7a1dd311c173d048dff49e71ffb9ae3fda9ec4bfaeb7194d1581617d8afc43fcafe325a1e034319853f0677bc41ff96b8ec94b2d987fc9349838144fff54d75fdb3242fe2febbd7c3238a5df7b66f87f17ff5b1f9d5b1c03bc37e06c7f5a7bd3d4aa8c820ba88810bd51453ff58070fea9ae5f6d79eebcfe55bd5dc7ace66ac2d05ebbfe2efeb73e75e00bbf347a85e04fce147fd529750c5e5ab879d2ce1e933048fdb375723dccff81de0b523169f64ba63f392facdce4fcd208c4db9fd4fe47feeaafb504c45f32e10a7daa411cff635d7f686171e7bfac77f036e1bd984276d0fe3efed73e5c8dc63c7885bf7eaeeb4f751285b456819472eb94763dd653cc9f9f5f70e65fd75b5e9113c9b26f1fff43fcaf735bebafd767e09ffff3397457658ebf018270d19796e94af4ffa9aebf6a0171dbbfac7790dab059cce13efcc9b941aa5155fe559afa3f9ecd3e425cc7404eb9754ebb1def3546fda7ba7e692a009cf857f55e26f9c44e244bbf92f377f1bff5b9b489fba591b8827fccf5bfeae3b91d881ccf020431832fcd9395f8cf75fdd13683fe75bd85805220739fc3fd1fe27feb13d4881a022dacff6c8ff843a7efe7d75aaab55f32255d010fda3fd7f5871636c0ff65bd975986b10359c3e74fce0dfc957e0420ae40d4c13fe6fa5ff9f3dcee188037018aecee97fea2a5f8cf75fd6a5b67ccbfacb72f445444997bf2defe2efeb73eaa14804b0b7f1d08ff6c8ff8a33eec238026add3945fc9a229ce56fda7bafeda1606ffbadecbaec0f2f04ba63f393768572aeabf3492f53fabfdd7bdf71f6b89fb43a61ba2bf5228ff635d7f6821d419f22febed77151da5d299b4f5dfc5ffd62736c37ff9bd05254f643197e79fbf1735e17f5d6f6e617556c69839fc87f8dffa2c576c69317b390b376af207fcfaf7f603cbd5483e56d5ffe76fbfff36a76b39eebffde5fff39bfda97ffbcb6fbffdfe9b990ee52ffa8ffff8fdb7badd9b23fbb77c1ae0a21fdaa2e8cb7cda4b789aa6a6f90b5cadd3b89763016f7bbab739dc0e695d6e703fd5d3bfef6b3a6e7f1dfedfe6b1fe35453b56d3afdf45b9a76dbffdc2f1af93fd698fdf7fdbdaa7fced2f048d62c8efbf0d53f1eb02a7fec07fdfdb3f7a620886fc5f08fd7f218c87617f21a9bf106cf2dbefbfb5dbbf17edfadb5faab4dfcadf7fdbbe7fcc2694e76f7fa14804237eff4d1da7dffe82a2288192ccefbf997d3b7e7efb0bfafb6fc61fd3e0388610bfffe6b7c56f7f417eff4dfedbefe8dfff7d4e0be40f768b5fa321bffff6fefb2cb9fef3b7ac1196fa7539e59fedb7bffc62b0b7c3af2cde65fedb5f509ac55092c031fcf7dfccedd71d166568924008f43f7effcdf887a6244b60048b20ec7f3645fee3f7dff8fffd6804fdc76828f91fbfff16fdfbbf1fe3b195c56f7ff97f21bf23bf23ffeffff8656e53aebfd2117ee9f4dbff43b10c111ea6e2e84b381f8adf7eff4d1de669dded746ffee1c1f86b5098f2bfdef5d2b52ef7bfb23b4d7f2323ddf3e6b7bf8c47dffffedb7b4ffbf2bfbcf8e3ca2dd36d1affda569ea4b62fb7ff6cfdd769ffeb5228e7ff62afdcf67f6afdebd63ff530fe28e257697fcbfd7ffb14ff916c3bfef6977d3dcadfff448d3f3234a6e29f45aaa77f1ba65f52c85350ae5bfb4739e8bfa1c46fbfc49dff98fa3f97d5ffaf0cfe26edffd182fa8fdf7f2bd23dfded2fbfb50167b917a2c9f5f46b899b6fbf11fd1a004eff75f9be781003008455cd6cfbd71d10996f1751c1ba1139e5000044b70fc57ef07c0468f5af063500713103f02b08b85fffc97fdcfff53eecd7b5f1b7fb22001ae0ffae9db86940f887eb5f11c081447d0dbf48917bd143ff88f37f1b1180fe8fc17f25cf87ff792dbd27c514b4d0f9351af77245c92f81b2e1cee0eb4191e838479c5b9e55d64c51dfcfe970a0b9de8e33387e031cb4766a81735eb5e28489e04a6fc789fd8937da5afd984ed2fa9a2b01592487b7ca3bdb1ba8fc11d9d552c20e0b673baebec597cd95fe01d3c50c7b8552582cd53fcb87c6a9ca3bd73108f187649b65aee8744d2194a5697a0dd6eaea1c1500e0a822cf81fa877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff8877ff89fb9aefcc397a2604dcb71c6e1f6388ec382754da320c67d6eebb49665b9498a2d8268bc35db65b2736668e379dd0c640b4d440c9540ac117e14340e9fa551297065a3b6e793167c5644674308de60bb7dfd6d4af1d79f76ff2f76c48d17bd874d84ab9455c51f2ae5a961e599eab62db6c88614a5672b99d01c6da3e6795d97e138ab2c653a835fbe4bd5b2552eab4c75cbc741673714e18f7c9f1dabc10afd82ec11ab24a2a81e58762564741d0e93f08e7e98a4c5e9b56fb2eabeff5113444f40606995424690c944b6793d542b26c279b073f686bdf01c98d406cc3918d72661f1092bcee49ba33b414041b795c2369277416d880b677174f99aa8b523ba8e603fae2b637a6d8d324541f0b9ad0a4bc3301ccea5f3f29b201a452b6d5318aaa1f1619ec798154e721f27a810825f2a14c6f34ac218989c095a5d3bc88f6e682acdbc4159b3fe476d9d6b16e70a7b0f1845a5be2c9dfd71d370fec8cd9e3015ec7f24882577261412c69e11119e51123e614596a97d5a24c48531ea215b79fbc3276e526f59412ae64d92c077951e4bd5c2374df676ae65b60edda927758df66aa64da8d36806eff2f611f8fbd8da357fff674e7fe4f7eb2bc8c2da885e4db44fc52c59ef80d63aa6c26ffffb64c7789f2bbc13c64ca4f7f72b9e4c46eb9cd475e7ecefca25f2d3fd560988ef26a2f24df8c520dde710c6c050ca9838ed9a3d9ccb8d0514efad73ddaecf3f3c6bb2a4d6da09f89557764e6a846bfc64c0d1d768e096f45815956ea8f479209c0691efc68a2fa2b084f13b84a9af5f5f97f02bfd5f55c4af4be2a44ec86e84434f0ec33f03b8ec29682d89829f9db246046bae55ce232f28fb6b17d64b0cad03f7a4f9f9cf316ac073e61bd4173743ea355ccd5a3d5ead8ae85200fc40ce6e46abefc92d79cc23d8cae89b998c43e6857fef718ab8a01622a6695eb510c2e3e838ea2a40dca03dec0de9c77233c90d83e57a0c0e3da7f5c3a6f17ff92072b5e07cd44dcdaa10681b5162cc87e152c96b08c6e2d9951e8761b5da172bad3dbe8a5b296f969e423ad03298ee431437fc2f8978b3553001243ec58a9d9cd47698a5429feb7f69558bbc0e6a6d233c510a0d3b2bdf25b7bf27672a225a9ddb85a9a9cffb4121c830aae4c33d45eca03b7aac143b62f987b5bc9d683c3b5360a9dc1b344f5ad127f6c3e960966413894751d1b0573dc58f8de9fe610d6b2f10585ed1449cea5d4af85184d21d7893ffdcb607b3226de71eec6bf1a19b25d7261e8a9234ed4a6b210559045d044a7f8f359505d94fa52c09abfe813bf686651265bc8e3adb3ceb9e435f1f5c896e0366211fa370411bbf55650b377405fd08ebe4d7504b945e35881178f7eff75d6ed21b3e286b25fca88f9b2af3694e3a9f5b9c62ae6b5b254591e77eb0dfe397a23113a862204d0d0b2f8e3a63b406e49084030aec9120b87c879ccc6bdd7af8dac97b66312631b708ce885541a1d713ddbfd051a58389609a7db829be4fdbc28cfc795aab9e0bcc8124962533fd19f053a144c8bcd3ee8167bb23a7b32387b323d7b323dbaaa37bc81ec9b183834aa1922f9a972be3fefdfa520510825ee6240c98c73b6c91b70de45db7b0abdddc5824a29522bd96694191f1ab7b3608675053857daf0026f473c42d930ce58092e8326f37619deb7a3b2083d14f2c2270ec25521a36ce4b02d9028db3ab68d84fc4bcf0cff89f9effcdff4ebcb95af0e1cc9d38340d22b687e4e812b69728d5739da2011bc05d698f3b65c94f62746f211406b07c17a863d63d7a3a58c01b66919a92da31af30d8e353b25f4f7a46bd4492b7f9c7dacd5d9960b37a6b2dbf76540e0c97ee5a261052a473cc5add9cab556e3bc740bb6db9dc7c995abe1f18ae6830fa42108650f789e2d747a0d0982e8e87c82005c1bd95c351adc409965c288aa196f959fab3788f28d3e43bdb51d023444b5daa1300ceab5369e15e34bb3c0f6c9a688a61de247ee4ad507b5a2d98f7fbbbf1f2d329fb65f851844701addc46e5ee7d7b6f511e1f7b7ab22489a0a765eb2a8f31b33e4651ccd87b36561c3cda361eb1a8b97c43b94abfa8091ded4e88dc1782e1d76cde378e9325852df65944474ecce0bbc5dc87808e56379bfbbe4140e367783c41158db67de4e2d3daabf99017848d8ac0bfdc1da68f2ac42be95ec7a7a47c22ca3a9c24c5e3b015a16e88aad32749571c28aa3cbadab1c2deabd0f7690a6562123f65e1d8d8480fa8a0f0f75944bc381d8834e5de12a801cf358e96751d4d11db40e36b985af63c0957a6d5c2ebfa7e3f827ba3017a44b544938ee212df4b98725064fe51162186d3588231a4de453aa4c75d473d580501012dc506508f20875c5129229a97df1bf5dd317c3adb3298056066cc752b4d32b724a121897f896c1f3d8106df6b1fb075efe01a1491389610434f948d471a43fb24be8c69558d135a2875308e43895595dba149861e12391f1be175d0e325ee3e11e1feca185715797099b59aa9d79ae163dbbec858a9bf3e08a3baef0d991eb5686b494f70acad72a53acbba4e091952ef221d52631ad794147b04d0eee489e649d72d43eb3232c5e0a615a4456c607eedcced2ee07b6c66265ce141df95b2f02a790d58bbc3496d46e330ac8d73bd16276be1eb18747b095b3a75050c0e5e0f6e5cd6a6bc59f29d440a2e045e322ca5cb662d343b989fbdcd7b499be423feb170634dfef5cd407df9454383ff3ef97ee002682fb90b654eeab02da3698cc708313b4ab4c37136c0ce4a8e663e78af2c1acafaa56df9789b07899da511d4c569f3cbadc6cf76fbd84da38b3c0bacc1a51e141c915904b51e5e6359e3bf768cee85fd9abb4b826b159344c7c2dbe76a37eedc5900ed434794cd7a7417a4369bf9d539395e8462396399bb9bd6829fc5260eb17b78a03489519a121a91441761373ec3aad73785ace3f15dc386a471731307de8c023b008d583b32dfd480d3552cccb59a0adda8df56e752376e422710c4ec1685ece643829f551b31f77aaead82c80ec5816b07240403cc6a02f52563e890799dc34e9846d1524e3f2c5cad6a9171aae2c94fe20f10cbd2d8dc3d7c3044682755a9f48cdceb0f09a009f9631f797d05109e98373b8b9ea5c0e83460f03a48cd381643de983e8496bb43f4ce339d35e6918662e279eeefc6923e482922372fea3acf924dcf89c6a90f8697460996ebb63c42db8cf458c285e339506f12076a33809a48a8df638cedb6e5062cfb35f677ec0b7e957894f89cb0cec0234e0fa7428a0ecce88b15af63f204e1c0b144c9400895bc611d8f33894cc98cfda437ec67e890f507530cab3d8d7465d956b5e2c084e1322c526e67a189b65ecffdd501358411c3b81f287fbe4793e9a4d3e298d7060ab37db931d4e5c1df3bb82039fa9bbfed7b38637b78d810816c48165fd9f3fe6e1cba967014e25413304e6cfad3faddef8893febba653a025dd9b621672c07cc1709f8c371b148ff488b4cf72dee7fb72d6a80f70aac7c6f5fb2da2d07da82f6c3c15038f4c4913874cef24499e5ff86c12797c751800e5dbf73bda7bd55431f72a9cc531759a2c3d477b197202f5c5605b3d037487d9a018699f20e762fe229f31e81bd35e85aca2713a98d7b3aab28cb70ae690197d45df740497057cee33f2e07b18e2f4dc53d32ee0bdba57512390105a324eb72c885d852d4ae6a2bd76194cb46c3810047cee53bc9369a9d145426f59b1ae66117c23c0cf07097bf6c1cc7384e24615f5176632f94e8907f92808f5c5df54d2d60fd74464f42283c3d91bdab40b023d8f811dae2ffc4abe473acf68926dd8232b85f9adbe706f2ccf40db2fc20009d62f8a5c7a910ea5bcbd47e9f61c41b146e1c6d0e83d1a3375a7f21e32c787160d2954a4034fda269ff153861c54a3b1bd61fb70f388acebda163b310abd10b811383a9643d057a1676bb915779e91de48059144c7494d87a26fd4018e2a4af5a41afce217d241105e9516dc80bddedc6538ded80b7b7986eb4223153c02b4a86812cda5b3d2ecfc33ce030b914b72c065de9debe0184a32c2030d4b0cbeea14a18551d05b15847e519abc9f6547c731084cdbc6e7572abcb8de9c6f3990f2c55fab0127743b4fb05430ef11db4286f0b612bb3b0ac5e0933e03fcac589a5c2ae4624a887fbfa95908e9d89fc80f5be2e4c77c7c347d3e64e8e2e9b9221464a067d09b152c6b288d19d582a73013e3013be4f071b9b94c306472668f8abe99bbe87bcedb84036f3ea20e5030f18511dc9f873ecba0c461542b1780c2e4bb0bd93cb41218735fc78dd0ef3aa22fd58f82738399b85f98013d173c6508e0f79189223b7db29af0a8dfd3fd62b8dcc36c960868bc5dea7a6a97e4f7666ef863053d07eb972c0cc3ed5ab4a37260b89c41ebab2a9879f076828653f40c021b66073820cdb38a06147e4653c3a485bff66f14b0f3869fe59eaa7c5034e3423e44190761b83ef8598d39ca56a31db0760597f476aee88913f0c69c7033f8e639af343287ab5db2a4477b5dd7095ae45d03911123cdacf7be916b3fcdd1d9751ce3a337f2b56c525559334fef83acf6e8ea74ade0888a4307b892f1b92d4c04ca555c8756048208c38b528612a3a077e556e0dcb28873c7fbdc7e08bdf81b8a55cade5994e260faca688dd6d81c3d7b3777b82f6641556fe6ce6b67bee8218f8cc302ea22eec36792d7d9c5bb7a7d5101b7c3308e77ebbb3b74a9335c8a8934225f22cf1989e5a800bd6a6e8d16734be1778b9dd1b7272b47352cff54a22f511ec780a37909a11be7a9a36f211071c03dbbade406ef393c3dda73484455956cf93ed7c169a6b9a588c95cd9a62bfd5cab905e1dc18c3eaf90b182ae509dae047d89c25b69cbfaf7e1152ac7b2e57b76fd6e176cce3602519e479861d4a8444b9a14e7c00cf2a62aeac567b076c04b9b96ace6bedb1c934b680cf629dbbf8de0872ab461723c7d3a74de5611ce61ae96dff31b06e9dde25a186d3efdc530ff72ee56e849a8cca23183a9a1519544e551ac740352b1ee1666ce3ee5eebaaa9eee15dc493ea35f9b8180c14d5f397b3698b9c7a5be04123dfbaa5f310225fb1c2620258f93fbc8b0613d76a89dc9b57f833e97026ecb3d0f4797bd642a9816294955665c108f8ec0585a5f95fbfd8df0d26c7a2b3738bbac4a8c4099e43df69659291f2bc465baf3c52c229e9788bc90e689251886abc517afbc6a1bfe232c36ae15d879befbedabdcf228e8f98a8fb46eeb5b2a58d011accca107635747fd9b633025328080522f28bbedb3ecab1d2a48867ba368cdb8ba60d1b878aca751c049d23005fb0cefac82ad2c6198e1126a8bd109163d50fa6e7bb50af56b2b22f24c5e9dd55c54d062377a0ee784326fa86faa8be96eb4a577c764786c1d0d4894ffdbd968358114a68927bda3a8fdfacc6147d437de275328e3741bfda5558252642473bfe588c8499fb5bb18ca0e38a4c8ab47b806575699d5faad1793313034dcae56774e8a801bc91e8e34ab1b1e23392cb4fb1c05cab8631f82c199c75caf4b183e8305c5f6d2c7176e15435aec6fe9fa0e8a3724f6e2dbee81cd075ca94bc9ecd82b104c873ff89efe6aa354b639d4345a05ad92fe18412064fccab7374bbd857cdd14b75b870c2785f14117dc6822765428f20d470bfcf2f94c276017d9ab5250c86b6f6fd97952341a616c5c8b1215075e7a57c9459b7d4d9d8e852b599aa67e94ce4bc0e6447dcc853e96d5d78055422c7da6bcbbe163605fda9bfbe98d64236b5f2737d25e951e037b96478ed336a0610254c13896ccc44c64c3a662457a27c0a5264d39f4b46439ddcb35dafded303e4023e23581b8a8ec33340f51c63b3a1cf26de60c9fb5a0c9e7ce1274c8f3a55cbd9185e3b5797018df12b270da71dc8e2f6a1522a2c0d7b66d622844d678695b53a2e2798bb5230b1b07dc58789f6d8b403296f226909596354d9df39055d602ae4f42cdaab16b5ee097c327f44b743abdb6a4f1cdd261d883a2b6d02da5898430b075f2065882e9a92924f31046ed64cda85c201bdf12376a9d2918b1d438c929890dfd4e4e888d183bdab82640cb32674abfc0c10e5ca8284a962a8fef0b5562b28826c5d4822ffae22fd3d70476d236ed15f91af3fdd8bbff34d0c34230b4d6eff52820f498e0f16cbe7832c3e5ae0d6969ae5ed9d282665763506b1b25871e433d856ef01aa231c6590dfa4976c5f31c8d8fed509a14fd56eceb0bdfdb3588c9c8c0bb6b6ea00222ed32bea61b2ed39b4498198bcea3d32b646e0f947d20d92abfb6527c74aae59aa390ca17c2d6ee51acf4c605f5f7d61d49f10a386457243c5bc82ebf5b12cc31a0e8f05422a25cee2c2c4b0fcaa34c03850a00e74e6fdfe50285b696f78a5cd65c9177517701eaa18eeaaa22574f31000b0cbf328d7c634bf51e5fd1773499a544d04f0f738747f06e807cc3a5bbd1a6cd7a5bac32e52c09d4413c68ef25f0d8234ff60c85eeb0a453481e048f96ecd08317d4e53ff08db3be45d2306c7e64d773356a4e2529420b767c6bf7391c014d7d3d23c9e4c95fa5a4c3f2ed71cc8f0d644ef34ea7d7721da467a6a4fc0a206696b3c62fc915abce71b81cc962dd7ef7d520858c2961c8021d4e5546fd24c502601d6dcbac1f18b68509ba9bc12b824158cfd3fcc8c4b7d3d3338b3bcbac9fb18fca31d6b63c6be557d26b44b109ae5bdf11e590b49aab1d04db2ab6c04245e1e0eb124b1c830bd95b18af7dd5b5cad232dc8f1d73412df4b8c805e3891262467b094b119f99dd6b6e8dbdd3cd2e4715b99a068e14f2dfea4839b7bf1e754c3618a814539a0715e2df2d966d8da4b9cf57244d996479c23f659b3f482d70bca512991780b423eec6a4c8c42c894dcc318c5c9e8b688bec374320eef60a694e455faff7bebfbf50cd03eb7ba3d3cbd36dce3e00d51d0f2a3b2f79257ad6880b9f5b0fb36c7c236649b9e6022e02d99b21acceefe94e586425ee282c5e8937bef6857a7b15bc3fa3deb7a1759ed1a6b1b3220b52feaec6881f2b9cfd84afecab59256245e3737fcf006b6d046721838137bcea368b2a25f8c9b1b8e471addc46ad5cb913ba3bca81d007cbb815875c5c873afc6e636b8fe0d469ddc32c2c06b11587143160463ae3c64254cd447eac8c956348c528b512624210e82ee040ed847cdd5ef24c11162405c21ee951a3f68cd4beb803ab7152360f507bcc422903fe521fb21543b357c7b11b0c2c1fb38d6c5cd53a506461ec29efdc779b14389c57264c3f67f69115515a3e646f5818661faa4bbe49ac86dd4f288f5da46773fa6af6b2ea84e04940ca8fe0dbca095e824ea6928c9862a30c2a0e9d72836d47bda12c6e202d3f23b0abf886b29ff9efd8880ac93fe38fb9b55dd7933d8508114c7f1d013dc37638e5785560955f0df7fd3c2bc5d03de5a9fe89f9ea42aae15ed16abf0f1a6c5b5526d3bece13071641086d394faf5e2fecc42be684599dc1f9078883805a73f171e4498d53ecabe65c8a8934225f22cf1989e5a842a01a82c6f053baeb2704a9ec4892533cb3c479bea78b09be32ef0df61bd05108218e86581c9db374747c0a73078cea1f5bf8dd4c3cf4210bc2bf4f3f640acc83c0816e64a0b3f8263dba6f71e4a368864127d6eb2c374d49cb63993536f7160a01dab7b48403ce23662d104c8d912f30114c66fa71b6fb4d9e19063a89b5669941f23bc7dab2170743bbf5c4be79b478b7b5d778bfbfb09e54e93317294b5526999752cdbe844252aa21b2d00e877410b3383b8ea67827883ad330bae6cad0aa93a3e11b45e23d3e1ae8172707032ad06f45a159365af0573f9b64806af7d8868b5b63ef8236ee8c6a236b63146b602a5318b07cef949a5315a822cf5fbe2803b38274cb3db0c386974e1b7779d5a297bf5aed6997798ec5d9f542e56adf825aa1aafe8e183a83c9810d0c569a33241e65f949f33751070c3c6fd6491341b889093d66c3c78ee3899ab712bf51c6206b3a36b648b3fa47adfc18d2629afd1aac5085d7721d6bf13c5854422c8daffd04100c51c927abf23c4e36dc4ebb82bff2980fe2ea03c381f18261ed318ea65a4bee7c5a2a4c2bdd86f1e753d45680dcd271d152d9cd7594417305e3616dcc8af279216799f779c7347794314c707cf5ad63d53267510c56b2934519dc7163b05c25c942cc5bab9560c5a9b3e6d6d83bddec725491ab69e048e1e9da2931cddfa6ae93cb7a5ffd4b1683e7737eed5b59aff5b51970ffd1bb4949241c0b0e536347727447f0584f6aba2724645bf9a6d1f3ec4fa8a2c648a87aa6e93b3a1ef4d398e1f85d9700293b1d4a1d2b1a693255d3ab2ba6e3dbe35691c52d08391d14d961c3b8474d4b051ffd02d1566567c7d9519c21042ff98d40ed8aa33ba9945edada003b4fbc42d9fdb0d07da1b07114543ae2bf0a5363244e47df73be15ad24aea31b07a250f5946e1e1c7a3d44c6c3093c6a87e32bd412f9c29bcfd85e4d93b08d6f1289e71e1eca7c2325ebf0d9e73bb812d296ac8f09672e8540dba221b06a29a423d05ef742cde924aa22cf5fbec803d3777a7ba10eb2c155e1c063a3e8ae06a582f6201f2413345feb7d7235950079e2489946cf87227734201b2e717a3c52d8ba44a9382a267e8600bf94d4b945325a9faa243d257bb6ed8cf941aefd0242b58e7227de78923b9a313a5fabb09e2ae6dcb1b35a038d3d3c15827859a96aec8492e77a7c4a3a3090f8505e86b06c4436b3af9b9494b626317a641edfef4d012b85fd45cdb9dd95ea3bd38ff61c77a4c375fa150aa6d864ccb19070f922b60ac1abc1fa34032286ca593224f17dc9d3d2345a48a06511a329d515e0e829d3a03882fbae42d3c2b99917645ebc68959983c765e343c23fcc77da14395e878f494ae393632753dcd6017dcbb0d3fb73615bc8c8fb0e5d4c1686e10d13d09002a6f72121182fe07098473cc2be65506eac379a63073100c103e52ca19c1eae6fbff6b3f86d6c7edba895c16b3f12ea2269a0d0a0ce7bff584c4aae3ed9d8ebfb8d1410119a1bbb592946d311532f97b4c61eeca700a8f2fdc7676841ed3e48729b723ac6a3de3aefb5b4dda309d3e1b5f1b3501fc3445b344d8985aebd5413c1b0710c92bd2adeddb7899236cda1bd58c9926623bac20147ad7386b374b137311e6748de84e36a72e2843f9e5d52773c16e877e75a1a3e4b9421ae157c8fe9233d9a327fe90af6676a2d2a581dd12a1b6dad4ad9b5539e6265b1850d4484a5fcd57f9a21dacaaaa497cbac6078de64b336d3397da8aac4798eff72ec107e1032dd9c41da18296a965b2e83e16bdb41461f7465e1f103c165f42d3f230ebf8774cbf5a8e7760bc691743f3ae5c1e956ef87627d26b7883e184b864adc349f7b5892bedf159efc90de11aacc89beb9a3cd1c37de32922469b658f13560ce93261a1ea5c8872e88cca6dcf44cb3a7b0663693cfd77d724dcf9b8d5ceb11fdc0d4368c1d8590d599ba631ffbb23a1c7e57d2c9b09810dac2109e3495723ae62d31def60a4ccaf79289b2dd0556da03ef3ee81723ca11caf91a22a08d796c9d2fbc08eef84ce9022c8395a7dba96f48b050e925edc517318ac15bbf5341407edf699f993ce5c921602951ec4563d3ade8a3108b5fe2ce16c049727e3378bc5355e0c88c81b510da59f8394af84c4303da5e2b5dd4c395b7b4676bcc49d0918f53b4c113b8de9b0754dd874b107adf5526ced66b5e58dfbb1b77f63bf92085bee9d2a4eaafcf96f9cb1743db5f3016d5f22e25de9885352effe1a4228a2465875503d6a462db10adeaf28ce99a5b36ec3266f1dbaaf4b3db716a8dc47e559147e50efd13b20c85b57ce3a39f4948b28909fc257ffc95fdec01acab8d719b7862eccd47da2d4b535251c5642b3b11ea9c513a57510d233ca642bd087edacc82ce79db9c297f4303c7c16e1cb7490ac1877de68f45176c0a2f3a2a37cf96bccd6ec54605c5762f3a845783b7e53e4a4cf94af7bd53d07330f9f9e93bdf90149d56f2bbc995cf539777822e056f155e155eedb58d7251dcb64ee8edc662ba97357cac96130ce356427e07453e1211b90d971be278f470941d7186807cab1ef06dc3c2aab518765782d50a29e3bbb077cd45b44b649a4fc27065c1653fc899b28feab7de7839caf656e72e62ea6d5d9416ae8713e75b9da3b0b06960d26ac831d9936df9dd3c0424a5d3b7c529c87f0cac2699dc5d920d2aa1452214a51c4ed82ad2dd2ee887c2ba0a30155935776de42ab2d5b003c38844d4557edd2fdfd3072cfb8ce14cdb592642a1176928ed42748b4142175170119fd057b24fe5f3625808bdf50a810e1a7a8808c2b252d7827b900a3c825664d8f6ced9d93ea4df65e98aeb43453d66d2fa52b04f9866ddba665975e2d1171556278360f6381edd720adb366455090af418574eaa758ce82115e1c165d62f5e07bf3e6ffb42d2698cc9ab80a30d4bce3d08ac8cb5ddf3c24a6369eec53f2e5a4ad87209148f6feaef971de9aba62d764b26d1283ece33932292803875792ff655f10daa561bad0342752e78e1e346a9049bfa675f90f6fda6f9059db1cdf9f637022393b1952e1469156996868be3023fcb986b0ce817c9b066e013d11abb5d7aafc8489fb619b1bdaed7f2abab64723fae5a60e00cb5509842da99cebaa8e430954e3698df9a6fb7f0352e64e04ba96d4d334092606fb38390bf1441b588027d5149250277285f7353a8d99ba9e04df2c4e4c234ea8c8f10fba4e848c065e40e4eefa92c58d99c3e1ed48ade094fb32d7a0fd631f06c3e37880298673456720b8970da289c678cbc98efe793e31b5e9cda66a1f98b3154eee9f02ee598c46b5d27f02c12ad687d75ef9327d80829d87358ae731d2bda5e05fbfe4e3d2e87f7755f5f78d8aea8b295fc7cb05735dede172219a6ecbe8765697a0aafef518898fcb9b815a3d36f86d1468e0c367d77d8e1aa5b07adfcdd6b509815e93d1c73918d6d825383f23ae473dd17051c230351d37da35116df1d1ee815027724bd4efb5e51e5486d3a9f09f2baf44bedd48eaa738ea372c1796b959047af9dbe7b0824c58b1134c0a70a92846b8b8eb34c65ada82a5133761f453085081e57fd0363d73467d5042d6a70f1832e6479992674ec4679592e995d1b8a3ba3cec6331a3abd6277126b798061d2aa74736f170b8d63f61ccb230b30f7e151cc23a26618c4d1ac7b8e5cb1929f9802c14b04936bbda4f1183e54f35303fed145c6a0de5f68f3f606d920264b9a81a6a19e496a824c9b77ca1ecdeb9340d185c1fa6c9cc5dd9f784956945ced9439500f87f9728c608a24a79d49b00a940cfb3186e189cba0cfcb68970ea4a28222aa3d75c0b80ff6a6cc7ba4339b6071bfa430bba2efedce1dab56e2bae7b7fcf9ae0cfa241965e3893d1e7396462452bcd7238dd0f2224328435ac89cc6120dbfbd6aeccc5a4e9645d195ca1c6d3540dcf6810cb48b1d1256209f2c7592863b183f9d073434e1200e7661738ba7177ea6584410e55ed928571f29bf250408ece879a6e33d95bb71e0670245345dea0c9762228dc897c8734662392a8fc26abfd11fa712d21a2b7bb6bfb071816d7324e9457dc887866571c9ed111a8cb2ef9c843a12352de83c7bbfa12d7d3d039afbb931c701eb84c17e89e9e80d1c7b3af889f6fb79b0ecf014653c0f5fb0c2b873b38b547303f13cc3c934e48464e164cc72d4bd31f90a83312174ac38face4fde4be68db35e98256b4b105e328c1aeeba699bec126260b9a5c47be317219fd5ea1bb93bcac664c5c8c7eabe19e17c490ff36f65e88677f538c9c1c996590e60cfb793b5cf0a761e3137d41c130a3b7b59c8b0ed729163cece6fb16c2da438c152cd8e534c50d2647e4ffb22afee3e2f36cc330cb6834ab8338c14940eca998a9e5bd7091b266171c510edd898df9975400cb455b266e4496a954c9886d1e3ccfbf6dc5816c1e176b9b190215228442b02b022805f846680f4a0d2857bbf406d703c70a4f04ccc33183b9d335797c2e4bad975c6edbf322f2895cb10342a65b11fada3ab34efd760ee8826e8ee3958837531286defed479b35017e862a54dafcc2299880d12f525a7111a4cc160b31c650ac090ae25cf40d6246ca1f1f098692e8d4cd0c6a25668fc995d6d46833770c925f9b25721dae3b177db6b6805c5209216deb3f8a16e548d14f143cb2cc17976d0f9eaef6323e1e4b8f959e1b42ce12494af6df6350abcfa5fa113ac09fa7ddffbfecfd778fe3c8b22f8a7e958bc6fb8f7356d29b01ce1fa4289194e824517407070bf4de889e7cd8dffd4165baabbaab7b7af65efbddb3eead01341599f963324d44646430337a0ae828f6937403fa3e1fed530c2155c79a3e8c2a796e03a7f2654927c0be48175c837bb5dcee5b87f285e563b574461c58e5b2fbc42e1e35c467925202825bb604373628bc07168a254a6b88709b819314df8978b4e3e03223c616fb5457ebe7d81f5039967b25a371b20cedfee8165055b16a4b53d8a88629a6869b46f8734003bb4d4c6be2bbfc366513eb8d1e566ac35dcf006b0da9c6703dc7268660143bd68cf4a29a314d4dbb6e6f534314041477677086ac1ab750496cab6b5e3d4b96ba262b83079a7bc90f07cc59817ce0648b570f47522e41e0f9fc80655e49a785311be19d17a2f39ec778b58193ab920a5641dc302c27e88a34d0702c16bb3c0443bd6e8d25929b7f1b47910347da3d2ac68015e319962f223eca4cabc1ec69d6f782e210b47bad559514330676dded2cf7728b06178d1fa648f6f9ad503ac775047bba13f0146375be97ad3dd33084212be91022acbef31471bb4bf6618b004fcccd714235274cb490b2792ddfac63cad3b24af3832c51672f36190477b62a22c6a087a83c4d87237f0a191bc2a64c90fd3239d33d8d19a6783d0651290bf9cd3cf924d0146f4299edd604f3a9176a0b081dbeeb44273049594100acb1a1efa8e66dd8b3fb1dc739a79dcc9335b66e0373456118f7efc713a349fc11cb950d029e89e597815f74291bf3800af23a9d351ec8c0972091e35112bbc0a7fa5cd30d95a6ce001f81420d414ea5a7c940287bc6aed529bd40c13214d1e19866292f23343a29d234c0429747025da5b4812d901279c715122e7ab974bec60d2dea8ec91012b61d06d20cb7534224b7a976a764a1e48a1eeca4813981bc302752193824236ab1c2eea67f72d4bce42fcb4a0a641ea41167a5a48f8edd450b21783bef3a463664dc2109c36d5078d299e0aa096add01c25fe86476521ac7e809002240aa5ce7c17e3b6327ccb473e1d0d5c22118877a437b4a4d63155d4b25ba937b1811ed98e9c2293fe93136d9801a66cdcc91a39de915c1a5756675540d226622a8fcaa1bbe0d1d8a5d77ccc4ee4e899befa99acf9dfa5dcd7a75a2dc0f07abdc5021d77886bdaf07ea7605fb2bc73adabce70e396350943bab451892989c8dbe7aaaf78c305e19bb54bc40adf97b8444c7ba5ef3a2a52b4bbd17724f30663efa5c06eb17e662b6c70d1e3b9a3ae0e3e0521d53ef4894413d152328d376b245ed0079a331723da020b6cbad7107b5716567cdc474f3dc49c6fceb1af1eb45b84de6169fa368b6fc4d0d18e03094a00f97090a634feef404ad33656408b3ef147b8f1363de8788c71eb152ae1b531d2bb752c4f4ae11fb4a1dd1f2423842cc55f0d1c5a6744151cdca80ef99411a8b01166494082001ad732560c2c09c81c047f65d450d85a645d853600a6d7d759e07dd47c990593b34543a8644830013cef57d70e57e22fc8695f9ae0625248674485eac0c4429240735a78b3c862d67872de7437b6b4b6f0512b58ef3c57198f036dcd861a72f8065591600c61188eb95653996dd97fbb369f4d839c2ddbd159e44deb6ed381e476aa3421d43f85bee1687c3ded8a7a7ec98ccf2ee249c95539766f19dc48944e25a109e910c2d914b7043ba797fde9399e3da27680f314590e60d03a098bc5023a69533d46e068310643ca90c88ad30da861bf05aadf63a70f7da2d5926fc82ee144ef3a71182882b88c361b38151c72b2958f3b6910363452112b6e47ab506d91c51536cfb2936ae48dfd993872314e36162536f933a056d6793cec274be8ff57dd1b52bb6951112023cd506705a2bb25021a4ed0c8384e2ae616857bca0b7abd30160c616520a65d06364ef93222067d500f76ba5a2958763135545b6e77711c3749b0f78f1d2ca0863d09b3f0dbe39c8351014a4750774ed7cdfaf9b66f0051d40c460f10098d34661b9480cb22b3713a283f6d2592a8650e2329f69d44aa24d365384bcd7c89a471a7ab9d5f5903663de9515547511345b617d08ba78aa5464da7c0c504bdfa030e5d390a676a535a2e1a24f3160248abf0b75126327c80fa9da3d806b1e82c2f57bb46ee1c1df71db9a84f268319689d47bed5e7390ad6be5b1b3b67a6d2f65705be3f070ec48c8b24b94d6079499641b2361780017a2844bb154eb76b4a7883d74d6024e04864903ea97d9ecded3edd46a13c9b42d0140a677a6cd97537d8bab6b0400852f8cb703b1db31d366df37d031b3a613d65139085aae5cbafb7a6e383631842bccb21ecc8adaec43d942ad85ea57a637de79e684f9153254eed2cb0645d3aa477349b8dcb681442f6518f7d29139bbf0010141956f00a83e3d326b59dd3885e3580bd394a80bea18742e79dc0a2a6a859112124c30737fbbe2c24dea6388c9adb2407dab1cd7eee4a2723f41b5a4dddd60f4a860116db3c63a0b983b723b4822666a0cbe8cc58114395ae6b775160e9cc557a7320a93298ab7dcda2aa7361b74b089f1d4b026198eb473533bda522298e86247bf9f1ce01f6492c0b6bd4fb8138b7034897472a37893b40973992c676e406d5154ed8e7673c42dfab01f4e83993385e2de3782d885a23384b0e56c1b81de980a6458bcf6b1ad35410e07c1cd2319f2b88af114514dc0f01797dff4886eed5bb0c2b25dd1043d61a82f4696be9183355cce0c884e0a87e532d29ed3648a77873331f55a4e9f1cce1cba84b7129e804b4c95abb8212a92beccdc7d0a3c2ad8efd8394da49da523fa3414a91dfbbd8bc41321dcd8e3099a41b4d7bbb38d4d42b025de61141d490ab319a2642e6b0e08723e065cb6c7e4920a2cb15e66f23af9b1d1bb85e71e722cdd7b402343f726566e55358d0e7a29400ad21dc35cec5cfac05083ee69220379f000af7588c04a2f74e08850016f17774d97f3a8215b4fbbfb4056154b6925d8b6cb64322201638e697ea943645ae002ad794df3d0616f65bdcb8590160f19140c9159df13548ea3f032fbbb8a2ba7dc0b2288d2d8834829f0c0c46b10ea2eb9f966bd2ebb081daa2d9a4e13c46ba3cd428be963a5dca3d4c0f0ce75565a8eea4f87ceb989a2caf79424293547c03761dab2d432e575beb1dea6c7b553840576cb29ac54a853d4d9f9e1443762ec9f9855eabd0d2bb5bbc735813b3350505028e9a21a82a1da6ed4800b988eb7c156076308911a733f343b7ad2909182f9e8b833086d0be9e9269e851ed428501a9f1012a177d34d8e7ab027e7ebbcd4bbf904f7fb9db4da09cb598050a7f04ed84bc22edc36941a85193611ca3c3144e74e17afc6cc8b530485e8944f626db20b359cf78b7176930c12e3bef72d3e263400dd906e0eb149ef1a2d4e9c12ef448892f58eb384ac3e460068b6ca4b09c5061539fbd0310bbd8cdb9026540440297c6d1c113da6ba48b91c653f6de36db869ea6086e7369793ab1f8188a8d6dabc2bbb9e9fa420b2924b73a789e8341f9dde9ecb15dde96a25de43c4c6c76b6e8ffd00a46d4730f721f60d92ad462494e5da854e01302d6a822b6a385034beefc66a454f814e428e71896546728e04e7ca740900541ecb1298385e63d39d71a0d072293dee2e091a4a614071fd652529c93a371598290bd207111b4d3b3ab1075fc9e5ebbe17e9b83f863101829da5dbb4d6101b6916ac476dc2681ff944619d5d92268c010837e5aafbeec609e7abc7f409476b7781a1411b75b27ea768002621d805724f0eb9c9c7d441e99a8aa141eb96d12da037e1ce594d2e6ed5ad4daa24a90fb826131bc998655ecc27e7081a62239099483abbf351dcf3cebe282aae4c1d452c17684cb1c77eef2dcdc9d1cc2454cbad4686fb5044d838d85bb855f249f797d5b3eae17c745a62bb5e8fa85ff784c096e9629c161a2646cf40a01be59c28cc8311c819629327842ce1ad9202412c9728d9823a28445367f1085278abcd0e158cda6e1753e714ef5c99d13bcaa2c086b52b42f5d615d2ba2de5556a6dcd7455e20c3d55b4e6211e3ce9f7ddb62a1795495602e98d5c9cb4f4c09669e88b5e779e7568ba8fb142af908be854c617f2b6100e11de4fac755b0cb9462212669134d1c2ca3ab292b8ec539e2553c0ae6d410818b516f49d6fb9b521183b3b5cade93005d4fd4c9116421ded692c65e6261ff7e7da3fdcb44c5073b8106315caa7113f02e5bc73c48e6d2000650941fb231fe1bdbd62a1104247a81def477433021c6f887d62b08e1e8cd07d1319f70e55d34a4e144901005c71181977d9e4a36bd7c1d4226b75a0056999dc78c589713f0b7d2ded2f2c733ca4cb7605bbf5c8e4f2c5168e76d8fb8258dd02c653707b8c33feb4045d6bca96a01980f674ec713956afa5b49d11cbf60864b8700ee5cb8e1f5aec6940358669b9b5012ec1b6ee906a606ced3680b6e846840c654dbcbfcebd354e8304fbd73932993c17fa141b3036cb31cf8557748c28c28128aba026322e6ebdd58feb10414157e5387d65019a610ece22832335fb4d0fc81aa0b3d669a2b3ef591f6e6ed36db128114637c848b86e523c22283476e692d34ee0112d2eab05b41183b603d0b1e98e40e5b63f1821c789e3b92408f81a9f1d2820cd5b4772023fe6b71860c926468dd39c00a0eaa0e3b7ddb627d00d413c0644a8caa967d218d00846a9838710864cc2042c1514969bcb4281fec085d8e6b5a469c483d3ee302219624fa408a43b6b9e719823ba354e499866fde1ca4beeaa99c998a0586f34c6305bde70742e44686c06129fc3fdd073c3fdc808967c84e32be75ea82ed82ab60c04b0f87bdd1e95ed24ab7d8f610405a03156e693a3df080686a9a51b91ea864172a2aa2445414c28d9367e67ee3605d1f881ba2d379b2d70680c1d4271b4b982a44a6efa08683bc8b787856fcac4943d0ac681114f408fe3ddca5bd8b5a7979af2bbf926dad339d9f7ac37bb7e169f10adafec4c6355c7e36db3c8cec28ee6d8eb0cd546862efd8120d1ecbe52cbc9b88ff0f9307a25d4c6595ce3459b70e2288c8d89714356e952d3a16814593660abb3aea07a8ad26d0464113163ab21b59cb64d32f3d132ba1b68d0694071ddd34a6e3bc3a820a05ae72b319c8a0d22c3388a6dcada045fae3c91e77b87ec129374eae4d20945105e370495e7ca8e9b759b90d0f0b69ab86bb71cd49228966b25b5b78bc2cdca094dce9ca5983c44de20bfbed4d6dd892e53b72e67864a9aa88154512193a3598ef5a5a597110fc19ac5577c47ec1581e73be476de751b554dceb6f0db52d3959d4f113dc6e376c4f7b962d390e3eb673d8ae2ad966514676fba69d9778a2cdaa0dea5a33208b57cc5564559220b2b69628f18ce54160e8a457bcd343979a7e914456a379abdce5c97e99dd551f48600bc4a7833c29195906e5cc2b3b77dafb7309e0f18ecf59ba24025271f68c73ef46cde4f7a05dd8e5097ed26aeb3917e228f0779b4a4b8f31baee296dbe1389d10aa92edc4b782c9f530ada134a6cf87a8ef019e6a91159f95e9b24d7c80c12683636042c5f26a92318becc5fc3870d186a092eb0c9e7c0bbb923ade693ede2a94df41c19dc54aa83b04be8fdf1ce82e6edb20f07152b1e2bed66c0362d7cc1094c785f0d12114c4ad738d3c73d65652f248024156bde8ecb89a659a445edf3139f7d92eed8a43c0df0388ec6e298e60ad3a20ba6418eb129743197587dab0a64dba000752ef2ae48ae31ac518493622c2f456d809fa819e584821cdf530d85e87ad65cc5119a424b22ec163b59019cf5ee7ea5e833265517ccbaf7adf9c17d8aace33d7f16c44f5c5959dc5e4b81378449fea4a3ee687cb42120ac95d82029275478a02d74468a8339733078e929e9cae71d5149a97e03bdcea16511b9a90be0940469d4b6d03686b38519bb9f3ddd22e3d13ae09d41d32a81fe4f308da1b03a03eb32dee42b5c606d1f14dd00a242c6e1456c9a815c1b259a57b6b9d19fb52b09bc664e65a73272cea2830acb4c6870f2fa71b1987ed349f98eb4251e8de47f7bbe98a222c7b67d260ea013b788caad3cac2a37c89e577630214426a78726a58041d35d266900112980304c6fd715e90232d07ea4a61b57647c08ee49d6c8a21c525d739a0081ae9515417105ae2f93189720d85515fe87b6bf5a76bdf1c2654592b44ca6da98192beae0f2c39d9132cba0d8931b634a32b6ec48cc67a5b1447796b0e910de621c9c30037515cda7329cf9e851ecbe2b8295586510584455222244d0e420338b0a449bb5f89933599fa155d7b82e4b7a41f3d05d5b52d36abc632596fe5f995b4aeead52d5d8dcbafe692815b9fad9e12d588104f5426e144e387157cb79bf38988f69743594f553fec829a830fd8786b2f3ce299a516e6126b9e9673bc6b5c943ff8f2786028064c1657724d82f9286e42f41e364347e57cde8a27abd3cff1718a5ae31e427a34b35dc95ca310219603957afead9a580f679122f797c311190fb1cbeb13b525966e1d8e36532f1779c780e53a901b4da97a2867c7d4e16528bb4d7c66b2611585abaeaaf96e672da338ee0ebc62f7ab9fce2b9df48a1eb43bf63460180a396306b188b3e58ae6e5f11d669134d1c2ca3ab292b8ec539e256b6c950daf8ec7d6b8cf01ac64bbdbd9419dca94a8bb2ae4fbad432e473c84874dbcdfa8b3c440146d47e0443828726961e2be06e946c28351a0d30a53925ae7bb8aa11c795f74fda65f59bea315d5afd6a0be1321b6759eecda57599c4e6734117a6c1f75db404897f3aeeb51201bec757686ba680b4bbef710ea113102e77914f568531d3afb0ae03e39dcbaf9b8df2cd50be2ba2b85ebec5e7760940f0526a864475d74f65e5a50eb90a93db92ad8487e340ffab1d2519f2964852bd8aacef5886ac44497699ada89075f8b218200cd801d5b27f19d16a2ce76b0db22c6074ea9872ce410b67cb01412779d7ce0f253bc42daee4690984b2a7d96f0d61521ae683dea34a5b8d6de2276168f5085ae5f3a64c4f3fed02b6e57ef7a8c6333a57158d61c21c35f44361a9ded3e878996083dca5e20fcb61791f3702fb96476c5bb2dda430fcebc2502e0581d1fc7036a5fdb8b7461042e6329a62914dd8fcc70ddb5137544b8dc5d4d18baae7d49b5db2c4a8e139be84a971cac77487ae35ba4932fb6329758043ab9049682d3964ee960db48dde73d884006abe3127cc7cd397c388b994eacb2ac11de7199d832196e30a9d668942145d012f35ae492356b2de357695668e72d9c6215c78870139d7e2443179950c4064b8600272321f264520c490be1612ff8ab4a316de7642552f6c2666ea3adae476165148cd10672d7d3105350d0dcb9e57423dc7c1a6c13ef57778873bdcb8c134396854bc459e22bea9c1bf3d161cb8c814adda6195a76242c750e91b5e358479ef75ca7c2146c2a54045bb5aafb0e29f68710e1c4c150786ff64755351cfeb43926df4d79e9765ce908bd2e9d220555275f37a69d81b6698b1c371a430f50a0503b61944ed0050f2017e5cbb80ad54dd9911083f8c2aa9d2d2140ed8bc866397609eeec3a9838066a65ace9c2b5f64a5f33352f2721434719d6d2d899341cfe8e78c40ee5113d0f14fb124dc4ac9155904e48171d6118efe26bbce3e5b5d7c1d4d7b645c7de82c16d3d407e07a03bc4b1de9289642325e3ed0a88f568466e76a584220eef51c417f2115713c134fc06c53aa488257c0fc6bb6963694a236479bbcc62d75ee760eaa3d502d31ab24787351b8c44b6d222a0d3c6aa2e9edb7a99263b4eb99ccfb3d63057a54aae1175bc6197fb8a2c2615fa9775e62d4383b883199dae98958de40dc386e9269609715232da9c717668142f8f481939e97c69992125ed4d01af6c21b8c08c85da6184954d5e382b748315434bcc84ed189a3fe9d6b1921a0e99408ff4736815600c77167b7444b3c3993b38a1fe1cebb5dc53dede5b710d139ccdb899ecd18de0d2878fd7f3e5ac4e14b16dd314e405921c6dca8b4cf578ebfa9632bb9ae69ca58b534c54c4adf04f5aa9f416b159ede81f1ae6164325dfb20747c0f03b05229ff084ce8b8835be94fb912d3306c2283e3a0c3b54da7245f3f2f80eb3489a6861651d59495cf629cf9235b6cae7a373591004bb9c6abdd1d22e11d458c4b4b529994694d0db1d55a7db00fb52cfc851d3ef9c1c76fb8064d67dab2529a20d0e914969591043065946c91e7c92f0d6e0388507723c38a56ee762194ddd7124a5161b834bbc73628f274d1f04dd0ec1736b227d42345848bca6186f94c5649b77a6e35547bbc5d3b94a0c86386dd7bb87e4f42dd123b2b0e251d8cbd8ddebc9158a3d8ca3c995b4dd9ed0e87d2fde6368bd232c859dee9131659b8397cd684fed41b0735e0ad0a91f8c1cc1e4b6f3c9904b7c9656a148416f8ae60528b55c11749b704f91b4fb5d550084d417bda0555db03d1a25cb795d80ad6bee462f775a3e4b8e6a111e7630086abc32968292d5d5a51683adca69b81e8b5500678ff5b64518e3137fdeef9c354912d5ae89e324af2985dc856362dba565249b1220a51717e410bb8753a89fd633d5653620cb70179128bd1bc9132f9651d6d6b1ca1dc138c610084aafd6a622212489d0e84eae750b14628c64a3958e28076284464b4403d97499ea2d1a983bb85d5d337586441323e706ada47e0b615a16c70b35940414599369313409259c25ee0262775a195b365a9c34865127447d6dc01dd53c8712f7eb01964e8cc9b1de0c1184b7fa8118317243bbcb823bc260a0370ee73a2c8f0ef0359c3b8d3dc8b57ebc0c50d801a0401c410b01e9db50bad1fd56db445d9dd0305a09540f036cab621d741784c0f49e80dd30d1c98a4329ba4726b1c67cec14430756e84b0aed8361687691a8e148cf7a60f3b4b3d02b97ad5f53896347a59424de24a0ab1f6e471296f4bbece8326b1fe0a80a33099c320958e840d8d199bcf8ceee2c52daa4e9ba466e7515f33557cf2a8f6846872c265a9219c96733afd143564b24740f011addc7ca673a8a0682adc718def9841d6d9ead5098ccea53d5d994bdf2cd096171961821a3b3171adf69a3dd95946c5f5a6cdb73139542685c63600e211857b9d10b807bd80df6a11840db93d5fd685aacb7780b3c6b818a1e7a05108ed9f8b7fb3842f735f42337a44a3918fd638fcb944340108e9338a5a3e7b37c0cb692c974999944d316a04dbc471525d7530319c1398ecec188d218dc61e05a91845b11581c0140f0b2276a34bd5ddd0dedc8d98715c4d23979e769be73e88fed529f4ad2865c8a9379c123e64e9dcf527e1258f6e0c505caa0de14c713652f0606dd7543adad704d4f27b09679499835a286456ac710cb33e7a3031b650c7c41b78d20b9f3dabceb68b247e8753eec95c340e14b7b88ef3792c2ef8499105ec8b0f58e92ef99888bfabdb29dc8842a97866b1da928590d69b2d4f20e0b8808128f06494231cfa90ebcb32bc6af69c367f8920e0b5288399fbb238196f12d014563c9c4b6235f356f116631e45de78c6477be8906ba8e5d92ef56ace26113224ea6dddc6d85a82aeea890875512384f5c9bf2b81c6836cf870489d6291e21c7a897d4c1f00105f514a34ca9b997d160e3d529fc5b49659d1302d72298f5725dc27c06197d85a0c6456decbe8183168152de02fe8e7acc81e6ef301e8d5e384ea0a1567f3e3ace963be5f5b6e314573eb3bb6ea30b4c23638a1a464fd7203a2a4642cab2ebec8abd22516b7b9ce4937e42f1ea46014611ebbdcd9e8e5158db97ab340bb994233cbf77c9f61af141a6611935489dd195a524f0254ab494be8e9640a1ed150c42ca9ba768cc6ed876bffbb6d143848fa14da1c7d85a241a20ae0c798b2deeb68b1dd832a7caa6620d70135b66089af65cc753753a4eed2c6c8962e8321434a40a8431246e1aa605877c98693d9ce4edd05fd25d730955d8b425d8b509cced0b7b269934e7688a5d7338cc281443ebc8df3cdeefc7806eb199d589c85b606b41eb98ecccd2f25c1883828cb5b5205087cb46ec746c3c6c78c2c6fd90c4ae7d6272c30b75569868b428b84edf7b04dda8ecf9989f763b568df8a222c62d1e916e70d4dd1e62208624b47050c2fb018dcb4d8bb6bcaaed8e1d4a683b5c26dead42808c971bb5f6664288d8745fd0bd654f826028d75429d5d05659f570aea75a35754799f7f1dcad5dc59d85dbe570ba6fb38553a7a3c121a034b8aa4456caf0214a0b4c8a2ae5d8d20a7325853e761119e9c71ab36f326dcca716e18588b00a09c7f5e5dc5b70704fa9e912329741eca7b816db00c950049d36a33d111ce2d64b6d0495cd9ff9d34263f485c2a8894add031e33559928f6750913706aa00b2fb0b708862c5d8c7bc551bbb26f3018c1f7fd491e3adbd0f634ef0986a16608b3c057a003e2166894a633cc388d612df6c8b55eee66b8a7596fa68551e6f68972c0776c9933dc9abbab3b4995c199da1ab85729d1f03ba131f7843c0c25318188b1967e9a649e0cfa383888b1d10e7e26e14c7f3c72677eef6ada49240f21d99edd0c3f21a0bed6755e7b6725542e3908cbba5c703c8cc686286d8608821883b7599edd62b4188f3486350d6f9117dc5db6dba741452a989a41b712bbfb8bd840fab5261738d7bc9d22d83c6139a8d9cd9c25076366ef27c51daa1cebec0ebf13aedc891c73cb342c9cebad84258f3017870ebd12f3d2b50a6b49688fb508d6fc7e29cb5131678f0f321e85749ac6ae04809823bc35f13140ee885aeb27ea78634838a5e43bd02984365561f655672010a301ae09df623cc62699c1a083af0b60c2e20ac5bad394d66319d1747be369a6336e67ae7329d898b97ba766b9bf4f849ebe74f59ac01c5b298d24b18824b1661d1dcbde58eed7f95c47b55fb4f5f5345270c5612783206880b6c53c9e1911d3aa19297c0fbd1ec2e1943b9c1c1d63275d1599c5993469b5256fdd32e81124437dd9900fbc8c8704a006ded76a849e202ee2bbded2a3dbd2d9ede55006a2ef8fe0889e7784108bd912dbd783189bd624e3cb165c0b751e6217e66e6b28958a00eec570c1a7a44d6a66a9b55b32681316cac45469a1860eeba13f9e5af6e083005673c6be3a5a8e8ddd826487f2b24c4dee9e087569881dbd43792d3d3461e2095c8fe69bee03c6b7264e8fc8e582ee90f0c0d61b612db778dbb133deb0e5a173a01a6f4e9c4d80d5a2a28be2ef8af92e92d6568d136860d61c84d12a243c1ed182551d87afdd323bef38fa229d67ae9de911dea7b9db9f44f330aaea118b604245d1b01292211a53fe42b9adb76a5bed1df3e9badfed1d4c2dfb9cbbaa187b13bd6846410b1324ba30f67abe60705fd353373580aa45c4cd87838b51f9249687b8ed25909ae59e151cdeb6ec54f1fb09d9a2a2d1cc3e25f8e98aa39d423337f15499846ff893052134574323b39d210b4fd0bde07053881c12f68e78ee0e65558d8f9468e35a753ad90bba29c380cd300159d58cf97c669494a6d110da0b3dddf422b23185c3c0fea26f5bafe811927634d4992c336ed3514da81a4628c4c38491a88ea11885eec4a8e6954261de3db0426f52e83dd4b821a71cc60579259689281ac655f0f18d32e49e99cb243769eae4b0883daac1783db00e3b1f3899156a71cdcf149c7423a181e964b3db24449831b709750bb0d2a534ec42b2ab71723107a89b290280e3a749bf310a4c45616613bc618c53d4bb60b56f2a428bc7e3d296b539d2c5656936db1b34bdabdd708f1d0dcc2f4c8a4af9d8ee8304aa2df17e9dfd5d1a9fbcc2011da5942861d0132b9c6d63ad0f77fdba5ae3680874a6912cea42d470acf54e276579df63c0511663d384648cda7bcf9a77943c492c82abdb9960b230ce362ac66a593f46663d08b7b37b354f789f5c7b1c96c33b740e7a518232d3377d5dcd452f378ed846230367ba0052d403bdf3298ea934c342a811900169a24bd3d9cdf90c798cc7e9d22cf4358730b4226c751bbbba8cef7ba589514a758d5ea58799b70496405da76093787fdd2bac17ebb29a9744d47bb0799592643b767a8ce130ebcd4adf9b6b26a1006028231d06b86b0388f12a46038ec9554b3b0019e948a63ecc1acf1b000a3c8c8b54a05d9105b1411c528cebb3695c8e949c5637e2106899e3a0cd21a5fc6293d9c30e69640a9be2c29acfd632fbaeeb0f0c3baea989e9c2656f92c33af6a9cfde6b5b71d5da5b446c86ac831d7b8361ce11a3a2dee6d6f52dcacf8ecd31659d6bfa9da188ad3e52d7ceacfd152789239f497dcc06d8154da983ee2a9443e6a6314c3934500c83f345c7228e1f4fd4e6dc4b0c2b6f33cadcc7b337b6be498f36d8900eefcb9c8059684d06084350a2d32dfb9ce513c51188d766cbd0340c92f7251e11341f701d5f5f2f09713fe3313ecebc1549c846380e9b18fbdb5e61bd5897d5132dcbebb04411da8a61dce9b05f0e410db5f70b8a192a346ed658c72870011a8bfae4b16b6682c9e8d01b056d940211226364f34c74be576d1caf4d7725a62aa60de22e822fa0b1f4784b5bd753186e0d0636bc54012394345feefb59d205f4de6fa15dd6b43288523c90733d422693b1448880f000a5febe7615148c7b9d399feaf172aef33b10e035624d6fa4039ced12dec681ca23e829a5fc3dc2c42bd145f439b7ee53764c02624fd9570925f6fdb8101b3aa2b17bf796c405724f62cb82d8fe008e3a1f177acd882283780060134fcd52711646d5058caf42685ba6d778b85c12108e9be88021711da800c0d456d1b49c631890d2e68fc3d6d2fc1c9ce9a98d0073baf15b7c8f98d5a92dbc8f419477171ff6107b7f4ff89b88f6898012507f9c8f8eb3e54e79bded38c595cfecae5b9402e3a08ea218fade75b2e0c8439da204dbef12aa5b870862daac678a4a43741737096a492ba484ea72df1b4232e76e1f24a56000fc1c5b5ad0e5d5bc53305241b49054d13e16a7459e623de6ee1145af3244ee0f8e56dfcce5b281a343d3a58e144cbcf15c07652da19c3972bd4d27d9c5dad0daf6b46b88e685888db3ab655b932507bbb338913dd587d113f3043921c3699648195b520f1a4c73b187b3ec1de68d446d7b18faeb3a91b1b75958db81159fa27481226190b97d7cc2a462b62925636af904dc44e05ce154c1d6de0dc166cb677fb1096f029d89cbce204f6b1ff501751d69aed26e28ed5298aae2e25d3846c8a997bcd6f3b4d0eb8043d1046d6d75df2f2ec4e3f7a38c1d073a4ff81b86f645b52267d6a33661b48f7ca2b0ce2e4993d29808b70f01730ea0db6e3c17c74926f213a865fb8a082ca3910782f16bab8ea9f554bbf0ed18d0b453e4a49355f6199fd7812cefd6389f8e4147ec7d3c5d280f53ed4ed8e52548b1c3bc0b48bc44c180c4621c745346f3c509a12e91c80e1a7a269443be96a7b817950c59d1ba09653f36a18d828773dfb1db9db8fb478280b1e8c8628bd592683330ae76c285be66ad8493d9d31063ce90cc4333c554091441d17865279f157dab11a62b2b45300697a66a2dd702d253fbbbdb62076ca236d926eb64bdbb6ae7db80be808371da5a2a184680d3a83e82fb38f110b33038c8308d59008d2bfc169ffd3dd2c7872440171e895ce73044d42185e6a4dc9a9e2a530f2a0d8c583b5b95eb6958019d5d06955111b2bfc42ece9a5d018547856bcb032813de125802759d824de2fdd51277b5069002f5296a1865b7c119ce98ca3818af17c2a34fcc92e0ae134ef8844df2d6d243006f19c7fa364ae324b9903d3166b70d21a8ae1a385bc4dad92418bfd2cfa4052e46632f1e9b96c8ce125a1eeecdd117485b2cdace239d857693006256ab3b98b97c36f7cac0f921859103671691110e6574bb12864e1547609f34d6f5046bf10285a186d42b6c1a810f2d8662a7a0227b624ce873e48631a25e4a2723adf48ae37893a427a4c00c71642604baadc276c40c1314c7681090ae26f6b73cc081da099513da694a213066a1723fb28b460cc2027152e51b2503b9a92aef644dbdd7281d769ac6ea57d2c7208a9e043f4c6bb64a190ef3fca19a56da50c19a2647a72193da883c043acdb47f57f53e13b5ab77432f0d5da68a79c8d83d07f2d54230ac3c91b657e55b546e582e78e8104442e1a3ec7dea14c9aeb040939370d38d0349f802dd1bbdb1192332c20e194754ad55d60ac539df710ccd64b571659409d4eae80a715c17211e828a58b7b180f1fb8086be4a8f74afd7a24e39d6d2d86a119fd34b9b63960778896efd08dee002ec3d2a2854f62ced972249dc6a17ee91c00ffbb3c93b0cc44d53dfafca4e2ce31a730574097635827318ae41db7ee943aab245d10e558edd8f196ad665b8d5582c68266d425722302b7a10176c4b18ccef34d904c386ee047a8899d3b1c3b3f6defad7dbdd9808f4a2d5b5ed0f4cdea2458807f0692151661c797f90716c9ce4490f6184de7c8261ba9ec216c2ce3da05aa46cf77841dcee0c8574d520fa97d0a63c700cee770349d7b903d4adc54ea0e8878c1b391346901398c07d1430baf50f3562d8877d4b07b86eb516c0a0b20f2f62412f2bb4ddef7dc861db15cb490c710904f14393b516918586ceee1ac4551438160801daedf2f1ce4d6c95e708222cf2298565106fabc2843491ebb6db61ad89930c687dd14b2e6a472000a1a651d8d7043612a8c6b80cb14db69bd6c8b5d000b6045cc7ebda2a6858e00f50d7eb901c6a18c5dea671e7d6b4eecb060501d7c473eb0806a2dd348f0eaabc8bba94bae3ca8ec34bc3f79b96ec355ddc0715868811844bc0e331f94060ddc61d10e4148be1451d629555fbcb4a55be0f03923121125cb12d5201450de9edd8400c3aa5e84da08e4a10175a6cafc6540f46c02c7ab790f878353aba76171dd5669700e47c9d9981011762b55d8a89c253df454c3d65f9e1924b98e09d92fa349fa53d974cc979c2b03888f6c1998c5421a4b0f57cc1203e35220a3deb56ac5f738319c938ee4d146d144cd3992e827db55c09a852620daa3195ea2e71ccd02bd24796ea43733e05b8c7ca3eb6e5d08a93b4b2137d23a7288e094b5e4dd6da4b46ecb293e99ac320a3e06245d7758e83c2b28437b1275792de2c58b3263f3b2cb455fb1972606e4e269891ccdc51caed9c9d1ec33a83d1c414eb17a6825d9daab0f85a71f0c5133b1edd780d14477af314e856b798d62b0bedab241d45e87aa0a8b9579618b5cf0a44b50a834ba23ef15cb5b93d242017bbc44ebd4993218007ca3b41171923e2e4a289e5e2943b6c8b0e88072051503722d9bc000ac3ce62a271baa73abd426a8d027f284940d25214977d6cf63bafb407a059cc8a4e9decb78638e74c11ba088267480fdb1d75a0634fba6c4b4f3377e44485b6be73c6fc261a5009203a55641fabe525c136055204760012c5a4668a7b4db484a21df6b0e21f12fcb61775eb7cf36f7d1410aa5cb6abed9ae73276ab293ff736a8925b78b7ef2e5d6efedd9db64b594f9a970989d8af248937f98df0190223ea36d250d2ddfa7adb00c3b6dd70426f04303001c2ba85f0d6a5d4a9636f11e88452179834a20e9940eb5d332d65ac0e805c1c196cc0f7668932e835f48c5c1d2e5b2b88d134f59072f42b40755a6499fe70407c93a482715a551fdb961a65e604eba74943862b086ba648a756a853310278a2cdc6e60cdbe6326320e90cbd1c4ad8cea9a10f3193ba428a42c4028d0340aa2689de5160ec657fb2ae0d8e9321670cee4127b0630f1de970af198ed7314284716c729678d6cee0b3186aba5d0a9b43a0e1d48ce328c8fec50c63e0b7d37068813e6fcd58fadd2c8de3a44bd401b2d9eee6cf5e35d20c74926506bf404699551a34d14c2341f276ef9b86df7a92958e21628b064198e13845a7138f6c8e37206ba59f7bdfb7c749c7d6758336101ee57031e433ee3885184113d002e89cf3e50c36609b91c030947974edb2ec6bfea60077e1bbbe1fc7dc6fb1a5b838fc518b76c87db06093d1af362d37757203373815cb05089bc71c0fe9d481bb476ac14691309ead617327460c50e57da0195284eba16c418fd57299986642ddedcbc255307db3274c3c3388c1409427cb8070e23e3e33d7ed041d4fe11cdf647fe0eec0b44d6a01f0d463fe50a3b5578f57c8e9b018adcf21bd1e261422f0a6c971269b4c9670c3baa22f260ef44bbcde7247856f0e331464d432be1ba618610cf54475f35876737ca3eca1d23b06b2d50b46b923b86d750fc5a700d9f6911987a0582241aa5dc4eb47b41373e8c28c83455c4f283420090250a10b43c1ea42871ebad0cf871261186b006a7421a6c1c74c2cdfcaa21f23133167521e30aa45d628f43a1fea68d58ece0dcbb26769bfe35847e658be47867d8a5d5c2c20103b35c790dcea058a1839910c86a9f02052a2d9db1092890116190521534b55c5078fc22f143566d98d40142f7727bd30124c0fb0dde029d64e8f8f8b1f86ac4badb0105ceafd7a3e70fa1eec2998d46635e7687b33207d05d5b1f332a73a4e380a30c38d42ec4cebe980a24c37ddb7d9d9f82b0c638c16ab4c6c0014bac57410c5e50d718875214903dc77521f8c680e9f193d3f93b011c993be95887e9eec6ec37dea342e68afa114c140739cf59e731cb7656cb2d5f2876cc62fae31a1cc4d87432846362452ca35ef2e906b5e5a8f89f5d4beedacc3bec6f2d39dd9db914e94a5ded963a9a3c44ee006343ef9bb15a203c110af9ac14488ed17fd624f2253cc4151ada861b405a2df450df2fc18a9d162353c1dea76991fc16e386ef2dd39d9a44a59d1304e121d6895161ee70a6481bf0bbb76153b0bdbb4695efdb414803ec938292c80ccbbda85ce8b889541a145b2230e82cee1c144319daed2e394c5fa4c45fad4af210e00b5c4b49ef7710a4721114d1b74880fb80f367007024643da729c889811f5ac678ed084ec2944afe9ab4afbf796b4511403704530d3d6b67489ef3836394bfb1d3b0bf80ef64bd2a90d2bcc8d4b1fa0dcd5e8e74030e1f4e8ef713a5e20d1a1a7560117321c1d63896b499f9966478655406ac4fd92aef6a2ef542e3d95386cee2989bf9790c7a07a276f08e5cb9575ee70201ad1c9f08755052e6d6f35a45c5020f6aa4066132f877498583506c5f7b8f23217ca690cc8904a96964d5e3ae4ae0f42a2dfa7e386f47e3e89292d2f549c8d00778c0dc829ee2b1420c6cc3f9d287a5bb3635d5c60668f5084e8ee45d65b4fe8e1a0b16562526e4ba30ceeccd21dc55390a107cc1447cef6c7c9f363ea00dbd4a0a1ab4bb0d6e5aaa8c09cd1eb520f58eb4fa19bd288cfb502883b096718516be35b1851c5606ba3ef0ee11ea0f60a1da7036de6d14689608554621d333fac2e0471a1edb30be8743572cc3861741c6bb0809c20856196153913989e835972d41bc71cf01dc7266769cf256e72cd6ec0d228912b2f6b4f2af22d868cdacda2797fef8e79b9819a62ee21ad767111d5ca86f79a932f2173dcb945d86dd67a9a361cd5a8dc54096fb46ddbab2a70d70665d2b38440b96a174d5634baa383edcf2c7d64fb09e4e65564a4919b6722501212214bcc0b03f7ca5fcc09681b4ea504442f1215afd655ee59b3b0e73bd7ef2cce1eaa7af3b13467a371f031ace335d105666da3ee2956bd0e809a5d04f35a19de8989ea3126b63134238b8c939554836ea14ae3aa40397a59a8f056dfc4dbb6eabaecd618e9003a8e8285d4b1259c85fe66066c0d432ccbee771c9bf7e259be4831d99187852183a6ebc8cd1c23cc4eac89f499eab229dc253c5d638519cb835850f7a0601d55b5e08d9e346675b1b6d066f1dcb56b061ca257d83da5936b0e611ba14d6d2e1a7e40e7518b159780eba43b7e8b8243bfeb3c35aed0c910d8d2262e60234c15cb6f7ea7039bb1d74c77a07293abfc249e0850a38be39770ec9be802ddbdcc11a61d3d66c37ca7bba8ba3b7d66b83040476767b1fa6923e8e37ec7b109cb72ecfd76080668470a1726be5c6c9998ee9ce8404734362e2a9f9369a2d4664226ab4a3bb651814d123800593d26df791627a1cb5406b30fa16349fba44ba5bbb5d4b7aeb7539ccf2bc95db27cc3fa4b4d80fdc58d57443f102797daa507d827399fbd5b85e7ee2cce1c8469f5eda1637793371e625bf3e32abada1ed58f39efa736129f24662284c221e2810858336933e2bace90b46ef7bb9b1e07dc26ed816b6b65ac9dc9f72e225b35c509e77b69bfe3d884dd71d32d48b42ec1f2825a0f9496d78ea01d139f366c469093485e16fb723590516e4d05d56e91448630d3218d36306a7c20eac84468a4a85cacab8bc32ca438a9061dbdadfef53419362388091870f5662377228c20ddeda59ede599c9972317b4f729b56271449384b94477d334bce74943be5f106144541009383e54297bcd5ef9c2aaf932908224ae2566e90817d1847548479989c0a1aaab685de59126cf5673a3f4b2ccb9ea5fdee30d7fb230a269cd2d06dcc7b0c436cb6af08ee74da29529fd3c105214ddc9fe05b98457bccf748888061cc1135cb8c2e5d865d7a1ba41877a2e4fc7659c436e0a4d6424ce40e5397904ff16de43cbe9023952e0818814b18acf57a7478b3b6fcc49fad994b657565271449388bd087318817cbee487b76d36644a7b835f42bad728d43428350e4320da7f49214dbad2ceff1be0e8edab92a8270226a79bad7951af32eaa7856c8e5daecb5d77658a4e42cb12c7b96f650d2ee53686a482d19579cd8743eb60a6fba9e8285b5723c0b74332e3c9c62f6182eea69cef3678def14194d04ccf26df90e70826433caa6cf09d9b3c4ac2ad8ce15b538d217ccf6cfdec11fa4143b74a7be5961f3e2a331d395e3854b847eeaac9a59a01cf377fb9e8ff55c474a7f4692c53f503096f5abeee0751392aecee6e87dd7555506148a35300ac2af79b362b100f1221e2a9562b6bd630f8eead474255f4d7bd3d0c565ec96292b9136cebbee5297e469deef3836394b7b2e69f61ccc0ab42b7a00bb443151244b41383bc89cc3e120035dda3b4212c914419b9848a858b0dc1d92afd76498f3d36d8904a88e0ad1d93047d4287400147cbde1c150794ce997912cb9c6e902c65018afe0a82b1b6c289c86efeed1003b9e51de317fc0c0dd3de9ed401bccad60efebf12eb2cacee2eca1aa371f4b3bd6cbe845a7e2d3a62e280422b1e6b0f07228f71a9017ff1089ba158a16914fe8a5ab09b5c70a8774216945a1b9be947720eb42ecebd50a5558c6b0aaab94b23f49fb1dcbb2fb1dc76630e7c95be8826612f15e6cc76dbfe527de9436fa78e4eed00a2f2066d4fc1ec83856c754061d0aaf885d20c1549f89b47d1de5ab8ce168b127d0902696a10ce98bbe42a7a01eb68df5a82db64d7fc24826a6b1ee801b915bd991d5e9476e3cdaae7b8c8bd89a6cce1718408034ed37b2f4972064afc9ae732a4b53529bbd57b94da8138a249c25f4fe0281436fe38e9ac957def002d43c138bccafcbe4c5d64d285b8e912bf5624adeda00d7c06bb9900329961950e53682066eae1f8362bcf91872dbaf5b63e3bea4a050ccaced349774596361b8470770ee2a86471c4c09801fe275ddfaea96b402094d2ecdb26769bfe358e7868b4da2c186e81af6058a7b17ec0310f0723b7b7e3ab49d940f38ea89b25130e8b1d38d9207228584c211681b1daf7b7297831c13d24d2d7b27f6f5c2a3e345d7d14dd449f71c6074391de501b2cf6e04af3474dc18fc9c6f7aced04c34da72c9187ea3529822cd39a6d37a5fb91516ed17946e37dd7379e42412f1bdd7c28e9e6c83b2e5bec9f51c6126081669109c1d5765c828edaa79023cee2f5d47a1e89d8f4287047db02cb2175fd55b64e64e2567feaa32271282a0b13b91e7090b71fc1e9b0254c323df97846163b1aa866101e27c3be901c8a835b60d93817c42c2985074c3b8206007aaa94bbee0c48970b9b298a0acaed165350c1511e2031dc7274307b7b1b42b02dd6a7cd870f8e45f474be4d372d8c7614922a669f3ae650d1382554527fae2a22147ccbf0c44bc6fc074c1ca7ecd09b19d376feb26ed6457d1ba8fe8aece47c043310dd60b45a389dd9113bdd404c652307181738436a97841f58ebe504643011dd3460e0bb2ce82da5b116c1853a3290479a34b89a8976253abb0ec59daefb89de33a6283abf3ac5535961594bb0bc55433e068c2310d755d7cbab7db621fee294a911b53a71911d776dad88ba5b6405f874824a850c4a3fdaca760c364f43211081ccc1e433b2b07fbf1444739c2ec411e0ae00e39685b45fee013f1ecfa7867b7215cd0415d273c246d4633d547d816e90b23d653ea82e6388f987d2b30e8c406174a62987aa141378fc22a1e3838d71b66c1a62bd51d710c34b3b68510a8763483d40b84219566f5fcb45157c84df0a9c56dd22050741f4132d489cd5903ad26c94d81e1ebbc68170e1d6b9992600cbec713c60ef08dd0a9ec6e474e8b4d10eeeb811097e2e1005f18fdb4dca1a12d21b03bd91b9afbf324a2883548485de34caef5752ee53b8f6cae90cca8507a1ef06da283dea6481a767a82764b9177476b90689494f66a106ce4025d8923b76a99ec031bd2091d9217184bfabc3e79b9160ec16d898486562e9a19e64027e03a0d0e12835f63c13bc4e2264272dd433271057b5cbe50e2026952a4ad5834d9f70ab6fc5b348e85a1a907ad8e74ba27e8dc80b5abdfdd9004821c4996bbfc629c629f59f6c9596259f62cedd3f42419c35ded73c5a9dd98ba9ce3e62e26909cb77487131acc4d3958631183c963cdd0814ed1959841c76983893aa5838e1a8c490faa3386b9950ce31c6d121763a1a9141e533ade684826cc48dcd23a0b0ea2572f1388851c02ea44a0ceb4d183984047fe2e87385683cdacc4bd5a1f97653c643e96cb1dbcea1c1e4e1b9d751c191b2b900842cf4388915d223e000644c60c4edb1c571ea4ebc16114271728f1d98e12856393b3941f11b64e4016440ade3233bda5039689fa01b31417c288051b12204e5309809edd8819f8ad5ed7714e1c2791bc4c4e296c83225c8f6cc2b21c9b9ca5fd8e631396e5d8e42ced771c9bb02cf7eddfd2653936394bfb1dc7262ccbb1c959daef383661598e4dced27ec7b109cb726c7296f63b8e4d58966393b3b4df716cc2b21c9b9ca5fd8e631396e5d8e42ced771c9bb02cc7266769bfe3d884653936394b4fffcae99b7f07f893fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93febf87762fa28c252c2bf5eef1b23fdc7ee7994ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93fea43fe94ffa93feff0bade7800a770bfbf84f6193181a526a57ed792e991d65c7b26745f8a43fe94ffa5f4bb7cd9e0da21db80e476cb19b87f4b1d79ba95d4ec4ce91a4fff9e58f2fadd745f5f0e5cfffef17bd48befcf9e5cb1f5f54af8a1ed47ffcc71f5f926c4847ff1f415381b0acb2302ca3a01922d0344d9afe09e2aea987a80e413f784316803ee8b276e81fd56575dc3cfe86d1e065e55356fd5cf12be88f2f7db6455ffec46186fce34bd584d1973f5104a7701a4708ea29e79f43f6f4080aa3f0ff80a9ff01d3068afe49907fe28cfbe58f2f59ffcf30ebbefc397463f4c7977e7d7a0b1f4d5ffe240918c5fff822d5cd973f1104c11182f9e38b5a6675f1e54ff48f2fcad3cb109266b03fbedcb2f0cb9ff01f5f8497bff63fffd97a21fc445fc2476df01f5fae6f9aca95c5db9673651314fd973fe93fbeb043563dda708d822f7f22148322048ea1d81f5fd4fe91c3203446913486fcc71f5f94775082c1519c8161e6150affc71f5f76bfae0da7510a21288cfe8f3fbed8fffce7588f7d147ef9f37fc17fc07fc0fffb3f1ed39746dda339fc6390befc7f444dd983aa09c732faf2c717a96a9b6ed0bd21fdf2e7afe7f91b4fbc26f9267870c81f5f0caf4ba2e199be34cdf0e36b146f08d22f7ffeaf2ffff8f2bffff8721dbc32faf267ec957df492ba445edfd4cf5508cd212ba3fe017f7ad53f92e6cb1f5fda2289c26772688aa87e90fffbb5034fe0e0c186cbf0e58f2f41b7b643033aaf0ebfa5fad44309f24d7af487a7c64575d084599d00dfeb2312fff28ee12baf2b7c6f887af06840f7cbc207e4514f15555ffef8523d06f58f2f5d94444bfbe58f2ffdd0054d3d3d53599d3c78ff89b3fff832d659d084111887987e0c101fb54f3df2c7387b74d85f87e8010faa47454153b55dd4f7202ebd217a9b916cd933a01ebcac8e3a5066fdf0e58f1f46e6db2078cff53e2782ac7df0cad774f8b630ecbd6f892808d377a97785214a1008f326a32cb376c8826f3971d6f6080e7fcb488b307e93aabc37e0b42da26fa9ac1ea2aef64ae0375d56273f2d00be9ffda2b4ffb03068ea7ef0eae1655ebe2f8eeaa16bda154cc83fe07fc01f007ee8d7f725ef07fca3529004d5af1065e6fdaa063f4baa26fc052048a3a0f84579d8f9c92f8adfcffc47c5bdf7abf2ef79e303c4ec7561ff776020cea2f2577d7ecf5d3f16bf63b71f8aabf2d77daaca22fad594d5593f44bf7ac13300c49937fc02d5fdb211df6bb78f00d8af8b0904fd15e0555dfe0c3094fd2f2b7894ffa2058117a4bfa83e8cda1e3cf460d38551f717b8a01dff02913461e48fbf60f427d44fd4c00b24f5fa5f88425397eb07a559d5961f64bf5faade658f43f6d113fddabf7fa80a893789f73cfb1d8bbe7fb00bf06f0fbee3b23ef5905f2ca0ef38ea7b06fa9e5f86f28dda1acafe87017b075808f88df43f52a02db2e5cd6afd76e1f6fa1a799b7e2ce418fa7d0e89bfcbc96aaf5bdfe6a4d1dbfa41fe3048be4b7f6df44f0b9e6071e925fdaf214d3bfc0562ceba77d6c9e3a9a78297a5fc7dc1f4aebbed93428abaaee91e6f89abe1bde19234fe18c75ed98034eaa2f7657fcbe2f9cbc7bff5a9f2dafe37a18357444d0d3e84a651ebfd8baa0171560e51f717ad7a36ea7e0703a2ca8fc2df423e9b887f8deb87b079b4ef45d73cfe80a00b9e98fb6b3f3d3f7b97ecbdfa6ddacffa2818dee5ac43e495c9f759afaaf56b66907a41ead12feae25b7633459d9744a01b82667a57d28e6f93715646ad37a4653644eff2aba16fba774d4a1aaf0bd2f739af2afafbacfe7d5eb4b45197558f1decbbfce61daefa6e54ea68183a2f78d7aea67f1299b7596d5396efd25df3e85517054df76e50beafab8be2320a86efbbde8df5635501ded05459f051499074cdd87e54122dd990364df15159f2615dc96337eed51f15bd88e307f943fa517edb764d0c4acf8fca8f8a1ffbee8fb303af2c4199d5e3f216d07b71d465cdbbacac4eca282eb3247d3793df364e6fb31e3ba8ef07b75feb77c3f0480f51ffbeb69716454b1444f5f451d15867efdafaa8a26cde71e213eb3cff7f7a278b63fde8591a792fa2f4d4c306c44fa3333ce53d575536c9574df5b45b6cfb6f9bc6c7de113cef5f5ec8e1b5f47557fb95064f0da89e4d95c71f508de590b5de93803d65dcc76688c2b6cbeac1f39f16e53a7a14d6d100d26168df904fe957c1f89af9a6a13fe401af0fb2ecc392470afd6949d0545553ffb4b88fa797b23a1ab2d7363e16bfb66b9ef6b08fb2b17bb0e393a837fdd3a43e7c05cfa3f8503d5f75d0d356fc4924df6eca9f77e7a05febc17bccf90bdf7ea340f0e472e8cb2c78dab9bde8ad8f36f32fdcf7f8f34dbc5f78ea87bdfe37eab1eb47c8f769fa39791f9f9f78b0d4973fbe4c511d361d489ad2ab937f345d0216f062213d6b6a14fe3d54db942b82c1c45fa09faa7ed8dcbf8b7b35c47e01fe3ac5af1bd9dfc1fe457b1f7c10d63d08ebbe8afade4b7ed6e0af8cf6f85f320efdefe0daae59d6bf00a2206dbda0f8052a0b6bef27c5fdfaba63f9a8f4c1f0a08f82b18b809f8559f7ecb8fb2974e8bcba8f9baefa15e895d51e15fe0eae7eae6f8ebce2e19f32a27ef8eaa5abc7b27ccefaea8b7bce529e9d8c0faff2eff917152fab5ffdb81f7a2b854669c2efb241d2fce3d9e3213466d4f5d9931b11f907827f79383f1f3ae09b63fb373d9c3f7569ffc71f5f426ff05e7bf8dfe23707591d46cb3ff2bf70a07f45bd7ad031f8d57f8e63e4df719cbff8617fe93927e1af9e73e4d5738e61288cff2dcf3906ffd7fce61481c3f8bfcc6f4e1208cec0ff09bf3908aaf07bdff9b7a97f2efc9573fc9b43fc998d7ed71ffe8cfe4eca9e7dc5df84f0ff8d72f94d64be0ae8170765c600657af74af43e1a34529196beb04cd2219c826a29e40c1fa5aca0fe759fbf86a86a1f1ef91ef88dd785ff4887aafcb50cbfc1bd4a314a22e82fe498fc1f08fc3f10d240883f09f44f82f8074ac2084ea108f2f7249ac070ea5f21d1cfcdfd3b324d120c45bc4a214ae10849d234f5834cff087dede887b2fd53e8a76cffdbc9f68772f44db0f5dda1f051158e6cae948414f6ad19da250ded8997c1df71bd6311b57e3d967e263de70b66eaf2f849dab189c42f836b5f5249501bc73e6eae7d66a4da84dd0cc6a5fc2b06f6ec4bef1a0415adec680a25ec0bb724b6615ac62e6d282ca59e34c903ab8b2a1c59dfd2cf3f620b8543ef5e396a97bde6bdfc0466762cb50dc582947876548dfef4ad9c635ef0b48c7dad9796d1b474acf3535f9c8a2922e36b3b1be5a58de78a4983ea3c1ad56170af1c131bf3e4f17be8b93e8e8a563809d112f676dfb017c1dc1cecd806e2a5f551fcd1bfd77a6749507bc75637fd7a2c5c5bcd83aa9c43a19c1e631a617d2267cfef085ec7551c28f9ca957e757e5b4f2a09e9ea5a0e2365cce8da41a6174419aedcce470fabcbcf93f3a62fc1b7f16f948de05ce19c5c8432f5f9475f9497beb0b45b31ab7fe51ef3d8f8e899914a4ebf21e74c1288d4b76e8c541d0a7787149e75e8a58c1b7dcc4d7da12c1cfbf27e9e9edb8c79f6a59176c4739bf697d6b196a35fb9932422aff3f1f48bb07e90c4b00d8524910b730d2a660d56c270edcbf3b33b8e89cf6feae797c2b39dd776bfcd7f3bbe65501fa7e067e3ba7be107f4d286afe3b3fb36a7ae45141fb65330c7df6ecf5f8cc3d7f9dcfde558ec1f637ebd5ddebff7a50fc18ecb7de1b0051b9cb9f671f53129d3eb819276f88b6cb13f6bdb7bbedafd465f5fdfc9e3b42f1c32d79aa1275eda71c70bdfd032baa44e65f67afec358bfc8350be9423906a2094be28508841b23d5973212dff2d933af5c76521256873e7cf05e3d509ee52472719c1cfb583cbd735f8e6ec530b13d503fe6d3e30bbd7fcce3d7fe880823e5f80f63a2ef981fe65017bee78d8fe6e883f6fc305eeff5c4e5698e8edbebb3a6c074ae45fc1f39b72f3a2d796e234eea3ba677ac63e9f33f8ee1ab0ceaf9d2fa3587843bee692dd1afc7f9a1f3429459dd9d94f80f5d6ac059f85c6726896eea8b66a95f8f548496d5c7b28250d1fa63fefb317cf03bf2557e631bcedeaf1faf6bc07f7eee3fe65f15712aa40ddff26bf1d09512a4ef98f95d1bf8797285127dcdd3774ce563c7e16b5a48d76f74b2e9c5535f377dc734caf6d29ee7f95d1febb17b7db3ee60c729b4b9ed8ddea23dfefcacff8a27d97af0032da309fcf51da2d9bfd21fd5fd2443a2f21d1f3df3f351280b896f1849e456cf226069471c2fdff314bff49e3d50dfe4459d7c81591f6d945ed7f8eb31f7d1650af226f9aaabaf472a5a5f79f831a75ceb66dccbfacf8e4fcf7fd3f52ff952123edb148c541053b8e39040b8a4a1601692a8b67ea5968ffe493bc27ccd3fdbe7d1342fbc5cb853502169b86333256767358367e50acf4ac62eaad1ccea8e539fd6c2c77b1ff2f72a3f2f76c257fbe0d157a1ac24e1f17eb6900b240dd0a17c5a33aeecf0f4fe177e977684e5580bf2edb9300dadb07cd6dbc89bfec3bfd441c71d71752d640a2bf3799db088ca5fdfb6c55ca4ddeb1a727e9eb7efd6b60fd6abc3d3fa592be30d35e1573df0e09fdfd1498f79fc680dfdd57b9edf71b9f9e850fa0f1e307e673d27fe7a9c5ee7ca1ea89febbd679e96d7263185b49484073fc089672b89879a73b8e3263fe326bfba25be508eee754e02944e3cfb9c38d6a508566ef4570e76edf4b1ae4d927869dc2bf7642bc919a73ab6dab9d639715fe4c9410fe3113b274e754b82ca1c7ccc2ddddd9c38b69284425a7ab6945c6f974d120eab6be175b8e3364f60303fc393534627b2fdf1b8fe94f78ce549cf48025286423ab93c9cddf68c61bed1c7afed90ad87ce991febf1f4587f3deb212bb71ff55e95b6ae7079ec07bed6e1582aec59cc28651cfac4db6fea7dacbb9e45cca17d4e42b19c5d03ce3ca12c5cc17ce047c7421ebaa078e1d3f7ebc2ca8e0fde7dd2e97f7b7d79ec5dd4d21398de15cc55bf1e5fedca175e937e4fa797c7d2b718d4bd3ee9f5a7f1d4dfe98279f285e2dd3af2d15aabef18c47fb58dbe5bab77f93cbdee451ef4d3de8bc7ffe77f8733e5e993ec6f3853dee05e9d29af2788ffc29782fe49607f62e83f089824609c86c9bfed1dc5fe15ded1a7d6fe2df728813ebc2fcfae1402266998a4c91fdda31f419ffbf9b19bf467d04f57cabfb12be58d74fc1fe74af92d178761abf563997e32310dfca4ef98cc472f849e340fba0905a4d793fff9dfa2829eddcb7fad82dee0beaa2006fd9b2a0841680a21feb60ac2ff252a88f99bdedcff820a7aeee76fa9a0afd04f15f46fac82de48c7bfa70a7af72c9a2c5f77a7c23ef1c463e9e67026a30f0fa70419958949fb67af8bbe63d2e7771c27572c9ed49c8c3ef7f5bf435dd5d1fcc673fe4b8df51efa5569c1c8b78fc928fc53ad85fd49c07f62c43f4806811118c3ffbee1447c64382134fdf7b4d65373ff96daa2c987327e563034f6683cc97cacb56812fbfa61e96b3f3fd65a3f837e6aad7f63adf55e42fe8f535c2feeb16717d5b76de193ab7a723075fb5adfc39edab1e38b2b2489e61f5c0b5fdd5b416516dadb72f1c565c1cf53805d085fb8414fdbbe973eedf2e5d5167b75092e7aa1aeae7580ddebb7cf3ddf3e49fdce679cd7e791ece9f9a72d33f3d8aeb7ae70805d03ffced5f781ab063b968fcf3e6fdc426fc7827eebe2fcafb8b33f72cdfeccb5f40b77fd07aef7afeed01ff0bac8260ebaa401a63052f5fc394aca9f79e5c54e7eefd2fd364e3f75433dbb8d5f3e8d3cbb62ffb3aef46717d33777c9d3dfb7ee96efdcbd999effce67af9fcdd9c7f3ffcdddfe7fc3f89597c943cd51cb1fee96e74f501fd7f9fc29e37b9753f0f89c823dceaabc9189fab9ce1fc6f1e55dd2bfe0b3d16fb998becab6f4abf7d032fae49e84fedbdc456d9100afcd40d346b5d766ff785c8ff9f516ed1df2d5dea1118cfa2d8387f893a0ffc4917fd0240163188e637fd7e0a1e97f85c1f3d2de9f583c08097f64f390248622afe6094d303849a118fea1d1f31efadad70f8d9e9f42ffb6d1f33297df1b3ebfe4805706f8c635cf35fcca3a7afbd29f5d4d7fb645de5b4afd23f57f85511bd5615407eb9fffd72f9bf6d6b0fa5f5f1e2cf7c385f5afd7babfbfb8fefe42ea0ff7ee5e6fd6fdd5cdb59f5eff7ad7da221b5e86f0affaf3cbf2a7a9e8a36eca82083c38b11987df7ba67c3e39fdd7c8f12f1bf9d48839f25f6ec9fcfc7ed5fbc23e4a1ed787b20614fd983dcceba4f9c7e847ddd359e8cd7bdc14c8aac78da7f6e926d8ebad8eac79b99cf9726de3c35b15cf3714be8501f8f505826f3707bebb2ff0af080bf092decaccff0c13f01926e0334cc0679880cf30019f6102fe9f1826e0714de7110600f4f712845d3645ddf7b9af35bf319d3e830afc7e5081686927ef31a88f867d6465fa51d3d51468a3aee8c17df4ea212bbf0b16e037e510fa4f7fde170451ffd81982657988cf73437e66f9fdfb18b0fdd074d16f21ff2ba6eeaf823b3c5ff17bdc621f1e28f07a95f65790616da3fe7730c0abd7dfc28563e70d8f0b41bf037e68d77ef0aaf63d3a1fcb2caafb20adb2f0d9deee9a7188babf61f67f57f8c1707d8ff82a4b2fd7b5ff3561235e833cbc430ecf3d0a5f86e59fafc3f2cf6819a2fae1eeee41ebbf68fd374fb6d110757e33764953832aea92a8f2be1bba072b7e15e3b7f95d5345431a8d3d081e833bfcf3db94bc14fced07be0ed95f3df9b8f25482a4f939eee9ba388896f6074df323eaeb44f9d9e08f41110d4f5bb9790649e38d435347c95f55f1d4a09f82daae09e29f8fdf73f1b766fc0de88f53fac1f6f45be15465a997950f2d0daa3e795c430613f13b18f0d857f63f470e5ed27a5d1f75bf01f9dafcbf8305dfaa7fb3ddfeba017e9bf914d220eabec73e6fcdbfcb010ff5f743b58f82af2f7e46b44d53fe0a153465f3cb6aa2251b3e28dfbc367851f40d780a56f8047a73b9fa71e5fb6721237e00be84a3489a2629a37fbc29feaa29bf2dd18f9c97a5fcb7f12f36c12ff05f9b1a467d10577f51fb3bf48b49f27bf0e1c5e2fc1df4d73e84513c79e5df7dea2550401f0d7ff7c9c14bfef623d1f2dbaff9aa977f03fcb4a1adc7eaefc05ffc4fbf872fa3c78cff0df863c9fe5df88b3dfb3bd824aa9f0e2cfc1efa65f3f43bd0c73afefb03d2765e5279bf09fe7dd6ea87df9ef1e9e54bf72fd15f8dba9f435e42a13c677c8b8bf2fb4f24593f74eb5f3cf21242e5a98e2c7e8e29f3dbf8bf9cc407aff5a0a89bb97e589eadfffbf05703f4ef3cf3d50e6dfdef02727961197518fa7d7caea7b2f829c4d0cbe99ac79faf470b5e7dca5f7dcbafcbcdb3a739ecbcf96be2ebfc3f95bd5808ef3cd29fb1c03e63817dc602fb378a05f6e6ebd1675cb07fdbb860afa957f5f4af8813f608bcf4669178977ede32fd4ba2898dcf5bc98f623f7d172eecf7507f11a4eb15fd3558d86750b1cfa0623f0d2af67462e29f0f65fb726ce2dd39d3bf7f74e2affcf1afbbae1f5c4b3ff550ffb7b9a0abe677bdc9cf872dfe2d7dd4bfebbd1dbaf511d1b7ffd1cbf2177e971f4a1ebc041affe1e08fbaefade7efcf73bc8ae01bf2e97f2f06c4770bccb7b31d5f75fc03f8ecfe7d983e5ffef7bff5a9e80f4fd97d3b09fd7c92f8380582393a36d74a799348b9322a3b7c93b2f971f232f39e2e7b4ba424be06bc393e07d9b94ae4e3591f651a6f27f52f270733f77d709a4ccbd8ece5927fffb8b4ecd8e72440d3295cb9c6c7822410cc291096f2e922f295ab5ccbec3d8ba8e51d973e4e6b3a4f27801f179599eaf1bc6bc1e34bdb126977445dfbb83d5d087e6afbfed1f6597a9c9e15e1e736d55c1a0ae9f6e8dbd793d319930642313d4e76fbe2a57957b663331735e1eff21eefda420b191cfb483ccaa4426d5c4bed5e2e62cf41c5e4aead6e92503e2e55f79eed9672f6755c1ea72a13a9bea42ea6645ac6f9eff21fe3fcda96b7a74c0f70ff0e571fcb00e326bf56cbe0dd9cbcfea455e1d947fd3f0630dafd1010e8317f9c2f94a87bc533997ddbd7a75ff688c4160ae618ee3e7ad70bc6e6665f2873c77e0a463479b5fa0836f433fca31d9b83a6a56fed7fd2ced79f540495593d8d75a64c0eca0c81c08cae45c0c1cabcd471d86444851dfb8204abf4f1fb5e4fc0bffb711f5c6e874f3f4fbfc1bfe6bff2d78ecd64f4307b57e671b2b688aedff5bd0acb0fc62f0b3073f0adc3fa84df1d794f30736fe5604fb825016aae6155e6ee956b83957ba99783fd95db5cfb88ba576e75ed03e2da2a1cacdfcfdd1176acf089dfad77fccb2652f5b82ab5c0deeeed5871e177cfafaeadce3e4a6caead7c34474f012c7e948f9fcbffad3253572cdec8ed9b5fa54e7e7d29fdfafc737ea80e73202cad831e9e2effcb68bb3dd7fd13fc8ecd02f4219f48fa9376befeb2e3e3fad7835f77522ea3eae4db8f200ce6188acaf45a47b03246281ce0d056b28fdff7013fedd8e4832010dfe138e6fdd8b38994df66e543d97e8cd5a50c5075f56c0efeaaf3ca07ff1fe1179d3905d9330f3d6e5a38d6310dd0db539089d022d2b03ab48f13edbe70fb9c8b0fe662977d847fcd7f335719d3b8d6a10f8594fccfad1d8fa01787e7356df77e2dfa5c33fe5fb2663c6e38591722cafe53ebc563dd4e83959bdc8c9d1eb6db6b7dff06fc737d5ecf3ef9e703fe793ff63b36530df6bdedfa576b2d764983fad2fa55d04b4f81b09eecd34710c5d4a90edb8b4d81f8d50175acf27143acff94e5ffa22c3fdb7f5ff75172f5d19a78ac5dfb8375b55611ffb1aed5c5d3fc9942fab0f91e815211df7a8cfddfb1ef2e9383bd5f17edebbb75fe112c697b8c63809a1ff3552ecdcaeeefd81f4ffca53ffa7e36b9a3243cda600e8f79fa41173dead83d82b111f0a3fc277cfdc0a481c8f59ea5a6cf7a83b9072833fe1cff348eb9279883f3bdfdfdfdaf7eba1df734d63e7abcbb960abfd37fbb8f6c809ff1c5c7b877e937f8d7fcf8eb9c48cfba1b7d04c45ab6ff9c2db1b401767e0aea2509cff53cf6ce92c8a52e7a4b1efb6de7fab0179152ce38ddaf164212decafecbedcbe77d6cea0a48eb671cfc1cb8ea3779efffc7deb5b527aa2cd1bf8418cfd93e06471012dd9f38727bb3211b888df13bdefdf5e7aba65184e666d82633d30f3ec46104fa525db56ad52a78ae485e2213efcacf9209b6bbc66961e93dd66fbc1d1836c8d4b70bb3970869fd4795b7de202cdecbb9f5aec827db045132e344ce2f39b33698eb9c546d9235629b8ee0586a661ce8f969c21e7f0e5dcbc0ee6ac9bca6201e123c4bdb25b61904c9dc9314382b1dc7fe3b60083097077f369fee54995c172d2cedec0da4830d227e046780b9d741fc8fcc21ea1a820a3172813daff6c33552050cffaeae26821be19dc3b0ab37ebfb663dd71cd3ae7172c5fe891d8360b2cfd515c4b75a8f7d0dc30e2de59daaa4fc6a858e67f4bc51156fed454632de3ba870b6c449c75d4d205eda3b346672471af622bcf7acb1ef2af2eabb8f2352f076614d99cf78efda8cc5097b1d646a988e4db23e017fd82cac89f01a4a63db82f34c05b1c2edc2a2428233527dbf7515635960fbabcffe947d06d14fc7f4b0cbfc2d188bce1a4578eb99025c7f64bc23d8cbad6d056b64195b988ff1cfe743c1754bc77402cf3c0ac97583b0c2f68f8487e228646ea85d47a29d883d6e5405efd4211e82bf070284afa134070c0045c6d3adbd9037202a0902930bd15892ff17ef89f1c2ecfd4f55001f021bc45ef7102f38891d65adcdc173f8137ebbf8dfebad81067b88ce037b7d94af7150daa0fbfce083f8339ca16e14e32548ec017ecbb81f03b321df6bf6aba1ef4128f6753ed97ba5fecd7dfe4a6a3fb4370ea3f899d97bfe8989b164ed56c1987c71fc905a3f513f5c44c6bb77cd53303e1a60f80787606905bef1cd47a36354f6acac67914f6e24b3cfb59b8fb6f614635beff79f7d35d441b09fccab7aeabf23b103b607a3957e7eedd2b5668df724177383ff323e593b573dd7a98f96395f1b8ecf75af97cc55d5debe9ced97332b7df627be71acdaf2e4eb66e7e08d963e3453001ba02aa02e92d886a7b0ec39f27b81ed97567dcfda5383b062dcdfa7f7c46a97dc417c26e01da8832ccc697b9870573a2151db38a6f0bfc7e301977b870f19ff59e3f1cfc458048fd92eacc919307c38c3d5917742626f55e04301f6bc84bc67b18dd22ca3e36ea796212cc0fec6f615b77d1eb95d1d84d95737315f993f5b8e0b4a68e4056fd6d8b787a0fea461377abe8d274becd33f33be769baedd4913db110bde47a80b62d34f7e12c33ae6d4f7e03c86862d27e6da06bc275a985e07d4c9009b70699e338eed9f88da96abf4d768758923960b6b12d8d1113b39fceb7edfc21d697bf84d34dbb68727d53c77e7977bab2ff5cf5cc67c67afcbfb7c8019378a2be6629fa8b991bd3cf2c0d6fb5e57dba3c8e56742c599300859d7dfecb7d055fae71a399d1f6e64041eec8b8bb07ffd5c0e8d05c3522c2c328e9e699cbd219133658d2dbd069f1c93d84cc15d1938b7ff2ae7bf297e5b6bbe43a2b046794776b4c67697897dd0b1924ea82b61c88514c4dfa90f55b92b7cbe523ff5257f5dc999947c1245b8fbee99ddf3d5f61b6bd8b58c35b5c11d141db1db8d9b41a8a30976570ee03d2bc7ec613792050738034485af777e0def7d47da88a4c93b46491c7ae73d23da00e651e3ba1cfa0bc5785295f8beaf0309bf82dd1c4c2227b2d9fe4bde46643fa1439aed14e555eff3c7f2f6abc6b3acaeeb8689fb5f3f2169d2312b9837f9eef3eda5f8396b616cc72667218d1f8173255c1aaa0c9efc042b212a8e246eeaefd068e92fccde3b1a194bb816302468d8e28980417b1845c609544cdbf4611e7e760a5431d2d449839fa2b5953f2b1973c9b82ebf9755a1219f80609d97b36d40e2a9355ac5cd035bcc41023e1422c5d8be3c9ef3344deefd80f1ef341cff9f4884fc5a009c03df13613d4b5d37eaef9c537bebfe37f5dd5f98d727dfe739061473ec87b6a5bf2f061d06a7403ba0eea43a6f8c35ec8db4b5633958553a015a2dafcda356cb38ef78ebfb879e22af9839d42b165a910f819cde449882a22c736ec09ecb9d85a56187ac0dfde499f3ecfead5e3f4d398ab5d64b233c3984f5ea2ac6c95370016f2af589f0b206ce9bf67f0377a5056fb3c2fb27bf2b3866a70696ddd48f60c69db79fa813bc0daff767e6d30699f55eff1ed93805155c07f3b6763271d1f52365b8046c1b99dfcbb77ffff3188e0ddd63090673b8eed321e58fd0fa0ac0ce50e809d064ce337b4b8aa9ec918905684ad8e299d89e2d66f172c243f1f5d02c58c1647fdbe671da2c7753d7f781713d8477ac5bc6f7d935c4b82ebfdec3c98f66584eeca7129f1d430e0372990bb317abef732c8d6369bf2096c6e0df13ce3cf557f6f66ab985bc9e63018e73d8bf89c69337c4c37fb2eb8d743aa8c4e1668e05e79f017536b496026240690bb118c4799ef2976f9b4f7eea9e60873749dc48ce4e050ba45926e41d2d8df0b5629bcce26795723a63db5ecaab930217626f4bc7ee8a850fb2e6fd8a79a9c01301fe48291f0beae87ca6fd28b4438af104bc3b14c91be0a7149c3bd5ebb3966f92c506987e02c133cbd61dc74c7f5bccb4f89ed30ff6f785cf928953e44dc17557be5ee19893b80a70d6a7dfc147c56f0a3e7bd0c0f85490c3f80ebe661b5c99167d8c9630cad67cbbc7638c657eb6d4bf77fd73becc6fc19749730938ae7b3fae2b8cdf974d70dd3902aeb029efde66d771bfe64064c012b6ee08381b41e04573df8e0c98a72d70882106b5010b568e3d8ec1730cfe37c4e0ffbb30f5fe5d355b37d8fbb0e0fcfeea98102fdb8f0753354dacdfae5e33e978e60cf90b14b9f9732bef6315e5165ecaff5fc518ada493634ed6ae48f621eb9e6bba3f81777362e3f56cdb0bb8035a195b3b324e713d938e6d116f61fc5ce518d850bf39d2f7ea48a3186f3cb6e09b40bc0a76bac0ee368ab71bd6e01cead4e04cfc8f8a7d9af71d6ef7d30ddf00d6c21a45938d07e74eab356578678bc78e03189015086e34847c7f84bada96da44df3681a791aebf3cb4bab60bc613c3da60da86e4b32275d1b736a2848b51792655d4a2cd4c7bfb33eaef9c596712e7264bf703e530abb5edc7d47cf275430f9c9314b9517fab2a50ef67046e745c431dda359e9d432d5444f9fe577ec84cfab0adc94eeb4e7d5b0c0214795037099ceb776776f0edd9f379fc23c6cbdd1b6e2b16164a1f3aa406aa4230a07fdb76bd14fffda01a3352477cd8a8236fed29be4feafb46743d01a6a9c8e7b87eccd9bb617bfec5d7c49d470b6a67e75d3df08af38419fbc4de33cc98a766dd5cbcb64bdf9d754e48eac8c3b6b921767f61ea8107dc37c5b9e800a5f605e8afed81eb17ebaac9a037055c6d3fb7779567f61a6f34070d30b8ec9a2f1af39150715e3f87e3f3b3d078bd8fae1a2710cbb8e2d3ca1b48ef0b45de39b0ce4713385f30b5292bd07643a1241b8abcca7123790eeffbe5f07e345b133fa3fe2577ce63571ebbfe82b16b6acd6a07ea8f55e53d0d57d133f50792e40da48d63caa03bb147d1dc77228c9132f55117f29a2af8bd01a2670dcd63f8c8223cccf06a2b297f56997cd8666fe5408d17c9897602f0ad3da5bf82fa13c75237ea68b227795385e04e549bc1d8aa8a132ccce3f97520ed608c29a785604dadd7497c523364614ecbf542145928f08f59fb639eca416b7a81fdafb617f5cee3fc7ece73c0bd91d671a61fc5762187452467993e40a2de633e23eb1eb967a9b4bb4d7dca7a76b61137a9511df925f7577216e49ee1c2cf2b7c865afefd0aeaf0626cfde0a3ae7af10fbd01ddcf044b986fd4d10d0e0f7b92c442b6a52d13ce19ea7abba4ae2fc1b30adf277f4633f19592716be65f16adeb2fad53d1311ae9c19df72c19bf222c03f872e0cbeafbb4ee90d61dfba0c9e4426e6220818f09fb0e6a3ad7b608b11fd40191face8bb64bea37e8f55248ea4247e39d3a9c0431cf45c357dd9d388eb447713d90fd89b581ba3a6e3466d7b571c7986db13ba218c0ed9ef01766a21570d94b1fa08d83ba5aeff56b6a97eebca777425de3f0d87bf6b0d7e9537b52e16335c2c54acfd19963491bdb9ce05b3be59d939c56e9bc4d3fd8dfb7c11149782d05beb9c5ba77d617643ce3cddfd97dd5beae27f1533c8b684d02b6467dc8d6e2a7df96ffc1f1812fc7079af36f2e7970ce09f92c27e4133a147cad375febbd266b7d3a934224ca2727aeab84dccb7f1cf389aff3e6ebfcf4f7acc13ac7fa1e9da4ad6df67a29fb72c1586c4b87daaa83371afb0b4506ae7ae898c0cb8931153732de615fa3d192cf55e3b99a37e4094ef6c8348445cc7bedc2b857683871fc38c18fa71fcceb6ffcea48c7483104675689610e1df3886f304cda4788e430415bbbabefddd5b2eddad6836d6901ac8144972b9b97afe22f38e5f972c0703fd8fbb8bc5e90d75dfc7675179f8a9b0b7f97b157e9f78cb5c31c7f54705dfa99bf9b3688d0c417d21563e3503d8f4158b10738d79c73cdff64aef98f6913ece86fd49dfa4ed4efa068ca630b1e5bf0d882c716ff5e6c31fdc8d8addb3e2cafddedda19097b2f324e0bd3e9bd5accbad3daba8eb4b76370831966727074eff1b884c7253c2ee17149a3b824978f28b22d7e1dbfad79ce8f6befb5a6bd278c7f0c1b8f3f9d5f9e07fc7c1e90f3841fc713e6f5c8bc1e99d723b75c8ffc2a5e721c77f51f9d470670bb30d4f3137e1be5ec417f42ca75837ce319f87d70e6b8d0a392556bca39d88fe760cf0e8dc6c815a1be755e3e4e0dfb07fe147b9b37c0b14d7909f9a25c5f4b5953a6a7e7f3f83ceea9f0ff4ce851aa11fbec8d402bac9dde81977c152b3685678ffa2764cac4d6ab11a97bd87a0a66ea97e7ec63fa6ff931bc3ae80de1407eb93b86bece1be085010f26ee09da9e4d6d0f7b6bd8f3acb6566d4d4c4f005b759c25ebb0e88c64dae492efb36b217f1dcf057dc75c10e7983d9063c63553bfad662aedb9b54f7cb07b7c44dd0a0ea8ab09aa6288a44773a27b318c7b62d816a9d523fdbb92da0fe24b2ab447388dd99af88cf4b9cb7d46d178f72c6ded8d968cdfc8bc138de7482f3e7cc5f7cd4e6e7dff9bf8fa666176d65e39c6de543b664afafda63040f774805a95b57bc9f9c982aa0cfdd8df79f27f422da5083a33c735688483cf067d706df118204b821e6cfed43c6e92fef2b4f612b05b0c5a38c00d75a0ff1be470452c16f243ab6d5a1a1b867e70983c17ebb7601c4dbc5b989d0e82eb7f0c19e34375e8956b8fe8f18fe797aa7dd6d48fe63de26bf488ff029f99da38567f6c6ba1405d1af48125fdb00476bfece31a455ba865592735ce717f6b29d1af29389f681fed1513df8f3f4bf8ed4df1bfd79a8ba6f914866f54e607e15ccfdc4d56d33a594bacfbe5f6117d2f73d099a3ae1ea041e707ea96fa5077c61b18b024e84fdfe63808f1334b3bcfec8450fb7ed3837de47d103c04fa89035f8b156f647da7a231bac7bf69117f4cada71a7d466af7a9ae8b6b96f699cafa3c55df67ed50fe3a1eaf7dc7788df755e67d95795f65de5799f755e67d95d37d950b718da81fb8917ebe97a397eabd4ceb1d9be8f1c65a28153542bcff5d61ffbb24962e5b93cdce48a66dfe42dd9df99593b65147c60e29c639b1d7ae689c806b9aee197bd1e6598d77f7be63fe39da1ddf41d8f09932394cf35070dd1523fb6ebcbc4ff5e9886dd405ab499fe57b141d499d6fac370cdaaaa081860575e405ee29d597bbcd78e2f19c15dae3de584ee3b108792cf7bbc772bcdee1cbeb1dd2315ce4e11afed018b4ac28f61cfb43d017b80bf1e3154b8e73088deb1692df2bcfe57c92ff939cef6d62f2373cebccd878a2717266d27b62df68de8b6d2f9ae5418a31dd7c8c52e443be14af25c9bbe31c6c14079171a37a81241715eba9c5675e3c8e9a3e1a133c15f2840b4b4d70d5f6ecedef838f0ec8fe91af39d9c27bdfcc73d5f70c9b5f2726e6da555fac5da536d2f33194fe3ee9f71edbc8de19fef69449ccbf507afb44dbd33d41cf04e80f1f733dc10e012ec5eb3f79fde7ef59ff99acfb3ef16f5e4dca77680d533af836e9d593f47c4afc282300add5e4fcbbe8a62a808b1824c70ab16023be4c8287f9157977aecbccd465be7074d8cfc7f2052a72ef4cfbcdaa457ce43d29afa4d13d6bf83c4df8bc4d71a7aa77a29c9fe1f5fee39fcf87c2eb1976a4c5fad258cbba704d16f472fb16daafc3c67c9b547c78b177543b790d5a12dfd9a7e7f815c7af387ef54df1ab6a7fadb55e9c14a3bfed7d4679afedfb5f130cdafb0b4befb1f2216f5d6153a367676c6be52fe330ff1b1a21f4fc90347d407a53243de83e62def964ef28f3ef8aadd59933de43f4fbf5107db7cde326ee8bd7ea3c586e241f6eb0f4984f1ca8ca24400a70f3ff4af8f92f503b31a77b42559c0e8a26646fbcc61cfb97b9d0ff7b1e6b316314c950ffdd6ebd64fbe39ac4356d8e2959dbd456dff4b181b1744503b8c9827ba2fd4cac71c2a5bae0f549ff2957dc1672a1aacfcd3bb9f0d9f8b9229791ebcf586e6f136e3af39d786fd5afeead1a634c2dfa820fc7b7db8a7d78af54de2b95f74afd76bd52792f14de0be5cfe885c2f9dc5fcee74ead59e0fc08b6e857d69f1bb4c7580aafd9a943a8693e621b784bf06e33295a98c6c619487bc8a9c567bc9cf07668bc206d3d6b72d3abf03594e2d882fab1492d34029f9afc3f0f74adb01b1e7c57fccb5f9836e4f47a24be86df3605df89e435a909547aa4676eaad7edb7e349fd1b3a49744efcd97c0a5a35271a8b9d174aff04be0212193d7b5b8e5959ef94c9db70edd7efaffdba29fcb71486c61e933b7c6b566c5e71063ff0de2c2e42f93debe82e81269aa5635439c777cc5fd53b510d87d9e5fecfc7c2f5d8a0af27b53f800bd1fcf6d3bd6b6cef89bdb3253279201563afed9178c4369b835af54ea04f007dcf4fb7b8e1b55729c10e43e9ec58d3ac4e1ff467becdb7fa1f05f766dac9e4133a2b6dff16e574cfbfe6bdb3d874ac4172ed4757f28e83b0e1bb67382fe6a1e0ba541d51c13dbc56fca5db3d145e718a021ecbbb7a4fceb8e32a8089184b8e515d31aa4158b166b856c0afa81590f8ff5caffcd37ae5853e2ceff3d37e9f9f43a33e3fcb4b7c4c78f6c979cef5afb9fef52fa87f9d5ab3da92f6f9acc48a929e7db7581116aeb101c57788963fed330a35daa0710d3ea8b504cdf91ed440c43cec7eec9f521fc93db5ae13c0f938edf271cac69a6b32146932acae7cb2429b720fc6c1b2c94c9b5b30fef2a6e0bad4337f3b2e75231f89f630263ae82f157b80e74b79be94e74b79be94e74bff907c6995fe55d2bb9262bf99f7d40ea83ba9e6c42fe5a533b8f67ba1fc54ac2aa05147f0e520f17fb5ee05674eeb1aad692fb800118d681df2a3b79a0903ee3773bf99fbcdb5fde6eadce03f90cb2f1c9746f9f3e78cbdc97e1867f127722785bf95f48a92fbb037bf9b1658a3b330e6291a0747c96881b5750e3e1e7f8b75488ae6652430beaf352f3cc6e1310e8f71788cf33531ce8fe7467a3ad3ab8f07b9c42b1f81d759f33aeb5fadce3aed6346341732abd4b9993966670ffd421dcb815e9189b60dad254be55d38f795735f39f7b52ef795fd5bf933ab68fc518d67fef5632a65b2714cf9c4632a1e53f1988ac7543ca6e231158fa9784cf56d62aae94726b6626b57257fbfaea45d4edba09e66fb0cfa3d815ec7556f94f4840aa05f1432e595336b3de77676cc49c78db0f03663c55fdbdc9a64cc61a29535bff8d1f236533751119bf038b0d538b0ce9ca5f2a6492c51963bfdb3f47da6659a6f75f8628db8c692aa68811b4a0727d1cdff848fb2303bd0e775ef2abd552bfe086bad8687e2eb2367ef469d80f0a0071a68879568dc67cf40f67ce4afe37524bc8ee44fae23e17ee1afe317eaeb58135beb5cd67f73df90f6d966f2b67c7bb5a475b5f3f6317ad178f72c6ded8d960c4c52fa6fce1630d764fccc06e58b80deaa5536ceb933176a3c64e022263dd973cf31088bf505d4082fd93606efa88667e0293ef3cc2dc472e83ca8433c7c1d487b97d66cbb62b07757a0a3d75fe6fdf5ba6bbfa6df921d7b067f87e8fd4f3f4affdfffd9fbba26459167efafd2413c979e11b19d5d3be25c0c8ea04eb73ba2a2f28f7f6cf02630142f0b686b3fb1dffd441685020262afb33bbbcb45cf0855144555565666d62f33afc9d71a83ecb2f1f963b998345f1a41fe84be93e450d8b8e2379911e9678b9d40ac91cdead1d860ff9e9f0de807c8481a6f9a493e7fd581b865cb732ef7c149a68418c09ebc9a7a3886f6e74f58f68458f2637ed21bf302921caea38cb0af10e02277ca91dd6dd693a3d2d552f166b4bdb63ad810ff4566208e4c1ffcd6e3b2cfc3d797016b69ab6920ad85b7125e7a7d9f797facd592780be05f2599daea80f5fa97c5a78bf9ddfe519a28c77efd319a48f137822f3bc5f293e6f11a3bc7b78d73f627d859125320daaca781546657bd2ef7d63be7c9ef1d23ba4acffe4e6727f9bde5fd7a43deb6f9a7cb4ff4c53e59fcde115d70bf40b6aa67d76cce509a3394e60ca53943f9fe67288defcddfc6f74621318c89fd32f7bd13575acfaefaad2f1d6e271fafea6be198377d8931f17e2239e8a876678d0ed7e870f7d7e11a79fdaf97d747135371205fc139aeddbdf6d13f9d7fd3f1da59e4786571bb6cbfe87e865fdc20b73476fec6cedfd8f91b3bff8f65e79f861067e97eb966496ed9d8e7fa0dcb41248fd929b6d1a9eea391d8834839ecab6f32ecaf381612d6ddbe295dd6c7d7a329eccff4066223f18891e6ec1e9fbd77c1169b7c57833569b0267f7facc98639e018eef27af6f7c29834799dffe9799dbfdcd897fc7a544aea9df5e6923d32afb797f5a79266df1547ab8c4f3539899b9cc4ffa69cc48d0efbd7eab02fb7f32122a3411c5ea0179911ede7016b427e392d772ea5581a4d64383267f7b3f3fc8bce06e95bec6b0b1cff55c279709bf8c84d7ce4bf617ce42fb9b5603d33dcab3cef27faca5e6790bd9df7bbd26ae2294c3fb890b35c76af766b9c4d8dc4377990c9b582b1519b392b2eedd7584f724406b056f2fa9c13563d3e1a0ba617ea73d6d42047160ff98b97388796c68896ccf7f77237d1abc4dd6605fbe96c37e64e71e5401f71e495d6c1671b2416b3c6a037c8332b8a931788c101f9c215bebf57f989b96142c8cf658f47ec515e4bb18d6425c019e39bca9cf2881ca5958f365da1408fabb05bb81ada38e65e61a22b79de9bb3abbb9e5dcdaac6bad17dcb745f72be56c2a78bfb506ef3a9904fcf7f96b69e8457fa559b5ecaf6a34a7a2a8a45c88525f552f1fb4ac71cea884765f0c3c54eb81d0bc573b6c443cede848f85f7d3c5fe7c79679dec6b65b45a4837f97928a877495763fa46796749f24b831fdc9b36c8e96031fe1befa7b087810e40f2529b85fb5623235d93911aac6083156cb0820d56f02fc30aa668d69218b146cced830f18bef3f9adf676de97a3b7678bfdaa3887de98e798cd0a8558379ab3be64b1297d0ef48ca521ad7a26e89edf479799a24d573cca6ba157d44e73e6fae39fb9568ef5f7c92d99a2d1fbf1f3fbf1911b73e63a8896569d6bfad60dfc29597b61097f2adb1fcaee67e7b8f6bed1f85334fe148d3f45e34ff183f953a46cc37bbd7b925326c288ce7d7bfdbc804b478c94ae84f3029eda7faf7dbabebf456a1facc4ba35b94cee9bcb24f7dc157bfb59169e08f71fc7130d60db7b130bb88905dcc4022e8a054cdfb43f137e0e3aefc0bab2061afc52835ffa57e39766b79c5d343e388d0fce3fd407e700b909914afc4fdee97b33dfac7ac1d9ef85b5f439c1c6403e0c07e2ae929842739c3b11302bbe6a9d7c388d19d177c02767037899f504c5184082478bcf07bf6d568fae36602d8ca55d71706eeb6bbc41723302ce7982e30e113ed995797197ea17b60daba389af391c0d7819a83fe6fb91bc16e81bf59804536455ca893f84df78830729c383247af64df88c3af651f0095f0b48b92a2fbf43162edcd3327f96b2e21ee7a7f77f3abc7cfef4a5a46e96766abde3062c49a15fdb0f215bdf64fb4ae3c5627c1de4817d35641eb9328ff10ca6e688c7cd4a43635ef255774adf71afba9f1d32b596204edb665d18abe0761aae69dfccfaba0b9ce20a109ff176da2cba9fa791827adf1dc7d2c827d5f249839bfe3be1a6df149efb26c77d8d3086f87df2e1189e059c3396d306fdaec66f8c2573c0d8649539982a6340bc4f786677db99fd34dcaca641e5797da18e719289dec67ca7837107201b32c85530ae7ad21b7371dbcf168bb16b1a2f3e92f56e8e4792a98c448471c3789fe8990a0fd80469aff087bdc69036f8a1a138fd58d6fcdc37c0c7653c021fb0498ff89f7754463c9e621b11bbf286379132628d8b3de48fc600424644c63d5a9e78586729af3ae02b6b2aeb4f95380199f0ef42d9a7709ce3f892e7b986f36d96cef2d0e2757b9da7d5c3cc5ef29c1279f71aafba82b1580d3a8b78bd7416f24adb6dd642477596771ecf9826815e625ae5682dd6619cf1286ba32b913faedb115232c2bfc8aefef8bcd6badab1e328dd49f4bc168f0a9c6f317d5b5a95b55197f77fc75ceb60775a75c087aebc8f55f35a4477e73f4b5af53a4a51ac898bbff15eeb0ace259d5c93f52bd668f2e70a481fbdf3fb4afb5348bfc99fa5ada7484538e77af5f8dfaabb959f57009fc67bc19a4978ca9ff8bdbc682ae277f9de2f378e437e7d2b25f5885c4de4922a3a76c537693dd99660e08ac6e04b7dfacdf2b3c26fce5cff59ba2aece753c86342f2c5987bed18efbb98b6ffce718e3bf11eb83ce1f49625f457303785ba4bbe5e735ef60f392f4b8dff14c7a08f6dca13341e815d1a39f20ae47488053585b31b9c0782c8aa21ccd786397424720e11db927b10f7c997981ef079c8c3f44d754432bfd3bde248bed4158f05b6e4668e73733cb08aea27f7e3ff895c6065fb97fefee47bb9bc0dd74ae9d1f9f1aa817fa861473eedbf45e35c4337b9dc032d8d47b472e736255ea4012f5ff80db5f6f382f5e84e69acdf94c9a7c5f918aef635f6b9bddee649deb15eebf1037b68807e0bf4f03c60a3cd6a0a3846f4bc9ec0f917f61520f82d4372fa47a24b9d6c7025ba14525c312ab44b24182957402ae8885c1fa9ee645f9a93c2157c6525eeb575998c32019ddf5318610bf68435c3850a0f7eeca5f54f36c97537b175d4a84b6c8d25f5e00ccf972017e0aabf5b33e25185b12a9a03a0ab3866edf61cdfbfe4db8127f2fde39a39ed8f65fd8cf10fa87fea6fe9bbf159596f9b96254bdaec280ed7d17873af3ae863453dd00b2d7925d8253180a17fa1c240de0c61ab74c5b233aae4cc692bb993bdee94fad95af13cc7e79f6575b0cd88ebef94aeb025f884b2bec5e795f5ea9ef0796ba6b7d7b83e39ef187fb916072bc3f7f3eb3b6773b9b4e5c5ebfba24faee06bfc015d8e01d8e705535ebd5a15fc294f73098fa9c29e16d99d59c562e782fd624cdf8cd7e931c31bbe14eab1a89447de69ff296dff467e29eeb4155dae6b39fda0f039d0d5ba82a902ef72d4aaf595f2d7ba99868ac7d686389daf97fbe4f5b3e21af69549b91e5c43e7cef433677fbddffe1bdb9f6bb4b9d3561d4b5a8fafb749f8f05dfbe96847a52bbedeb54d577c853d08f8ec663dbb4556caf38284af47713bc04f0117d2a1c11e5e5fb6403b222f6463b3c0b9c02a96279415473f0f62fef1f2f6f2f6cb1c63644e39a6d23c244ff7976370edfcbd10fb9e60e32fee933c0ad6b571aeb2db16d92166f318dff36cb1715e6337f11be821382b3feb57a25dca43edf8cc5d807dee724d5a9bd5215c309b02de55441335751e3ae62dd7e8f00a3d97f0f5ba3c88d4833399527de0e29d96c2f44369c595c914657bdb5ae621e6b598c8bd78dec6bc69aa0cc15badc4dd7894d0038e477e1cf31017369de7ad700d7810af41e6fb21e0255ebecd0c99ef7754a005d736245e74366b31d42e30cb057b41c93cd4b619824cb03a14c4f4646ff2c5ada2f531dfdf813f0df18ddd9ddf95e9b7354b62720e4bf631870b9561df2992938ae8aee6b9fe10f7f1da7e7565dfabc460d5f5154862cc14ef0b17efacd4f7cae99af88b9ff9cc9847c9395982a5ee6ed62f86c4883b693d31b51c2f2aa3894b1e7dc1b3cf3495918327780e4406d13287e5b702d96e0267c95f25ab80b717cd4d4d5c01e1a1b9f6e8f03adfac7b7e55436625361b8de772769cf7bef3267e4ae86a8ab4d174abf0dc9bc6f53da52b1ecbfb5ad41f043672f752a62aa3856bf7ef4b5b4b07cee8b1bd293f0e77d23bbe87ecd9db17fa2c96cb7257e8f893d5c88685b221c1615f7caf7521e3cdbca27758988f0ccbe4fa1aebf6fa1e71f6e3bcb217dda08311acf9f5362b7091d7f190357931c1bb17eec797fc207bbd2d999724f6f545dfaeefdd7f854e8ce383dc552f3c61716aaff9ab3ce452d6bb5fdb4a57dba99d7e7dba74fa38af9e625d6ffb645bbc3ebe679de1fa9c2579c6ae8f8333d92bd8b7a4d0565a7c6ecd9880eb44895f4882b14bfc36641e41acbe1d8e190d67414792df05e7747935e64be10dca5472ce7d99cf82ed6ed6d3dd047cb918c448abc7dd3f9d6f94d9637e94efc9ec25eff99ec616f42e5b10b1818725fbeec57d9223c2aaa0a78b67b4356be3bc135cdfd406b7dbdb137f8b3f663f9a1ea515d82cc0f709628afd593624e24f778dbf34baf6f7d4b5e339e7d2714f7263d4c8468d6c741fd9e8cf911352ed94e93ecdbe59bc6f0eac229a980ce535fe364b5b97d0f17d7844575e09b43cb8335ec615bfc98c586e4bfb0e74573206378c45f23789e4abb1d85334e8f43aca0afcbbc76145fda2bd09fbef60bc0c6001895e01781f0d8ff5cc90578f890f7967c344a8ec3ca2702d257f4ec7d4cbe6b7681c5d31da3825be1f556b258e0542ec3844d72174907c937a643bea80a5956392d7f260621fa7533d2e5419c8edd7ef804c9eca7b99e4dd89366be382ef95f3bfe46ff24de956e287ebd37be13a2e58cf65f5eaadff58461856c641b89b5d2b91a38b69bea68f79394d903d28e3979cd08923af276fdae0d110480e7d92030e3039a692d45bbf00561cc749d8ccd923c47548723491580947f0df853340c0cac6763ac81369827c3711068f3f127fc1fd2fd9e78bf67b8cd793986b6bb2e32b0e8a62cccca7c3ed7c0215d057699f4a64bc3aefa992f90a68ae4c3e1b14ad9b22acdff23dbcbcec5def5df3f93e5a49aeb192312f96f76aaf3bcd035c26e4f98135f097d8ac665ee1786478b49de56f02e0a85dfbdf8b9f723806ce7b25a68c666bd0ea882eb5935c6fb3ec0cb1a8af497edd9bf4a07cdfea623f52712cc067c5f4255ec0b980e19c9cc848d8b6013ea8249f8ea1311cc9d9a7f99a23eede298b9ff3975cccf57df65f797593ae93ff86ecfb5258de7bb75b9133e89df42e99f2ea00b6c8b71aed9ef7c1eb6bd396d753126bff26fabc3a06a7bcb5b1fdf06adb277db890774b7bd5e9c4ed0c26d82ef90cfade6a59fb7c627ed211327e3e86c6a3489ab3890e1c8eb9093f3b7e7a7b797be9c531000424395c4719cddec7bbd1a5bfdb05addd876f9f7d11c5fe896eebd260e13856fb0f5bd29a8598231023ace83c43bb4ad795bec1b5d65e195fba8889718ee58516b19df7622d5de679c88e717adc3c6d24bc5ecc511cf380f0b9249e598ecf95cbff89cc909625306f1ef3891d7c46e4fe947e9b597b1353e23bbe82caf059a931754c5a1be5e34524fe58c97a174df552c72d5c5f62c1dadaac04b01124fb4c6afde4fcc4667e7f60fcefff522dca9703dd8da8a7ff4f7db50dea89a25ad4547674f8f5fbef2dcab02273a77c503da7ad21c7d234a4ab5ea4b73dcf33cda7b66f1bed500ff696aab77d2f8c8c400fdba16aea8efc21fc0d41b396bbf5e07f4d8f640b85f0d38d5f90aad7a242eb4da79e98cee3c716e5789a4e3d3d3234fef96b64e1ea0ccd7cfc9f0efd3f9d8f8bcee353efe3d363ef43a747f77eea311f25aa4559e1af9a15504f5b19857a8b0a8ff85d9ff53df5f4b147338f2d6aec7ad453a7d365fa4caf454d91e5dad453a745bde0f775bb9d9f7f6e514b4ba39ee816c593ffd7bffeeacb1a8d7f0b1ab446b7a879aab72cb2e3ce3fd27d7ce9a976483dfddca23e4596037d98eb2af5d4f9a9cf743e323f313fb5a86908777e7efc89e9757bfd8fbfb7a89782aa1fe95e5235f94efaf71635a85f75fdebaf3b7717ea1af5f41fba45b7e8fffe0e936aea0174eb338c16f5ff46bfbc0cdb8ea7ed905e389f548b1a3bbe17445fe5c8a49eaa49a2ac054253a93b9f3d957aa2becaaa2d1bfa4352f0e007deded2f4f041761f30917d98c7ad3d44a61c3dd8baee870f56143e6872243f58eec357f264eb21f4e23a8eec1e1f2c378c6457d5c3076f1b37f4a0caee4368ca81fe60451fa816b59003438f80d25b94e079516e30a816f52247aa493dfd87fa40fdb745cd2319e9d45314ec747221e872e8b9d4131542d183a6fbbaabe9ae7a7c7aa81c25aa45f11e67213d84c6936fff6078f09a78b07189eab9917e88a816a506473ff2da81ec6a548b826f57e4506fc31a6b51baab7a9ae51a6db8d765d277be41ff5ad4d68146aef5a9fecc224fb5bd5dae4d470e6c458ef41088400fb285f0b41e045e1066ef87bae1e86e64796d3bdc59f07186f761a7e8c1072f30da6fb20f2bdb6b5bde2eb2e05b1d3982ae865160b906b485b9c37f5bd467ddc763a6ecb69647b528e518613a531d6842f51c3fd0c3b0bd4572a4a76f186f565cc18d64cbd58336b2c2886a5d8cfd7912e4b8ddf842b57c584ea76b2d5da885f2f942573533739529d4985eafd34fdd40c8f2234b3ddfd95a7ed879a4cf374c5bdba6ae1c3955d9f46dfd7c65b9911eb8326a2b1e0c5b69415b51ac8ad2b0b050f5f0528bf0445c16eb6e1478feb1bdef7ca03fd005152ebe2b5f921df0a2d2b6a13a5535902557b5a05886e3691515545357ed8a722d508c8ae2eccc1715877255799e360a6abcca8116de52adbdb57454f5cd59eaba2cce90db45b183aabfc941b65e3565ae15467ad50be20aedad254715b582ca4e84a6ccf43e5657e85617f73a4c55859d1221bda24284c2ca06a0bca207aaac9a15cd6bba1fb6810f7a81a60757eaa9feee4a0dc3d374655741e8b856091b20554c39ac580a9e8b8e05a596e3a382db64332cba4d368b7c51780cb30f395a2f7591a5d91c89661f0cd4c7d445fab1d0943b99ab0c8965292a4f40797a89508a6d4528bc18b04c85438f4ead7eb86afbb675b89417d2976d2db0f67a90bf9bbc28254ba4c50a39743bd58207b4fff13173c772e5e098be63ea87025925735dd4916c01beda22d908abab787e74a5c6ab15e81735be852739205bb0cf7cae8fb9d949c081fefc596297e129bbed56465edbd4033d5b562992e50aaf3f7e1e2e47f6c3eaaabe6dc43473b54e3b8c342fbc87b428479e63a9b99bce0e45961e04b9dbb16499bbd38691d40baa9ebf3caee17b1eaaaaa57ac8ab6c463f585141f99becab1e9e44c228e1bfb61aa858aa3f3d2d2b56e63294ddf4b56285ba1a65ee1c235d4646fe56b22f9c6eaaa6ac9af2cf84d79d6f7b7b3d900dbd1d44aab7cf94f8bbf4e5d642ba2f4726b2223d73df89422fc874c9f0e44035b37792fd257f2bccded30fbe1e584008d9fb5ea69e931b15578fa2405633fdf242bc64d3b77c0fa1cc75e0c15705baea059941c9b715e85ba4ab51fed3839d0b5be299382f4a5423f0767e51095089e97976519951d896a1b64355768b8ac89a2db81f9945f77d3ff0b66d242b3a2a2a06fb4af16d5546a88d2c7777485708e5ad1e585ee696e51a48df22cb303333194681eab9193a23fa5e7e70c3a39b1906b88ef430db1ae9917ed055dddd1715ed5c2bd357680279194ac4a413ffbbcfacc59d0b5f66ea32594af80bbdf636cc29ae5684cbe36691679c7801566afdf0acdb828adb8e1531f2334a4a1399e7f4bb8d3be3c43217fc17f33b5fc68b0ddff86de745bae607961bc90a96465d1d0a5d3d6a9b51e4a77ee2eb64919c6ea63a7a71af2d87aa651596c015535aa27a8ee3b9a5c5e1764fca5c3db2923ec246ec071e56c6a16c17c0c8e265ef857882b14513d333b0a1133fa25a14599ef897a11ffcd38f7678742319e69fd0f0f9575b35e05521b254ac82121e76a6cfb319825022fc775eea84be122b458bdab996ea69a95fed5db4ed7ccc5eff1c5ffeb68beb0179c18fd83ab2d75dcd0bda868764d7c0dbd3a14d24be98793374bd5abe878e9d2eddbb521b370d3a44dd7a896c5851f934d389625ea7ee95fe0239686ed8d6dcd0d1c35036ca3a7ca237f8c7d845619d7a7ee01d8e572a326dd39755bba296a5b9724971784c34b0a252a0fb76a8abbb406f2b9666053b54f679b86a14c86eb8f502a7aa524271d0609d7a6edcdeab2edb60a65ce8615460c4fc157827b1644295426b665ad5b82600df242093ffc916907a0e594adbff2d7bcf91a3e008526d78298955ca660525f0c6b6a7c0fb319bc73c096ec6427062a47cc1667630bed731a6630bb4e52626e74b7b3db623bf785aee76dbf03ec4062dde13f520b4b08dbaf3a1f34881f91f38e3f9c0e75a0f88e53e33cc45473dbfc77a25f5443dcfe9184539229e4ba72c464b630911f079ed1823e7c4a3e6a06fd2fcd11056dc3779c0624f7738e1c4d125190eb211a1f168d2512cd69418ce0764052031015931b0e828f50e1fa3887056084042432475e2f912233520aa2d23afa6d02f7ab39ed0eaf1f10b6e638886090269c3f4438544edd8b88896d69aaf32cb703c9aee53a8cf9d3420d94d4789970d64e317903460fde444516500edc7ee55388134bce8799e412e85da20d5a7f8f937dcd7150d08dbddc09ece8525b714e6ec72b69cbc0873762258ec2f8bcecc104473b2ec088b539f06467c620851dece278786381437e280fdbca00f2f3351e0c683c9e7b1c5ce96369acec489341e46dc2a393d4f3273679fc5ef5b20f1653120f5788c9433aed64b90c3a49e30ec8b33fbc08d39762e2ca793258dbe2eac82e7781259a9b47d76282c25565c1e96e381ea9e4e548977d5189fceb24b413497e361efab38607f11978797f150e0049b1317dccc981c55728acb76545ec0de356b86a3c79c30592cc58538e4a64b4e90d3cf0f2cfba7413c8f7369c5d9809e00ba3945e88fe73bf680e3b9a334670369cd02423841ebc7b480e943ec4dba40371c7869c6d90f4653a4ce1fbfcc3a130ecf1527b078bc868827fde05643b4103992fd80eb7b80c657ba93de79fe313d40f4e6b2b94f8fe35c584adcd2167f99d1628a964c5f1ad8c6827e3484a1f822c0b3c329bba4a7ac305c26e3f626ad87787ec6c3c944e84cb9a58dd8a2b9af3fef38aac14d730ed9cfc809f772beec70cb8ec02e4476b92a6a9f63e7f365875d22db98d3a2341e981801bc6690fd1c7f8f1fcfb1ff79698bac385c1af3656f288886315f6e8c85dd5f8e87e2662e4e97cb234b9e3561ddee55178147251eb3cbb9c9bcc7d406c0c3c4c3974548f88ff8a8f1e251015447cc8bf6da5a08e5b504d9f23d69858ec9dc10f423a7b8534027c59ead095a61fd626ce6ec4e5aab243b06a06cc6c666f5f80578b2b436213bf42e465202b2c1dcabcc32a9eb01626de38880882059b145fb393516e2901b2c86cba2b140fae814b9d397462fc617323fe7776668b298ffa46817cf2927702b6e66cce8fecb62087cf093353b8ec9bb7be262f8fa058f612d3e9964a3fae411faf525c25f12fa89f9c5f4eb6278c0df3bb65fac3ccd9ce80e7b849e9f2fa057716123415c9ed60b419be4df77e62f49db314abcaaedd37865912d64bc52e303eb979f89e28b38f8e44e8ec973fdaee24eb7047d698c8793af0bfac0cd968f25cfb2fc6c795808a779928e4ad73f7fcbadcf8f32de54464d9e9b44e424a87df63785413bf578de8721723bc805f27a6c906fc351a993b94fd0f8cf163b246d00da063cdcf1fa004f550932293a5304d1b7e2f54032def11192d69f62441b59a3526a9d9cd6a7c5f2aad3ff0828cffcb39b19fe8e3705225633e65e1b60b9084174dd534692d45a4fb23b4a6b33912de2a8dac013d6366473823dc621efab292fc463b166f27243721fd935d69db1b4455e1027dcc29e72cbd41c7ce191fd65cec2be3e1496070ed6d18ce616339a1b9ee79fddabe04d01343b44bf884371248899f6933573e29ba5ebb360fda84ccf5447534fe9ce8c399a7e5dd809222ccf773e79648e80e784e97e7d9979fe2fafde792f1ea25f84a1b829da8b9336629a23eb8a83ec10781c7274fbc93b97db3fd54161c5881dbd18b273133ae7dc6c7219a370de0f862160ab2c1a260b6fc1af028db045c1398316ff8c3c5b77eb235eca8ed39203b3cb73a7f273875a87128eee9c2d72278b5191e5276bda0183cecf0d1ea5c1a3347894068fd2e0511a3c4a8347b9c4a324bb750329b90152e2fc2d701fa7aa916ceb9edb2eac6aeabe7ca766e0882fd283b0bab91bd028baa3e85aad9ab188581fdfd2403a1a484703e9f8bb433a1a184703e3f803308ea263f61c32a35ead06bfd1e037ee86df707708e5f01af1ad1f00b0f0fbff010000ffff0300a97105632ad20400`)))
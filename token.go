@@ -0,0 +1,67 @@
+package ooohh
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+)
+
+// tokenHashPrefix prefixes hashed tokens, to tell them apart from tokens that
+// were stored before tokens were hashed.
+const tokenHashPrefix = "sha256$"
+
+// HashToken returns a salted hash of the token, to store in place of the token.
+func HashToken(token string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	return tokenHashPrefix + base64.RawStdEncoding.EncodeToString(salt) + "$" + hashToken(salt, token), nil
+}
+
+// TokenHashed reports whether the stored token is a hash made by HashToken.
+func TokenHashed(stored string) bool {
+	_, _, ok := splitTokenHash(stored)
+	return ok
+}
+
+// TokenMatches reports whether the token is the one the stored token was made
+// from. Stored tokens that aren't hashed match the token itself.
+func TokenMatches(stored, token string) bool {
+	salt, hash, ok := splitTokenHash(stored)
+	if !ok {
+		return subtle.ConstantTimeCompare([]byte(stored), []byte(token)) == 1
+	}
+
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(hashToken(salt, token))) == 1
+}
+
+// splitTokenHash returns the salt and hash of a hashed token.
+func splitTokenHash(stored string) ([]byte, string, bool) {
+	if !strings.HasPrefix(stored, tokenHashPrefix) {
+		return nil, "", false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(stored, tokenHashPrefix), "$")
+	if len(parts) != 2 {
+		return nil, "", false
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, "", false
+	}
+
+	return salt, parts[1], true
+}
+
+// hashToken returns the encoded hash of the salted token.
+func hashToken(salt []byte, token string) string {
+	h := sha256.New()
+	h.Write(salt)          //nolint:errcheck
+	h.Write([]byte(token)) //nolint:errcheck
+	return base64.RawStdEncoding.EncodeToString(h.Sum(nil))
+}